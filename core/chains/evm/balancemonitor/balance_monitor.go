@@ -15,6 +15,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -30,6 +31,7 @@ type (
 	BalanceMonitor interface {
 		httypes.HeadTrackable
 		GetEthBalance(gethCommon.Address) *assets.Eth
+		GetTokenBalances(gethCommon.Address) []TokenBalance
 		services.Service
 	}
 
@@ -42,22 +44,29 @@ type (
 		ethBalances    map[gethCommon.Address]*assets.Eth
 		ethBalancesMtx *sync.RWMutex
 		sleeperTask    utils.SleeperTask
+
+		tokens           []*monitoredToken
+		introspectOnce   sync.Once
+		tokenBalances    map[gethCommon.Address]map[gethCommon.Address]*big.Int
+		tokenBalancesMtx sync.RWMutex
 	}
 
 	NullBalanceMonitor struct{}
 )
 
-// NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, logger logger.Logger) BalanceMonitor {
+// NewBalanceMonitor returns a new balanceMonitor. In addition to ETH, it
+// tracks the balance of each of the given ERC-20 tokens for every key.
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, tokens []evmconfig.BalanceMonitorToken, logger logger.Logger) BalanceMonitor {
 	bm := &balanceMonitor{
-		utils.StartStopOnce{},
-		logger,
-		ethClient,
-		ethClient.ChainID().String(),
-		ethKeyStore,
-		make(map[gethCommon.Address]*assets.Eth),
-		new(sync.RWMutex),
-		nil,
+		StartStopOnce:  utils.StartStopOnce{},
+		logger:         logger,
+		ethClient:      ethClient,
+		chainID:        ethClient.ChainID().String(),
+		ethKeyStore:    ethKeyStore,
+		ethBalances:    make(map[gethCommon.Address]*assets.Eth),
+		ethBalancesMtx: new(sync.RWMutex),
+		tokens:         newMonitoredTokens(tokens),
+		tokenBalances:  make(map[gethCommon.Address]map[gethCommon.Address]*big.Int),
 	}
 	bm.sleeperTask = utils.NewSleeperTask(&worker{bm: bm})
 	return bm
@@ -82,8 +91,10 @@ func (bm *balanceMonitor) Ready() error {
 	return nil
 }
 
+// Healthy returns an error if any key holds less than the configured minimum
+// balance of a monitored token
 func (bm *balanceMonitor) Healthy() error {
-	return nil
+	return bm.checkTokenMinimums()
 }
 
 // OnNewLongestChain checks the balance for each key
@@ -173,6 +184,11 @@ func (w *worker) Work() {
 			w.checkAccountBalance(k)
 		}(key)
 	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		w.checkTokenBalances(keys)
+	}()
 	wg.Wait()
 }
 
@@ -203,6 +219,9 @@ func (w *worker) checkAccountBalance(k ethkey.KeyV2) {
 func (*NullBalanceMonitor) GetEthBalance(gethCommon.Address) *assets.Eth {
 	return nil
 }
+func (*NullBalanceMonitor) GetTokenBalances(gethCommon.Address) []TokenBalance {
+	return nil
+}
 func (*NullBalanceMonitor) Start() error                                               { return nil }
 func (*NullBalanceMonitor) Close() error                                               { return nil }
 func (*NullBalanceMonitor) Ready() error                                               { return nil }
@@ -2,12 +2,16 @@ package balancemonitor_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"testing"
 	"time"
 
 	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -17,9 +21,12 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/balancemonitor"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 var nilBigInt *big.Int
@@ -44,7 +51,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 		_, k1Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := balancemonitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := balancemonitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.TestLogger(t))
 		defer bm.Close()
 
 		k0bal := big.NewInt(42)
@@ -74,7 +81,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := balancemonitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := balancemonitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.TestLogger(t))
 		defer bm.Close()
 		k0bal := big.NewInt(42)
 
@@ -96,7 +103,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := balancemonitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := balancemonitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.TestLogger(t))
 		defer bm.Close()
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
@@ -124,7 +131,7 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 		_, k1Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := balancemonitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := balancemonitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.TestLogger(t))
 		k0bal := big.NewInt(42)
 		// Deliberately larger than a 64 bit unsigned integer to test overflow
 		k1bal := big.NewInt(0)
@@ -175,6 +182,92 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 	})
 }
 
+func TestBalanceMonitor_TokenBalances(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	ethClient := newEthClientMock(t)
+	defer ethClient.AssertExpectations(t)
+
+	_, kAddr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	daiAddr := cltest.NewAddress()
+	// Does not implement the optional ERC-20 metadata methods
+	anonAddr := cltest.NewAddress()
+	tokens := []evmconfig.BalanceMonitorToken{
+		{Address: daiAddr, MinBalance: big.NewInt(100)},
+		{Address: anonAddr, MinBalance: big.NewInt(10)},
+	}
+
+	bm := balancemonitor.NewBalanceMonitor(ethClient, ethKeyStore, tokens, logger.TestLogger(t))
+	defer bm.Close()
+
+	encodedSymbol, err := utils.GenericEncode([]string{"string"}, "DAI")
+	require.NoError(t, err)
+	encodedDecimals, err := utils.GenericEncode([]string{"uint8"}, uint8(18))
+	require.NoError(t, err)
+
+	ethClient.On("BalanceAt", mock.Anything, kAddr, nilBigInt).Return(big.NewInt(1), nil)
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 4
+	})).Once().Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		assert.Equal(t, daiAddr, elems[0].Args[0].(evmclient.CallArgs).To)
+		*elems[0].Result.(*hexutil.Bytes) = encodedSymbol
+		*elems[1].Result.(*hexutil.Bytes) = encodedDecimals
+		elems[2].Error = errors.New("execution reverted")
+		elems[3].Error = errors.New("execution reverted")
+	})
+	mockBalances := func(daiBal, anonBal int64) {
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 2
+		})).Once().Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			for _, elem := range elems {
+				assert.Equal(t, "eth_call", elem.Method)
+				assert.Equal(t, kAddr.Bytes(), []byte(elem.Args[0].(evmclient.CallArgs).Data[16:36]))
+			}
+			*elems[0].Result.(*hexutil.Bytes) = common.LeftPadBytes(big.NewInt(daiBal).Bytes(), 32)
+			*elems[1].Result.(*hexutil.Bytes) = common.LeftPadBytes(big.NewInt(anonBal).Bytes(), 32)
+		})
+	}
+	mockBalances(50, 20)
+
+	require.NoError(t, bm.Start())
+
+	balances := bm.GetTokenBalances(kAddr)
+	require.Len(t, balances, 2)
+
+	assert.Equal(t, daiAddr, balances[0].Token)
+	assert.Equal(t, "DAI", balances[0].Symbol)
+	require.NotNil(t, balances[0].Decimals)
+	assert.Equal(t, uint8(18), *balances[0].Decimals)
+	assert.Equal(t, big.NewInt(50), balances[0].Balance)
+	assert.True(t, balances[0].BelowMinimum())
+
+	assert.Equal(t, anonAddr, balances[1].Token)
+	assert.Equal(t, "", balances[1].Symbol)
+	assert.Nil(t, balances[1].Decimals)
+	assert.Equal(t, big.NewInt(20), balances[1].Balance)
+	assert.False(t, balances[1].BelowMinimum())
+
+	err = bm.Healthy()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("key %s holds 50 DAI, below the minimum of 100", kAddr.Hex()))
+	assert.NotContains(t, err.Error(), anonAddr.Hex())
+
+	// Topping up the key makes the monitor healthy again, and does not
+	// introspect the tokens a second time
+	mockBalances(150, 20)
+	bm.OnNewLongestChain(context.TODO(), cltest.Head(1))
+
+	gomega.NewWithT(t).Eventually(func() error {
+		return bm.Healthy()
+	}).Should(gomega.BeNil())
+	assert.Equal(t, big.NewInt(150), bm.GetTokenBalances(kAddr)[0].Balance)
+}
+
 func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := cltest.NewTestGeneralConfig(t)
@@ -184,7 +277,7 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 
 	ethClient := newEthClientMock(t)
 
-	bm := balancemonitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+	bm := balancemonitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.TestLogger(t))
 	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
 		Once().
 		Return(big.NewInt(1), nil)
@@ -0,0 +1,292 @@
+package balancemonitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	balanceOfSelector = evmtypes.HexToFunctionSelector("0x70a08231") // balanceOf(address)
+	symbolSelector    = evmtypes.HexToFunctionSelector("0x95d89b41") // symbol()
+	decimalsSelector  = evmtypes.HexToFunctionSelector("0x313ce567") // decimals()
+
+	stringArgs = mustNewArguments("string")
+	uint8Args  = mustNewArguments("uint8")
+)
+
+var promTokenBalance = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "token_balance",
+		Help: "Each Ethereum account's balance of each monitored ERC-20 token, scaled by the token's decimals if known",
+	},
+	[]string{"account", "evmChainID", "token", "symbol"},
+)
+
+// TokenBalance is the latest known balance of an ERC-20 token held by a key
+type TokenBalance struct {
+	Token gethCommon.Address
+	// Symbol is empty if the token contract could not be introspected
+	Symbol string
+	// Decimals is nil if the token contract could not be introspected
+	Decimals *uint8
+	// Balance is denominated in the token's smallest unit
+	Balance *big.Int
+	// MinBalance is nil if no minimum is configured
+	MinBalance *big.Int
+}
+
+// BelowMinimum returns true if a minimum is configured and the balance is
+// lower than it
+func (tb TokenBalance) BelowMinimum() bool {
+	return tb.MinBalance != nil && tb.Balance.Cmp(tb.MinBalance) < 0
+}
+
+type monitoredToken struct {
+	evmconfig.BalanceMonitorToken
+	symbol   string
+	decimals *uint8
+}
+
+// label is used to identify the token in metrics and logs; it falls back to
+// the address for tokens that don't implement symbol()
+func (t *monitoredToken) label() string {
+	if t.symbol == "" {
+		return t.Address.Hex()
+	}
+	return t.symbol
+}
+
+func newMonitoredTokens(tokens []evmconfig.BalanceMonitorToken) []*monitoredToken {
+	monitored := make([]*monitoredToken, len(tokens))
+	for i, t := range tokens {
+		monitored[i] = &monitoredToken{BalanceMonitorToken: t}
+	}
+	return monitored
+}
+
+func ethCallElem(to gethCommon.Address, data []byte) rpc.BatchElem {
+	return rpc.BatchElem{
+		Method: "eth_call",
+		Args: []interface{}{
+			evmclient.CallArgs{To: to, Data: data},
+			"latest",
+		},
+		Result: &hexutil.Bytes{},
+	}
+}
+
+// introspectTokens fetches the symbol and decimals of every monitored token.
+// It is only ever called once; tokens that fail introspection (e.g. because
+// they don't implement the optional ERC-20 metadata methods) are tracked by
+// address alone.
+func (bm *balanceMonitor) introspectTokens() {
+	if len(bm.tokens) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ethFetchTimeout)
+	defer cancel()
+
+	reqs := make([]rpc.BatchElem, 0, 2*len(bm.tokens))
+	for _, t := range bm.tokens {
+		reqs = append(reqs, ethCallElem(t.Address, symbolSelector.Bytes()), ethCallElem(t.Address, decimalsSelector.Bytes()))
+	}
+	if err := bm.ethClient.BatchCallContext(ctx, reqs); err != nil {
+		bm.logger.Warnw("BalanceMonitor: failed to fetch token metadata, tokens will be identified by address", "error", err)
+		return
+	}
+	for i, t := range bm.tokens {
+		symbolReq, decimalsReq := reqs[2*i], reqs[2*i+1]
+		if symbolReq.Error == nil {
+			t.symbol = decodeSymbol(*symbolReq.Result.(*hexutil.Bytes))
+		}
+		if decimalsReq.Error == nil {
+			if vals, err := uint8Args.Unpack(*decimalsReq.Result.(*hexutil.Bytes)); err == nil && len(vals) == 1 {
+				if d, ok := vals[0].(uint8); ok {
+					t.decimals = &d
+				}
+			}
+		}
+		if t.symbol == "" || t.decimals == nil {
+			bm.logger.Warnw(fmt.Sprintf("BalanceMonitor: could not introspect token %s, it will be identified by address", t.Address.Hex()),
+				"token", t.Address, "symbolErr", symbolReq.Error, "decimalsErr", decimalsReq.Error)
+		}
+	}
+}
+
+// decodeSymbol handles both the standard string return value and the bytes32
+// returned by some early tokens (e.g. MKR)
+func decodeSymbol(b []byte) string {
+	if len(b) == utils.EVMWordByteLen {
+		return strings.TrimSpace(string(bytes.TrimRight(b, "\x00")))
+	}
+	vals, err := stringArgs.Unpack(b)
+	if err != nil || len(vals) != 1 {
+		return ""
+	}
+	s, _ := vals[0].(string)
+	return strings.TrimSpace(s)
+}
+
+// checkTokenBalances fetches the balance of every monitored token for every
+// key in a single batch call
+func (w *worker) checkTokenBalances(keys []ethkey.KeyV2) {
+	bm := w.bm
+	if len(bm.tokens) == 0 || len(keys) == 0 {
+		return
+	}
+	bm.introspectOnce.Do(bm.introspectTokens)
+
+	ctx, cancel := context.WithTimeout(context.Background(), ethFetchTimeout)
+	defer cancel()
+
+	reqs := make([]rpc.BatchElem, 0, len(keys)*len(bm.tokens))
+	for _, k := range keys {
+		data := utils.ConcatBytes(balanceOfSelector.Bytes(), gethCommon.LeftPadBytes(k.Address.Bytes(), utils.EVMWordByteLen))
+		for _, t := range bm.tokens {
+			reqs = append(reqs, ethCallElem(t.Address, data))
+		}
+	}
+	if err := bm.ethClient.BatchCallContext(ctx, reqs); err != nil {
+		bm.logger.Errorw("BalanceMonitor: error getting token balances", "error", err)
+		return
+	}
+	bm.pruneTokenBalances(keys)
+	for i, k := range keys {
+		for j, t := range bm.tokens {
+			req := reqs[i*len(bm.tokens)+j]
+			if req.Error != nil {
+				bm.logger.Errorw(fmt.Sprintf("BalanceMonitor: error getting %s balance for key %s", t.label(), k.Address.Hex()),
+					"error", req.Error, "address", k.Address, "token", t.Address)
+				continue
+			}
+			result := *req.Result.(*hexutil.Bytes)
+			if len(result) == 0 {
+				bm.logger.Errorw(fmt.Sprintf("BalanceMonitor: error getting %s balance for key %s: empty result, token may not be a contract", t.label(), k.Address.Hex()),
+					"address", k.Address, "token", t.Address)
+				continue
+			}
+			bm.updateTokenBalance(t, k.Address.Address(), new(big.Int).SetBytes(result))
+		}
+	}
+}
+
+func (bm *balanceMonitor) updateTokenBalance(t *monitoredToken, address gethCommon.Address, balance *big.Int) {
+	bm.promUpdateTokenBalance(t, address, balance)
+
+	bm.tokenBalancesMtx.Lock()
+	balances, exists := bm.tokenBalances[address]
+	if !exists {
+		balances = make(map[gethCommon.Address]*big.Int)
+		bm.tokenBalances[address] = balances
+	}
+	oldBal := balances[t.Address]
+	balances[t.Address] = balance
+	bm.tokenBalancesMtx.Unlock()
+
+	if oldBal == nil || oldBal.Cmp(balance) != 0 {
+		bm.logger.Named("balance_log").Infow(fmt.Sprintf("%s balance for %s: %s", t.label(), address.Hex(), balance.String()),
+			"address", address.Hex(),
+			"token", t.Address.Hex(),
+			"balance", balance.String())
+	}
+}
+
+// pruneTokenBalances forgets balances of keys that are no longer sending keys,
+// so that a deleted key cannot hold the BalanceMonitor unhealthy
+func (bm *balanceMonitor) pruneTokenBalances(keys []ethkey.KeyV2) {
+	current := make(map[gethCommon.Address]struct{}, len(keys))
+	for _, k := range keys {
+		current[k.Address.Address()] = struct{}{}
+	}
+	bm.tokenBalancesMtx.Lock()
+	defer bm.tokenBalancesMtx.Unlock()
+	for address := range bm.tokenBalances {
+		if _, exists := current[address]; !exists {
+			delete(bm.tokenBalances, address)
+		}
+	}
+}
+
+func (bm *balanceMonitor) promUpdateTokenBalance(t *monitoredToken, from gethCommon.Address, balance *big.Int) {
+	bf := new(big.Float).SetInt(balance)
+	if t.decimals != nil {
+		bf.Quo(bf, new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(*t.decimals)), nil)))
+	}
+	f64, _ := bf.Float64()
+	promTokenBalance.WithLabelValues(from.Hex(), bm.chainID, t.Address.Hex(), t.label()).Set(f64)
+}
+
+// GetTokenBalances returns the latest known balance of each monitored token
+// for the given key, in the order the tokens were configured. Tokens whose
+// balance has not yet been fetched are omitted.
+func (bm *balanceMonitor) GetTokenBalances(address gethCommon.Address) (balances []TokenBalance) {
+	bm.tokenBalancesMtx.RLock()
+	defer bm.tokenBalancesMtx.RUnlock()
+	for _, t := range bm.tokens {
+		bal, exists := bm.tokenBalances[address][t.Address]
+		if !exists {
+			continue
+		}
+		balances = append(balances, TokenBalance{
+			Token:      t.Address,
+			Symbol:     t.symbol,
+			Decimals:   t.decimals,
+			Balance:    new(big.Int).Set(bal),
+			MinBalance: t.MinBalance,
+		})
+	}
+	return balances
+}
+
+// checkTokenMinimums returns an error naming every key that holds less than
+// the configured minimum of a token
+func (bm *balanceMonitor) checkTokenMinimums() error {
+	bm.tokenBalancesMtx.RLock()
+	defer bm.tokenBalancesMtx.RUnlock()
+	var breaches []string
+	for _, t := range bm.tokens {
+		if t.MinBalance == nil {
+			continue
+		}
+		for address, balances := range bm.tokenBalances {
+			if bal, exists := balances[t.Address]; exists && bal.Cmp(t.MinBalance) < 0 {
+				breaches = append(breaches, fmt.Sprintf("key %s holds %s %s, below the minimum of %s", address.Hex(), bal.String(), t.label(), t.MinBalance.String()))
+			}
+		}
+	}
+	if len(breaches) == 0 {
+		return nil
+	}
+	sort.Strings(breaches)
+	return errors.Errorf("BalanceMonitor: %s", strings.Join(breaches, "; "))
+}
+
+func mustNewArguments(types ...string) abi.Arguments {
+	var args abi.Arguments
+	for _, t := range types {
+		typ, err := abi.NewType(t, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		args = append(args, abi.Argument{Type: typ})
+	}
+	return args
+}
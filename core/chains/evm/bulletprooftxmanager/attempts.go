@@ -7,12 +7,97 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// ErrTxMaxFeeExceeded is returned when a dynamic fee attempt would cost more
+// than the MaxFeeWei of its transaction and cannot be clamped to fit
+var ErrTxMaxFeeExceeded = errors.New("attempt would exceed the max fee of the transaction")
+
+// ErrMaxGasPriceExceeded is returned when the estimated gas price (or fee cap)
+// of a transaction that has not been broadcast yet exceeds the max gas price
+// of its key, and ETH_MAX_GAS_PRICE_EXCEEDED_POLICY is defer or fail
+var ErrMaxGasPriceExceeded = errors.New("max gas price exceeded")
+
+// newEstimatedDynamicFeeAttempt is NewDynamicFeeAttempt for a fee that came
+// from the estimator, see applyMaxGasPrice
+func (c *ChainKeyStore) newEstimatedDynamicFeeAttempt(etx EthTx, fee gas.DynamicFee, gasLimit uint64) (attempt EthTxAttempt, err error) {
+	estimatedFeeCap := fee.FeeCap
+	if fee.FeeCap, err = applyMaxGasPrice(c.config, etx, fee.FeeCap); err != nil {
+		return attempt, err
+	}
+	clampedFeeCap := fee.FeeCap
+	if fee.TipCap.Cmp(fee.FeeCap) > 0 {
+		fee.TipCap = fee.FeeCap
+	}
+	if attempt, err = c.NewDynamicFeeAttempt(etx, fee, gasLimit); err != nil {
+		return attempt, err
+	}
+	recordMaxGasPriceClamp(c.config, &attempt, estimatedFeeCap, clampedFeeCap)
+	return attempt, nil
+}
+
+// newEstimatedLegacyAttempt is NewLegacyAttempt for a gas price that came
+// from the estimator, see applyMaxGasPrice
+func (c *ChainKeyStore) newEstimatedLegacyAttempt(etx EthTx, gasPrice *big.Int, gasLimit uint64) (attempt EthTxAttempt, err error) {
+	clampedGasPrice, err := applyMaxGasPrice(c.config, etx, gasPrice)
+	if err != nil {
+		return attempt, err
+	}
+	if attempt, err = c.NewLegacyAttempt(etx, clampedGasPrice, gasLimit); err != nil {
+		return attempt, err
+	}
+	recordMaxGasPriceClamp(c.config, &attempt, gasPrice, clampedGasPrice)
+	return attempt, nil
+}
+
+// applyMaxGasPrice enforces ETH_MAX_GAS_PRICE_EXCEEDED_POLICY on an estimated
+// gas price (or fee cap) for etx. A price within the max gas price of the key
+// (or of etx, if lower) is returned unchanged, otherwise it is clamped to the
+// max. If etx has not been broadcast yet and the policy is defer or fail,
+// ErrMaxGasPriceExceeded is returned instead; it is up to the caller to defer
+// or fail etx. Once etx has been broadcast its nonce is in use, so it is
+// always clamped.
+func applyMaxGasPrice(cfg Config, etx EthTx, price *big.Int) (*big.Int, error) {
+	max := maxGasPrice(cfg, etx)
+	if price.Cmp(max) <= 0 {
+		return price, nil
+	}
+	policy := cfg.EvmMaxGasPriceExceededPolicy()
+	if policy != "clamp" && (etx.State == EthTxUnstarted || etx.State == EthTxInProgress) {
+		return price, errors.Wrapf(ErrMaxGasPriceExceeded, "estimated gas price of %s wei exceeds max gas price of %s wei for key %s (ETH_MAX_GAS_PRICE_EXCEEDED_POLICY=%s)", price.String(), max.String(), etx.FromAddress.Hex(), policy)
+	}
+	return max, nil
+}
+
+// maxGasPrice is the max gas price of the key of etx, or the MaxGasPriceWei
+// of etx if it is lower
+func maxGasPrice(cfg Config, etx EthTx) *big.Int {
+	max := cfg.KeySpecificMaxGasPriceWei(etx.FromAddress)
+	if etx.MaxGasPriceWei != nil && etx.MaxGasPriceWei.ToInt().Cmp(max) < 0 {
+		return etx.MaxGasPriceWei.ToInt()
+	}
+	return max
+}
+
+// recordMaxGasPriceClamp notes the estimate and the policy on the attempt if
+// applyMaxGasPrice clamped it
+func recordMaxGasPriceClamp(cfg Config, attempt *EthTxAttempt, estimated, clamped *big.Int) {
+	if estimated.Cmp(clamped) == 0 {
+		return
+	}
+	attempt.UnclampedGasPrice = utils.NewBig(estimated)
+	attempt.MaxGasPricePolicy = null.StringFrom(cfg.EvmMaxGasPriceExceededPolicy())
+}
+
 func (c *ChainKeyStore) NewDynamicFeeAttempt(etx EthTx, fee gas.DynamicFee, gasLimit uint64) (attempt EthTxAttempt, err error) {
+	estimated := fee
+	if fee, err = applyMaxFee(c.config, etx, fee, gasLimit); err != nil {
+		return attempt, err
+	}
 	if err = validateDynamicFeeGas(c.config, fee, gasLimit, etx); err != nil {
 		return attempt, errors.Wrap(err, "error validating gas")
 	}
@@ -41,9 +126,42 @@ func (c *ChainKeyStore) NewDynamicFeeAttempt(etx EthTx, fee gas.DynamicFee, gasL
 	attempt.GasFeeCap = utils.NewBig(fee.FeeCap)
 	attempt.ChainSpecificGasLimit = gasLimit
 	attempt.TxType = 2
+	if c.config.GasEstimatorRecordInputs() {
+		attempt.EstimatorInputs = c.newEstimatorInputs(etx, gasLimit)
+		attempt.EstimatorInputs.TipCap = utils.NewBig(estimated.TipCap)
+		attempt.EstimatorInputs.FeeCap = utils.NewBig(estimated.FeeCap)
+	}
 	return attempt, nil
 }
 
+// applyMaxFee enforces the MaxFeeWei of etx, if set. If the worst case cost
+// of fee (FeeCap * gasLimit) exceeds it then, depending on
+// ETH_TX_MAX_FEE_MODE, the fee cap is either lowered to fit or
+// ErrTxMaxFeeExceeded is returned.
+func applyMaxFee(cfg Config, etx EthTx, fee gas.DynamicFee, gasLimit uint64) (gas.DynamicFee, error) {
+	if etx.MaxFeeWei == nil || gasLimit == 0 {
+		return fee, nil
+	}
+	maxFee := etx.MaxFeeWei.ToInt()
+	limit := new(big.Int).SetUint64(gasLimit)
+	cost := new(big.Int).Mul(fee.FeeCap, limit)
+	if cost.Cmp(maxFee) <= 0 {
+		return fee, nil
+	}
+	if cfg.EthTxMaxFeeMode() == "fatal" {
+		return fee, errors.Wrapf(ErrTxMaxFeeExceeded, "worst case cost of %s wei (fee cap of %s wei * gas limit of %d) exceeds max fee of %s wei", cost.String(), fee.FeeCap.String(), gasLimit, maxFee.String())
+	}
+	feeCap := new(big.Int).Div(maxFee, limit)
+	if minTip := cfg.EvmGasTipCapMinimum(); feeCap.Cmp(minTip) < 0 {
+		return fee, errors.Wrapf(ErrTxMaxFeeExceeded, "cannot clamp fee cap to %s wei to fit max fee of %s wei, it would be below the min configured gas tip of %s wei", feeCap.String(), maxFee.String(), minTip.String())
+	}
+	tipCap := fee.TipCap
+	if tipCap.Cmp(feeCap) > 0 {
+		tipCap = feeCap
+	}
+	return gas.DynamicFee{FeeCap: feeCap, TipCap: tipCap}, nil
+}
+
 var Max256BitUInt = big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil)
 
 // validateDynamicFeeGas is a sanity check - we have other checks elsewhere, but this
@@ -124,10 +242,22 @@ func (c *ChainKeyStore) NewLegacyAttempt(etx EthTx, gasPrice *big.Int, gasLimit
 	attempt.Hash = hash
 	attempt.TxType = 0
 	attempt.ChainSpecificGasLimit = gasLimit
+	if c.config.GasEstimatorRecordInputs() {
+		attempt.EstimatorInputs = c.newEstimatorInputs(etx, gasLimit)
+		attempt.EstimatorInputs.GasPrice = utils.NewBig(gasPrice)
+	}
 
 	return attempt, nil
 }
 
+func (c *ChainKeyStore) newEstimatorInputs(etx EthTx, chainSpecificGasLimit uint64) *EstimatorInputs {
+	return &EstimatorInputs{
+		Estimator:             c.config.GasEstimatorMode(),
+		GasLimit:              etx.GasLimit,
+		ChainSpecificGasLimit: chainSpecificGasLimit,
+	}
+}
+
 // validateLegacyGas is a sanity check - we have other checks elsewhere, but this
 // makes sure we _never_ create an invalid attempt
 func validateLegacyGas(cfg Config, gasPrice *big.Int, gasLimit uint64, etx EthTx) error {
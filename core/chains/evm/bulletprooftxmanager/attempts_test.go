@@ -6,9 +6,11 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
@@ -41,6 +43,28 @@ func TestBulletproofTxManager_NewDynamicFeeTx(t *testing.T) {
 		assert.Equal(t, assets.GWei(200).String(), a.GasFeeCap.String())
 	})
 
+	t.Run("records estimator inputs if enabled", func(t *testing.T) {
+		gcfg.Overrides.GlobalGasEstimatorRecordInputs = null.BoolFrom(true)
+		t.Cleanup(func() { gcfg.Overrides.GlobalGasEstimatorRecordInputs = null.Bool{} })
+		cks := bulletprooftxmanager.NewChainKeyStore(*big.NewInt(1), cfg, kst)
+		a, err := cks.NewDynamicFeeAttempt(bulletprooftxmanager.EthTx{Nonce: &n, FromAddress: addr, GasLimit: 90}, gas.DynamicFee{TipCap: assets.GWei(100), FeeCap: assets.GWei(200)}, 100)
+		require.NoError(t, err)
+		require.NotNil(t, a.EstimatorInputs)
+		assert.Equal(t, cfg.GasEstimatorMode(), a.EstimatorInputs.Estimator)
+		assert.Equal(t, uint64(90), a.EstimatorInputs.GasLimit)
+		assert.Equal(t, uint64(100), a.EstimatorInputs.ChainSpecificGasLimit)
+		assert.Nil(t, a.EstimatorInputs.GasPrice)
+		assert.Equal(t, assets.GWei(100).String(), a.EstimatorInputs.TipCap.String())
+		assert.Equal(t, assets.GWei(200).String(), a.EstimatorInputs.FeeCap.String())
+	})
+
+	t.Run("does not record estimator inputs by default", func(t *testing.T) {
+		cks := bulletprooftxmanager.NewChainKeyStore(*big.NewInt(1), cfg, kst)
+		a, err := cks.NewDynamicFeeAttempt(bulletprooftxmanager.EthTx{Nonce: &n, FromAddress: addr}, gas.DynamicFee{TipCap: assets.GWei(100), FeeCap: assets.GWei(200)}, 100)
+		require.NoError(t, err)
+		assert.Nil(t, a.EstimatorInputs)
+	})
+
 	t.Run("verifies gas tip and fees", func(t *testing.T) {
 		tests := []struct {
 			name        string
@@ -113,3 +137,42 @@ func TestBulletproofTxManager_NewLegacyAttempt(t *testing.T) {
 		assert.Contains(t, err.Error(), fmt.Sprintf("specified gas price of 100 would exceed max configured gas price of 50 for key %s", addr.Hex()))
 	})
 }
+
+func TestBulletproofTxManager_NewEstimatedLegacyAttempt(t *testing.T) {
+	addr := cltest.NewAddress()
+	gcfg := cltest.NewTestGeneralConfig(t)
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	gcfg.Overrides.GlobalEvmMaxGasPriceWei = big.NewInt(50)
+	gcfg.Overrides.GlobalEvmMinGasPriceWei = big.NewInt(10)
+	gcfg.Overrides.GlobalEvmMaxGasPriceExceededPolicy = null.StringFrom("fail")
+	kst := new(ksmocks.Eth)
+	kst.Test(t)
+	tx := types.NewTx(&types.LegacyTx{})
+	kst.On("SignTx", addr, mock.Anything, big.NewInt(1)).Return(tx, nil)
+	cks := bulletprooftxmanager.NewChainKeyStore(*big.NewInt(1), cfg, kst)
+	var n int64
+
+	t.Run("returns ErrMaxGasPriceExceeded for a transaction that has not been broadcast", func(t *testing.T) {
+		_, err := cks.NewEstimatedLegacyAttempt(bulletprooftxmanager.EthTx{Nonce: &n, FromAddress: addr, State: bulletprooftxmanager.EthTxInProgress}, big.NewInt(100), 100)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrMaxGasPriceExceeded))
+		assert.Contains(t, err.Error(), "estimated gas price of 100 wei exceeds max gas price of 50 wei")
+	})
+
+	t.Run("always clamps a transaction that has been broadcast", func(t *testing.T) {
+		a, err := cks.NewEstimatedLegacyAttempt(bulletprooftxmanager.EthTx{Nonce: &n, FromAddress: addr, State: bulletprooftxmanager.EthTxUnconfirmed}, big.NewInt(100), 100)
+		require.NoError(t, err)
+		assert.Equal(t, "50", a.GasPrice.String())
+		require.NotNil(t, a.UnclampedGasPrice)
+		assert.Equal(t, "100", a.UnclampedGasPrice.String())
+		assert.Equal(t, "fail", a.MaxGasPricePolicy.String)
+	})
+
+	t.Run("does not record anything if the estimate is within the max gas price", func(t *testing.T) {
+		a, err := cks.NewEstimatedLegacyAttempt(bulletprooftxmanager.EthTx{Nonce: &n, FromAddress: addr, State: bulletprooftxmanager.EthTxInProgress}, big.NewInt(50), 100)
+		require.NoError(t, err)
+		assert.Equal(t, "50", a.GasPrice.String())
+		assert.Nil(t, a.UnclampedGasPrice)
+		assert.False(t, a.MaxGasPricePolicy.Valid)
+	})
+}
@@ -4,21 +4,27 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/url"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	exchainutils "github.com/okex/exchain-ethereum-compatible/utils"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
@@ -40,14 +46,46 @@ type Config interface {
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
+	EthTxBumpDigestInterval() time.Duration
+	EthTxDeadlineBoostCurve() []evmconfig.DeadlineBoostStage
+	EthTxDuplicateInstancePolicy() string
+	EthTxFailureWebhookURL() *url.URL
+	EthTxFundsRecoveryBatchSize() uint32
+	EthTxFundsRecoveryCheckInterval() time.Duration
+	EthTxInProgressResolutionPolicy() string
+	EthTxInsufficientEthBackoffMax() time.Duration
+	EthTxInsufficientEthMode() string
+	EthTxOutcomeKafkaTopic() string
+	EthTxOutcomeKafkaURL() *url.URL
+	EthTxMaxFeeMode() string
+	EthTxResumeBatchSize() uint32
+	EvmBroadcastMaxInitialBumps() uint32
+	EvmBroadcastPollJitterDisabled() bool
+	EvmChainHaltThreshold() time.Duration
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
 	EvmGasLimitDefault() uint64
+	EvmGasLimitLearningEnabled() bool
+	EvmGasLimitLearningMarginPercent() uint16
+	EvmGasLimitLearningMin() uint64
+	EvmGasLimitMax() uint64
+	EvmInProgressTxAlertThreshold() time.Duration
+	EvmKeyIdleTimeout() time.Duration
 	EvmMaxInFlightTransactions() uint32
+	EvmMaxInFlightValueWei() *big.Int
+	EvmMaxGasPriceExceededPolicy() string
+	EvmMaxInProgressAge() time.Duration
+	EvmMaxNonceHoles() uint32
 	EvmMaxQueuedTransactions() uint64
 	EvmNonceAutoSync() bool
+	EvmNonceAutoSyncInterval() time.Duration
+	EvmRejectSelfTransactions() bool
 	EvmRPCDefaultBatchSize() uint32
+	EvmTxQueueTiebreak() string
+	GasEstimatorRecordInputs() bool
 	KeySpecificMaxGasPriceWei(addr common.Address) *big.Int
+	KeySpecificStrictSequencing(addr common.Address) bool
+	KeySpecificTxQueueOrdering(addr common.Address) string
 	TriggerFallbackDBPollInterval() time.Duration
 	LogSQL() bool
 }
@@ -67,6 +105,17 @@ var _ TxManager = &BulletproofTxManager{}
 // ResumeCallback is assumed to be idempotent
 type ResumeCallback func(id uuid.UUID, result interface{}, err error) error
 
+// ResumeRequest holds the arguments for a single ResumeCallback
+type ResumeRequest struct {
+	ID     uuid.UUID
+	Result interface{}
+	Err    error
+}
+
+// ResumeBatchCallback resumes several task runs at once. Like ResumeCallback,
+// it is assumed to be idempotent.
+type ResumeBatchCallback func(reqs []ResumeRequest) error
+
 //go:generate mockery --recursive --name TxManager --output ./mocks/ --case=underscore --structname TxManager --filename tx_manager.go
 type TxManager interface {
 	httypes.HeadTrackable
@@ -75,6 +124,18 @@ type TxManager interface {
 	CreateEthTransaction(newTx NewTx, qopts ...pg.QOpt) (etx EthTx, err error)
 	GetGasEstimator() gas.Estimator
 	RegisterResumeCallback(fn ResumeCallback)
+	RegisterResumeBatchCallback(fn ResumeBatchCallback)
+	StuckInProgress() []EthTx
+	KeyParked(address common.Address) bool
+	Drain(ctx context.Context) error
+	SyncNonce(ctx context.Context, address common.Address) error
+	ForceRebroadcast(beginNonce, endNonce int64, gasPriceWei *big.Int, address common.Address, overrideGasLimit uint64) error
+	ReserveNonce(address common.Address) (int64, error)
+	ReleaseNonce(address common.Address, nonce int64) error
+	TransactionCosts(subject uuid.UUID, from, to time.Time) (TransactionCosts, error)
+	GasUsedStats() ([]GasUsedStats, error)
+	BumpAllUnconfirmed(ctx context.Context, address common.Address, gasPriceOrFeeCapWei, tipCapWei *big.Int, dryRun bool) ([]BumpResult, error)
+	OnFinalized(fn FinalizedCallback)
 }
 
 type BulletproofTxManager struct {
@@ -90,22 +151,60 @@ type BulletproofTxManager struct {
 	gasEstimator     gas.Estimator
 	chainID          big.Int
 
-	chHeads        chan *evmtypes.Head
-	trigger        chan common.Address
-	resumeCallback ResumeCallback
+	chHeads             chan *evmtypes.Head
+	trigger             chan common.Address
+	resumeCallback      ResumeCallback
+	resumeBatchCallback ResumeBatchCallback
 
 	chStop   chan struct{}
 	chSubbed chan struct{}
 	wg       sync.WaitGroup
 
-	reaper      *Reaper
-	ethResender *EthResender
+	reaper               *Reaper
+	ethResender          *EthResender
+	fundsRecoveryChecker *FundsRecoveryChecker
+	inProgressMonitor    *InProgressMonitor
+
+	// keyLocks and finalityHooks are shared with every EthConfirmer, see
+	// newEthConfirmer
+	keyLocks      *keyLocks
+	finalityHooks *finalityHooks
+	// outcomeHooks are shared with every EthBroadcaster and EthConfirmer
+	outcomeHooks *outcomeHooks
+	// simulationCache is optional, and shared with every EthBroadcaster
+	simulationCache *evmclient.SimulationCache
+	// nonceSyncer is shared with every EthBroadcaster
+	nonceSyncer *NonceSyncer
+
+	// ethBroadcaster is replaced whenever keys change
+	ethBroadcasterMu sync.RWMutex
+	ethBroadcaster   *EthBroadcaster
 }
 
 func (b *BulletproofTxManager) RegisterResumeCallback(fn ResumeCallback) {
 	b.resumeCallback = fn
 }
 
+// SetSimulationCache makes transaction simulation share the results of
+// identical eth_calls within one block with the rest of the chain, see
+// evmclient.SimulationCache. It must be called before Start.
+func (b *BulletproofTxManager) SetSimulationCache(cache *evmclient.SimulationCache) {
+	b.simulationCache = cache
+}
+
+// RegisterResumeBatchCallback registers a callback to resume task runs in
+// batches, which takes precedence over any ResumeCallback
+func (b *BulletproofTxManager) RegisterResumeBatchCallback(fn ResumeBatchCallback) {
+	b.resumeBatchCallback = fn
+}
+
+// OnFinalized registers a callback that is called for every confirmed
+// transaction once its receipt is buried under ETH_FINALITY_DEPTH blocks.
+// Several callbacks may be registered.
+func (b *BulletproofTxManager) OnFinalized(fn FinalizedCallback) {
+	b.finalityHooks.register(fn)
+}
+
 func NewBulletproofTxManager(db *sqlx.DB, ethClient evmclient.Client, config Config, keyStore KeyStore, eventBroadcaster pg.EventBroadcaster, lggr logger.Logger) *BulletproofTxManager {
 	lggr = lggr.Named("BulletproofTxManager")
 	b := BulletproofTxManager{
@@ -123,6 +222,10 @@ func NewBulletproofTxManager(db *sqlx.DB, ethClient evmclient.Client, config Con
 		trigger:          make(chan common.Address),
 		chStop:           make(chan struct{}),
 		chSubbed:         make(chan struct{}),
+		keyLocks:         newKeyLocks(),
+		finalityHooks:    newFinalityHooks(),
+		outcomeHooks:     newOutcomeHooks(),
+		nonceSyncer:      newChainNonceSyncer(db, lggr, config, ethClient),
 	}
 	if config.EthTxResendAfterThreshold() > 0 {
 		b.ethResender = NewEthResender(lggr, db, ethClient, defaultResenderPollInterval, config)
@@ -134,6 +237,10 @@ func NewBulletproofTxManager(db *sqlx.DB, ethClient evmclient.Client, config Con
 	} else {
 		b.logger.Info("EthTxReaper: Disabled")
 	}
+	b.inProgressMonitor = NewInProgressMonitor(lggr, db, config, *ethClient.ChainID())
+	if mode := config.EthTxInsufficientEthMode(); mode == "skip" || mode == "defer_value" {
+		b.fundsRecoveryChecker = NewFundsRecoveryChecker(lggr, db, ethClient, config, *ethClient.ChainID(), b.Trigger)
+	}
 
 	return &b
 }
@@ -151,11 +258,12 @@ func (b *BulletproofTxManager) Start() (merr error) {
 			b.logger.Warnf("Chain %s does not have any eth keys, no transactions will be sent on this chain", b.chainID.String())
 		}
 
-		eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
-		ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+		eb := b.newEthBroadcaster(keyStates)
+		ec := b.newEthConfirmer(keyStates)
 		if err := eb.Start(); err != nil {
 			return errors.Wrap(err, "BulletproofTxManager: EthBroadcaster failed to start")
 		}
+		b.setEthBroadcaster(eb)
 		if err := ec.Start(); err != nil {
 			return errors.Wrap(err, "BulletproofTxManager: EthConfirmer failed to start")
 		}
@@ -176,6 +284,12 @@ func (b *BulletproofTxManager) Start() (merr error) {
 			b.ethResender.Start()
 		}
 
+		if b.fundsRecoveryChecker != nil {
+			b.fundsRecoveryChecker.Start()
+		}
+
+		b.inProgressMonitor.Start()
+
 		return nil
 	})
 }
@@ -190,6 +304,10 @@ func (b *BulletproofTxManager) Close() (merr error) {
 		if b.ethResender != nil {
 			b.ethResender.Stop()
 		}
+		if b.fundsRecoveryChecker != nil {
+			b.fundsRecoveryChecker.Stop()
+		}
+		b.inProgressMonitor.Stop()
 
 		b.wg.Wait()
 
@@ -211,6 +329,7 @@ func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 		case address := <-b.trigger:
 			eb.Trigger(address)
 		case head := <-b.chHeads:
+			eb.OnNewHead(head)
 			ec.mb.Deliver(head)
 		case <-b.chStop:
 			b.logger.ErrorIfClosing(eb, "EthBroadcaster")
@@ -227,12 +346,13 @@ func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 			b.logger.ErrorIfClosing(eb, "EthBroadcaster")
 			b.logger.ErrorIfClosing(ec, "EthConfirmer")
 
-			eb = NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
-			ec = NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+			eb = b.newEthBroadcaster(keyStates)
+			ec = b.newEthConfirmer(keyStates)
 
 			if err := eb.Start(); err != nil {
 				b.logger.Errorw("Failed to start EthBroadcaster", "error", err)
 			}
+			b.setEthBroadcaster(eb)
 			if err := ec.Start(); err != nil {
 				b.logger.Errorw("Failed to start EthConfirmer", "error", err)
 			}
@@ -258,6 +378,94 @@ func (b *BulletproofTxManager) OnNewLongestChain(ctx context.Context, head *evmt
 	}
 }
 
+// StuckInProgress returns transactions that have been in_progress for longer
+// than ETH_IN_PROGRESS_TX_ALERT_THRESHOLD as of the most recent check
+func (b *BulletproofTxManager) StuckInProgress() []EthTx {
+	return b.inProgressMonitor.StuckInProgress()
+}
+
+func (b *BulletproofTxManager) setEthBroadcaster(eb *EthBroadcaster) {
+	b.ethBroadcasterMu.Lock()
+	defer b.ethBroadcasterMu.Unlock()
+	b.ethBroadcaster = eb
+}
+
+// BroadcasterHealthReport returns the EthBroadcaster's per-key health, see
+// EthBroadcaster#HealthReport
+func (b *BulletproofTxManager) BroadcasterHealthReport() map[string]error {
+	b.ethBroadcasterMu.RLock()
+	eb := b.ethBroadcaster
+	b.ethBroadcasterMu.RUnlock()
+	if eb == nil {
+		return nil
+	}
+	return eb.HealthReport()
+}
+
+// KeyParked returns true if the EthBroadcaster has parked the given key for
+// being idle, see EthBroadcaster#KeyParked
+func (b *BulletproofTxManager) KeyParked(address common.Address) bool {
+	b.ethBroadcasterMu.RLock()
+	eb := b.ethBroadcaster
+	b.ethBroadcasterMu.RUnlock()
+	if eb == nil {
+		return false
+	}
+	return eb.KeyParked(address)
+}
+
+// Drain waits for the EthBroadcaster to finish sending any in_progress
+// transactions, and stops it picking up new ones, see EthBroadcaster#Drain
+func (b *BulletproofTxManager) Drain(ctx context.Context) error {
+	b.ethBroadcasterMu.RLock()
+	eb := b.ethBroadcaster
+	b.ethBroadcasterMu.RUnlock()
+	if eb == nil {
+		return nil
+	}
+	return eb.Drain(ctx)
+}
+
+// SyncNonce fast-forwards the next nonce of a key to the on-chain nonce, if
+// the chain is ahead, e.g. because the key was used by an external wallet. If
+// the EthBroadcaster is running, this is serialized with its processing of
+// the key, see EthBroadcaster#SyncNonce.
+func (b *BulletproofTxManager) SyncNonce(ctx context.Context, address common.Address) error {
+	b.ethBroadcasterMu.RLock()
+	eb := b.ethBroadcaster
+	b.ethBroadcasterMu.RUnlock()
+	if eb != nil {
+		return eb.SyncNonce(ctx, address)
+	}
+	if err := b.checkStateExists(b.q, address); err != nil {
+		return errors.Wrap(err, "SyncNonce failed")
+	}
+	return b.nonceSyncer.Sync(ctx, ethkey.State{Address: ethkey.EIP55AddressFromAddress(address)})
+}
+
+// Healthy reports unhealthy if any transactions are stuck in_progress, or if
+// the EthBroadcaster is failing for any key
+func (b *BulletproofTxManager) Healthy() (merr error) {
+	if err := b.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	if stuck := b.StuckInProgress(); len(stuck) > 0 {
+		merr = errors.Errorf("%d transaction(s) stuck in_progress for longer than %v", len(stuck), b.config.EvmInProgressTxAlertThreshold())
+	}
+	report := b.BroadcasterHealthReport()
+	addresses := make([]string, 0, len(report))
+	for address := range report {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	for _, address := range addresses {
+		if err := report[address]; err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "key %s", address))
+		}
+	}
+	return merr
+}
+
 // Trigger forces the EthBroadcaster to check early for the given address
 func (b *BulletproofTxManager) Trigger(addr common.Address) {
 	select {
@@ -266,29 +474,154 @@ func (b *BulletproofTxManager) Trigger(addr common.Address) {
 	}
 }
 
+// newEthBroadcaster instantiates an EthBroadcaster that shares the outcome
+// hooks of the BulletproofTxManager, so that OutcomeCallbacks survive
+// EthBroadcaster restarts, and its simulation cache and nonce syncer
+func (b *BulletproofTxManager) newEthBroadcaster(keyStates []ethkey.State) *EthBroadcaster {
+	eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.resumeBatchCallback, b.logger)
+	eb.outcomeEmitter.hooks = b.outcomeHooks
+	eb.simulationCache = b.simulationCache
+	eb.nonceSyncer = b.nonceSyncer
+	return eb
+}
+
+// newEthConfirmer instantiates an EthConfirmer that shares the per-key locks
+// of the BulletproofTxManager, so that the EthConfirmer's gas bumping is
+// serialized with BumpAllUnconfirmed across EthConfirmer restarts, and its
+// finality hooks, so that FinalizedCallbacks survive those restarts
+func (b *BulletproofTxManager) newEthConfirmer(keyStates []ethkey.State) *EthConfirmer {
+	ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.resumeBatchCallback, b.logger)
+	ec.keyLocks = b.keyLocks
+	ec.finalityHooks = b.finalityHooks
+	ec.outcomeEmitter.hooks = b.outcomeHooks
+	return ec
+}
+
+// ForceRebroadcast immediately sends a new attempt at the given gas price for
+// every transaction from address in the (inclusive) nonce range, bypassing
+// the gas estimator. Nonces with no transaction are filled with a zero-value
+// transaction to self. Attempts for unconfirmed transactions are saved and
+// marked as manual so that the EthConfirmer tracks them like any other.
+//
+// It does not check gasPriceWei against the max gas price, that is up to the
+// caller.
+func (b *BulletproofTxManager) ForceRebroadcast(beginNonce, endNonce int64, gasPriceWei *big.Int, address common.Address, overrideGasLimit uint64) error {
+	if beginNonce < 0 || endNonce < beginNonce {
+		return errors.Errorf("invalid nonce range %d to %d", beginNonce, endNonce)
+	}
+	if gasPriceWei == nil || gasPriceWei.Sign() <= 0 || !gasPriceWei.IsUint64() {
+		return errors.Errorf("invalid gas price %v", gasPriceWei)
+	}
+	keyStates, err := b.keyStore.GetStatesForChain(&b.chainID)
+	if err != nil {
+		return errors.Wrap(err, "ForceRebroadcast failed to load key states")
+	}
+	ec := b.newEthConfirmer(keyStates)
+	return ec.ForceRebroadcast(uint(beginNonce), uint(endNonce), gasPriceWei.Uint64(), address, overrideGasLimit)
+}
+
+// BumpAllUnconfirmed replaces every unconfirmed transaction from address with
+// a new attempt at the given gas price (legacy) or fee cap and tip cap
+// (dynamic fee), see EthConfirmer#BumpAllUnconfirmed. If dryRun is true,
+// nothing is sent or saved, and the results report what would be bumped.
+func (b *BulletproofTxManager) BumpAllUnconfirmed(ctx context.Context, address common.Address, gasPriceOrFeeCapWei, tipCapWei *big.Int, dryRun bool) ([]BumpResult, error) {
+	if gasPriceOrFeeCapWei == nil || gasPriceOrFeeCapWei.Sign() <= 0 {
+		return nil, errors.Errorf("invalid gas price or fee cap %v", gasPriceOrFeeCapWei)
+	}
+	if tipCapWei != nil && tipCapWei.Sign() < 0 {
+		return nil, errors.Errorf("invalid tip cap %v", tipCapWei)
+	}
+	if err := b.checkStateExists(b.q, address); err != nil {
+		return nil, errors.Wrap(err, "BumpAllUnconfirmed failed")
+	}
+	keyStates, err := b.keyStore.GetStatesForChain(&b.chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "BumpAllUnconfirmed failed to load key states")
+	}
+	ec := b.newEthConfirmer(keyStates)
+	return ec.BumpAllUnconfirmed(ctx, address, gasPriceOrFeeCapWei, tipCapWei, dryRun)
+}
+
 type NewTx struct {
 	FromAddress    common.Address
 	ToAddress      common.Address
 	EncodedPayload []byte
 	GasLimit       uint64
 	Meta           *EthTxMeta
+	// Value is optional, and defaults to zero
+	Value assets.Eth
 
 	MinConfirmations  null.Uint32
 	PipelineTaskRunID *uuid.UUID
+	// Priority is optional, see EthTx.Priority
+	Priority null.Int64
+	// MaxFeeWei is optional, see EthTx.MaxFeeWei
+	MaxFeeWei *big.Int
+	// MaxGasPriceWei is optional, see EthTx.MaxGasPriceWei
+	MaxGasPriceWei *big.Int
+	// Deadline is optional, see EthTx.Deadline
+	Deadline *time.Time
+	// DeadlineFromContext, if set and Deadline is not, uses the deadline of
+	// the parent context passed to CreateEthTransaction with
+	// pg.WithParentCtx as the transaction's Deadline
+	DeadlineFromContext bool
+	// PacingTag and PacingInterval are optional, see EthTx.PacingInterval
+	PacingTag      string
+	PacingInterval time.Duration
+	// StrictSequencing is optional, see EthTx.StrictSequencing. It requires
+	// the Strategy to have a subject.
+	StrictSequencing bool
+	// UseLearnedGasLimit is optional, and if set the GasLimit is replaced by
+	// one derived from the GasUsedStats of the method being called, if there
+	// are any yet. It has no effect unless ETH_GAS_LIMIT_LEARNING_ENABLED is
+	// set.
+	UseLearnedGasLimit bool
+	// Origin is optional, and records what created the transaction
+	Origin EthTxOrigin
+	// OnOutcome is optional, and is called once the transaction is confirmed,
+	// fatally errored or dead lettered, see Outcome. It is only kept in
+	// memory, so it is not called if the node restarts before then.
+	OnOutcome OutcomeCallback
 
 	Strategy TxStrategy
 }
 
+// isSelfTransfer returns true if the transaction only transfers value, which
+// is the one legitimate reason to send a transaction to self
+func isSelfTransfer(newTx NewTx) bool {
+	return newTx.Value.ToInt().Sign() > 0 && len(newTx.EncodedPayload) == 0
+}
+
 // CreateEthTransaction inserts a new transaction
 func (b *BulletproofTxManager) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err error) {
 	q := b.q.WithOpts(qs...)
 
+	if newTx.DeadlineFromContext && newTx.Deadline == nil && q.ParentCtx != nil {
+		if deadline, ok := q.ParentCtx.Deadline(); ok {
+			newTx.Deadline = &deadline
+		}
+	}
+
+	if newTx.FromAddress == newTx.ToAddress && b.config.EvmRejectSelfTransactions() && !isSelfTransfer(newTx) {
+		return etx, errors.Errorf("BulletproofTxManager#CreateEthTransaction: refusing to send transaction with payload from %s to itself; this is most likely a bug, e.g. the wrong address was used for the contract. Only plain transfers of value to self are allowed with ETH_REJECT_SELF_TRANSACTIONS set", newTx.FromAddress.Hex())
+	}
+
+	if newTx.StrictSequencing && !newTx.Strategy.Subject().Valid {
+		return etx, errors.New("BulletproofTxManager#CreateEthTransaction: strict sequencing is scoped to the subject of the transaction, so it requires a strategy with a subject")
+	}
+
 	err = CheckEthTxQueueCapacity(q, newTx.FromAddress, b.config.EvmMaxQueuedTransactions(), b.chainID)
 	if err != nil {
 		return etx, errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
 	}
 
-	value := 0
+	if gasLimit, ok, err := b.learnedP99GasLimit(q, newTx); err != nil {
+		b.logger.Warnw("Failed to load gas used statistics, falling back to the requested gas limit", "toAddress", newTx.ToAddress, "err", err)
+	} else if ok {
+		b.logger.Debugw("Using gas limit learned from gas used statistics", "toAddress", newTx.ToAddress, "gasLimit", gasLimit, "requestedGasLimit", newTx.GasLimit)
+		newTx.GasLimit = gasLimit
+	}
+
 	err = q.Transaction(func(tx pg.Queryer) error {
 		if newTx.PipelineTaskRunID != nil {
 			err = tx.Get(&etx, `SELECT * FROM eth_txes WHERE pipeline_task_run_id = $1 AND evm_chain_id = $2`, newTx.PipelineTaskRunID, b.chainID.String())
@@ -305,15 +638,20 @@ func (b *BulletproofTxManager) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt)
 			return err
 		}
 		err := tx.Get(&etx, `
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, simulate)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, simulate, simulation_mode, priority, max_fee_wei, deadline, pacing_tag, pacing_interval, max_gas_price_wei, origin_job_id, origin_job_name, origin_user, origin_credential, strict_sequencing)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,NULLIF($16, ''),$17,$18,NULLIF($19, 0),NULLIF($20, ''),NULLIF($21, ''),NULLIF($22, ''),$23
 )
 RETURNING "eth_txes".*
-`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Strategy.Simulate())
+`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, newTx.Value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Strategy.Simulate(), newTx.Strategy.SimulationMode(), newTx.Priority, utils.NewBig(newTx.MaxFeeWei), newTx.Deadline, newTx.PacingTag, newTx.PacingInterval, utils.NewBig(newTx.MaxGasPriceWei), newTx.Origin.JobID, newTx.Origin.JobName, newTx.Origin.User, newTx.Origin.Credential, newTx.StrictSequencing)
 		if err != nil {
 			return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction failed to insert eth_tx")
 		}
+		if newTx.OnOutcome != nil {
+			// Registered before the eth_tx is committed, so that it can't
+			// be missed
+			b.outcomeHooks.register(etx.ID, newTx.OnOutcome)
+		}
 
 		pruned, err := newTx.Strategy.PruneQueue(tx)
 		if err != nil {
@@ -348,7 +686,7 @@ func (b *BulletproofTxManager) GetGasEstimator() gas.Estimator {
 
 // SendEther creates a transaction that transfers the given value of ether
 // TODO: Make this a method on the bulletprooftxmanager
-func SendEther(q pg.Q, chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint64) (etx EthTx, err error) {
+func SendEther(q pg.Q, chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint64, origin EthTxOrigin) (etx EthTx, err error) {
 	if to == utils.ZeroAddress {
 		return etx, errors.New("cannot send ether to zero address")
 	}
@@ -361,13 +699,35 @@ func SendEther(q pg.Q, chainID *big.Int, from, to common.Address, value assets.E
 		State:          EthTxUnstarted,
 		EVMChainID:     *utils.NewBig(chainID),
 	}
-	query := `INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, evm_chain_id, created_at) VALUES (
-:from_address, :to_address, :encoded_payload, :value, :gas_limit, :state, :evm_chain_id, NOW()
+	etx.setOrigin(origin)
+	query := `INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, evm_chain_id, created_at, origin_job_id, origin_job_name, origin_user, origin_credential) VALUES (
+:from_address, :to_address, :encoded_payload, :value, :gas_limit, :state, :evm_chain_id, NOW(), :origin_job_id, :origin_job_name, :origin_user, :origin_credential
 ) RETURNING eth_txes.*`
 	err = q.GetNamed(query, &etx, etx)
 	return etx, errors.Wrap(err, "SendEther failed to insert eth_tx")
 }
 
+// ErrEthTxNotCancellable is returned by CancelEthTx for a transaction that is
+// no longer unstarted
+var ErrEthTxNotCancellable = errors.New("only unstarted transactions can be cancelled")
+
+// CancelEthTx errors an unstarted transaction so that it is never sent. Once
+// the EthBroadcaster has picked a transaction up it may already be on its way
+// to the eth node, so it is left alone and ErrEthTxNotCancellable is returned.
+// sql.ErrNoRows is returned if there is no transaction with the given id.
+func CancelEthTx(q pg.Q, id int64) (etx EthTx, err error) {
+	err = q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1 FOR UPDATE`, id); err != nil {
+			return err
+		}
+		if etx.State != EthTxUnstarted {
+			return ErrEthTxNotCancellable
+		}
+		return tx.Get(&etx, `UPDATE eth_txes SET state = 'fatal_error', error = 'cancelled' WHERE id = $1 RETURNING *`, id)
+	})
+	return etx, errors.Wrap(err, "CancelEthTx failed")
+}
+
 type ChainKeyStore struct {
 	chainID  big.Int
 	config   Config
@@ -428,9 +788,33 @@ func sendTransaction(ctx context.Context, ethClient evmclient.Client, a EthTxAtt
 	return sendErr
 }
 
+// sendTransactionWithLog sends the attempt like sendTransaction, and appends
+// how each eth node handled the send to the attempt's send log
+func sendTransactionWithLog(ctx context.Context, q pg.Q, ethClient evmclient.Client, a EthTxAttempt, e EthTx, logger logger.Logger) *evmclient.SendError {
+	var recorder evmclient.SendRecorder
+	sendErr := sendTransaction(evmclient.WithSendRecorder(ctx, &recorder), ethClient, a, e, logger)
+	results := recorder.Results()
+	if len(results) == 0 {
+		return sendErr
+	}
+	b, err := json.Marshal(results)
+	if err != nil {
+		logger.Errorw("Failed to encode send log", "ethTxAttemptID", a.ID, "err", err)
+		return sendErr
+	}
+	_, err = q.WithOpts(pg.WithParentCtx(ctx)).Exec(`UPDATE eth_tx_attempts SET send_log = COALESCE(send_log, '[]'::jsonb) || $1::jsonb WHERE id = $2`, b, a.ID)
+	if err != nil {
+		logger.Errorw("Failed to save send log", "ethTxAttemptID", a.ID, "err", err)
+	}
+	return sendErr
+}
+
 // gimulateTransaction pretends to "send" the transaction using eth_call
 // returns error on revert
-func simulateTransaction(ctx context.Context, ethClient evmclient.Client, a EthTxAttempt, e EthTx) (hexutil.Bytes, error) {
+//
+// Identical simulations within one block are only sent to the eth node once
+// if cache is not nil, see evmclient.SimulationCache.
+func simulateTransaction(ctx context.Context, ethClient evmclient.Client, cache *evmclient.SimulationCache, a EthTxAttempt, e EthTx) (hexutil.Bytes, error) {
 	// See: https://github.com/ethereum/go-ethereum/blob/acdf9238fb03d79c9b1c20c2fa476a7e6f4ac2ac/ethclient/gethclient/gethclient.go#L193
 	callArg := map[string]interface{}{
 		"from": e.FromAddress,
@@ -444,8 +828,12 @@ func simulateTransaction(ctx context.Context, ethClient evmclient.Client, a EthT
 		"value":                (*hexutil.Big)(e.Value.ToInt()),
 		"data":                 hexutil.Bytes(e.EncodedPayload),
 	}
-	var b hexutil.Bytes
-	baseErr := ethClient.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil)) // always run simulation on "latest" block
+	msg := ethereum.CallMsg{From: e.FromAddress, To: &e.ToAddress, Gas: a.ChainSpecificGasLimit, Value: e.Value.ToInt(), Data: e.EncodedPayload}
+	b, baseErr := cache.Call(msg, func() ([]byte, error) {
+		var b hexutil.Bytes
+		err := ethClient.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil)) // always run simulation on "latest" block
+		return b, err
+	})
 	return b, errors.Wrap(baseErr, "transaction simulation using eth_call failed")
 }
 
@@ -480,8 +868,8 @@ func makeEmptyTransaction(keyStore KeyStore, nonce uint64, gasLimit uint64, gasP
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap)
+INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_manual, unclamped_gas_price, max_gas_price_policy, estimator_inputs, deadline_boost_factor)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_manual, :unclamped_gas_price, :max_gas_price_policy, :estimator_inputs, :deadline_boost_factor)
 RETURNING *;
 `
 
@@ -509,6 +897,14 @@ func CountUnconfirmedTransactions(q pg.Q, fromAddress common.Address, chainID bi
 	return countTransactionsWithState(q, fromAddress, EthTxUnconfirmed, chainID)
 }
 
+// SumUnconfirmedValue returns the total value of unconfirmed transactions
+func SumUnconfirmedValue(q pg.Q, fromAddress common.Address, chainID big.Int) (*big.Int, error) {
+	var sum utils.Big
+	err := q.Get(&sum, `SELECT COALESCE(sum(value), 0) FROM eth_txes WHERE from_address = $1 AND state = $2 AND evm_chain_id = $3`,
+		fromAddress, EthTxUnconfirmed, chainID.String())
+	return sum.ToInt(), errors.Wrap(err, "failed to SumUnconfirmedValue")
+}
+
 // CountUnstartedTransactions returns the number of unconfirmed transactions
 func CountUnstartedTransactions(q pg.Q, fromAddress common.Address, chainID big.Int) (count uint32, err error) {
 	return countTransactionsWithState(q, fromAddress, EthTxUnstarted, chainID)
@@ -552,7 +948,33 @@ func (n *NullTxManager) Trigger(common.Address)                            { pan
 func (n *NullTxManager) CreateEthTransaction(NewTx, ...pg.QOpt) (etx EthTx, err error) {
 	return etx, errors.New(n.ErrMsg)
 }
-func (n *NullTxManager) Healthy() error                           { return nil }
-func (n *NullTxManager) Ready() error                             { return nil }
-func (n *NullTxManager) GetGasEstimator() gas.Estimator           { return nil }
-func (n *NullTxManager) RegisterResumeCallback(fn ResumeCallback) {}
+func (n *NullTxManager) Healthy() error                                     { return nil }
+func (n *NullTxManager) Ready() error                                       { return nil }
+func (n *NullTxManager) GetGasEstimator() gas.Estimator                     { return nil }
+func (n *NullTxManager) RegisterResumeCallback(fn ResumeCallback)           {}
+func (n *NullTxManager) RegisterResumeBatchCallback(fn ResumeBatchCallback) {}
+func (n *NullTxManager) StuckInProgress() []EthTx                           { return nil }
+func (n *NullTxManager) KeyParked(common.Address) bool                      { return false }
+func (n *NullTxManager) Drain(context.Context) error                        { return nil }
+func (n *NullTxManager) SyncNonce(context.Context, common.Address) error {
+	return errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) ForceRebroadcast(int64, int64, *big.Int, common.Address, uint64) error {
+	return errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) ReserveNonce(common.Address) (int64, error) {
+	return 0, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) ReleaseNonce(common.Address, int64) error {
+	return errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) TransactionCosts(uuid.UUID, time.Time, time.Time) (TransactionCosts, error) {
+	return TransactionCosts{}, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) GasUsedStats() ([]GasUsedStats, error) {
+	return nil, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) BumpAllUnconfirmed(context.Context, common.Address, *big.Int, *big.Int, bool) ([]BumpResult, error) {
+	return nil, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) OnFinalized(FinalizedCallback) {}
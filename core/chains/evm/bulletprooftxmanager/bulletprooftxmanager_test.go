@@ -2,6 +2,7 @@ package bulletprooftxmanager_test
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -21,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -37,11 +40,47 @@ func TestBulletproofTxManager_SendEther_DoesNotSendToZero(t *testing.T) {
 	value := assets.NewEth(1)
 
 	q := pg.NewQ(db, logger.TestLogger(t), cltest.NewTestGeneralConfig(t))
-	_, err := bulletprooftxmanager.SendEther(q, big.NewInt(0), from, to, *value, 21000)
+	_, err := bulletprooftxmanager.SendEther(q, big.NewInt(0), from, to, *value, 21000, bulletprooftxmanager.EthTxOrigin{})
 	require.Error(t, err)
 	require.EqualError(t, err, "cannot send ether to zero address")
 }
 
+func TestBulletproofTxManager_CancelEthTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	q := pg.NewQ(db, logger.TestLogger(t), cfg)
+
+	t.Run("errors an unstarted transaction", func(t *testing.T) {
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		cancelled, err := bulletprooftxmanager.CancelEthTx(q, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, cancelled.State)
+		assert.Equal(t, "cancelled", cancelled.Error.String)
+	})
+
+	t.Run("leaves a broadcast transaction alone", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTx(t, borm, 0, fromAddress)
+
+		_, err := bulletprooftxmanager.CancelEthTx(q, etx.ID)
+		require.True(t, errors.Is(err, bulletprooftxmanager.ErrEthTxNotCancellable))
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+	})
+
+	t.Run("with an unknown id", func(t *testing.T) {
+		_, err := bulletprooftxmanager.CancelEthTx(q, 0)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+	})
+}
+
 func TestBulletproofTxManager_CheckEthTxQueueCapacity(t *testing.T) {
 	t.Parallel()
 
@@ -195,6 +234,9 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
 	config.On("EthTxReaperThreshold").Return(time.Duration(0))
 	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("GasEstimatorOwnConfirmationsBlockWindow").Return(uint16(0))
+	config.On("EthTxFailureWebhookURL").Maybe().Return(nil)
+	config.On("EthTxBumpDigestInterval").Maybe().Return(time.Duration(0))
 	config.On("LogSQL").Return(false)
 	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
 
@@ -281,6 +323,131 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 		assert.Equal(t, tx1.ID, tx2.ID)
 	})
 
+	t.Run("persists priority", func(t *testing.T) {
+		config.On("EvmMaxQueuedTransactions").Return(uint64(3)).Once()
+		etx, err := bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       21000,
+			Priority:       null.Int64From(7),
+			Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1`, etx.ID))
+		assert.Equal(t, null.Int64From(7), etx.Priority)
+	})
+
+	t.Run("persists the origin", func(t *testing.T) {
+		config.On("EvmMaxQueuedTransactions").Return(uint64(0)).Twice()
+		etx, err := bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       21000,
+			Origin:         bulletprooftxmanager.JobOrigin(42, "keeper registry"),
+			Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.JobOrigin(42, "keeper registry"), etx.Origin())
+
+		require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1`, etx.ID))
+		assert.Equal(t, bulletprooftxmanager.EthTxOrigin{JobID: 42, JobName: "keeper registry"}, etx.Origin())
+		assert.False(t, etx.OriginUser.Valid)
+
+		// Transactions with no known origin leave it NULL
+		etx, err = bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       21000,
+			Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+		})
+		require.NoError(t, err)
+		require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1`, etx.ID))
+		assert.True(t, etx.Origin().IsZero())
+		assert.False(t, etx.OriginJobID.Valid)
+	})
+
+	t.Run("captures the deadline of the parent context with DeadlineFromContext", func(t *testing.T) {
+		config.On("EvmMaxQueuedTransactions").Return(uint64(0)).Twice()
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+
+		etx, err := bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:         fromAddress,
+			ToAddress:           cltest.NewAddress(),
+			EncodedPayload:      []byte{1, 2, 3},
+			GasLimit:            21000,
+			DeadlineFromContext: true,
+			Strategy:            bulletprooftxmanager.SendEveryStrategy{},
+		}, pg.WithParentCtx(ctx))
+		require.NoError(t, err)
+
+		require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1`, etx.ID))
+		require.NotNil(t, etx.Deadline)
+		assert.WithinDuration(t, deadline, *etx.Deadline, time.Millisecond)
+
+		// Without DeadlineFromContext the context deadline is ignored
+		etx, err = bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       21000,
+			Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+		}, pg.WithParentCtx(ctx))
+		require.NoError(t, err)
+
+		require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1`, etx.ID))
+		assert.Nil(t, etx.Deadline)
+	})
+
+	t.Run("with ETH_REJECT_SELF_TRANSACTIONS rejects transactions with payload to self", func(t *testing.T) {
+		config.On("EvmRejectSelfTransactions").Return(true).Once()
+		_, err := bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      fromAddress,
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       21000,
+			Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("refusing to send transaction with payload from %s to itself", fromAddress.Hex()))
+	})
+
+	t.Run("with ETH_REJECT_SELF_TRANSACTIONS allows transfers of value to self", func(t *testing.T) {
+		config.On("EvmRejectSelfTransactions").Return(true).Once()
+		config.On("EvmMaxQueuedTransactions").Return(uint64(0)).Once()
+		etx, err := bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress: fromAddress,
+			ToAddress:   fromAddress,
+			Value:       assets.NewEthValue(42),
+			GasLimit:    21000,
+			Strategy:    bulletprooftxmanager.SendEveryStrategy{},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1`, etx.ID))
+		assert.Equal(t, assets.NewEthValue(42), etx.Value)
+		assert.Equal(t, fromAddress, etx.ToAddress)
+	})
+
+	t.Run("without ETH_REJECT_SELF_TRANSACTIONS allows transactions with payload to self", func(t *testing.T) {
+		config.On("EvmRejectSelfTransactions").Return(false).Once()
+		config.On("EvmMaxQueuedTransactions").Return(uint64(0)).Once()
+		_, err := bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      fromAddress,
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       21000,
+			Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+		})
+		require.NoError(t, err)
+	})
+
 	t.Run("returns error if eth key state is missing or doesn't match chain ID", func(t *testing.T) {
 		config.On("EvmMaxQueuedTransactions").Return(uint64(3)).Twice()
 		rndAddr := cltest.NewAddress()
@@ -312,6 +479,7 @@ func newMockTxStrategy(t *testing.T) *bptxmmocks.TxStrategy {
 	strategy := new(bptxmmocks.TxStrategy)
 	strategy.Test(t)
 	strategy.On("Simulate").Return(true)
+	strategy.On("SimulationMode").Return(bulletprooftxmanager.SimulationModeFatalOnRevert)
 	return strategy
 }
 
@@ -332,6 +500,9 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
 	config.On("EthTxReaperThreshold").Return(time.Duration(0))
 	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("GasEstimatorOwnConfirmationsBlockWindow").Return(uint16(0))
+	config.On("EthTxFailureWebhookURL").Maybe().Return(nil)
+	config.On("EthTxBumpDigestInterval").Maybe().Return(time.Duration(0))
 	config.On("LogSQL").Return(false)
 	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
 	lggr := logger.TestLogger(t)
@@ -423,8 +594,12 @@ func TestBulletproofTxManager_Lifecycle(t *testing.T) {
 	config.On("EthTxReaperThreshold").Return(1 * time.Hour)
 	config.On("EthTxReaperInterval").Return(1 * time.Hour)
 	config.On("EvmMaxInFlightTransactions").Return(uint32(42))
+	config.On("EvmMaxInFlightValueWei").Maybe().Return(big.NewInt(0))
 	config.On("EvmFinalityDepth").Maybe().Return(uint32(42))
 	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("GasEstimatorOwnConfirmationsBlockWindow").Return(uint16(0))
+	config.On("EthTxFailureWebhookURL").Maybe().Return(nil)
+	config.On("EthTxBumpDigestInterval").Maybe().Return(time.Duration(0))
 	config.On("LogSQL").Return(false)
 	kst.On("GetStatesForChain", &cltest.FixtureChainID).Return([]ethkey.State{}, nil).Once()
 
@@ -442,6 +617,7 @@ func TestBulletproofTxManager_Lifecycle(t *testing.T) {
 	sub.On("Events").Return(make(<-chan pg.Event))
 	eventBroadcaster.On("Subscribe", "insert_on_eth_txes", "").Return(sub, nil)
 	config.On("EvmNonceAutoSync").Return(true)
+	config.On("EvmNonceAutoSyncInterval").Return(time.Duration(0))
 	config.On("EvmGasBumpThreshold").Return(uint64(1))
 
 	require.NoError(t, bptxm.Start())
@@ -457,6 +633,9 @@ func TestBulletproofTxManager_Lifecycle(t *testing.T) {
 	sub.On("Close").Return()
 	ethClient.On("PendingNonceAt", mock.AnythingOfType("*context.cancelCtx"), keyState.Address.Address()).Return(uint64(0), nil)
 	config.On("TriggerFallbackDBPollInterval").Return(1 * time.Hour)
+	config.On("EvmBroadcastPollJitterDisabled").Return(false).Maybe()
+	config.On("EvmKeyIdleTimeout").Return(time.Duration(0)).Maybe()
+	config.On("EvmChainHaltThreshold").Return(time.Duration(0)).Maybe()
 	keyChangeCh <- struct{}{}
 
 	require.NoError(t, bptxm.Close())
@@ -0,0 +1,186 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// BumpStatus is what BumpAllUnconfirmed did with a single transaction
+type BumpStatus string
+
+const (
+	// BumpStatusBumped means the replacement attempt was broadcast and saved
+	BumpStatusBumped = BumpStatus("bumped")
+	// BumpStatusWouldBump means the replacement attempt is valid, but was
+	// not broadcast since it was a dry run
+	BumpStatusWouldBump = BumpStatus("would_bump")
+	// BumpStatusSkipped means no valid replacement attempt could be created
+	// at the given pricing
+	BumpStatusSkipped = BumpStatus("skipped")
+	// BumpStatusFailed means the eth node rejected the replacement attempt
+	BumpStatusFailed = BumpStatus("failed")
+)
+
+// BumpResult summarizes the replacement of a single unconfirmed transaction
+// by BumpAllUnconfirmed
+type BumpResult struct {
+	EthTxID  int64
+	Nonce    int64
+	TxType   int
+	GasLimit uint64
+	// PreviousPrice and NewPrice are the gas price of legacy transactions,
+	// or the fee cap of dynamic fee transactions. The tip caps are only set
+	// for dynamic fee transactions.
+	PreviousPrice  *big.Int
+	PreviousTipCap *big.Int
+	NewPrice       *big.Int
+	NewTipCap      *big.Int
+	// AdditionalCostWei is the worst case increase in the cost of the
+	// transaction, (NewPrice - PreviousPrice) * GasLimit
+	AdditionalCostWei *big.Int
+	// Hash is the hash of the replacement attempt, if it was broadcast
+	Hash   gethCommon.Hash
+	Status BumpStatus
+	Error  string
+}
+
+// BumpAllUnconfirmed replaces the highest priced attempt of every unconfirmed
+// transaction from address, in nonce order, with one at the given gas price
+// (legacy) or fee cap and tip cap (dynamic fee), keeping the transaction
+// type and gas limit of the previous attempt. The replacements are sent in
+// batches, and saved as manual attempts so that the EthConfirmer tracks them
+// like any other.
+//
+// A transaction is skipped if the new pricing is not a valid replacement for
+// it, i.e. it is less than the previous attempt bumped by the gas bump
+// strategy, exceeds the max gas price of the key or the max fee of the
+// transaction, or if a dynamic fee transaction is given no tip cap.
+//
+// The key's lock is held throughout, so that the EthConfirmer does not bump
+// the same transactions concurrently.
+func (ec *EthConfirmer) BumpAllUnconfirmed(ctx context.Context, address gethCommon.Address, gasPriceOrFeeCap, tipCap *big.Int, dryRun bool) ([]BumpResult, error) {
+	unlock := ec.keyLocks.lock(address)
+	defer unlock()
+
+	etxs, err := findUnconfirmedEthTxsWithAttempts(ctx, ec.q, address, ec.chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "BumpAllUnconfirmed failed")
+	}
+
+	results := make([]BumpResult, len(etxs))
+	var attempts []EthTxAttempt
+	var attemptResults []*BumpResult
+	for i, etx := range etxs {
+		result := &results[i]
+		attempt, err := ec.newBumpAllAttempt(*etx, gasPriceOrFeeCap, tipCap, result)
+		if err != nil {
+			result.Status = BumpStatusSkipped
+			result.Error = err.Error()
+			continue
+		}
+		result.Status = BumpStatusWouldBump
+		attempts = append(attempts, attempt)
+		attemptResults = append(attemptResults, result)
+	}
+
+	if dryRun || len(attempts) == 0 {
+		return results, nil
+	}
+
+	ec.lggr.Infow(fmt.Sprintf("BumpAllUnconfirmed: sending %d replacement attempts", len(attempts)), "address", address, "gasPriceOrFeeCap", gasPriceOrFeeCap, "tipCap", tipCap)
+	reqs, err := batchSendTransactions(ctx, ec.lggr, ec.ethClient, ec.config.EvmRPCDefaultBatchSize(), attempts)
+	if err != nil {
+		return nil, errors.Wrap(err, "BumpAllUnconfirmed failed")
+	}
+	for i, req := range reqs {
+		result := attemptResults[i]
+		sendErr := evmclient.NewSendError(req.Error)
+		if sendErr != nil && !sendErr.IsTransactionAlreadyInMempool() {
+			ec.lggr.Warnw("BumpAllUnconfirmed: replacement attempt was rejected", "ethTxID", result.EthTxID, "nonce", result.Nonce, "err", sendErr)
+			result.Status = BumpStatusFailed
+			result.Error = sendErr.Error()
+			continue
+		}
+		attempt := attempts[i]
+		if err := ec.saveManualAttempt(&attempt); err != nil {
+			ec.lggr.Errorw("BumpAllUnconfirmed: failed to save replacement attempt, it will not be tracked for a receipt", "ethTxID", result.EthTxID, "hash", attempt.Hash, "err", err)
+		}
+		result.Status = BumpStatusBumped
+		result.Hash = attempt.Hash
+	}
+	return results, nil
+}
+
+// newBumpAllAttempt validates the new pricing against the previous attempt of
+// etx and creates the replacement attempt. result is filled in either way.
+func (ec *EthConfirmer) newBumpAllAttempt(etx EthTx, gasPriceOrFeeCap, tipCap *big.Int, result *BumpResult) (attempt EthTxAttempt, err error) {
+	result.EthTxID = etx.ID
+	result.Nonce = *etx.Nonce
+	if len(etx.EthTxAttempts) == 0 {
+		return attempt, errors.New("transaction has no attempts")
+	}
+	for _, a := range etx.EthTxAttempts {
+		if a.State == EthTxAttemptInProgress {
+			return attempt, errors.New("transaction has an attempt in progress")
+		}
+	}
+	previous := etx.EthTxAttempts[0]
+	gasLimit := previous.ChainSpecificGasLimit
+	result.TxType = previous.TxType
+	result.GasLimit = gasLimit
+	result.NewPrice = gasPriceOrFeeCap
+
+	var previousPrice *big.Int
+	if previous.TxType == 2 {
+		previousPrice = previous.GasFeeCap.ToInt()
+		result.PreviousTipCap = previous.GasTipCap.ToInt()
+		result.NewTipCap = tipCap
+	} else {
+		previousPrice = previous.GasPrice.ToInt()
+	}
+	result.PreviousPrice = previousPrice
+	result.AdditionalCostWei = new(big.Int).Mul(new(big.Int).Sub(gasPriceOrFeeCap, previousPrice), new(big.Int).SetUint64(gasLimit))
+
+	if min := gas.MinReplacementPrice(ec.config, previousPrice); gasPriceOrFeeCap.Cmp(min) < 0 {
+		return attempt, errors.Errorf("%s wei is too low to replace the previous attempt at %s wei, it must be at least %s wei", gasPriceOrFeeCap.String(), previousPrice.String(), min.String())
+	}
+	if etx.MaxFeeWei != nil {
+		cost := new(big.Int).Mul(gasPriceOrFeeCap, new(big.Int).SetUint64(gasLimit))
+		if maxFee := etx.MaxFeeWei.ToInt(); cost.Cmp(maxFee) > 0 {
+			return attempt, errors.Wrapf(ErrTxMaxFeeExceeded, "worst case cost of %s wei exceeds max fee of %s wei", cost.String(), maxFee.String())
+		}
+	}
+
+	if previous.TxType != 2 {
+		return ec.NewLegacyAttempt(etx, gasPriceOrFeeCap, gasLimit)
+	}
+	if tipCap == nil {
+		return attempt, errors.New("a tip cap is required to replace a dynamic fee transaction")
+	}
+	if min := gas.MinReplacementPrice(ec.config, result.PreviousTipCap); tipCap.Cmp(min) < 0 {
+		return attempt, errors.Errorf("tip cap of %s wei is too low to replace the previous attempt at %s wei, it must be at least %s wei", tipCap.String(), result.PreviousTipCap.String(), min.String())
+	}
+	return ec.NewDynamicFeeAttempt(etx, gas.DynamicFee{FeeCap: gasPriceOrFeeCap, TipCap: tipCap}, gasLimit)
+}
+
+// findUnconfirmedEthTxsWithAttempts returns the unconfirmed transactions
+// from address in nonce order, with their attempts
+func findUnconfirmedEthTxsWithAttempts(ctx context.Context, q pg.Q, address gethCommon.Address, chainID big.Int) (etxs []*EthTx, err error) {
+	qq := q.WithOpts(pg.WithParentCtx(ctx))
+	err = qq.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Select(&etxs, `SELECT * FROM eth_txes WHERE state = 'unconfirmed' AND from_address = $1 AND evm_chain_id = $2 ORDER BY nonce ASC`, address, chainID.String()); err != nil {
+			return errors.Wrap(err, "findUnconfirmedEthTxsWithAttempts failed to load eth_txes")
+		}
+		err = loadEthTxesAttempts(tx, etxs)
+		return errors.Wrap(err, "findUnconfirmedEthTxsWithAttempts failed to load eth_tx_attempts")
+	}, pg.OptReadOnlyTx())
+	return
+}
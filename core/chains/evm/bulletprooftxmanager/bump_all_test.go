@@ -0,0 +1,201 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+func TestEthConfirmer_BumpAllUnconfirmed(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	config := newTestChainScopedConfig(t)
+
+	// Both previous attempts are priced at 1 wei, with a gas limit of 42
+	legacyEtx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+	dynamicEtx := cltest.MustInsertUnconfirmedEthTxWithBroadcastDynamicFeeAttempt(t, borm, 1, fromAddress)
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 2, 1, fromAddress)
+
+	gasPrice := assets.GWei(20)
+	tipCap := assets.GWei(10)
+	additionalCost := new(big.Int).Mul(new(big.Int).Sub(gasPrice, big.NewInt(1)), big.NewInt(42))
+
+	t.Run("dry run reports what would be bumped without sending anything", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+		results, err := ec.BumpAllUnconfirmed(context.Background(), fromAddress, gasPrice, tipCap, true)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		assert.Equal(t, legacyEtx.ID, results[0].EthTxID)
+		assert.Equal(t, int64(0), results[0].Nonce)
+		assert.Equal(t, 0, results[0].TxType)
+		assert.Equal(t, bulletprooftxmanager.BumpStatusWouldBump, results[0].Status)
+		assert.Equal(t, big.NewInt(1), results[0].PreviousPrice)
+		assert.Equal(t, gasPrice, results[0].NewPrice)
+		assert.Nil(t, results[0].NewTipCap)
+		assert.Equal(t, additionalCost, results[0].AdditionalCostWei)
+
+		assert.Equal(t, dynamicEtx.ID, results[1].EthTxID)
+		assert.Equal(t, 2, results[1].TxType)
+		assert.Equal(t, bulletprooftxmanager.BumpStatusWouldBump, results[1].Status)
+		assert.Equal(t, big.NewInt(1), results[1].PreviousTipCap)
+		assert.Equal(t, tipCap, results[1].NewTipCap)
+		assert.Equal(t, additionalCost, results[1].AdditionalCostWei)
+
+		etx, err := borm.FindEthTxWithAttempts(legacyEtx.ID)
+		require.NoError(t, err)
+		assert.Len(t, etx.EthTxAttempts, 1)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("skips dynamic fee transactions if no tip cap is given", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+		results, err := ec.BumpAllUnconfirmed(context.Background(), fromAddress, gasPrice, nil, true)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, bulletprooftxmanager.BumpStatusWouldBump, results[0].Status)
+		assert.Equal(t, bulletprooftxmanager.BumpStatusSkipped, results[1].Status)
+		assert.Contains(t, results[1].Error, "tip cap is required")
+	})
+
+	t.Run("skips transactions if the new price is too low to replace the previous attempt", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+		results, err := ec.BumpAllUnconfirmed(context.Background(), fromAddress, gasPrice, big.NewInt(2), true)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, bulletprooftxmanager.BumpStatusWouldBump, results[0].Status)
+		assert.Equal(t, bulletprooftxmanager.BumpStatusSkipped, results[1].Status)
+		assert.Contains(t, results[1].Error, "tip cap of 2 wei is too low")
+	})
+
+	t.Run("skips transactions if the new price exceeds the max gas price of the key", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+		tooHigh := new(big.Int).Add(config.KeySpecificMaxGasPriceWei(fromAddress), big.NewInt(1))
+		results, err := ec.BumpAllUnconfirmed(context.Background(), fromAddress, tooHigh, tipCap, true)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		for _, result := range results {
+			assert.Equal(t, bulletprooftxmanager.BumpStatusSkipped, result.Status)
+			assert.Contains(t, result.Error, "would exceed max configured gas price")
+		}
+	})
+
+	t.Run("bumps legacy and dynamic fee transactions in a single batch", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 2 && b[0].Method == "eth_sendRawTransaction" && b[1].Method == "eth_sendRawTransaction"
+		})).Return(nil).Once()
+
+		results, err := ec.BumpAllUnconfirmed(context.Background(), fromAddress, gasPrice, tipCap, false)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		ethClient.AssertExpectations(t)
+
+		etx, err := borm.FindEthTxWithAttempts(legacyEtx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+		attempt := etx.EthTxAttempts[0]
+		assert.Equal(t, bulletprooftxmanager.BumpStatusBumped, results[0].Status)
+		assert.Equal(t, attempt.Hash, results[0].Hash)
+		assert.True(t, attempt.IsManual)
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, attempt.State)
+		assert.Equal(t, 0, attempt.TxType)
+		assert.Equal(t, gasPrice, attempt.GasPrice.ToInt())
+		assert.Equal(t, uint64(42), attempt.ChainSpecificGasLimit)
+
+		etx, err = borm.FindEthTxWithAttempts(dynamicEtx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+		attempt = etx.EthTxAttempts[0]
+		assert.Equal(t, bulletprooftxmanager.BumpStatusBumped, results[1].Status)
+		assert.Equal(t, attempt.Hash, results[1].Hash)
+		assert.True(t, attempt.IsManual)
+		assert.Equal(t, 2, attempt.TxType)
+		assert.Equal(t, gasPrice, attempt.GasFeeCap.ToInt())
+		assert.Equal(t, tipCap, attempt.GasTipCap.ToInt())
+	})
+
+	t.Run("reports replacements rejected by the eth node as failed", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 2
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			elems[0].Error = errors.New("nonce too low")
+		}).Once()
+
+		results, err := ec.BumpAllUnconfirmed(context.Background(), fromAddress, assets.GWei(40), assets.GWei(20), false)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		ethClient.AssertExpectations(t)
+
+		assert.Equal(t, bulletprooftxmanager.BumpStatusFailed, results[0].Status)
+		assert.Contains(t, results[0].Error, "nonce too low")
+		assert.Equal(t, bulletprooftxmanager.BumpStatusBumped, results[1].Status)
+
+		etx, err := borm.FindEthTxWithAttempts(legacyEtx.ID)
+		require.NoError(t, err)
+		assert.Len(t, etx.EthTxAttempts, 2)
+		etx, err = borm.FindEthTxWithAttempts(dynamicEtx.ID)
+		require.NoError(t, err)
+		assert.Len(t, etx.EthTxAttempts, 3)
+	})
+
+	t.Run("waits for the EthConfirmer to finish bumping the key", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+		unlock := ec.LockKey(fromAddress)
+		chDone := make(chan struct{})
+		go func() {
+			defer close(chDone)
+			_, err := ec.BumpAllUnconfirmed(context.Background(), fromAddress, assets.GWei(100), assets.GWei(50), true)
+			assert.NoError(t, err)
+		}()
+
+		select {
+		case <-chDone:
+			t.Fatal("expected BumpAllUnconfirmed to wait for the key lock")
+		case <-time.After(100 * time.Millisecond):
+		}
+		unlock()
+
+		select {
+		case <-chDone:
+		case <-time.After(cltest.WaitTimeout(t)):
+			t.Fatal("timed out waiting for BumpAllUnconfirmed")
+		}
+	})
+}
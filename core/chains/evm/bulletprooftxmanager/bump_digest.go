@@ -0,0 +1,201 @@
+package bulletprooftxmanager
+
+import (
+	"math/big"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// BumpDigestReason is the reason sent with gas bump digests
+	BumpDigestReason = "gas_bump_digest"
+
+	// maxBumpDigestPriceSamples bounds the number of bumped prices kept per
+	// key for calculating the median. Beyond this, a uniform sample is kept.
+	maxBumpDigestPriceSamples = 1000
+	// bumpDigestOldestTxes is the number of oldest affected eth_tx IDs reported
+	bumpDigestOldestTxes = 5
+)
+
+// BumpDigest summarizes the gas bumps of a single key over one digest interval
+type BumpDigest struct {
+	Reason            string         `json:"reason"`
+	FromAddress       common.Address `json:"fromAddress"`
+	EVMChainID        string         `json:"evmChainID"`
+	NumBumps          int            `json:"numBumps"`
+	MinGasPriceWei    *utils.Big     `json:"minGasPriceWei"`
+	MaxGasPriceWei    *utils.Big     `json:"maxGasPriceWei"`
+	MedianGasPriceWei *utils.Big     `json:"medianGasPriceWei"`
+	// OldestEthTxIDs are the IDs of the oldest transactions that were bumped
+	// in this interval, oldest first
+	OldestEthTxIDs []int64   `json:"oldestEthTxIDs"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+type keyBumps struct {
+	count     int
+	min, max  *big.Int
+	samples   []*big.Int
+	oldestIDs []int64
+}
+
+func (k *keyBumps) add(etxID int64, price *big.Int) {
+	k.count++
+	if k.min == nil || price.Cmp(k.min) < 0 {
+		k.min = price
+	}
+	if k.max == nil || price.Cmp(k.max) > 0 {
+		k.max = price
+	}
+	// Reservoir sampling keeps memory bounded no matter how many bumps there
+	// are in an interval
+	if len(k.samples) < maxBumpDigestPriceSamples {
+		k.samples = append(k.samples, price)
+	} else if i := rand.Intn(k.count); i < maxBumpDigestPriceSamples {
+		k.samples[i] = price
+	}
+	k.addOldestID(etxID)
+}
+
+// addOldestID keeps the lowest (i.e. oldest) distinct eth_tx IDs, sorted
+func (k *keyBumps) addOldestID(etxID int64) {
+	i := sort.Search(len(k.oldestIDs), func(i int) bool { return k.oldestIDs[i] >= etxID })
+	if i < len(k.oldestIDs) && k.oldestIDs[i] == etxID {
+		return
+	}
+	if i >= bumpDigestOldestTxes {
+		return
+	}
+	k.oldestIDs = append(k.oldestIDs, 0)
+	copy(k.oldestIDs[i+1:], k.oldestIDs[i:])
+	k.oldestIDs[i] = etxID
+	if len(k.oldestIDs) > bumpDigestOldestTxes {
+		k.oldestIDs = k.oldestIDs[:bumpDigestOldestTxes]
+	}
+}
+
+func (k *keyBumps) median() *big.Int {
+	sorted := make([]*big.Int, len(k.samples))
+	copy(sorted, k.samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	sum := new(big.Int).Add(sorted[n/2-1], sorted[n/2])
+	return sum.Div(sum, big.NewInt(2))
+}
+
+// BumpDigester aggregates gas bumps per key and emits a single digest per
+// key every ETH_TX_BUMP_DIGEST_INTERVAL, instead of one log line per bump
+type BumpDigester struct {
+	interval time.Duration
+	chainID  big.Int
+	log      logger.Logger
+	webhook  *FailureWebhook
+
+	mu    sync.Mutex
+	bumps map[common.Address]*keyBumps
+
+	chStop chan struct{}
+	chDone chan struct{}
+}
+
+// NewBumpDigester instantiates a new bump digester. webhook may be nil.
+func NewBumpDigester(lggr logger.Logger, interval time.Duration, chainID big.Int, webhook *FailureWebhook) *BumpDigester {
+	return &BumpDigester{
+		interval: interval,
+		chainID:  chainID,
+		log:      lggr.Named("BumpDigester"),
+		webhook:  webhook,
+		bumps:    make(map[common.Address]*keyBumps),
+		chStop:   make(chan struct{}),
+		chDone:   make(chan struct{}),
+	}
+}
+
+// Start the digester. Should only be called once.
+func (d *BumpDigester) Start() {
+	d.log.Debugf("BumpDigester: started with interval %v", d.interval)
+	go d.runLoop()
+}
+
+// Stop the digester. Should only be called once. Any bumps recorded since
+// the last digest are flushed.
+func (d *BumpDigester) Stop() {
+	d.log.Debug("BumpDigester: stopping")
+	close(d.chStop)
+	<-d.chDone
+	d.Flush()
+}
+
+// Record adds a gas bump of the given transaction to the current digest
+func (d *BumpDigester) Record(fromAddress common.Address, etxID int64, bumpedPrice *big.Int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	k, exists := d.bumps[fromAddress]
+	if !exists {
+		k = &keyBumps{}
+		d.bumps[fromAddress] = k
+	}
+	k.add(etxID, bumpedPrice)
+}
+
+// Flush emits a digest for every key that was bumped since the last flush,
+// and resets the aggregates
+func (d *BumpDigester) Flush() (digests []BumpDigest) {
+	d.mu.Lock()
+	bumps := d.bumps
+	d.bumps = make(map[common.Address]*keyBumps)
+	d.mu.Unlock()
+
+	now := time.Now()
+	for address, k := range bumps {
+		digest := BumpDigest{
+			Reason:            BumpDigestReason,
+			FromAddress:       address,
+			EVMChainID:        d.chainID.String(),
+			NumBumps:          k.count,
+			MinGasPriceWei:    utils.NewBig(k.min),
+			MaxGasPriceWei:    utils.NewBig(k.max),
+			MedianGasPriceWei: utils.NewBig(k.median()),
+			OldestEthTxIDs:    k.oldestIDs,
+			Timestamp:         now,
+		}
+		d.log.Infow("Gas bump digest",
+			"fromAddress", address,
+			"interval", d.interval,
+			"numBumps", digest.NumBumps,
+			"minGasPriceWei", digest.MinGasPriceWei,
+			"maxGasPriceWei", digest.MaxGasPriceWei,
+			"medianGasPriceWei", digest.MedianGasPriceWei,
+			"oldestEthTxIDs", digest.OldestEthTxIDs,
+		)
+		if d.webhook != nil {
+			d.webhook.NotifyBumpDigest(digest)
+		}
+		digests = append(digests, digest)
+	}
+	return digests
+}
+
+func (d *BumpDigester) runLoop() {
+	defer close(d.chDone)
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.chStop:
+			return
+		case <-ticker.C:
+			d.Flush()
+		}
+	}
+}
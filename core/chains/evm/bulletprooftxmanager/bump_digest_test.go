@@ -0,0 +1,126 @@
+package bulletprooftxmanager_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestBumpDigester(t *testing.T) {
+	t.Parallel()
+
+	fromAddress := cltest.NewAddress()
+
+	t.Run("aggregates 500 bumps into a single digest", func(t *testing.T) {
+		digests := make(chan bulletprooftxmanager.BumpDigest, 10)
+		u := newBumpDigestServer(t, digests)
+
+		w := bulletprooftxmanager.NewFailureWebhook(logger.TestLogger(t), *u)
+		w.Start()
+		t.Cleanup(w.Stop)
+		d := bulletprooftxmanager.NewBumpDigester(logger.TestLogger(t), time.Hour, cltest.FixtureChainID, w)
+
+		// 100 transactions bumped 5 times each, prices 1..500 in no
+		// particular order
+		for i := 0; i < 500; i++ {
+			etxID := int64(100 - i%100)
+			price := big.NewInt(int64((i*7)%500 + 1))
+			d.Record(fromAddress, etxID, price)
+		}
+
+		flushed := d.Flush()
+		require.Len(t, flushed, 1)
+		digest := flushed[0]
+		assert.Equal(t, bulletprooftxmanager.BumpDigestReason, digest.Reason)
+		assert.Equal(t, fromAddress, digest.FromAddress)
+		assert.Equal(t, cltest.FixtureChainID.String(), digest.EVMChainID)
+		assert.Equal(t, 500, digest.NumBumps)
+		assert.Equal(t, "1", digest.MinGasPriceWei.String())
+		assert.Equal(t, "500", digest.MaxGasPriceWei.String())
+		assert.Equal(t, "250", digest.MedianGasPriceWei.String())
+		assert.Equal(t, []int64{1, 2, 3, 4, 5}, digest.OldestEthTxIDs)
+
+		select {
+		case delivered := <-digests:
+			assert.Equal(t, 500, delivered.NumBumps)
+			assert.Equal(t, digest.OldestEthTxIDs, delivered.OldestEthTxIDs)
+			assert.Equal(t, digest.MedianGasPriceWei.String(), delivered.MedianGasPriceWei.String())
+		case <-time.After(cltest.WaitTimeout(t)):
+			t.Fatal("timed out waiting for digest")
+		}
+		select {
+		case delivered := <-digests:
+			t.Fatalf("unexpected second digest: %v", delivered)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		// Aggregates are reset after a flush
+		assert.Len(t, d.Flush(), 0)
+	})
+
+	t.Run("emits one digest per key", func(t *testing.T) {
+		d := bulletprooftxmanager.NewBumpDigester(logger.TestLogger(t), time.Hour, cltest.FixtureChainID, nil)
+
+		otherAddress := cltest.NewAddress()
+		d.Record(fromAddress, 1, big.NewInt(10))
+		d.Record(fromAddress, 1, big.NewInt(20))
+		d.Record(otherAddress, 2, big.NewInt(30))
+
+		flushed := d.Flush()
+		require.Len(t, flushed, 2)
+		for _, digest := range flushed {
+			switch digest.FromAddress {
+			case fromAddress:
+				assert.Equal(t, 2, digest.NumBumps)
+				assert.Equal(t, "15", digest.MedianGasPriceWei.String())
+				assert.Equal(t, []int64{1}, digest.OldestEthTxIDs)
+			case otherAddress:
+				assert.Equal(t, 1, digest.NumBumps)
+				assert.Equal(t, "30", digest.MedianGasPriceWei.String())
+				assert.Equal(t, []int64{2}, digest.OldestEthTxIDs)
+			default:
+				t.Fatalf("unexpected digest for %s", digest.FromAddress.Hex())
+			}
+		}
+	})
+
+	t.Run("flushes periodically", func(t *testing.T) {
+		digests := make(chan bulletprooftxmanager.BumpDigest, 10)
+		u := newBumpDigestServer(t, digests)
+
+		w := bulletprooftxmanager.NewFailureWebhook(logger.TestLogger(t), *u)
+		w.Start()
+		t.Cleanup(w.Stop)
+		d := bulletprooftxmanager.NewBumpDigester(logger.TestLogger(t), 100*time.Millisecond, cltest.FixtureChainID, w)
+		d.Start()
+		t.Cleanup(d.Stop)
+
+		d.Record(fromAddress, 1, big.NewInt(10))
+
+		select {
+		case delivered := <-digests:
+			assert.Equal(t, 1, delivered.NumBumps)
+		case <-time.After(cltest.WaitTimeout(t)):
+			t.Fatal("timed out waiting for digest")
+		}
+	})
+}
+
+func newBumpDigestServer(t *testing.T, digests chan<- bulletprooftxmanager.BumpDigest) *url.URL {
+	return newWebhookServer(t, func(w http.ResponseWriter, body []byte) {
+		var digest bulletprooftxmanager.BumpDigest
+		assert.NoError(t, json.Unmarshal(body, &digest))
+		assert.Equal(t, bulletprooftxmanager.BumpDigestReason, digest.Reason)
+		digests <- digest
+	})
+}
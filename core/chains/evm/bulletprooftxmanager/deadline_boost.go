@@ -0,0 +1,114 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// deadlineBoostFactor returns how much gas bumping is accelerated for a
+// transaction with the given deadline at time now. It is 1, i.e. no boost, if
+// the transaction has no deadline, the deadline is too far away, or the
+// deadline has already passed.
+func deadlineBoostFactor(curve []evmconfig.DeadlineBoostStage, deadline *time.Time, now time.Time) uint32 {
+	if deadline == nil || !now.Before(*deadline) {
+		return 1
+	}
+	remaining := deadline.Sub(now)
+	factor := uint32(1)
+	// The curve is ordered longest remaining first, so the last stage that
+	// applies is the one closest to the deadline
+	for _, stage := range curve {
+		if remaining <= stage.Remaining {
+			factor = stage.Factor
+		}
+	}
+	return factor
+}
+
+// boostedGasBumpThreshold divides the gas bump threshold by the boost factor,
+// rounding up so that it is never less than one block
+func boostedGasBumpThreshold(gasBumpThreshold int64, factor uint32) int64 {
+	f := int64(factor)
+	return (gasBumpThreshold + f - 1) / f
+}
+
+// FindEthTxsRequiringDeadlineBoost returns transactions close enough to their
+// deadline to be boosted, whose attempts have all been unconfirmed for at
+// least the boosted gas bump threshold. These transactions may not have
+// reached the normal gas bump threshold yet.
+func FindEthTxsRequiringDeadlineBoost(ctx context.Context, q pg.Q, address gethCommon.Address, blockNum, gasBumpThreshold, depth int64, curve []evmconfig.DeadlineBoostStage, now time.Time, chainID big.Int) (etxs []*EthTx, err error) {
+	if gasBumpThreshold == 0 || len(curve) == 0 {
+		return
+	}
+	var candidates []*EthTx
+	qq := q.WithOpts(pg.WithParentCtx(ctx))
+	err = qq.Transaction(func(tx pg.Queryer) error {
+		stmt := `
+SELECT eth_txes.* FROM eth_txes
+WHERE eth_txes.state = 'unconfirmed' AND eth_txes.from_address = $1 AND eth_txes.evm_chain_id = $2
+	AND eth_txes.deadline > $4 AND eth_txes.deadline <= $5
+	AND (($3 = 0) OR (eth_txes.id IN (SELECT id FROM eth_txes WHERE state = 'unconfirmed' AND from_address = $1 ORDER BY nonce ASC LIMIT $3)))
+ORDER BY nonce ASC
+`
+		if err = tx.Select(&candidates, stmt, address, chainID.String(), depth, now, now.Add(curve[0].Remaining)); err != nil {
+			return errors.Wrap(err, "FindEthTxsRequiringDeadlineBoost failed to load eth_txes")
+		}
+		err = loadEthTxesAttempts(tx, candidates)
+		return errors.Wrap(err, "FindEthTxsRequiringDeadlineBoost failed to load eth_tx_attempts")
+	}, pg.OptReadOnlyTx())
+	if err != nil {
+		return nil, err
+	}
+	for _, etx := range candidates {
+		threshold := boostedGasBumpThreshold(gasBumpThreshold, deadlineBoostFactor(curve, etx.Deadline, now))
+		if allAttemptsBroadcastBefore(etx.EthTxAttempts, blockNum-threshold) {
+			etxs = append(etxs, etx)
+		}
+	}
+	return etxs, nil
+}
+
+// allAttemptsBroadcastBefore mirrors the condition used by
+// FindEthTxsRequiringGasBump: every attempt must have been broadcast at or
+// before blockNum
+func allAttemptsBroadcastBefore(attempts []EthTxAttempt, blockNum int64) bool {
+	if len(attempts) == 0 {
+		return false
+	}
+	for _, attempt := range attempts {
+		if attempt.State != EthTxAttemptBroadcast || attempt.BroadcastBeforeBlockNum == nil || *attempt.BroadcastBeforeBlockNum > blockNum {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeEthTxsForRebroadcast adds the boosted transactions that are not
+// already due for rebroadcast, keeping nonce ASC order and the limit on
+// transactions in flight
+func mergeEthTxsForRebroadcast(etxs, boosted []*EthTx, maxInFlightTransactions uint32) []*EthTx {
+	seen := make(map[int64]struct{}, len(etxs))
+	for _, etx := range etxs {
+		seen[etx.ID] = struct{}{}
+	}
+	for _, etx := range boosted {
+		if _, exists := seen[etx.ID]; !exists {
+			etxs = append(etxs, etx)
+		}
+	}
+	sort.Slice(etxs, func(i, j int) bool {
+		return *(etxs[i].Nonce) < *(etxs[j].Nonce)
+	})
+	if maxInFlightTransactions > 0 && len(etxs) > int(maxInFlightTransactions) {
+		etxs = etxs[:maxInFlightTransactions]
+	}
+	return etxs
+}
@@ -8,15 +8,20 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/atomic"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/sqlx"
 
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -31,6 +36,17 @@ const InFlightTransactionRecheckInterval = 1 * time.Second
 
 var errEthTxRemoved = errors.New("eth_tx removed")
 
+var promTimeUntilBroadcast = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "bptxm_time_until_broadcast_seconds",
+	Help:    "Time from when a transaction was created until it was first successfully broadcast. Does not include time spent waiting to be confirmed on-chain",
+	Buckets: []float64{0.1, 0.5, 1, 2, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+}, []string{"evmChainID"})
+
+var promInitialSendBumps = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bptxm_initial_send_bumps_total",
+	Help: "Number of times gas was bumped because the eth node rejected the initial send of a transaction as underpriced",
+}, []string{"evmChainID"})
+
 // EthBroadcaster monitors eth_txes for transactions that need to
 // be broadcast, assigns nonces and ensures that at least one eth node
 // somewhere has received the transaction successfully.
@@ -51,17 +67,39 @@ type EthBroadcaster struct {
 	ethClient evmclient.Client
 	ChainKeyStore
 	estimator      gas.Estimator
-	resumeCallback ResumeCallback
+	resumer        *resumer
+	failureWebhook *FailureWebhook
+	outcomeEmitter *OutcomeEmitter
+	// simulationCache is optional, see
+	// BulletproofTxManager.SetSimulationCache
+	simulationCache *evmclient.SimulationCache
+
+	insufficientEthBackoff *insufficientEthBackoff
+	haltDetector           *haltDetector
+	heartbeats             *heartbeats
+	nonceHoles             *nonceHoles
+	pacer                  *pacer
+	// nonceSyncer is kept so that the external transactions it found are
+	// remembered across syncs
+	nonceSyncer *NonceSyncer
 
 	ethTxInsertListener pg.Subscription
 	eventBroadcaster    pg.EventBroadcaster
 
+	// keysMu guards keyStates and queues, since keys may be added or
+	// removed at runtime
+	keysMu    sync.RWMutex
 	keyStates []ethkey.State
+	// queues serializes the work for each key, each drained by its own
+	// monitor goroutine
+	queues map[gethCommon.Address]*keyQueue
 
-	// triggers allow other goroutines to force EthBroadcaster to rescan the
-	// database early (before the next poll interval)
-	// Each key has its own trigger
-	triggers map[gethCommon.Address]chan struct{}
+	healthMu sync.RWMutex
+	health   map[gethCommon.Address]keyHealth
+
+	// draining is set by Drain, after which no new unstarted transactions
+	// are picked up
+	draining atomic.Bool
 
 	chStop chan struct{}
 	wg     sync.WaitGroup
@@ -73,11 +111,10 @@ type EthBroadcaster struct {
 func NewEthBroadcaster(db *sqlx.DB, ethClient evmclient.Client, config Config, keystore KeyStore,
 	eventBroadcaster pg.EventBroadcaster,
 	keyStates []ethkey.State, estimator gas.Estimator, resumeCallback ResumeCallback,
-	logger logger.Logger) *EthBroadcaster {
+	resumeBatchCallback ResumeBatchCallback, logger logger.Logger) *EthBroadcaster {
 
-	triggers := make(map[gethCommon.Address]chan struct{})
 	logger = logger.Named("EthBroadcaster")
-	return &EthBroadcaster{
+	eb := &EthBroadcaster{
 		logger:    logger,
 		db:        db,
 		q:         pg.NewQ(db, logger, config),
@@ -87,44 +124,73 @@ func NewEthBroadcaster(db *sqlx.DB, ethClient evmclient.Client, config Config, k
 			config:   config,
 			keystore: keystore,
 		},
-		estimator:        estimator,
-		eventBroadcaster: eventBroadcaster,
-		keyStates:        keyStates,
-		triggers:         triggers,
-		chStop:           make(chan struct{}),
-		wg:               sync.WaitGroup{},
+		estimator:              estimator,
+		resumer:                newResumer(config, resumeCallback, resumeBatchCallback),
+		eventBroadcaster:       eventBroadcaster,
+		keyStates:              keyStates,
+		queues:                 make(map[gethCommon.Address]*keyQueue),
+		health:                 make(map[gethCommon.Address]keyHealth),
+		insufficientEthBackoff: newInsufficientEthBackoff(config),
+		haltDetector:           newHaltDetector(config, logger),
+		pacer:                  newPacer(),
+		nonceSyncer:            newChainNonceSyncer(db, logger, config, ethClient),
+		outcomeEmitter:         NewOutcomeEmitter(logger, NewOutcomeSink(logger, config)),
+		chStop:                 make(chan struct{}),
+		wg:                     sync.WaitGroup{},
+	}
+	eb.heartbeats = newHeartbeats(eb.q, eb.chainID)
+	eb.nonceHoles = newNonceHoles(eb.chainID)
+	if u := config.EthTxFailureWebhookURL(); u != nil {
+		eb.failureWebhook = NewFailureWebhook(logger, *u)
 	}
+	return eb
 }
 
 func (eb *EthBroadcaster) Start() error {
 	return eb.StartOnce("EthBroadcaster", func() (err error) {
+		if err = eb.checkForDuplicateInstances(eb.addresses()); err != nil {
+			return errors.Wrap(err, "EthBroadcaster could not start")
+		}
+
 		eb.ethTxInsertListener, err = eb.eventBroadcaster.Subscribe(pg.ChannelInsertOnEthTx, "")
 		if err != nil {
 			return errors.Wrap(err, "EthBroadcaster could not start")
 		}
 
-		if eb.config.EvmNonceAutoSync() {
-			ctx, cancel := utils.CombinedContext(context.Background(), eb.chStop)
-			defer cancel()
-
-			syncer := NewNonceSyncer(eb.db, eb.logger, eb.ChainKeyStore.config, eb.ethClient)
-			if ctx.Err() != nil {
-				return nil
-			} else if err := syncer.SyncAll(ctx, eb.keyStates); err != nil {
-				return errors.Wrap(err, "EthBroadcaster failed to sync with on-chain nonce")
-			}
+		// Synced without holding keysMu, since it calls the eth node. Keys
+		// cannot be added or removed meanwhile, as that waits for Start.
+		if err := eb.syncNonces(eb.keyStatesCopy()); err != nil {
+			eb.ethTxInsertListener.Close()
+			eb.ethTxInsertListener = nil
+			return err
 		}
 
-		eb.wg.Add(len(eb.keyStates))
+		eb.keysMu.Lock()
+		defer eb.keysMu.Unlock()
+
 		for _, k := range eb.keyStates {
-			triggerCh := make(chan struct{}, 1)
-			eb.triggers[k.Address.Address()] = triggerCh
-			go eb.monitorEthTxs(k, triggerCh)
+			eb.startMonitor(k)
+		}
+
+		if eb.failureWebhook != nil {
+			eb.failureWebhook.Start()
 		}
+		eb.outcomeEmitter.Start()
 
 		eb.wg.Add(1)
 		go eb.ethTxInsertTriggerer()
 
+		if err := eb.heartbeats.beat(eb.addressesLocked()); err != nil {
+			eb.logger.Errorw("Failed to record heartbeat", "err", err)
+		}
+		eb.wg.Add(1)
+		go eb.heartbeatLoop()
+
+		if interval := eb.config.EvmNonceAutoSyncInterval(); interval > 0 && eb.config.EvmNonceAutoSync() {
+			eb.wg.Add(1)
+			go eb.nonceSyncLoop(interval)
+		}
+
 		return nil
 	})
 }
@@ -138,23 +204,354 @@ func (eb *EthBroadcaster) Close() error {
 		close(eb.chStop)
 		eb.wg.Wait()
 
+		if err := eb.heartbeats.clear(); err != nil {
+			eb.logger.Errorw("Failed to clear heartbeats", "err", err)
+		}
+
+		if eb.failureWebhook != nil {
+			eb.failureWebhook.Stop()
+		}
+		eb.outcomeEmitter.Stop()
+
 		return nil
 	})
 }
 
+// DrainPollInterval is how often Drain checks whether in_progress
+// transactions have finished sending
+var DrainPollInterval = 100 * time.Millisecond
+
+// Drain stops the EthBroadcaster from picking up any new unstarted
+// transactions, and waits for any in_progress transactions to finish sending
+// and become unconfirmed or fatally errored. This avoids leaving transactions
+// in_progress on a planned shutdown, where they would otherwise be recovered
+// on the next start. Close should still be called afterwards.
+//
+// Returns an error if ctx expires before all keys have finished.
+func (eb *EthBroadcaster) Drain(ctx context.Context) error {
+	if eb.State() != utils.StartStopOnce_Started {
+		return errors.New("cannot drain EthBroadcaster, it is not running")
+	}
+	if !eb.draining.CAS(false, true) {
+		return errors.New("EthBroadcaster is already draining")
+	}
+	eb.logger.Info("Draining EthBroadcaster")
+
+	// Trigger every key so that in_progress transactions left over from an
+	// earlier failed send are retried now rather than on the next poll
+	addresses := eb.addresses()
+	for _, address := range addresses {
+		eb.Trigger(address)
+	}
+
+	ticker := time.NewTicker(DrainPollInterval)
+	defer ticker.Stop()
+	for {
+		n, err := eb.countDraining(ctx, addresses)
+		if ctx.Err() == nil && err != nil {
+			return errors.Wrap(err, "EthBroadcaster failed to drain")
+		} else if err == nil && n == 0 {
+			eb.logger.Info("EthBroadcaster drained")
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "EthBroadcaster failed to drain, %d key(s) still sending", n)
+		case <-ticker.C:
+		}
+	}
+}
+
+// countDraining returns the number of keys that are still processing or have
+// a transaction in_progress
+func (eb *EthBroadcaster) countDraining(ctx context.Context, addresses []gethCommon.Address) (n int, err error) {
+	eb.keysMu.RLock()
+	busy := make(map[gethCommon.Address]bool, len(eb.queues))
+	for address, kq := range eb.queues {
+		busy[address] = kq.isBusy()
+	}
+	eb.keysMu.RUnlock()
+
+	var inProgress []gethCommon.Address
+	err = eb.q.WithOpts(pg.WithParentCtx(ctx)).Select(&inProgress, `SELECT from_address FROM eth_txes WHERE state = 'in_progress' AND evm_chain_id = $1`, eb.chainID.String())
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to load in_progress transactions")
+	}
+	for _, address := range inProgress {
+		busy[address] = true
+	}
+	for _, address := range addresses {
+		if busy[address] {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// syncNonces syncs the next nonce of the given keys with the chain, if
+// ETH_NONCE_AUTO_SYNC is enabled
+func (eb *EthBroadcaster) syncNonces(keyStates []ethkey.State) error {
+	if !eb.config.EvmNonceAutoSync() {
+		return nil
+	}
+	ctx, cancel := utils.CombinedContext(context.Background(), eb.chStop)
+	defer cancel()
+
+	if ctx.Err() != nil {
+		return nil
+	} else if err := eb.nonceSyncer.SyncAll(ctx, keyStates); err != nil {
+		return errors.Wrap(err, "EthBroadcaster failed to sync with on-chain nonce")
+	}
+	return nil
+}
+
+// nonceSyncLoop re-syncs the nonces of idle keys every interval until the
+// EthBroadcaster is closed, so that drift introduced while the node is running,
+// e.g. by an external wallet, is corrected without a restart
+func (eb *EthBroadcaster) nonceSyncLoop(interval time.Duration) {
+	defer eb.wg.Done()
+	ctx, cancel := utils.ContextFromChan(eb.chStop)
+	defer cancel()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			eb.SyncIdleNonces(ctx)
+		}
+	}
+}
+
+// SyncIdleNonces syncs the next nonce of every key that has no unstarted,
+// in_progress or unconfirmed transactions with the chain. Keys with pending
+// transactions are skipped, since the EthBroadcaster is using their nonces.
+func (eb *EthBroadcaster) SyncIdleNonces(ctx context.Context) {
+	for _, k := range eb.keyStatesCopy() {
+		address := k.Address.Address()
+		err := eb.withKeyProcessing(address, func() error {
+			pending, err := eb.hasPendingTxes(ctx, address)
+			if err != nil {
+				return err
+			} else if pending {
+				eb.logger.Debugw("EthBroadcaster: key has pending transactions, skipping nonce sync", "address", address)
+				return nil
+			}
+			return eb.nonceSyncer.Sync(ctx, k)
+		})
+		if err != nil && ctx.Err() == nil {
+			eb.logger.Errorw("EthBroadcaster: failed to sync nonce", "address", address, "error", err)
+		}
+	}
+}
+
+// SyncNonce syncs the next nonce of a key with the chain, whether or not it
+// has pending transactions. Like NonceSyncer, it only ever moves the nonce
+// forward.
+func (eb *EthBroadcaster) SyncNonce(ctx context.Context, address gethCommon.Address) error {
+	for _, k := range eb.keyStatesCopy() {
+		if k.Address.Address() != address {
+			continue
+		}
+		return eb.withKeyProcessing(address, func() error {
+			return eb.nonceSyncer.Sync(ctx, k)
+		})
+	}
+	return errors.Errorf("key %s is not registered with this EthBroadcaster", address.Hex())
+}
+
+// withKeyProcessing runs fn serialized with the processing of the key's
+// transactions. If the key has no monitor yet, fn is run straight away.
+func (eb *EthBroadcaster) withKeyProcessing(address gethCommon.Address, fn func() error) (err error) {
+	eb.keysMu.RLock()
+	kq, exists := eb.queues[address]
+	eb.keysMu.RUnlock()
+	if !exists {
+		return fn()
+	}
+	if !kq.process(func() { err = fn() }) {
+		return errors.Errorf("key %s has been removed", address.Hex())
+	}
+	return err
+}
+
+// hasPendingTxes returns true if the key has transactions that have been, or
+// are about to be, assigned a nonce and are not yet confirmed
+func (eb *EthBroadcaster) hasPendingTxes(ctx context.Context, address gethCommon.Address) (pending bool, err error) {
+	err = eb.q.WithOpts(pg.WithParentCtx(ctx)).Get(&pending, `SELECT EXISTS (
+	SELECT 1 FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND state IN ('unstarted', 'in_progress', 'unconfirmed')
+)`, address, eb.chainID.String())
+	return pending, errors.Wrap(err, "failed to check for pending transactions")
+}
+
+// keyStatesCopy returns a copy of the registered keys
+func (eb *EthBroadcaster) keyStatesCopy() []ethkey.State {
+	eb.keysMu.RLock()
+	defer eb.keysMu.RUnlock()
+	return append([]ethkey.State(nil), eb.keyStates...)
+}
+
+// startMonitor starts the monitor goroutine for k. Caller must hold keysMu.
+func (eb *EthBroadcaster) startMonitor(k ethkey.State) {
+	kq := newKeyQueue()
+	eb.queues[k.Address.Address()] = kq
+	eb.wg.Add(1)
+	go eb.monitorEthTxs(k, kq)
+}
+
+// AddKey starts broadcasting transactions for a key that was added after the
+// EthBroadcaster was created. The key's nonce is synced first if
+// ETH_NONCE_AUTO_SYNC is enabled.
+func (eb *EthBroadcaster) AddKey(state ethkey.State) error {
+	address := state.Address.Address()
+	if state.EVMChainID.ToInt().Cmp(&eb.chainID) != 0 {
+		return errors.Errorf("cannot add key %s for chain %s to EthBroadcaster for chain %s", address.Hex(), state.EVMChainID.String(), eb.chainID.String())
+	}
+
+	// Hold the StartStopOnce lock so that this cannot interleave with Start
+	// or Close
+	eb.RLock()
+	defer eb.RUnlock()
+
+	if eb.hasKey(address) {
+		return errors.Errorf("key %s is already registered with this EthBroadcaster", address.Hex())
+	}
+
+	ebState := eb.State()
+	switch ebState {
+	case utils.StartStopOnce_Unstarted, utils.StartStopOnce_Starting:
+		// Start will pick it up
+	case utils.StartStopOnce_Started:
+		// Synced without holding keysMu, since it calls the eth node
+		if err := eb.syncNonces([]ethkey.State{state}); err != nil {
+			return err
+		}
+	default:
+		return errors.Errorf("cannot add key %s, EthBroadcaster has been stopped", address.Hex())
+	}
+
+	eb.keysMu.Lock()
+	defer eb.keysMu.Unlock()
+	// The key may have been added concurrently while its nonce was synced
+	if eb.hasKeyLocked(address) {
+		return errors.Errorf("key %s is already registered with this EthBroadcaster", address.Hex())
+	}
+	if ebState == utils.StartStopOnce_Started {
+		eb.startMonitor(state)
+	}
+	eb.keyStates = append(eb.keyStates, state)
+	eb.logger.Infow("Added key", "address", address)
+	return nil
+}
+
+// hasKey returns whether the key is registered with this EthBroadcaster
+func (eb *EthBroadcaster) hasKey(address gethCommon.Address) bool {
+	eb.keysMu.RLock()
+	defer eb.keysMu.RUnlock()
+	return eb.hasKeyLocked(address)
+}
+
+// hasKeyLocked is like hasKey. Caller must hold keysMu.
+func (eb *EthBroadcaster) hasKeyLocked(address gethCommon.Address) bool {
+	for _, k := range eb.keyStates {
+		if k.Address.Address() == address {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveKey stops broadcasting transactions for a key. It blocks until any
+// transaction currently being sent for the key has been handled. Unstarted
+// transactions for the key are left in place, and will be picked up again if
+// the key is re-added.
+func (eb *EthBroadcaster) RemoveKey(address gethCommon.Address) error {
+	kq, exists, err := eb.unregisterKey(address)
+	if err != nil {
+		return err
+	}
+
+	// Drained without holding the StartStopOnce lock, so that Close is never
+	// stuck behind a worker that is slow to notice it has been stopped
+	if exists {
+		kq.drain()
+	}
+
+	eb.healthMu.Lock()
+	delete(eb.health, address)
+	eb.healthMu.Unlock()
+	eb.insufficientEthBackoff.reset(address)
+	eb.nonceHoles.remove(address)
+
+	eb.logger.Infow("Removed key", "address", address)
+	return nil
+}
+
+// unregisterKey removes the key from the set of keys being broadcast for, and
+// returns its queue, if it has one
+func (eb *EthBroadcaster) unregisterKey(address gethCommon.Address) (*keyQueue, bool, error) {
+	eb.RLock()
+	defer eb.RUnlock()
+	eb.keysMu.Lock()
+	defer eb.keysMu.Unlock()
+
+	idx := -1
+	for i, k := range eb.keyStates {
+		if k.Address.Address() == address {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, false, errors.Errorf("key %s is not registered with this EthBroadcaster", address.Hex())
+	}
+	eb.keyStates = append(eb.keyStates[:idx:idx], eb.keyStates[idx+1:]...)
+	kq, exists := eb.queues[address]
+	delete(eb.queues, address)
+	return kq, exists, nil
+}
+
+// OnNewHead records that the chain is still producing heads. If broadcasting
+// was paused because the chain had halted, every key is triggered so that it
+// resumes straight away.
+func (eb *EthBroadcaster) OnNewHead(head *evmtypes.Head) {
+	if !eb.haltDetector.onNewHead(head) {
+		return
+	}
+	for _, address := range eb.addresses() {
+		eb.Trigger(address)
+	}
+}
+
+// addresses returns the addresses of all registered keys
+func (eb *EthBroadcaster) addresses() []gethCommon.Address {
+	eb.keysMu.RLock()
+	defer eb.keysMu.RUnlock()
+	return eb.addressesLocked()
+}
+
+// addressesLocked is like addresses. Caller must hold keysMu.
+func (eb *EthBroadcaster) addressesLocked() []gethCommon.Address {
+	addresses := make([]gethCommon.Address, 0, len(eb.keyStates))
+	for _, k := range eb.keyStates {
+		addresses = append(addresses, k.Address.Address())
+	}
+	return addresses
+}
+
 // Trigger forces the monitor for a particular address to recheck for new eth_txes
-// Logs error and does nothing if address was not registered on startup
+// Does nothing if the address is not registered with this EthBroadcaster
 func (eb *EthBroadcaster) Trigger(addr gethCommon.Address) {
 	ok := eb.IfStarted(func() {
-		triggerCh, exists := eb.triggers[addr]
+		eb.keysMu.RLock()
+		defer eb.keysMu.RUnlock()
+		kq, exists := eb.queues[addr]
 		if !exists {
 			// ignoring trigger for address which is not registered with this EthBroadcaster
 			return
 		}
-		select {
-		case triggerCh <- struct{}{}:
-		default:
-		}
+		kq.enqueue()
 	})
 
 	if !ok {
@@ -180,16 +577,42 @@ func (eb *EthBroadcaster) ethTxInsertTriggerer() {
 	}
 }
 
-func (eb *EthBroadcaster) monitorEthTxs(k ethkey.State, triggerCh chan struct{}) {
-	ctx, cancel := utils.CombinedContext(context.Background(), eb.chStop)
+func (eb *EthBroadcaster) monitorEthTxs(k ethkey.State, kq *keyQueue) {
+	ctx, cancel := utils.CombinedContext(context.Background(), eb.chStop, kq.stopped())
 	defer cancel()
 
 	defer eb.wg.Done()
+	defer kq.done()
+	lastActiveAt := time.Now()
 	for {
-		pollDBTimer := time.NewTimer(utils.WithJitter(eb.config.TriggerFallbackDBPollInterval()))
+		pollInterval := eb.pollDBInterval()
+		eb.logger.Debugw("EthBroadcaster: polling database", "address", k.Address, "pollInterval", pollInterval)
+		pollDBTimer := time.NewTimer(pollInterval)
 
-		if err := eb.ProcessUnstartedEthTxs(ctx, k); err != nil {
-			eb.logger.Errorw("Error in ProcessUnstartedEthTxs", "error", err)
+		var err error
+		kq.process(func() {
+			err = eb.ProcessUnstartedEthTxs(ctx, k)
+		})
+		if err != nil {
+			// The insufficient eth error was already logged when the
+			// backoff started, and the nonce holes error when the key was
+			// paused, don't log them again on every poll
+			switch errors.Cause(err).(type) {
+			case errInsufficientEthBackoff, errNonceHolesPaused:
+			default:
+				eb.logger.Errorw("Error in ProcessUnstartedEthTxs", "error", err)
+			}
+		}
+
+		if err == nil && eb.isIdle(ctx, k.Address.Address(), &lastActiveAt) {
+			if !pollDBTimer.Stop() {
+				<-pollDBTimer.C
+			}
+			if !eb.park(ctx, k.Address.Address(), kq) {
+				return
+			}
+			lastActiveAt = time.Now()
+			continue
 		}
 
 		select {
@@ -199,11 +622,12 @@ func (eb *EthBroadcaster) monitorEthTxs(k ethkey.State, triggerCh chan struct{})
 				<-pollDBTimer.C
 			}
 			return
-		case <-triggerCh:
+		case <-kq.triggered():
 			// EthTx was inserted
 			if !pollDBTimer.Stop() {
 				<-pollDBTimer.C
 			}
+			lastActiveAt = time.Now()
 			continue
 		case <-pollDBTimer.C:
 			// DB poller timed out
@@ -212,8 +636,161 @@ func (eb *EthBroadcaster) monitorEthTxs(k ethkey.State, triggerCh chan struct{})
 	}
 }
 
+// isIdle returns true if the key has had no triggers for EvmKeyIdleTimeout,
+// and has no transactions that are still pending. Finding pending
+// transactions counts as activity and resets lastActiveAt.
+func (eb *EthBroadcaster) isIdle(ctx context.Context, address gethCommon.Address, lastActiveAt *time.Time) bool {
+	timeout := eb.config.EvmKeyIdleTimeout()
+	if timeout <= 0 || time.Since(*lastActiveAt) < timeout {
+		return false
+	}
+	var pending bool
+	// awaiting_funds is included because moving those transactions back to
+	// unstarted does not trigger the EthBroadcaster
+	err := eb.q.WithOpts(pg.WithParentCtx(ctx)).Get(&pending, `SELECT EXISTS (
+	SELECT 1 FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND state IN ('unstarted', 'in_progress', 'unconfirmed', 'awaiting_funds')
+)`, address, eb.chainID.String())
+	if err != nil {
+		eb.logger.Errorw("EthBroadcaster: failed to check for pending transactions", "address", address, "error", err)
+		return false
+	}
+	if pending {
+		*lastActiveAt = time.Now()
+		return false
+	}
+	return true
+}
+
+// park stops polling the database for an idle key until it is triggered.
+// Returns false if the monitor was stopped while parked.
+func (eb *EthBroadcaster) park(ctx context.Context, address gethCommon.Address, kq *keyQueue) bool {
+	eb.logger.Debugw("EthBroadcaster: key is idle, parking until triggered", "address", address)
+	eb.setParked(address, true)
+	defer eb.setParked(address, false)
+	select {
+	case <-ctx.Done():
+		return false
+	case <-kq.triggered():
+		eb.logger.Debugw("EthBroadcaster: waking parked key", "address", address)
+		return true
+	}
+}
+
+func (eb *EthBroadcaster) setParked(address gethCommon.Address, parked bool) {
+	eb.healthMu.Lock()
+	defer eb.healthMu.Unlock()
+	h := eb.health[address]
+	h.parked = parked
+	eb.health[address] = h
+}
+
+// KeyParked returns true if the given key is idle and its monitor is parked,
+// see EvmKeyIdleTimeout. A parked key is still healthy.
+func (eb *EthBroadcaster) KeyParked(address gethCommon.Address) bool {
+	eb.healthMu.RLock()
+	defer eb.healthMu.RUnlock()
+	return eb.health[address].parked
+}
+
+// throttleInFlight returns true, and logs a warning, if the key has reached
+// its maximum number of in-flight transactions, or the total value of its
+// in-flight transactions exceeds the maximum
+func (eb *EthBroadcaster) throttleInFlight(fromAddress gethCommon.Address) (bool, error) {
+	maxInFlightTransactions := eb.config.EvmMaxInFlightTransactions()
+	maxInFlightValueWei := eb.config.EvmMaxInFlightValueWei()
+	countLimited := maxInFlightTransactions > 0
+	valueLimited := maxInFlightValueWei != nil && maxInFlightValueWei.Sign() > 0
+	if !countLimited && !valueLimited {
+		return false, nil
+	}
+
+	nUnconfirmed, err := CountUnconfirmedTransactions(eb.q, fromAddress, eb.chainID)
+	if err != nil {
+		return false, errors.Wrap(err, "CountUnconfirmedTransactions failed")
+	}
+	valueUnconfirmed := big.NewInt(0)
+	if valueLimited {
+		valueUnconfirmed, err = SumUnconfirmedValue(eb.q, fromAddress, eb.chainID)
+		if err != nil {
+			return false, errors.Wrap(err, "SumUnconfirmedValue failed")
+		}
+	}
+
+	var throttledBy, label string
+	if countLimited && nUnconfirmed >= maxInFlightTransactions {
+		throttledBy, label = "count", static.EvmMaxInFlightTransactionsWarningLabel
+	} else if valueLimited && valueUnconfirmed.Cmp(maxInFlightValueWei) > 0 {
+		throttledBy, label = "value", static.EvmMaxInFlightValueWarningLabel
+	} else {
+		return false, nil
+	}
+
+	nUnstarted, err := CountUnstartedTransactions(eb.q, fromAddress, eb.chainID)
+	if err != nil {
+		return false, errors.Wrap(err, "CountUnstartedTransactions failed")
+	}
+	eb.logger.Warnw(fmt.Sprintf(`Transaction throttling by %s; %d transactions worth %s wei in-flight and %d unstarted transactions pending (maximum number of in-flight transactions is %d and maximum in-flight value is %s wei per key, 0 means no limit). %s`, throttledBy, nUnconfirmed, valueUnconfirmed, nUnstarted, maxInFlightTransactions, maxInFlightValueWei, label),
+		"throttledBy", throttledBy, "maxInFlightTransactions", maxInFlightTransactions, "maxInFlightValueWei", maxInFlightValueWei, "nUnconfirmed", nUnconfirmed, "valueUnconfirmed", valueUnconfirmed, "nUnstarted", nUnstarted)
+	return true, nil
+}
+
+func (eb *EthBroadcaster) pollDBInterval() time.Duration {
+	if eb.config.EvmBroadcastPollJitterDisabled() {
+		return eb.config.TriggerFallbackDBPollInterval()
+	}
+	return utils.WithJitter(eb.config.TriggerFallbackDBPollInterval())
+}
+
 func (eb *EthBroadcaster) ProcessUnstartedEthTxs(ctx context.Context, keyState ethkey.State) error {
-	return eb.processUnstartedEthTxs(ctx, keyState.Address.Address())
+	err := eb.processUnstartedEthTxs(ctx, keyState.Address.Address())
+	eb.recordHealth(keyState.Address.Address(), err)
+	return err
+}
+
+// keyHealth is the outcome of the most recent ProcessUnstartedEthTxs for a key
+type keyHealth struct {
+	lastErr       error
+	lastSuccessAt time.Time
+	// parked is set while the key is idle, see EvmKeyIdleTimeout
+	parked bool
+}
+
+func (eb *EthBroadcaster) recordHealth(address gethCommon.Address, err error) {
+	eb.healthMu.Lock()
+	defer eb.healthMu.Unlock()
+	h := eb.health[address]
+	h.lastErr = err
+	if err == nil {
+		h.lastSuccessAt = time.Now()
+	}
+	eb.health[address] = h
+}
+
+// HealthReport returns, for every from address, the error from the most
+// recent ProcessUnstartedEthTxs along with when it last succeeded, or nil if
+// it succeeded (or has not yet run). A key that stays unhealthy for a long
+// time is likely wedged, e.g. retrying a transaction that has insufficient
+// eth.
+func (eb *EthBroadcaster) HealthReport() map[string]error {
+	eb.keysMu.RLock()
+	keyStates := eb.keyStates
+	eb.keysMu.RUnlock()
+
+	eb.healthMu.RLock()
+	defer eb.healthMu.RUnlock()
+	report := make(map[string]error, len(keyStates))
+	for _, k := range keyStates {
+		address := k.Address.Address()
+		h := eb.health[address]
+		if h.lastErr == nil {
+			report[address.Hex()] = nil
+		} else if h.lastSuccessAt.IsZero() {
+			report[address.Hex()] = errors.Wrap(h.lastErr, "EthBroadcaster has not yet succeeded for this key")
+		} else {
+			report[address.Hex()] = errors.Wrapf(h.lastErr, "EthBroadcaster last succeeded for this key at %s (%s ago)", h.lastSuccessAt.Format(time.RFC3339), time.Since(h.lastSuccessAt).Round(time.Second))
+		}
+	}
+	return report
 }
 
 // NOTE: This MUST NOT be run concurrently for the same address or it could
@@ -228,6 +805,11 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 			eb.logger.Debugw("Finished processUnstartedEthTxs", "address", fromAddress, "time", time.Since(mark), "n", n, "id", "eth_broadcaster")
 		}
 	}()
+	defer eb.flushResumes()
+
+	if eb.haltDetector.halted() {
+		return nil
+	}
 
 	err := eb.handleAnyInProgressEthTx(ctx, fromAddress)
 	if ctx.Err() != nil {
@@ -235,24 +817,36 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 	} else if err != nil {
 		return errors.Wrap(err, "processUnstartedEthTxs failed")
 	}
+	if err := eb.checkNonceHoles(ctx, fromAddress); err != nil {
+		return err
+	}
 	for {
-		maxInFlightTransactions := eb.config.EvmMaxInFlightTransactions()
-		if maxInFlightTransactions > 0 {
-			nUnconfirmed, err := CountUnconfirmedTransactions(eb.q, fromAddress, eb.chainID)
-			if err != nil {
-				return errors.Wrap(err, "CountUnconfirmedTransactions failed")
-			}
-			if nUnconfirmed >= maxInFlightTransactions {
-				nUnstarted, err := CountUnstartedTransactions(eb.q, fromAddress, eb.chainID)
-				if err != nil {
-					return errors.Wrap(err, "CountUnstartedTransactions failed")
-				}
-				eb.logger.Warnw(fmt.Sprintf(`Transaction throttling; %d transactions in-flight and %d unstarted transactions pending (maximum number of in-flight transactions is %d per key). %s`, nUnconfirmed, nUnstarted, maxInFlightTransactions, static.EvmMaxInFlightTransactionsWarningLabel), "maxInFlightTransactions", maxInFlightTransactions, "nUnconfirmed", nUnconfirmed, "nUnstarted", nUnstarted)
-				time.Sleep(InFlightTransactionRecheckInterval)
-				continue
+		if eb.draining.Load() || eb.haltDetector.halted() {
+			return nil
+		}
+		if held, err := eb.strictlySequencedKeyHeld(fromAddress); err != nil {
+			return errors.Wrap(err, "processUnstartedEthTxs failed")
+		} else if held {
+			// Picked up again on a later poll, once the key has nothing in
+			// flight
+			return nil
+		}
+		if throttled, err := eb.throttleInFlight(fromAddress); err != nil {
+			return errors.Wrap(err, "processUnstartedEthTxs failed")
+		} else if throttled {
+			// ctx is cancelled when the key is removed, so a throttled key
+			// never holds up RemoveKey or Close
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-eb.chStop:
+				return nil
+			case <-time.After(InFlightTransactionRecheckInterval):
 			}
+			continue
 		}
-		etx, err := eb.nextUnstartedTransactionWithNonce(fromAddress)
+		// Transactions deferred during this run are left for the next one
+		etx, err := eb.nextUnstartedTransactionWithNonce(fromAddress, mark)
 		if err != nil {
 			return errors.Wrap(err, "processUnstartedEthTxs failed")
 		}
@@ -266,17 +860,35 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 			if err != nil {
 				return errors.Wrap(err, "failed to get dynamic gas fee")
 			}
-			a, err = eb.NewDynamicFeeAttempt(*etx, fee, gasLimit)
-			if err != nil {
+			a, err = eb.newEstimatedDynamicFeeAttempt(*etx, fee, gasLimit)
+			if errors.Is(err, ErrTxMaxFeeExceeded) {
+				// Nothing has been sent yet, so the nonce is not consumed
+				eb.logger.Errorw("Transaction would exceed its max fee, marking it as fatally errored", "etxID", etx.ID, "err", err)
+				etx.Error = null.StringFrom(err.Error())
+				if err = eb.saveFatallyErroredTransaction(etx, FatalReasonMaxFeeExceeded); err != nil {
+					return errors.Wrap(err, "processUnstartedEthTxs failed")
+				}
+				continue
+			} else if errors.Is(err, ErrMaxGasPriceExceeded) {
+				if err = eb.handleMaxGasPriceExceeded(etx, err); err != nil {
+					return errors.Wrap(err, "processUnstartedEthTxs failed")
+				}
+				continue
+			} else if err != nil {
 				return errors.Wrap(err, "processUnstartedEthTxs failed")
 			}
 		} else {
-			gasPrice, gasLimit, err := eb.estimator.GetLegacyGas(etx.EncodedPayload, etx.GasLimit)
+			gasPrice, gasLimit, err := eb.estimator.GetLegacyGas(etx.EncodedPayload, etx.GasLimit, gas.OptUnclamped)
 			if err != nil {
 				return errors.Wrap(err, "failed to estimate gas")
 			}
-			a, err = eb.NewLegacyAttempt(*etx, gasPrice, gasLimit)
-			if err != nil {
+			a, err = eb.newEstimatedLegacyAttempt(*etx, gasPrice, gasLimit)
+			if errors.Is(err, ErrMaxGasPriceExceeded) {
+				if err = eb.handleMaxGasPriceExceeded(etx, err); err != nil {
+					return errors.Wrap(err, "processUnstartedEthTxs failed")
+				}
+				continue
+			} else if err != nil {
 				return errors.Wrap(err, "processUnstartedEthTxs failed")
 			}
 		}
@@ -304,13 +916,63 @@ func (eb *EthBroadcaster) handleAnyInProgressEthTx(ctx context.Context, fromAddr
 		return errors.Wrap(err, "handleAnyInProgressEthTx failed")
 	}
 	if etx != nil {
-		if err := eb.handleInProgressEthTx(*etx, etx.EthTxAttempts[0], etx.CreatedAt); err != nil {
+		attempt := etx.EthTxAttempts[0]
+		if maxAge := eb.config.EvmMaxInProgressAge(); maxAge > 0 && time.Since(attempt.CreatedAt) > maxAge {
+			return errors.Wrap(eb.forceResolveInProgressEthTx(*etx, attempt, maxAge), "handleAnyInProgressEthTx failed")
+		}
+		if err := eb.insufficientEthBackoff.check(*etx); err != nil {
+			return errors.Wrap(err, "handleAnyInProgressEthTx failed")
+		}
+		if err := eb.handleInProgressEthTx(*etx, attempt, etx.CreatedAt); err != nil {
 			return errors.Wrap(err, "handleAnyInProgressEthTx failed")
 		}
 	}
 	return nil
 }
 
+// forceResolveInProgressEthTx resolves a transaction that has been
+// in_progress for longer than ETH_MAX_IN_PROGRESS_AGE, since retrying it the
+// same way will most likely keep failing and block the key forever. Depending
+// on ETH_TX_IN_PROGRESS_RESOLUTION_POLICY it is either resent with a freshly
+// estimated gas price, or marked as fatally errored.
+func (eb *EthBroadcaster) forceResolveInProgressEthTx(etx EthTx, attempt EthTxAttempt, maxAge time.Duration) error {
+	lggr := eb.logger.With("ethTxID", etx.ID, "ethTxAttemptID", attempt.ID, "nonce", etx.Nonce, "attemptCreatedAt", attempt.CreatedAt, "maxInProgressAge", maxAge)
+	switch policy := eb.config.EthTxInProgressResolutionPolicy(); policy {
+	case "fatal":
+		lggr.CriticalW("Transaction has been in_progress for longer than ETH_MAX_IN_PROGRESS_AGE, marking it as fatally errored")
+		etx.Error = null.StringFrom(fmt.Sprintf("transaction was in_progress for longer than %v", maxAge))
+		return eb.saveFatallyErroredTransaction(&etx, FatalReasonInProgressMaxAge)
+	case "resend":
+		lggr.Errorw("Transaction has been in_progress for longer than ETH_MAX_IN_PROGRESS_AGE, re-estimating gas and resending it")
+		var replacementAttempt EthTxAttempt
+		var err error
+		if attempt.TxType == 0x2 {
+			fee, gasLimit, ferr := eb.estimator.GetDynamicFee(etx.GasLimit)
+			if ferr != nil {
+				return errors.Wrap(ferr, "forceResolveInProgressEthTx failed to get dynamic gas fee")
+			}
+			replacementAttempt, err = eb.newEstimatedDynamicFeeAttempt(etx, fee, gasLimit)
+		} else {
+			gasPrice, gasLimit, gerr := eb.estimator.GetLegacyGas(etx.EncodedPayload, etx.GasLimit, gas.OptForceRefetch, gas.OptUnclamped)
+			if gerr != nil {
+				return errors.Wrap(gerr, "forceResolveInProgressEthTx failed to estimate gas")
+			}
+			replacementAttempt, err = eb.newEstimatedLegacyAttempt(etx, gasPrice, gasLimit)
+		}
+		if errors.Is(err, ErrMaxGasPriceExceeded) {
+			return eb.handleMaxGasPriceExceeded(&etx, err)
+		} else if err != nil {
+			return errors.Wrap(err, "forceResolveInProgressEthTx failed")
+		}
+		if err = saveReplacementInProgressAttempt(eb.q, attempt, &replacementAttempt); err != nil {
+			return errors.Wrap(err, "forceResolveInProgressEthTx failed")
+		}
+		return eb.handleInProgressEthTx(etx, replacementAttempt, etx.CreatedAt)
+	default:
+		return errors.Errorf("unrecognised in_progress resolution policy: %s", policy)
+	}
+}
+
 // getInProgressEthTx returns either 0 or 1 transaction that was left in
 // an unfinished state because something went screwy the last time. Most likely
 // the node crashed in the middle of the ProcessUnstartedEthTxs loop.
@@ -337,6 +999,21 @@ func getInProgressEthTx(q pg.Q, fromAddress gethCommon.Address) (etx *EthTx, err
 // broadcasting a tx which can negatively affect response time
 const SimulationTimeout = 2 * time.Second
 
+// simulationRevertReason returns the decoded revert reason if the node
+// returned a revert payload we understand, otherwise the raw RPC error
+func (eb *EthBroadcaster) simulationRevertReason(etx EthTx, jErr *evmclient.JsonError) string {
+	var customErrors map[string]string
+	if meta, err := etx.GetMeta(); err != nil {
+		eb.logger.Warnw("Failed to unmarshal eth_tx meta, custom revert errors will not be decoded", "ethTxID", etx.ID, "err", err)
+	} else if meta != nil {
+		customErrors = meta.RevertErrors
+	}
+	if reason, ok := DecodeRevertReason(extractRevertData(jErr), customErrors); ok {
+		return fmt.Sprintf("%s (%s)", reason, jErr.String())
+	}
+	return jErr.String()
+}
+
 // There can be at most one in_progress transaction per address.
 // Here we complete the job that we didn't finish last time.
 func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) error {
@@ -348,19 +1025,25 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 	if etx.Simulate {
 		simulationCtx, cancel := context.WithTimeout(parentCtx, SimulationTimeout)
 		defer cancel()
-		if b, err := simulateTransaction(simulationCtx, eb.ethClient, attempt, etx); err != nil {
+		if b, err := simulateTransaction(simulationCtx, eb.ethClient, eb.simulationCache, attempt, etx); err != nil {
 			if jErr := evmclient.ExtractRPCError(err); jErr != nil {
-				eb.logger.CriticalW("Transaction reverted during simulation", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
-				etx.Error = null.StringFrom(fmt.Sprintf("transaction reverted during simulation: %s", jErr.String()))
-				return eb.saveFatallyErroredTransaction(&etx)
+				reason := eb.simulationRevertReason(etx, jErr)
+				if etx.SimulationMode == SimulationModeSendOnRevert {
+					eb.logger.Warnw("Transaction reverted during simulation, will attempt to send anyway", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "revertReason", reason, "returnValue", b.String())
+				} else {
+					eb.logger.CriticalW("Transaction reverted during simulation", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "rpcErr", jErr.String(), "revertReason", reason, "returnValue", b.String())
+					etx.Error = null.StringFrom(fmt.Sprintf("transaction reverted during simulation: %s", reason))
+					return eb.saveFatallyErroredTransaction(&etx, FatalReasonSimulationReverted)
+				}
+			} else {
+				eb.logger.Warnw("Transaction simulation failed, will attempt to send anyway", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "returnValue", b.String())
 			}
-			eb.logger.Warnw("Transaction simulation failed, will attempt to send anyway", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "returnValue", b.String())
 		} else {
 			eb.logger.Debugw("Transaction simulation succeeded", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "returnValue", b.String())
 		}
 	}
 
-	sendError := sendTransaction(parentCtx, eb.ethClient, attempt, etx, eb.logger)
+	sendError := sendTransactionWithLog(parentCtx, eb.q, eb.ethClient, attempt, etx, eb.logger)
 
 	if sendError.IsTooExpensive() {
 		eb.logger.CriticalW("Transaction gas price was rejected by the eth node for being too high. Consider increasing your eth node's RPCTxFeeCap (it is suggested to run geth with no cap i.e. --rpc.gascap=0 --rpc.txfeecap=0)",
@@ -372,19 +1055,19 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 		)
 		etx.Error = null.StringFrom(sendError.Error())
 		// Attempt is thrown away in this case; we don't need it since it never got accepted by a node
-		return eb.saveFatallyErroredTransaction(&etx)
+		return eb.saveFatallyErroredTransaction(&etx, FatalReasonTooExpensive)
 	}
 
 	if sendError.Fatal() {
 		eb.logger.CriticalW("Fatal error sending transaction", "ethTxID", etx.ID, "error", sendError, "gasLimit", etx.GasLimit, "gasPrice", attempt.GasPrice)
 		etx.Error = null.StringFrom(sendError.Error())
 		// Attempt is thrown away in this case; we don't need it since it never got accepted by a node
-		return eb.saveFatallyErroredTransaction(&etx)
+		return eb.saveFatallyErroredTransaction(&etx, FatalReasonSendFatal)
 	}
 
 	etx.BroadcastAt = &initialBroadcastAt
 
-	if sendError.IsNonceTooLowError() || sendError.IsReplacementUnderpriced() {
+	if sendError.IsNonceTooLowError() || sendError.IsReplacementUnderpriced() || sendError.IsTransactionAlreadyMined() {
 		// There are three scenarios that this can happen:
 		//
 		// SCENARIO 1
@@ -422,14 +1105,34 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 		sendError = nil
 	}
 
+	if sendError.IsTransactionAlreadyKnown() {
+		// The node already has this exact transaction, so, as with nonce too
+		// low, assume the mempool has it and hand off to the eth confirmer to
+		// get the receipt. Unwrapped errors of this kind are already treated
+		// as success by sendTransaction.
+		eb.logger.Debugw("Transaction already known", "ethTxID", etx.ID, "txHash", attempt.Hash, "err", sendError.Error())
+		sendError = nil
+	}
+
 	if sendError.IsTerminallyUnderpriced() {
 		return eb.tryAgainBumpingGas(sendError, etx, attempt, initialBroadcastAt)
 	}
 
-	if sendError.IsFeeTooLow() || sendError.IsFeeTooHigh() {
+	if sendError.IsFeeTooLow() || sendError.IsFeeTooHigh() || sendError.IsL2FeeTooLow() {
 		return eb.tryAgainWithNewEstimation(sendError, etx, attempt, initialBroadcastAt)
 	}
 
+	if sendError.IsGasLimitTooLow() {
+		return eb.tryAgainWithSuggestedGasLimit(sendError, etx, attempt, initialBroadcastAt)
+	}
+
+	if sendError.IsL2Full() {
+		// The sequencer is not accepting any transactions right now, so
+		// nothing else for this key would succeed either
+		eb.logger.Warnw("L2 sequencer is full, will retry", "ethTxID", etx.ID, "err", sendError.Error())
+		return errors.Wrapf(sendError, "L2 sequencer rejected transaction %v", etx.ID)
+	}
+
 	if sendError.IsTemporarilyUnderpriced() {
 		// If we can't even get the transaction into the mempool at all, assume
 		// success (even though the transaction will never confirm) and hand
@@ -446,18 +1149,46 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 			attempt.Hash, attempt.TxType, sendError.Error(), etx.FromAddress,
 		), "ethTxID", etx.ID, "err", sendError, "gasPrice", attempt.GasPrice,
 			"gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
+		eb.notifyFailure(FailureReasonInsufficientEth, etx, sendError.Error())
+		switch eb.config.EthTxInsufficientEthMode() {
+		case "skip":
+			// Park the transaction and carry on with the rest of the queue,
+			// the FundsRecoveryChecker will move it back to unstarted once
+			// the key has been refunded
+			return eb.saveAwaitingFundsTransaction(&etx)
+		case "defer_value":
+			// Only park the transaction if its value alone is more than the
+			// key holds. Smaller transactions may still be affordable, so
+			// carry on with them rather than blocking the queue.
+			exceeds, err := eb.valueExceedsBalance(parentCtx, etx)
+			if err != nil {
+				eb.logger.Errorw("Failed to fetch balance, cannot tell whether transaction value is affordable", "ethTxID", etx.ID, "err", err)
+			} else if exceeds {
+				eb.logger.Warnw(fmt.Sprintf("Value of tx 0x%x exceeds the balance of key 0x%x, deferring it until the key is refunded", attempt.Hash, etx.FromAddress), "ethTxID", etx.ID, "value", etx.Value.ToInt())
+				return eb.saveAwaitingFundsTransaction(&etx)
+			}
+		}
 		// NOTE: This bails out of the entire cycle and essentially "blocks" on
 		// any transaction that gets insufficient_eth. This is OK if a
 		// transaction with a large VALUE blocks because this always comes last
-		// in the processing list.
+		// in the processing list, unless the queue is ordered otherwise (see
+		// ETH_TX_INSUFFICIENT_ETH_MODE=defer_value).
 		// If it blocks because of a transaction that is expensive due to large
 		// gas limit, we could have smaller transactions "above" it that could
 		// theoretically be sent, but will instead be blocked.
+		if wait := eb.insufficientEthBackoff.failed(etx, sendError); wait > 0 {
+			eb.logger.Warnw(fmt.Sprintf("Will not retry transaction for %v while key 0x%x is out of funds", wait, etx.FromAddress), "ethTxID", etx.ID)
+		}
 		return sendError
 	}
 
 	if sendError == nil {
-		return saveAttempt(eb.q, &etx, attempt, EthTxAttemptBroadcast)
+		eb.insufficientEthBackoff.reset(etx.FromAddress)
+		if err := saveAttempt(eb.q, &etx, attempt, EthTxAttemptBroadcast); err != nil {
+			return err
+		}
+		eb.resumeOnBroadcastIfRequested(etx, attempt)
+		return nil
 	}
 
 	// Any other type of error is considered temporary or resolvable by the
@@ -467,16 +1198,22 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 }
 
 // Finds next transaction in the queue, assigns a nonce, and moves it to "in_progress" state ready for broadcast.
+// Transactions skipped since skippedSince are ignored.
 // Returns nil if no transactions are in queue
-func (eb *EthBroadcaster) nextUnstartedTransactionWithNonce(fromAddress gethCommon.Address) (*EthTx, error) {
+func (eb *EthBroadcaster) nextUnstartedTransactionWithNonce(fromAddress gethCommon.Address, skippedSince time.Time) (*EthTx, error) {
 	etx := &EthTx{}
-	if err := findNextUnstartedTransactionFromAddress(eb.db, etx, fromAddress, eb.chainID); err != nil {
+	if err := findNextUnstartedTransactionFromAddress(eb.db, etx, fromAddress, eb.chainID, eb.config.KeySpecificTxQueueOrdering(fromAddress), eb.config.EvmTxQueueTiebreak(), skippedSince); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			// Finish. No more transactions left to process. Hoorah!
 			return nil, nil
 		}
 		return nil, errors.Wrap(err, "findNextUnstartedTransactionFromAddress failed")
 	}
+	// A paced transaction holds up the rest of the key's queue until it is
+	// due, at which point the key is triggered again
+	if wait := eb.pace(*etx); wait > 0 {
+		return nil, nil
+	}
 
 	nonce, err := GetNextNonce(eb.q, etx.FromAddress, &eb.chainID)
 	if err != nil {
@@ -495,6 +1232,16 @@ func (eb *EthBroadcaster) saveInProgressTransaction(etx *EthTx, attempt *EthTxAt
 	}
 	etx.State = EthTxInProgress
 	return eb.q.Transaction(func(tx pg.Queryer) error {
+		// The next nonce may have been changed by ReserveNonce/ReleaseNonce
+		// since it was assigned to this transaction, in which case bail out
+		// and pick up the new one on the next run
+		var nextNonce int64
+		if err := tx.Get(&nextNonce, `SELECT next_nonce FROM eth_key_states WHERE address = $1 AND evm_chain_id = $2 FOR UPDATE`, etx.FromAddress, eb.chainID.String()); err != nil {
+			return errors.Wrap(err, "saveInProgressTransaction failed to load next_nonce")
+		}
+		if nextNonce != *etx.Nonce {
+			return errors.Errorf("saveInProgressTransaction: next nonce for key %s changed from %d to %d, will retry", etx.FromAddress.Hex(), *etx.Nonce, nextNonce)
+		}
 		query, args, e := tx.BindNamed(insertIntoEthTxAttemptsQuery, attempt)
 		if e != nil {
 			return errors.Wrap(e, "failed to BindNamed")
@@ -509,17 +1256,47 @@ func (eb *EthBroadcaster) saveInProgressTransaction(etx *EthTx, attempt *EthTxAt
 			}
 			return errors.Wrap(err, "saveInProgressTransaction failed to create eth_tx_attempt")
 		}
-		err = tx.Get(etx, `UPDATE eth_txes SET nonce=$1, state=$2, broadcast_at=$3 WHERE id=$4 RETURNING *`, etx.Nonce, etx.State, etx.BroadcastAt, etx.ID)
+		// The transaction may have been cancelled since it was loaded
+		err = tx.Get(etx, `UPDATE eth_txes SET nonce=$1, state=$2, broadcast_at=$3, skip_reason=NULL, skipped_at=NULL WHERE id=$4 AND state = 'unstarted' RETURNING *`, etx.Nonce, etx.State, etx.BroadcastAt, etx.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errEthTxRemoved
+		}
 		return errors.Wrap(err, "saveInProgressTransaction failed to save eth_tx")
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
-func findNextUnstartedTransactionFromAddress(db *sqlx.DB, etx *EthTx, fromAddress gethCommon.Address, chainID big.Int) error {
-	err := db.Get(etx, `SELECT * FROM eth_txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+// Finds earliest saved transaction that has yet to be broadcast from the
+// given address, ignoring any that were skipped since skippedSince
+func findNextUnstartedTransactionFromAddress(db *sqlx.DB, etx *EthTx, fromAddress gethCommon.Address, chainID big.Int, ordering, tiebreak string, skippedSince time.Time) error {
+	query := `SELECT * FROM eth_txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 AND (skipped_at IS NULL OR skipped_at < $3) AND ` + strictSequencingHeldSubjectsClause + ` ORDER BY ` + unstartedQueueOrderBy(ordering, tiebreak)
+	err := db.Get(etx, query, fromAddress, chainID.String(), skippedSince)
 	return errors.Wrap(err, "failed to findNextUnstartedTransactionFromAddress")
 }
 
+// unstartedQueueOrderBy returns the ORDER BY clause for the unstarted queue.
+// ordering decides which columns take precedence; tiebreak decides between
+// transactions that are otherwise equal. fifo is strictly the order in which
+// transactions were enqueued, so tiebreak does not apply to it. Unknown
+// values fall back to the defaults of value_asc_fifo and created_at.
+func unstartedQueueOrderBy(ordering, tiebreak string) string {
+	if ordering == "fifo" {
+		return "id ASC"
+	}
+	var tiebreakBy string
+	switch tiebreak {
+	case "id":
+		tiebreakBy = "id ASC"
+	case "subject":
+		tiebreakBy = "subject ASC NULLS LAST, id ASC"
+	default:
+		tiebreakBy = "created_at ASC, id ASC"
+	}
+	if ordering == "priority" {
+		return "priority DESC NULLS LAST, value ASC, " + tiebreakBy
+	}
+	return "value ASC, " + tiebreakBy
+}
+
 func saveAttempt(q pg.Q, etx *EthTx, attempt EthTxAttempt, NewAttemptState EthTxAttemptState, callbacks ...func(tx pg.Queryer) error) error {
 	if etx.State != EthTxInProgress {
 		return errors.Errorf("can only transition to unconfirmed from in_progress, transaction is currently %s", etx.State)
@@ -532,7 +1309,7 @@ func saveAttempt(q pg.Q, etx *EthTx, attempt EthTxAttempt, NewAttemptState EthTx
 	}
 	etx.State = EthTxUnconfirmed
 	attempt.State = NewAttemptState
-	return q.Transaction(func(tx pg.Queryer) error {
+	err := q.Transaction(func(tx pg.Queryer) error {
 		if err := IncrementNextNonce(tx, etx.FromAddress, etx.EVMChainID.ToInt(), *etx.Nonce); err != nil {
 			return errors.Wrap(err, "saveUnconfirmed failed")
 		}
@@ -549,12 +1326,24 @@ func saveAttempt(q pg.Q, etx *EthTx, attempt EthTxAttempt, NewAttemptState EthTx
 		}
 		return nil
 	})
+	if err == nil && etx.BroadcastAt != nil {
+		promTimeUntilBroadcast.WithLabelValues(etx.EVMChainID.String()).Observe(etx.BroadcastAt.Sub(etx.CreatedAt).Seconds())
+	}
+	return err
 }
 
 func (eb *EthBroadcaster) tryAgainBumpingGas(sendError *evmclient.SendError, etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) error {
 	if attempt.TxType == 0x2 {
 		return errors.New("bumping gas on initial send is not supported for EIP-1559 transactions")
 	}
+	if max := eb.config.EvmBroadcastMaxInitialBumps(); max > 0 && etx.InitialBumps >= max {
+		// The node rejected the attempt, so it is safe to give up
+		eb.logger.Errorw("Transaction was rejected as underpriced too many times on initial send, marking it as fatally errored",
+			"etxID", etx.ID, "initialBumps", etx.InitialBumps, "attemptGasPrice", attempt.GasPrice, "err", sendError)
+		etx.Error = null.StringFrom(fmt.Sprintf("transaction was still underpriced after %d gas bumps on initial send (ETH_BROADCAST_MAX_INITIAL_BUMPS=%d), last gas price was %s wei: %s",
+			etx.InitialBumps, max, attempt.GasPrice.String(), sendError.Error()))
+		return eb.saveFatallyErroredTransaction(&etx, FatalReasonMaxInitialBumps)
+	}
 	bumpedGasPrice, bumpedGasLimit, err := eb.estimator.BumpLegacyGas(attempt.GasPrice.ToInt(), etx.GasLimit)
 	if err != nil {
 		return errors.Wrap(err, "tryAgainWithHigherGasPrice failed")
@@ -576,17 +1365,122 @@ func (eb *EthBroadcaster) tryAgainBumpingGas(sendError *evmclient.SendError, etx
 	if bumpedGasPrice.Cmp(attempt.GasPrice.ToInt()) == 0 && bumpedGasPrice.Cmp(eb.config.EvmMaxGasPriceWei()) == 0 {
 		return errors.Errorf("Hit gas price bump ceiling, will not bump further. This is a terminal error")
 	}
+	if err = eb.q.Get(&etx.InitialBumps, `UPDATE eth_txes SET initial_bumps = initial_bumps + 1 WHERE id = $1 RETURNING initial_bumps`, etx.ID); err != nil {
+		return errors.Wrap(err, "tryAgainBumpingGas failed to increment initial_bumps")
+	}
+	promInitialSendBumps.WithLabelValues(etx.EVMChainID.String()).Inc()
 	return eb.tryAgainWithNewGas(etx, attempt, initialBroadcastAt, bumpedGasPrice, bumpedGasLimit)
 }
 
 func (eb *EthBroadcaster) tryAgainWithNewEstimation(sendError *evmclient.SendError, etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) error {
-	gasPrice, gasLimit, err := eb.estimator.GetLegacyGas(etx.EncodedPayload, etx.GasLimit, gas.OptForceRefetch)
+	if attempt.TxType == 0x2 {
+		fee, gasLimit, err := eb.estimator.GetDynamicFee(etx.GasLimit)
+		if err != nil {
+			return errors.Wrap(err, "tryAgainWithNewEstimation failed to get dynamic gas fee")
+		}
+		eb.logger.Debugw("Transaction rejected due to incorrect fee, re-estimated and will try again",
+			"etxID", etx.ID, "err", sendError, "newGasTipCap", fee.TipCap, "newGasFeeCap", fee.FeeCap, "newGasLimit", gasLimit)
+		replacementAttempt, err := eb.newEstimatedDynamicFeeAttempt(etx, fee, gasLimit)
+		if errors.Is(err, ErrTxMaxFeeExceeded) {
+			// The node rejected the previous attempt, so it is safe to give up
+			eb.logger.Errorw("Transaction would exceed its max fee, marking it as fatally errored", "etxID", etx.ID, "err", err)
+			etx.Error = null.StringFrom(err.Error())
+			return eb.saveFatallyErroredTransaction(&etx, FatalReasonMaxFeeExceeded)
+		} else if errors.Is(err, ErrMaxGasPriceExceeded) {
+			return eb.handleMaxGasPriceExceeded(&etx, err)
+		} else if err != nil {
+			return errors.Wrap(err, "tryAgainWithNewEstimation failed")
+		}
+		if err = saveReplacementInProgressAttempt(eb.q, attempt, &replacementAttempt); err != nil {
+			return errors.Wrap(err, "tryAgainWithNewEstimation failed")
+		}
+		return eb.handleInProgressEthTx(etx, replacementAttempt, initialBroadcastAt)
+	}
+	gasPrice, gasLimit, err := eb.estimator.GetLegacyGas(etx.EncodedPayload, etx.GasLimit, gas.OptForceRefetch, gas.OptUnclamped)
 	if err != nil {
 		return errors.Wrap(err, "tryAgainWithNewEstimation failed to estimate gas")
 	}
-	eb.logger.Debugw("Optimism rejected transaction due to incorrect fee, re-estimated and will try again",
-		"etxID", etx.ID, "err", err, "newGasPrice", gasPrice, "newGasLimit", gasLimit)
-	return eb.tryAgainWithNewGas(etx, attempt, initialBroadcastAt, gasPrice, gasLimit)
+	eb.logger.Debugw("Transaction rejected due to incorrect fee, re-estimated and will try again",
+		"etxID", etx.ID, "err", sendError, "newGasPrice", gasPrice, "newGasLimit", gasLimit)
+	replacementAttempt, err := eb.newEstimatedLegacyAttempt(etx, gasPrice, gasLimit)
+	if errors.Is(err, ErrMaxGasPriceExceeded) {
+		return eb.handleMaxGasPriceExceeded(&etx, err)
+	} else if err != nil {
+		return errors.Wrap(err, "tryAgainWithNewEstimation failed")
+	}
+	if err = saveReplacementInProgressAttempt(eb.q, attempt, &replacementAttempt); err != nil {
+		return errors.Wrap(err, "tryAgainWithNewEstimation failed")
+	}
+	return eb.handleInProgressEthTx(etx, replacementAttempt, initialBroadcastAt)
+}
+
+// EstimateGasTimeout bounds the eth_estimateGas call made when the node
+// rejects a transaction for having too low a gas limit without saying what
+// the limit should be
+const EstimateGasTimeout = 5 * time.Second
+
+// tryAgainWithSuggestedGasLimit replaces an attempt that was rejected for
+// having too low a gas limit (e.g. on zkSync) with one at the same fee but at
+// the gas limit the node asked for. If the node did not include one, the gas
+// limit is estimated with eth_estimateGas instead. Either way it is capped at
+// EvmGasLimitMax.
+func (eb *EthBroadcaster) tryAgainWithSuggestedGasLimit(sendError *evmclient.SendError, etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) error {
+	gasLimit, ok := sendError.SuggestedGasLimit()
+	if !ok {
+		ctx, cancel := context.WithTimeout(context.Background(), EstimateGasTimeout)
+		defer cancel()
+		estimated, err := eb.ethClient.EstimateGas(ctx, ethereum.CallMsg{
+			From:  etx.FromAddress,
+			To:    &etx.ToAddress,
+			Value: etx.Value.ToInt(),
+			Data:  etx.EncodedPayload,
+		})
+		if err != nil {
+			return errors.Wrap(err, "tryAgainWithSuggestedGasLimit failed to estimate gas")
+		}
+		gasLimit = estimated
+	}
+	lggr := eb.logger.With("etxID", etx.ID, "err", sendError, "gasLimit", attempt.ChainSpecificGasLimit, "suggestedGasLimit", gasLimit)
+	if max := eb.config.EvmGasLimitMax(); max > 0 && gasLimit > max {
+		if attempt.ChainSpecificGasLimit >= max {
+			lggr.Errorw("Transaction gas limit was rejected as too low but it is already at ETH_GAS_LIMIT_MAX, marking it as fatally errored", "gasLimitMax", max)
+			etx.Error = null.StringFrom(fmt.Sprintf("gas limit of %d was rejected as too low and cannot be raised above ETH_GAS_LIMIT_MAX of %d: %s", attempt.ChainSpecificGasLimit, max, sendError.Error()))
+			return eb.saveFatallyErroredTransaction(&etx, FatalReasonGasLimitMaxExceeded)
+		}
+		lggr.Warnw("Suggested gas limit exceeds ETH_GAS_LIMIT_MAX, capping it", "gasLimitMax", max)
+		gasLimit = max
+	}
+	if gasLimit <= attempt.ChainSpecificGasLimit {
+		return errors.Errorf("tryAgainWithSuggestedGasLimit: gas limit of %d was rejected as too low but the suggested gas limit of %d is no higher", attempt.ChainSpecificGasLimit, gasLimit)
+	}
+	lggr.Debugw("Transaction rejected due to low gas limit, will try again with the suggested gas limit")
+
+	var replacementAttempt EthTxAttempt
+	var err error
+	if attempt.TxType == 0x2 {
+		replacementAttempt, err = eb.NewDynamicFeeAttempt(etx, attempt.DynamicFee(), gasLimit)
+	} else {
+		replacementAttempt, err = eb.NewLegacyAttempt(etx, attempt.GasPrice.ToInt(), gasLimit)
+	}
+	if errors.Is(err, ErrTxMaxFeeExceeded) {
+		// The node rejected the previous attempt, so it is safe to give up
+		lggr.Errorw("Transaction would exceed its max fee at the suggested gas limit, marking it as fatally errored", "err", err)
+		etx.Error = null.StringFrom(err.Error())
+		return eb.saveFatallyErroredTransaction(&etx, FatalReasonMaxFeeExceeded)
+	} else if err != nil {
+		return errors.Wrap(err, "tryAgainWithSuggestedGasLimit failed")
+	}
+	// Keep the raised gas limit for any later bumps by the EthConfirmer
+	if _, err = eb.q.Exec(`UPDATE eth_txes SET gas_limit = $1 WHERE id = $2 AND gas_limit < $1`, gasLimit, etx.ID); err != nil {
+		return errors.Wrap(err, "tryAgainWithSuggestedGasLimit failed to update gas limit")
+	}
+	if gasLimit > etx.GasLimit {
+		etx.GasLimit = gasLimit
+	}
+	if err = saveReplacementInProgressAttempt(eb.q, attempt, &replacementAttempt); err != nil {
+		return errors.Wrap(err, "tryAgainWithSuggestedGasLimit failed")
+	}
+	return eb.handleInProgressEthTx(etx, replacementAttempt, initialBroadcastAt)
 }
 
 func (eb *EthBroadcaster) tryAgainWithNewGas(etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time, newGasPrice *big.Int, newGasLimit uint64) error {
@@ -601,9 +1495,9 @@ func (eb *EthBroadcaster) tryAgainWithNewGas(etx EthTx, attempt EthTxAttempt, in
 	return eb.handleInProgressEthTx(etx, replacementAttempt, initialBroadcastAt)
 }
 
-func (eb *EthBroadcaster) saveFatallyErroredTransaction(etx *EthTx) error {
-	if etx.State != EthTxInProgress {
-		return errors.Errorf("can only transition to fatal_error from in_progress, transaction is currently %s", etx.State)
+func (eb *EthBroadcaster) saveFatallyErroredTransaction(etx *EthTx, reason FatalReason) error {
+	if etx.State != EthTxInProgress && etx.State != EthTxUnstarted {
+		return errors.Errorf("can only transition to fatal_error from in_progress or unstarted, transaction is currently %s", etx.State)
 	}
 	if !etx.Error.Valid {
 		return errors.New("expected error field to be set")
@@ -620,8 +1514,13 @@ func (eb *EthBroadcaster) saveFatallyErroredTransaction(etx *EthTx) error {
 	// Now we have an errored pipeline even though the tx succeeded. This case
 	// is relatively benign and probably nobody will ever run into it in
 	// practice, but something to be aware of.
-	if etx.PipelineTaskRunID.Valid && eb.resumeCallback != nil {
-		err := eb.resumeCallback(etx.PipelineTaskRunID.UUID, nil, errors.Errorf("fatal error while sending transaction: %s", etx.Error.String))
+	//
+	// When resuming in batches, the run is instead resumed after the fatal
+	// error was saved, along with the rest of the batch. The EthConfirmer
+	// picks up any run that is missed due to a crash in between.
+	batched := etx.PipelineTaskRunID.Valid && eb.resumer.batched()
+	if etx.PipelineTaskRunID.Valid && eb.resumer.enabled() && !batched {
+		err := eb.resumer.resume([]ResumeRequest{fatalErrorResumeRequest(*etx)})
 		if errors.Is(err, sql.ErrNoRows) {
 			eb.logger.Debugw("callback missing or already resumed", "etxID", etx.ID)
 		} else if err != nil {
@@ -630,20 +1529,183 @@ func (eb *EthBroadcaster) saveFatallyErroredTransaction(etx *EthTx) error {
 	}
 	etx.Nonce = nil
 	etx.State = EthTxFatalError
-	return eb.q.Transaction(func(tx pg.Queryer) error {
+	etx.FatalReason = &reason
+	err := eb.q.Transaction(func(tx pg.Queryer) error {
 		if _, err := tx.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id = $1`, etx.ID); err != nil {
 			return errors.Wrapf(err, "saveFatallyErroredTransaction failed to delete eth_tx_attempt with eth_tx.ID %v", etx.ID)
 		}
-		return errors.Wrap(tx.Get(etx, `UPDATE eth_txes SET state=$1, error=$2, broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
+		return errors.Wrap(tx.Get(etx, `UPDATE eth_txes SET state=$1, error=$2, fatal_reason=$3, broadcast_at=NULL, nonce=NULL WHERE id=$4 RETURNING *`, etx.State, etx.Error, reason, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
+	})
+	if err == nil {
+		if batched && eb.resumer.enqueue(fatalErrorResumeRequest(*etx)) {
+			eb.flushResumes()
+		}
+		eb.notifyFailure(FailureReasonFatalError, *etx, etx.Error.String)
+		eb.outcomeEmitter.Emit(NewOutcomeRecord(OutcomeFatalError, *etx))
+	}
+	return err
+}
+
+// fatalErrorResumeRequest resumes the pipeline run waiting on the fatally
+// errored transaction with its error
+func fatalErrorResumeRequest(etx EthTx) ResumeRequest {
+	return ResumeRequest{
+		ID:  etx.PipelineTaskRunID.UUID,
+		Err: errors.Errorf("fatal error while sending transaction: %s", etx.Error.String),
+	}
+}
+
+// notifyFailure sends a failure event for the transaction to
+// ETH_TX_FAILURE_WEBHOOK_URL, if configured. It never blocks.
+func (eb *EthBroadcaster) notifyFailure(reason string, etx EthTx, errMsg string) {
+	if eb.failureWebhook == nil {
+		return
+	}
+	eb.failureWebhook.Notify(NewFailureEvent(reason, etx, errMsg))
+}
+
+// saveAwaitingFundsTransaction releases the nonce of an in_progress
+// transaction that was rejected due to insufficient eth and parks it in
+// awaiting_funds. The nonce was never consumed, so the next transaction in the
+// queue will use it instead.
+func (eb *EthBroadcaster) saveAwaitingFundsTransaction(etx *EthTx) error {
+	if etx.State != EthTxInProgress {
+		return errors.Errorf("can only transition to awaiting_funds from in_progress, transaction is currently %s", etx.State)
+	}
+	etx.Nonce = nil
+	etx.State = EthTxAwaitingFunds
+	return eb.q.Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id = $1`, etx.ID); err != nil {
+			return errors.Wrapf(err, "saveAwaitingFundsTransaction failed to delete eth_tx_attempt with eth_tx.ID %v", etx.ID)
+		}
+		return errors.Wrap(tx.Get(etx, `UPDATE eth_txes SET state=$1, broadcast_at=NULL, nonce=NULL WHERE id=$2 RETURNING *`, etx.State, etx.ID), "saveAwaitingFundsTransaction failed to save eth_tx")
+	})
+}
+
+// BalanceFetchTimeout is how long to wait for the balance of a key when
+// deciding whether to defer a transaction whose value it cannot cover
+const BalanceFetchTimeout = 5 * time.Second
+
+// valueExceedsBalance returns true if the value of the transaction alone is
+// more than the current balance of its key, i.e. no gas price would make it
+// affordable
+func (eb *EthBroadcaster) valueExceedsBalance(parentCtx context.Context, etx EthTx) (bool, error) {
+	ctx, cancel := context.WithTimeout(parentCtx, BalanceFetchTimeout)
+	defer cancel()
+	balance, err := eb.ethClient.BalanceAt(ctx, etx.FromAddress, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "valueExceedsBalance failed to fetch balance")
+	}
+	return etx.Value.ToInt().Cmp(balance) > 0, nil
+}
+
+// handleMaxGasPriceExceeded defers or fails a transaction that has not been
+// sent because its estimated gas price exceeds the max gas price of its key,
+// depending on ETH_MAX_GAS_PRICE_EXCEEDED_POLICY
+func (eb *EthBroadcaster) handleMaxGasPriceExceeded(etx *EthTx, cause error) error {
+	if eb.config.EvmMaxGasPriceExceededPolicy() == "fail" {
+		eb.logger.Errorw("Estimated gas price exceeds the max gas price, marking transaction as fatally errored", "ethTxID", etx.ID, "err", cause)
+		etx.Error = null.StringFrom(cause.Error())
+		return eb.saveFatallyErroredTransaction(etx, FatalReasonMaxGasPriceExceeded)
+	}
+	eb.logger.Warnw("Estimated gas price exceeds the max gas price, deferring transaction until gas is cheaper", "ethTxID", etx.ID, "origin", etx.Origin(), "err", cause)
+	return eb.saveSkippedTransaction(etx, cause.Error())
+}
+
+// saveSkippedTransaction holds back an unstarted or in_progress transaction
+// without sending it, recording why as its skip reason. An in_progress
+// transaction goes back to unstarted and releases its nonce, which was never
+// used. Either way it is not picked up again until the next run of
+// processUnstartedEthTxs.
+func (eb *EthBroadcaster) saveSkippedTransaction(etx *EthTx, reason string) error {
+	if etx.State != EthTxInProgress && etx.State != EthTxUnstarted {
+		return errors.Errorf("can only skip an in_progress or unstarted transaction, transaction is currently %s", etx.State)
+	}
+	etx.Nonce = nil
+	etx.State = EthTxUnstarted
+	return eb.q.Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id = $1`, etx.ID); err != nil {
+			return errors.Wrapf(err, "saveSkippedTransaction failed to delete eth_tx_attempt with eth_tx.ID %v", etx.ID)
+		}
+		return errors.Wrap(tx.Get(etx, `UPDATE eth_txes SET state=$1, broadcast_at=NULL, nonce=NULL, skip_reason=$2, skipped_at=$3 WHERE id=$4 RETURNING *`, etx.State, reason, time.Now(), etx.ID), "saveSkippedTransaction failed to save eth_tx")
 	})
 }
 
+// resumeOnBroadcastIfRequested resumes the pipeline run waiting on this
+// transaction with the attempt hash, if the run asked to be resumed on
+// broadcast rather than on confirmation.
+//
+// This must only be called after the broadcast attempt has been committed.
+// If we crash before the callback succeeds, the EthConfirmer will pick up the
+// suspended run in ResumePendingTaskRuns instead, so failures here are logged
+// but not returned. For the same reason it is safe to hold the request back
+// until the end of processUnstartedEthTxs when resuming in batches.
+func (eb *EthBroadcaster) resumeOnBroadcastIfRequested(etx EthTx, attempt EthTxAttempt) {
+	if !etx.PipelineTaskRunID.Valid || !eb.resumer.enabled() {
+		return
+	}
+	meta, err := etx.GetMeta()
+	if err != nil {
+		eb.logger.Errorw("Failed to parse meta for transaction", "etxID", etx.ID, "err", err)
+		return
+	}
+	if meta == nil || !meta.ResumeOnBroadcast {
+		return
+	}
+	req := ResumeRequest{ID: etx.PipelineTaskRunID.UUID, Result: attempt.Hash}
+	if eb.resumer.batched() {
+		if eb.resumer.enqueue(req) {
+			eb.flushResumes()
+		}
+		return
+	}
+	err = eb.resumer.resume([]ResumeRequest{req})
+	if errors.Is(err, sql.ErrNoRows) {
+		eb.logger.Debugw("callback missing or already resumed", "etxID", etx.ID)
+	} else if err != nil {
+		eb.logger.Errorw("Failed to resume pipeline on broadcast, will retry from EthConfirmer", "etxID", etx.ID, "err", err)
+	}
+}
+
+// flushResumes delivers any batched requests queued by
+// resumeOnBroadcastIfRequested or saveFatallyErroredTransaction
+func (eb *EthBroadcaster) flushResumes() {
+	if err := eb.resumer.flush(); err != nil {
+		eb.logger.Errorw("Failed to resume pipelines, will retry from EthConfirmer", "err", err)
+	}
+}
+
 // GetNextNonce returns keys.next_nonce for the given address
 func GetNextNonce(q pg.Q, address gethCommon.Address, chainID *big.Int) (nonce int64, err error) {
 	err = q.Get(&nonce, "SELECT next_nonce FROM eth_key_states WHERE address = $1 AND evm_chain_id = $2", address, chainID.String())
 	return nonce, err
 }
 
+// GetNextNonces returns keys.next_nonce for each of the given addresses in a
+// single query. Addresses without a key state on the given chain are omitted
+// from the result.
+func GetNextNonces(q pg.Q, addresses []gethCommon.Address, chainID *big.Int) (map[gethCommon.Address]int64, error) {
+	nonces := make(map[gethCommon.Address]int64, len(addresses))
+	if len(addresses) == 0 {
+		return nonces, nil
+	}
+	query, args, err := sqlx.In(`SELECT address, next_nonce FROM eth_key_states WHERE address IN (?) AND evm_chain_id = ?`, addresses, chainID.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "GetNextNonces failed to build query")
+	}
+	var rows []struct {
+		Address   gethCommon.Address
+		NextNonce int64
+	}
+	if err = q.Select(&rows, q.Rebind(query), args...); err != nil {
+		return nil, errors.Wrap(err, "GetNextNonces failed to load next_nonce")
+	}
+	for _, r := range rows {
+		nonces[r.Address] = r.NextNonce
+	}
+	return nonces, nil
+}
+
 // IncrementNextNonce increments keys.next_nonce by 1
 func IncrementNextNonce(q pg.Queryer, address gethCommon.Address, chainID *big.Int, currentNonce int64) error {
 	res, err := q.Exec("UPDATE eth_key_states SET next_nonce = next_nonce + 1, updated_at = NOW() WHERE address = $1 AND next_nonce = $2 AND evm_chain_id = $3", address, currentNonce, chainID.String())
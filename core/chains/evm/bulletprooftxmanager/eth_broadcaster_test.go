@@ -1,15 +1,18 @@
 package bulletprooftxmanager_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"math/rand"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
@@ -24,12 +27,15 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	gasmocks "github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	cnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -402,6 +408,79 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 			assert.Equal(t, bulletprooftxmanager.EthTxFatalError, ethTx.State)
 			assert.True(t, ethTx.Error.Valid)
 			assert.Equal(t, "transaction reverted during simulation: json-rpc error { Code = 42, Message = 'oh no, it reverted', Data = 'KqYi' }", ethTx.Error.String)
+			assertFatalReason(t, bulletprooftxmanager.FatalReasonSimulationReverted, ethTx)
+
+			ethClient.AssertExpectations(t)
+		})
+		t.Run("on revert with an Error(string) payload, includes the decoded reason", func(t *testing.T) {
+			ethTx := bulletprooftxmanager.EthTx{
+				FromAddress:    fromAddress,
+				ToAddress:      toAddress,
+				EncodedPayload: []byte{42, 0, 0},
+				Value:          assets.NewEthValue(742),
+				GasLimit:       gasLimit,
+				CreatedAt:      time.Unix(0, 0),
+				State:          bulletprooftxmanager.EthTxUnstarted,
+				Simulate:       true,
+			}
+
+			jerr := evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted: hello",
+				// Error("hello")
+				Data: "0x08c379a0" +
+					"0000000000000000000000000000000000000000000000000000000000000020" +
+					"0000000000000000000000000000000000000000000000000000000000000005" +
+					"68656c6c6f000000000000000000000000000000000000000000000000000000",
+			}
+			ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+				return fmt.Sprintf("%s", callarg["value"]) == "0x2e6" // 742
+			}), "latest").Return(&jerr).Once()
+
+			require.NoError(t, borm.InsertEthTx(&ethTx))
+
+			require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+			ethTx, err := borm.FindEthTxWithAttempts(ethTx.ID)
+			require.NoError(t, err)
+			assert.Equal(t, bulletprooftxmanager.EthTxFatalError, ethTx.State)
+			assert.Contains(t, ethTx.Error.String, `transaction reverted during simulation: Error("hello")`)
+
+			ethClient.AssertExpectations(t)
+		})
+		t.Run("on revert with send_on_revert simulation mode, sends tx anyway", func(t *testing.T) {
+			ethTx := bulletprooftxmanager.EthTx{
+				FromAddress:    fromAddress,
+				ToAddress:      toAddress,
+				EncodedPayload: []byte{42, 0, 0},
+				Value:          assets.NewEthValue(842),
+				GasLimit:       gasLimit,
+				CreatedAt:      time.Unix(0, 0),
+				State:          bulletprooftxmanager.EthTxUnstarted,
+				Simulate:       true,
+				SimulationMode: bulletprooftxmanager.SimulationModeSendOnRevert,
+			}
+
+			jerr := evmclient.JsonError{
+				Code:    42,
+				Message: "oh no, it reverted",
+				Data:    []byte{42, 166, 34},
+			}
+			ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+				return fmt.Sprintf("%s", callarg["value"]) == "0x34a" // 842
+			}), "latest").Return(&jerr).Once()
+			ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+				return tx.Nonce() == uint64(7) && tx.Value().Cmp(big.NewInt(842)) == 0
+			})).Return(nil).Once()
+
+			require.NoError(t, borm.InsertEthTx(&ethTx))
+
+			require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+			ethTx, err := borm.FindEthTxWithAttempts(ethTx.ID)
+			require.NoError(t, err)
+			assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, ethTx.State)
+			assert.False(t, ethTx.Error.Valid)
 
 			ethClient.AssertExpectations(t)
 		})
@@ -410,6 +489,211 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 	ethClient.AssertExpectations(t)
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Tiebreak(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	createdAt := time.Unix(0, 0)
+	subjectA := uuid.FromStringOrNil("00000000-0000-0000-0000-00000000000a")
+	subjectB := uuid.FromStringOrNil("00000000-0000-0000-0000-00000000000b")
+
+	// Inserts three txes with identical value and created_at so that only the
+	// tiebreak decides the order in which they are broadcast
+	insertTxes := func(t *testing.T, fromAddress gethCommon.Address) []bulletprooftxmanager.EthTx {
+		var etxs []bulletprooftxmanager.EthTx
+		for i, subject := range []uuid.UUID{subjectB, subjectA, subjectB} {
+			etx := bulletprooftxmanager.EthTx{
+				FromAddress:    fromAddress,
+				ToAddress:      cltest.NewAddress(),
+				EncodedPayload: []byte{42, 42, byte(i)},
+				Value:          assets.NewEthValue(142),
+				GasLimit:       242,
+				CreatedAt:      createdAt,
+				State:          bulletprooftxmanager.EthTxUnstarted,
+				Subject:        uuid.NullUUID{UUID: subject, Valid: true},
+			}
+			require.NoError(t, borm.InsertEthTx(&etx))
+			etxs = append(etxs, etx)
+		}
+		return etxs
+	}
+
+	assertNonces := func(t *testing.T, expected map[int64]int64) {
+		for id, nonce := range expected {
+			etx, err := borm.FindEthTxWithAttempts(id)
+			require.NoError(t, err)
+			require.NotNil(t, etx.Nonce)
+			assert.Equal(t, nonce, *etx.Nonce, "unexpected nonce for eth_tx %d", id)
+		}
+	}
+
+	t.Run("id", func(t *testing.T) {
+		cfg.Overrides.GlobalEvmTxQueueTiebreak = null.StringFrom("id")
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Times(3)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		etxs := insertTxes(t, fromAddress)
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		assertNonces(t, map[int64]int64{etxs[0].ID: 0, etxs[1].ID: 1, etxs[2].ID: 2})
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("subject", func(t *testing.T) {
+		cfg.Overrides.GlobalEvmTxQueueTiebreak = null.StringFrom("subject")
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Times(3)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		etxs := insertTxes(t, fromAddress)
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		assertNonces(t, map[int64]int64{etxs[1].ID: 0, etxs[0].ID: 1, etxs[2].ID: 2})
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Ordering(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	insertTx := func(t *testing.T, fromAddress gethCommon.Address, value int64, createdAt time.Time, priority cnull.Int64) bulletprooftxmanager.EthTx {
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{42, 42, 0},
+			Value:          assets.NewEthValue(value),
+			GasLimit:       242,
+			CreatedAt:      createdAt,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+			Priority:       priority,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+
+	assertNonces := func(t *testing.T, expected map[int64]int64) {
+		for id, nonce := range expected {
+			etx, err := borm.FindEthTxWithAttempts(id)
+			require.NoError(t, err)
+			require.NotNil(t, etx.Nonce)
+			assert.Equal(t, nonce, *etx.Nonce, "unexpected nonce for eth_tx %d", id)
+		}
+	}
+
+	t.Run("fifo broadcasts a large value tx that was created first before cheaper ones", func(t *testing.T) {
+		cfg.Overrides.GlobalEvmTxQueueOrdering = null.StringFrom("fifo")
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Times(2)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		large := insertTx(t, fromAddress, 1000000, time.Unix(1, 0), cnull.Int64{})
+		small := insertTx(t, fromAddress, 1, time.Unix(2, 0), cnull.Int64{})
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		assertNonces(t, map[int64]int64{large.ID: 0, small.ID: 1})
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("fifo broadcasts in the order txes were enqueued regardless of tiebreak", func(t *testing.T) {
+		cfg.Overrides.GlobalEvmTxQueueOrdering = null.StringFrom("fifo")
+		cfg.Overrides.GlobalEvmTxQueueTiebreak = null.StringFrom("subject")
+		t.Cleanup(func() { cfg.Overrides.GlobalEvmTxQueueTiebreak = null.String{} })
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Times(3)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		// Subject order and creation time would both put these the other way
+		// round
+		var etxs []bulletprooftxmanager.EthTx
+		for i, subject := range []string{"0c", "0b", "0a"} {
+			etx := bulletprooftxmanager.EthTx{
+				FromAddress:    fromAddress,
+				ToAddress:      cltest.NewAddress(),
+				EncodedPayload: []byte{42, 42, 0},
+				Value:          assets.NewEthValue(1),
+				GasLimit:       242,
+				CreatedAt:      time.Unix(int64(10-i), 0),
+				State:          bulletprooftxmanager.EthTxUnstarted,
+				Subject:        uuid.NullUUID{UUID: uuid.FromStringOrNil("00000000-0000-0000-0000-0000000000" + subject), Valid: true},
+			}
+			require.NoError(t, borm.InsertEthTx(&etx))
+			etxs = append(etxs, etx)
+		}
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		assertNonces(t, map[int64]int64{etxs[0].ID: 0, etxs[1].ID: 1, etxs[2].ID: 2})
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("value_asc_fifo broadcasts cheaper txes first", func(t *testing.T) {
+		cfg.Overrides.GlobalEvmTxQueueOrdering = null.StringFrom("value_asc_fifo")
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Times(2)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		large := insertTx(t, fromAddress, 1000000, time.Unix(1, 0), cnull.Int64{})
+		small := insertTx(t, fromAddress, 1, time.Unix(2, 0), cnull.Int64{})
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		assertNonces(t, map[int64]int64{small.ID: 0, large.ID: 1})
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("priority broadcasts higher priority txes first and unprioritised txes last", func(t *testing.T) {
+		cfg.Overrides.GlobalEvmTxQueueOrdering = null.StringFrom("priority")
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Times(3)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		none := insertTx(t, fromAddress, 1, time.Unix(1, 0), cnull.Int64{})
+		low := insertTx(t, fromAddress, 1, time.Unix(2, 0), cnull.Int64From(1))
+		high := insertTx(t, fromAddress, 1000000, time.Unix(3, 0), cnull.Int64From(5))
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		assertNonces(t, map[int64]int64{high.ID: 0, low.ID: 1, none.ID: 2})
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("key-specific fifo broadcasts in creation order regardless of value", func(t *testing.T) {
+		cfg.Overrides.GlobalEvmTxQueueOrdering = null.String{}
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+		require.NoError(t, evmcfg.Configure(evmtypes.ChainCfg{
+			KeySpecific: map[string]evmtypes.ChainCfg{
+				fromAddress.Hex(): {EvmTxQueueOrdering: null.StringFrom("fifo")},
+			},
+		}))
+		t.Cleanup(func() { require.NoError(t, evmcfg.Configure(evmtypes.ChainCfg{})) })
+		assert.Equal(t, "value_asc_fifo", evmcfg.KeySpecificTxQueueOrdering(otherAddress))
+
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Times(3)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		first := insertTx(t, fromAddress, 1000000, time.Unix(1, 0), cnull.Int64{})
+		second := insertTx(t, fromAddress, 1, time.Unix(2, 0), cnull.Int64{})
+		third := insertTx(t, fromAddress, 500, time.Unix(3, 0), cnull.Int64{})
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		assertNonces(t, map[int64]int64{first.ID: 0, second.ID: 1, third.ID: 2})
+		ethClient.AssertExpectations(t)
+	})
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testing.T) {
 	// non-transactional DB needed because we deliberately test for FK violation
 	cfg, db := heavyweight.FullTestDB(t, "eth_broadcaster_optimistic_locking", true, true)
@@ -437,6 +721,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testi
 		[]ethkey.State{keyState},
 		estimator,
 		nil,
+		nil,
 		logger.TestLogger(t),
 	)
 
@@ -563,6 +848,8 @@ func TestEthBroadcaster_AssignsNonceOnStart(t *testing.T) {
 		ethClient.On("PendingNonceAt", mock.Anything, mock.MatchedBy(func(account gethCommon.Address) bool {
 			return account.Hex() == fromAddress.Hex()
 		})).Return(ethNodeNonce, nil).Once()
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "txpool_contentFrom", fromAddress).Return(errors.New("the method txpool_contentFrom does not exist/is not available"))
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("something exploded"))
 
 		require.NoError(t, eb.Start())
 		defer eb.Close()
@@ -698,6 +985,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 		assert.Nil(t, etx.BroadcastAt)
 		assert.True(t, etx.Error.Valid)
 		assert.Equal(t, "exceeds block gas limit", etx.Error.String)
+		assertFatalReason(t, bulletprooftxmanager.FatalReasonSendFatal, etx)
 		assert.Len(t, etx.EthTxAttempts, 0)
 
 		ethClient.AssertExpectations(t)
@@ -737,6 +1025,40 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 		ethClient.AssertExpectations(t)
 	})
 
+	t.Run("previous run assigned nonce and broadcast and a proxy returns a wrapped already known error", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, nextNonce)
+
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		// Crashed right after we commit the database transaction that saved
+		// the nonce to the eth_tx so keys.next_nonce has not been
+		// incremented yet
+		inProgressEthTx := cltest.MustInsertInProgressEthTxWithAttempt(t, borm, firstNonce, fromAddress)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(firstNonce)
+		})).Return(errors.New(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"already known"}}`)).Once()
+
+		// Do the thing
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		// Check it was saved correctly with its attempt
+		etx, err := borm.FindEthTxWithAttempts(inProgressEthTx.ID)
+		require.NoError(t, err)
+
+		assert.NotNil(t, etx.BroadcastAt)
+		assert.False(t, etx.Error.Valid)
+		assert.Len(t, etx.EthTxAttempts, 1)
+
+		ethClient.AssertExpectations(t)
+	})
+
 	t.Run("previous run assigned nonce and broadcast and now the transaction has been confirmed", func(t *testing.T) {
 		db := pgtest.NewSqlxDB(t)
 		borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
@@ -867,6 +1189,12 @@ func getLocalNextNonce(t *testing.T, q pg.Q, fromAddress gethCommon.Address) uin
 	return uint64(n)
 }
 
+func assertFatalReason(t *testing.T, expected bulletprooftxmanager.FatalReason, etx bulletprooftxmanager.EthTx) {
+	t.Helper()
+	require.NotNil(t, etx.FatalReason, "expected fatal reason %s", expected)
+	assert.Equal(t, expected, *etx.FatalReason)
+}
+
 // Note that all of these tests share the same database, and ordering matters.
 // This in order to more deeply test ProcessUnstartedEthTxs over
 // multiple runs with previous errors in the database.
@@ -964,6 +1292,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Errors(t *testing.T) {
 			require.Nil(t, etx.Nonce)
 			assert.True(t, etx.Error.Valid)
 			assert.Contains(t, etx.Error.String, "exceeds block gas limit")
+			assertFatalReason(t, bulletprooftxmanager.FatalReasonSendFatal, etx)
 			assert.Len(t, etx.EthTxAttempts, 0)
 
 			// Check that the key had its nonce reset
@@ -1058,6 +1387,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Errors(t *testing.T) {
 		require.Nil(t, etx.Nonce)
 		assert.True(t, etx.Error.Valid)
 		assert.Contains(t, etx.Error.String, "tx fee (1.10 ether) exceeds the configured cap (1.00 ether)")
+		assertFatalReason(t, bulletprooftxmanager.FatalReasonTooExpensive, etx)
 		assert.Len(t, etx.EthTxAttempts, 0)
 
 		// Check that the key had its nonce reset
@@ -1175,10 +1505,58 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Errors(t *testing.T) {
 		require.NotNil(t, etx.Nonce)
 		assert.False(t, etx.Error.Valid)
 		assert.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, uint32(2), etx.InitialBumps)
 		attempt := etx.EthTxAttempts[0]
 		assert.Equal(t, big.NewInt(30000000000).String(), attempt.GasPrice.String())
 	})
 
+	t.Run("eth node keeps returning underpriced transaction beyond ETH_BROADCAST_MAX_INITIAL_BUMPS", func(t *testing.T) {
+		underpricedError := "transaction underpriced"
+		localNextNonce := getLocalNextNonce(t, q, fromAddress)
+		cfg.Overrides.GlobalEvmBroadcastMaxInitialBumps = null.IntFrom(1)
+		t.Cleanup(func() { cfg.Overrides.GlobalEvmBroadcastMaxInitialBumps = null.Int{} })
+
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: encodedPayload,
+			Value:          value,
+			GasLimit:       gasLimit,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		// First was underpriced
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce && tx.GasPrice().Cmp(evmcfg.EvmGasPriceDefault()) == 0
+		})).Return(errors.New(underpricedError)).Once()
+
+		// Second with gas bump was still underpriced, and no more bumps are allowed
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce && tx.GasPrice().Cmp(big.NewInt(25000000000)) == 0
+		})).Return(errors.New(underpricedError)).Once()
+
+		// Do the thing
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		ethClient.AssertExpectations(t)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+		assert.Nil(t, etx.BroadcastAt)
+		assert.Nil(t, etx.Nonce)
+		require.NotNil(t, etx.FatalReason)
+		assert.Equal(t, bulletprooftxmanager.FatalReasonMaxInitialBumps, *etx.FatalReason)
+		assert.Contains(t, etx.Error.String, "transaction was still underpriced after 1 gas bumps on initial send")
+		assert.Equal(t, uint32(1), etx.InitialBumps)
+		assert.Len(t, etx.EthTxAttempts, 0)
+
+		// The nonce was not used
+		assert.Equal(t, localNextNonce, getLocalNextNonce(t, q, fromAddress))
+	})
+
 	etxUnfinished := bulletprooftxmanager.EthTx{
 		FromAddress:    fromAddress,
 		ToAddress:      toAddress,
@@ -1348,29 +1726,13 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Errors(t *testing.T) {
 
 		ethClient.AssertExpectations(t)
 	})
-}
-
-func TestEthBroadcaster_ProcessUnstartedEthTxs_KeystoreErrors(t *testing.T) {
-	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
-	value := assets.NewEthValue(142)
-	gasLimit := uint64(242)
-	encodedPayload := []byte{0, 1}
-	localNonce := 0
-
-	db := pgtest.NewSqlxDB(t)
-	cfg := cltest.NewTestGeneralConfig(t)
-	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
-
-	realKeystore := cltest.NewKeyStore(t, db, cfg)
-	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, realKeystore.Eth())
 
-	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
-	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
 
-	kst := new(ksmocks.Eth)
-	eb := cltest.NewEthBroadcaster(t, db, ethClient, kst, evmcfg, []ethkey.State{keyState})
+	t.Run("eth node returns L2 fee too low for EIP-1559 tx, re-estimates and resends", func(t *testing.T) {
+		l2FeeTooLowError := "max fee per gas less than block base fee: address 0x0499BEA33347cb62D79A9C0b1EDA01d8d329894c, maxFeePerGas: 100000000 baseFee: 110000000"
+		localNextNonce := getLocalNextNonce(t, q, fromAddress)
 
-	t.Run("tx signing fails", func(t *testing.T) {
 		etx := bulletprooftxmanager.EthTx{
 			FromAddress:    fromAddress,
 			ToAddress:      toAddress,
@@ -1381,13 +1743,206 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_KeystoreErrors(t *testing.T) {
 		}
 		require.NoError(t, borm.InsertEthTx(&etx))
 
-		tx := *gethTypes.NewTx(&gethTypes.LegacyTx{})
-		kst.On("SignTx",
-			fromAddress,
-			mock.AnythingOfType("*types.Transaction"),
-			mock.MatchedBy(func(chainID *big.Int) bool {
-				return chainID.Cmp(evmcfg.ChainID()) == 0
-			})).Return(&tx, errors.New("could not sign transaction")).Once()
+		// First was rejected, second is sent with a freshly estimated fee
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce
+		})).Return(errors.New(l2FeeTooLowError)).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+		assert.Equal(t, 0x2, int(etx.EthTxAttempts[0].TxType))
+
+		ethClient.AssertExpectations(t)
+	})
+
+	pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+
+	t.Run("eth node returns gas limit too low with a required gas limit, resends at that gas limit", func(t *testing.T) {
+		gasLimitTooLowError := "gas limit too low: 242, required: 78654"
+		localNextNonce := getLocalNextNonce(t, q, fromAddress)
+
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: encodedPayload,
+			Value:          value,
+			GasLimit:       gasLimit,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce && tx.Gas() == gasLimit
+		})).Return(errors.New(gasLimitTooLowError)).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce && tx.Gas() == 78654
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		assert.Equal(t, uint64(78654), etx.GasLimit)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+		assert.Equal(t, uint64(78654), etx.EthTxAttempts[0].ChainSpecificGasLimit)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+
+	t.Run("eth node returns gas limit too low without a required gas limit, resends at the estimated gas limit", func(t *testing.T) {
+		gasLimitTooLowError := "Not enough gas for transaction validation"
+		localNextNonce := getLocalNextNonce(t, q, fromAddress)
+
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: encodedPayload,
+			Value:          value,
+			GasLimit:       gasLimit,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce && tx.Gas() == gasLimit
+		})).Return(errors.New(gasLimitTooLowError)).Once()
+		ethClient.On("EstimateGas", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return msg.From == fromAddress && *msg.To == toAddress && msg.Value.Cmp(value.ToInt()) == 0 && bytes.Equal(msg.Data, encodedPayload)
+		})).Return(uint64(50000), nil).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce && tx.Gas() == 50000
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, uint64(50000), etx.EthTxAttempts[0].ChainSpecificGasLimit)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+	cfg.Overrides.GlobalEvmGasLimitMax = null.IntFrom(60000)
+
+	t.Run("eth node returns gas limit too low with a required gas limit above ETH_GAS_LIMIT_MAX, resends at ETH_GAS_LIMIT_MAX", func(t *testing.T) {
+		gasLimitTooLowError := "gas limit too low: 242, required: 78654"
+		localNextNonce := getLocalNextNonce(t, q, fromAddress)
+
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: encodedPayload,
+			Value:          value,
+			GasLimit:       gasLimit,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce && tx.Gas() == gasLimit
+		})).Return(errors.New(gasLimitTooLowError)).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce && tx.Gas() == 60000
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, uint64(60000), etx.EthTxAttempts[0].ChainSpecificGasLimit)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+
+	t.Run("eth node returns gas limit too low for a tx already at ETH_GAS_LIMIT_MAX, marks it as fatally errored", func(t *testing.T) {
+		gasLimitTooLowError := "gas limit too low: 60000, required: 78654"
+		localNextNonce := getLocalNextNonce(t, q, fromAddress)
+
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: encodedPayload,
+			Value:          value,
+			GasLimit:       60000,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == localNextNonce && tx.Gas() == 60000
+		})).Return(errors.New(gasLimitTooLowError)).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+		assert.Nil(t, etx.Nonce)
+		assert.Contains(t, etx.Error.String, "cannot be raised above ETH_GAS_LIMIT_MAX of 60000")
+		assertFatalReason(t, bulletprooftxmanager.FatalReasonGasLimitMaxExceeded, etx)
+		assert.Len(t, etx.EthTxAttempts, 0)
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_KeystoreErrors(t *testing.T) {
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	value := assets.NewEthValue(142)
+	gasLimit := uint64(242)
+	encodedPayload := []byte{0, 1}
+	localNonce := 0
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+
+	realKeystore := cltest.NewKeyStore(t, db, cfg)
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, realKeystore.Eth())
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	kst := new(ksmocks.Eth)
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, kst, evmcfg, []ethkey.State{keyState})
+
+	t.Run("tx signing fails", func(t *testing.T) {
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: encodedPayload,
+			Value:          value,
+			GasLimit:       gasLimit,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		tx := *gethTypes.NewTx(&gethTypes.LegacyTx{})
+		kst.On("SignTx",
+			fromAddress,
+			mock.AnythingOfType("*types.Transaction"),
+			mock.MatchedBy(func(chainID *big.Int) bool {
+				return chainID.Cmp(evmcfg.ChainID()) == 0
+			})).Return(&tx, errors.New("could not sign transaction")).Once()
 
 		// Do the thing
 		err := eb.ProcessUnstartedEthTxs(context.Background(), keyState)
@@ -1428,59 +1983,1473 @@ func TestEthBroadcaster_GetNextNonce(t *testing.T) {
 	assert.Equal(t, int64(0), nonce)
 }
 
-func TestEthBroadcaster_IncrementNextNonce(t *testing.T) {
+func TestEthBroadcaster_GetNextNonces(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := cltest.NewTestGeneralConfig(t)
 
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
-	keyState, _ := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	_, address1 := cltest.MustInsertRandomKey(t, ethKeyStore, int64(0))
+	_, address2 := cltest.MustInsertRandomKey(t, ethKeyStore, int64(42))
+	_, otherChainAddress := cltest.MustInsertRandomKey(t, ethKeyStore, *utils.NewBigI(1337), int64(7))
+	unknownAddress := cltest.NewAddress()
 
-	// Cannot increment if supplied nonce doesn't match existing
-	require.Error(t, bulletprooftxmanager.IncrementNextNonce(db, keyState.Address.Address(), &cltest.FixtureChainID, int64(42)))
+	q := pg.NewQ(db, logger.TestLogger(t), cfg)
 
-	require.NoError(t, bulletprooftxmanager.IncrementNextNonce(db, keyState.Address.Address(), &cltest.FixtureChainID, int64(0)))
+	t.Run("returns next_nonce for each known address on the chain", func(t *testing.T) {
+		nonces, err := bulletprooftxmanager.GetNextNonces(q, []gethCommon.Address{address1, address2, otherChainAddress, unknownAddress}, &cltest.FixtureChainID)
+		require.NoError(t, err)
 
-	// Nonce bumped to 1
-	require.NoError(t, db.Get(&keyState, `SELECT * FROM eth_key_states LIMIT 1`))
-	require.NotNil(t, keyState.NextNonce)
-	require.Equal(t, int64(1), keyState.NextNonce)
-}
+		assert.Equal(t, map[gethCommon.Address]int64{address1: 0, address2: 42}, nonces)
+	})
 
-func TestEthBroadcaster_Trigger(t *testing.T) {
-	t.Parallel()
+	t.Run("returns empty map for no addresses", func(t *testing.T) {
+		nonces, err := bulletprooftxmanager.GetNextNonces(q, nil, &cltest.FixtureChainID)
+		require.NoError(t, err)
 
-	// Simple sanity check to make sure it doesn't block
+		assert.Len(t, nonces, 0)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumeOnBroadcast(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
 
-	cfg := cltest.NewTestGeneralConfig(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
-	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
-	eb := cltest.NewEthBroadcaster(t, db, cltest.NewEthClientMockWithDefaultChain(t), ethKeyStore, evmcfg, []ethkey.State{})
 
-	eb.Trigger(cltest.NewAddress())
-	eb.Trigger(cltest.NewAddress())
-	eb.Trigger(cltest.NewAddress())
-}
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
 
-func TestEthBroadcaster_EthTxInsertEventCausesTriggerToFire(t *testing.T) {
-	// NOTE: Testing triggers requires committing transactions and does not work with transactional tests
-	cfg, db := heavyweight.FullTestDB(t, "eth_tx_triggers", true, true)
-	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	insertEtx := func(t *testing.T, meta bulletprooftxmanager.EthTxMeta) (bulletprooftxmanager.EthTx, uuid.UUID) {
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		b, err := json.Marshal(meta)
+		require.NoError(t, err)
+		m := datatypes.JSON(b)
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:       fromAddress,
+			ToAddress:         cltest.NewAddress(),
+			EncodedPayload:    []byte{42, 42, 0},
+			Value:             *assets.NewEth(0),
+			GasLimit:          500000,
+			State:             bulletprooftxmanager.EthTxUnstarted,
+			Meta:              &m,
+			PipelineTaskRunID: uuid.NullUUID{UUID: tr.ID, Valid: true},
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx, tr.ID
+	}
 
-	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	t.Run("resumes with the attempt hash once the attempt is saved as broadcast", func(t *testing.T) {
+		etx, trID := insertEtx(t, bulletprooftxmanager.EthTxMeta{JobID: 1, ResumeOnBroadcast: true})
 
-	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
-	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
-	eventBroadcaster := cltest.NewEventBroadcaster(t, evmcfg.DatabaseURL())
-	require.NoError(t, eventBroadcaster.Start())
-	t.Cleanup(func() { require.NoError(t, eventBroadcaster.Close()) })
+		var called bool
+		fn := func(id uuid.UUID, result interface{}, err error) error {
+			called = true
+			require.NoError(t, err)
+			assert.Equal(t, trID, id)
 
-	ethTxInsertListener, err := eventBroadcaster.Subscribe(pg.ChannelInsertOnEthTx, "")
-	require.NoError(t, err)
+			// The attempt must already be committed when the callback fires
+			var state bulletprooftxmanager.EthTxAttemptState
+			require.NoError(t, db.Get(&state, `SELECT state FROM eth_tx_attempts WHERE hash = $1`, result))
+			assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, state)
+			return nil
+		}
+		bulletprooftxmanager.SetResumeCallbackOnEthBroadcaster(fn, eb)
 
-	// Give it some time to start listening
-	time.Sleep(100 * time.Millisecond)
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Once()
 
-	mustInsertUnstartedEthTx(t, borm, fromAddress)
-	gomega.NewWithT(t).Eventually(ethTxInsertListener.Events()).Should(gomega.Receive())
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		assert.True(t, called)
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+	})
+
+	t.Run("does not resume when not requested", func(t *testing.T) {
+		insertEtx(t, bulletprooftxmanager.EthTxMeta{JobID: 1})
+
+		fn := func(id uuid.UUID, result interface{}, err error) error {
+			t.Fatal("callback should not be called")
+			return nil
+		}
+		bulletprooftxmanager.SetResumeCallbackOnEthBroadcaster(fn, eb)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+	})
+
+	t.Run("erroring callback does not fail the broadcast", func(t *testing.T) {
+		etx, _ := insertEtx(t, bulletprooftxmanager.EthTxMeta{JobID: 1, ResumeOnBroadcast: true})
+
+		fn := func(id uuid.UUID, result interface{}, err error) error {
+			return errors.New("something exploded in the callback")
+		}
+		bulletprooftxmanager.SetResumeCallbackOnEthBroadcaster(fn, eb)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+	})
+
+	t.Run("resumes in batches when a batch callback is registered", func(t *testing.T) {
+		var trIDs []uuid.UUID
+		for i := 0; i < 3; i++ {
+			_, trID := insertEtx(t, bulletprooftxmanager.EthTxMeta{JobID: 1, ResumeOnBroadcast: true})
+			trIDs = append(trIDs, trID)
+		}
+
+		var batches [][]bulletprooftxmanager.ResumeRequest
+		fn := func(reqs []bulletprooftxmanager.ResumeRequest) error {
+			batches = append(batches, reqs)
+			return nil
+		}
+		bulletprooftxmanager.SetResumeBatchCallbackOnEthBroadcaster(fn, eb)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Times(3)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		// All three are held back and delivered together at the end
+		require.Len(t, batches, 1)
+		require.Len(t, batches[0], 3)
+		for i, req := range batches[0] {
+			assert.Equal(t, trIDs[i], req.ID)
+			assert.NoError(t, req.Err)
+			assert.IsType(t, gethCommon.Hash{}, req.Result)
+		}
+	})
+
+	t.Run("resumes fatally errored transactions in batches after saving them", func(t *testing.T) {
+		var trIDs []uuid.UUID
+		for i := 0; i < 2; i++ {
+			_, trID := insertEtx(t, bulletprooftxmanager.EthTxMeta{JobID: 1})
+			trIDs = append(trIDs, trID)
+		}
+
+		var batches [][]bulletprooftxmanager.ResumeRequest
+		fn := func(reqs []bulletprooftxmanager.ResumeRequest) error {
+			// The fatal errors must already be committed when the callback fires
+			for _, req := range reqs {
+				var state bulletprooftxmanager.EthTxState
+				require.NoError(t, db.Get(&state, `SELECT state FROM eth_txes WHERE pipeline_task_run_id = $1`, req.ID))
+				assert.Equal(t, bulletprooftxmanager.EthTxFatalError, state)
+			}
+			batches = append(batches, reqs)
+			return nil
+		}
+		bulletprooftxmanager.SetResumeBatchCallbackOnEthBroadcaster(fn, eb)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(errors.New("exceeds block gas limit")).Times(2)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		require.Len(t, batches, 1)
+		require.Len(t, batches[0], 2)
+		for i, req := range batches[0] {
+			assert.Equal(t, trIDs[i], req.ID)
+			require.Error(t, req.Err)
+			assert.Contains(t, req.Err.Error(), "fatal error while sending transaction: exceeds block gas limit")
+		}
+	})
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_InsufficientEthSkip(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.EthTxInsufficientEthMode = null.StringFrom("skip")
+	// Send strictly in insertion order so the expensive tx goes first
+	cfg.Overrides.GlobalEvmTxQueueOrdering = null.StringFrom("fifo")
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	expensive := cltest.NewEthTx(t, fromAddress)
+	expensive.Value = *assets.NewEth(1000)
+	require.NoError(t, borm.InsertEthTx(&expensive))
+	cheap := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+	// The expensive tx is tried first and rejected, its nonce is then reused
+	// for the cheap one
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0 && tx.Value().Cmp(expensive.Value.ToInt()) == 0
+	})).Return(errors.New("insufficient funds for transfer")).Once()
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0 && tx.Value().Cmp(cheap.Value.ToInt()) == 0
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+	etx, err := borm.FindEthTxWithAttempts(expensive.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxAwaitingFunds, etx.State)
+	assert.Nil(t, etx.Nonce)
+	assert.False(t, etx.Error.Valid)
+	assert.Len(t, etx.EthTxAttempts, 0)
+
+	etx, err = borm.FindEthTxWithAttempts(cheap.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+	require.NotNil(t, etx.Nonce)
+	assert.Equal(t, int64(0), *etx.Nonce)
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_InsufficientEthDeferValue(t *testing.T) {
+	setup := func(t *testing.T) (bulletprooftxmanager.ORM, *evmmocks.Client, *bulletprooftxmanager.EthBroadcaster, ethkey.State, gethCommon.Address) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.EthTxInsufficientEthMode = null.StringFrom("defer_value")
+		// Send strictly in insertion order so the large value tx goes first
+		cfg.Overrides.GlobalEvmTxQueueOrdering = null.StringFrom("fifo")
+		borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+		return borm, ethClient, eb, keyState, fromAddress
+	}
+
+	t.Run("defers a tx whose value exceeds the balance and sends the smaller ones", func(t *testing.T) {
+		borm, ethClient, eb, keyState, fromAddress := setup(t)
+
+		large := cltest.NewEthTx(t, fromAddress)
+		large.Value = *assets.NewEth(1000)
+		require.NoError(t, borm.InsertEthTx(&large))
+		small := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.Value().Cmp(large.Value.ToInt()) == 0
+		})).Return(errors.New("insufficient funds for transfer")).Once()
+		ethClient.On("BalanceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(assets.NewEth(1).ToInt(), nil).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.Value().Cmp(small.Value.ToInt()) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(large.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxAwaitingFunds, etx.State)
+		assert.Nil(t, etx.Nonce)
+		assert.Len(t, etx.EthTxAttempts, 0)
+
+		etx, err = borm.FindEthTxWithAttempts(small.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.NotNil(t, etx.Nonce)
+		assert.Equal(t, int64(0), *etx.Nonce)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("keeps blocking on a tx whose value is covered by the balance", func(t *testing.T) {
+		borm, ethClient, eb, keyState, fromAddress := setup(t)
+
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.Value = *assets.NewEth(1)
+		require.NoError(t, borm.InsertEthTx(&etx))
+		cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		// Only the first tx is tried, it is the gas that can't be paid for
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0
+		})).Return(errors.New("insufficient funds for transfer")).Once()
+		ethClient.On("BalanceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(assets.NewEth(2).ToInt(), nil).Once()
+
+		err := eb.ProcessUnstartedEthTxs(context.Background(), keyState)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "insufficient funds for transfer")
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxInProgress, etx.State)
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_MaxGasPriceExceededPolicy(t *testing.T) {
+	maxGasPrice := assets.GWei(100)
+
+	setup := func(t *testing.T, policy string, estimate *big.Int) (*configtest.TestGeneralConfig, bulletprooftxmanager.ORM, *evmmocks.Client, *bulletprooftxmanager.EthBroadcaster, ethkey.State, gethCommon.Address) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalEvmMaxGasPriceExceededPolicy = null.StringFrom(policy)
+		cfg.Overrides.GlobalEvmMaxGasPriceWei = maxGasPrice
+		// The fixed price estimator always returns the default gas price
+		cfg.Overrides.GlobalEvmGasPriceDefault = estimate
+		borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+		return cfg, borm, ethClient, eb, keyState, fromAddress
+	}
+
+	for _, policy := range []string{"clamp", "defer", "fail"} {
+		policy := policy
+		t.Run(fmt.Sprintf("%s sends at the estimate if it is at the cap", policy), func(t *testing.T) {
+			_, borm, ethClient, eb, keyState, fromAddress := setup(t, policy, maxGasPrice)
+			etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+			ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+				return tx.Nonce() == 0 && tx.GasPrice().Cmp(maxGasPrice) == 0
+			})).Return(nil).Once()
+
+			require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+			etx, err := borm.FindEthTxWithAttempts(etx.ID)
+			require.NoError(t, err)
+			assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+			require.Len(t, etx.EthTxAttempts, 1)
+			assert.Nil(t, etx.EthTxAttempts[0].UnclampedGasPrice)
+			assert.False(t, etx.EthTxAttempts[0].MaxGasPricePolicy.Valid)
+
+			ethClient.AssertExpectations(t)
+		})
+	}
+
+	t.Run("clamp sends at the cap if the estimate is above it and records the estimate", func(t *testing.T) {
+		_, borm, ethClient, eb, keyState, fromAddress := setup(t, "clamp", assets.GWei(200))
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.GasPrice().Cmp(maxGasPrice) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 1)
+		attempt := etx.EthTxAttempts[0]
+		assert.Equal(t, maxGasPrice.String(), attempt.GasPrice.String())
+		require.NotNil(t, attempt.UnclampedGasPrice)
+		assert.Equal(t, assets.GWei(200).String(), attempt.UnclampedGasPrice.String())
+		assert.Equal(t, "clamp", attempt.MaxGasPricePolicy.String)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("defer leaves the transaction unstarted if the estimate is above the cap until it is cheaper", func(t *testing.T) {
+		cfg, borm, ethClient, eb, keyState, fromAddress := setup(t, "defer", assets.GWei(200))
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+		assert.Nil(t, etx.Nonce)
+		assert.Len(t, etx.EthTxAttempts, 0)
+		require.True(t, etx.SkipReason.Valid)
+		assert.Contains(t, etx.SkipReason.String, "estimated gas price of 200000000000 wei exceeds max gas price of 100000000000 wei")
+		assert.Contains(t, etx.SkipReason.String, "ETH_MAX_GAS_PRICE_EXCEEDED_POLICY=defer")
+		assert.NotNil(t, etx.SkippedAt)
+
+		// Once gas is cheaper it is sent with the nonce it would have had
+		cfg.Overrides.GlobalEvmGasPriceDefault = assets.GWei(50)
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.GasPrice().Cmp(assets.GWei(50)) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		assert.False(t, etx.SkipReason.Valid)
+		assert.Nil(t, etx.SkippedAt)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("fail marks the transaction as fatally errored if the estimate is above the cap", func(t *testing.T) {
+		_, borm, ethClient, eb, keyState, fromAddress := setup(t, "fail", assets.GWei(200))
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+		assert.Nil(t, etx.Nonce)
+		assert.Len(t, etx.EthTxAttempts, 0)
+		assert.Contains(t, etx.Error.String, "estimated gas price of 200000000000 wei exceeds max gas price of 100000000000 wei")
+		assert.Contains(t, etx.Error.String, "ETH_MAX_GAS_PRICE_EXCEEDED_POLICY=fail")
+		assertFatalReason(t, bulletprooftxmanager.FatalReasonMaxGasPriceExceeded, etx)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("a lower max gas price of the transaction takes precedence over the key's", func(t *testing.T) {
+		_, borm, ethClient, eb, keyState, fromAddress := setup(t, "clamp", assets.GWei(80))
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.MaxGasPriceWei = utils.NewBig(assets.GWei(60))
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.GasPrice().Cmp(assets.GWei(60)) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 1)
+		require.NotNil(t, etx.EthTxAttempts[0].UnclampedGasPrice)
+		assert.Equal(t, assets.GWei(80).String(), etx.EthTxAttempts[0].UnclampedGasPrice.String())
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_RecordsEstimatorInputs(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalGasEstimatorRecordInputs = null.BoolFrom(true)
+	cfg.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	cfg.Overrides.GlobalEvmGasPriceDefault = assets.GWei(42)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+	etx, err := borm.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	require.Len(t, etx.EthTxAttempts, 1)
+	attempt := etx.EthTxAttempts[0]
+	require.NotNil(t, attempt.EstimatorInputs)
+	assert.Equal(t, "FixedPrice", attempt.EstimatorInputs.Estimator)
+	assert.Equal(t, etx.GasLimit, attempt.EstimatorInputs.GasLimit)
+	assert.Equal(t, attempt.ChainSpecificGasLimit, attempt.EstimatorInputs.ChainSpecificGasLimit)
+	require.NotNil(t, attempt.EstimatorInputs.GasPrice)
+	assert.Equal(t, assets.GWei(42).String(), attempt.EstimatorInputs.GasPrice.String())
+	assert.Nil(t, attempt.EstimatorInputs.TipCap)
+	assert.Nil(t, attempt.EstimatorInputs.FeeCap)
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_MaxFeeWei(t *testing.T) {
+	const gasLimit = uint64(100000)
+	tipCap := big.NewInt(1000000000)
+	maxGasPrice := big.NewInt(100000000000)
+	// Enough for a fee cap of 50 gwei
+	maxFeeWei := new(big.Int).Mul(big.NewInt(50000000000), new(big.Int).SetUint64(gasLimit))
+
+	setup := func(t *testing.T, mode string) (bulletprooftxmanager.ORM, *evmmocks.Client, *bulletprooftxmanager.EthBroadcaster, ethkey.State, gethCommon.Address) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.EthTxMaxFeeMode = null.StringFrom(mode)
+		cfg.Overrides.GlobalEvmEIP1559DynamicFees = null.BoolFrom(true)
+		cfg.Overrides.GlobalEvmGasTipCapDefault = tipCap
+		cfg.Overrides.GlobalEvmMaxGasPriceWei = maxGasPrice
+		cfg.Overrides.GlobalEvmTxQueueOrdering = null.StringFrom("fifo")
+		borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+		return borm, ethClient, eb, keyState, fromAddress
+	}
+
+	t.Run("clamps the fee cap so that the worst case cost is within the max fee", func(t *testing.T) {
+		borm, ethClient, eb, keyState, fromAddress := setup(t, "clamp")
+
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.GasLimit = gasLimit
+		etx.MaxFeeWei = utils.NewBig(maxFeeWei)
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.GasFeeCap().Cmp(big.NewInt(50000000000)) == 0 && tx.GasTipCap().Cmp(tipCap) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, big.NewInt(50000000000), etx.EthTxAttempts[0].GasFeeCap.ToInt())
+		assert.Equal(t, tipCap, etx.EthTxAttempts[0].GasTipCap.ToInt())
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("marks the transaction as fatally errored and moves on to the next one", func(t *testing.T) {
+		borm, ethClient, eb, keyState, fromAddress := setup(t, "fatal")
+
+		capped := cltest.NewEthTx(t, fromAddress)
+		capped.GasLimit = gasLimit
+		capped.MaxFeeWei = utils.NewBig(maxFeeWei)
+		require.NoError(t, borm.InsertEthTx(&capped))
+		uncapped := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		// The capped tx never uses its nonce, so the next tx gets it
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.GasFeeCap().Cmp(maxGasPrice) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(capped.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+		assert.Nil(t, etx.Nonce)
+		require.True(t, etx.Error.Valid)
+		assert.Contains(t, etx.Error.String, "exceeds max fee of 5000000000000000 wei")
+		assertFatalReason(t, bulletprooftxmanager.FatalReasonMaxFeeExceeded, etx)
+		assert.Len(t, etx.EthTxAttempts, 0)
+
+		etx, err = borm.FindEthTxWithAttempts(uncapped.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.NotNil(t, etx.Nonce)
+		assert.Equal(t, int64(0), *etx.Nonce)
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_FailureWebhook(t *testing.T) {
+	events := make(chan bulletprooftxmanager.FailureEvent, 10)
+	u := newFailureWebhookServer(t, func(w http.ResponseWriter, event bulletprooftxmanager.FailureEvent) {
+		events <- event
+	})
+	awaitEvent := func(t *testing.T) bulletprooftxmanager.FailureEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(cltest.WaitTimeout(t)):
+			t.Fatal("timed out waiting for failure event")
+		}
+		return bulletprooftxmanager.FailureEvent{}
+	}
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.EthTxFailureWebhookURL = u
+	cfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	// Started without keys so that transactions are only processed when we
+	// ask, but the failure webhook is running
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{})
+	require.NoError(t, eb.Start())
+	t.Cleanup(func() { assert.NoError(t, eb.Close()) })
+
+	t.Run("notifies on fatal error", func(t *testing.T) {
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.Subject = uuid.NullUUID{UUID: uuid.NewV4(), Valid: true}
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(0)
+		})).Return(errors.New("exceeds block gas limit")).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		event := awaitEvent(t)
+		assert.Equal(t, bulletprooftxmanager.FailureReasonFatalError, event.Reason)
+		assert.Equal(t, bulletprooftxmanager.FatalReasonSendFatal, event.FatalReason)
+		assert.Equal(t, "exceeds block gas limit", event.Error)
+		assert.Equal(t, etx.ID, event.EthTxID)
+		assert.Equal(t, fromAddress, event.FromAddress)
+		assert.Equal(t, etx.ToAddress, event.ToAddress)
+		assert.Equal(t, cltest.FixtureChainID.String(), event.EVMChainID)
+		assert.Equal(t, etx.Subject.UUID.String(), event.CorrelationID)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("notifies on insufficient eth", func(t *testing.T) {
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(0)
+		})).Return(errors.New("insufficient funds for transfer")).Once()
+
+		err := eb.ProcessUnstartedEthTxs(context.Background(), keyState)
+		require.EqualError(t, err, "processUnstartedEthTxs failed: insufficient funds for transfer")
+
+		event := awaitEvent(t)
+		assert.Equal(t, bulletprooftxmanager.FailureReasonInsufficientEth, event.Reason)
+		assert.Equal(t, "insufficient funds for transfer", event.Error)
+		assert.Equal(t, etx.ID, event.EthTxID)
+		assert.Equal(t, fromAddress, event.FromAddress)
+		assert.Empty(t, event.CorrelationID)
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_EmitsFatalErrorOutcome(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	// Started without keys so that transactions are only processed when we
+	// ask, but the outcome emitter is running
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{})
+	sink := newFakeOutcomeSink()
+	bulletprooftxmanager.SetOutcomeSinkOnEthBroadcaster(sink, eb)
+	require.NoError(t, eb.Start())
+	t.Cleanup(func() { assert.NoError(t, eb.Close()) })
+
+	etx := cltest.NewEthTx(t, fromAddress)
+	etx.Subject = uuid.NullUUID{UUID: uuid.NewV4(), Valid: true}
+	require.NoError(t, borm.InsertEthTx(&etx))
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(0)
+	})).Return(errors.New("exceeds block gas limit")).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+	record := sink.awaitRecord(t)
+	assert.Equal(t, bulletprooftxmanager.OutcomeFatalError, record.Outcome)
+	assert.Equal(t, bulletprooftxmanager.FatalReasonSendFatal, record.FatalReason)
+	assert.Equal(t, "exceeds block gas limit", record.Error)
+	assert.Equal(t, etx.ID, record.EthTxID)
+	assert.Equal(t, fromAddress, record.FromAddress)
+	assert.Equal(t, etx.ToAddress, record.ToAddress)
+	assert.Nil(t, record.Nonce)
+	assert.Nil(t, record.TxHash)
+	assert.Equal(t, cltest.FixtureChainID.String(), record.EVMChainID)
+	assert.Equal(t, etx.Subject.UUID.String(), record.CorrelationID)
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_ForceResolveAgedInProgress(t *testing.T) {
+	nonce := int64(0)
+	maxAge := 10 * time.Minute
+
+	t.Run("does not force resolve in_progress transactions younger than the max age", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalEvmMaxInProgressAge = &maxAge
+		cfg.Overrides.EthTxInProgressResolutionPolicy = null.StringFrom("fatal")
+		borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, nonce)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		inProgress := cltest.MustInsertInProgressEthTxWithAttempt(t, borm, nonce, fromAddress)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(nonce)
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(inProgress.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, inProgress.EthTxAttempts[0].ID, etx.EthTxAttempts[0].ID)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("with policy fatal, marks the aged transaction as fatally errored", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalEvmMaxInProgressAge = &maxAge
+		cfg.Overrides.EthTxInProgressResolutionPolicy = null.StringFrom("fatal")
+		borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, nonce)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		inProgress := cltest.MustInsertInProgressEthTxWithAttempt(t, borm, nonce, fromAddress)
+		pgtest.MustExec(t, db, `UPDATE eth_tx_attempts SET created_at = $1 WHERE eth_tx_id = $2`, time.Now().Add(-1*time.Hour), inProgress.ID)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(inProgress.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+		assert.Nil(t, etx.Nonce)
+		assert.Equal(t, "transaction was in_progress for longer than 10m0s", etx.Error.String)
+		assertFatalReason(t, bulletprooftxmanager.FatalReasonInProgressMaxAge, etx)
+		assert.Len(t, etx.EthTxAttempts, 0)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("with policy resend, re-estimates gas and resends the aged transaction", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalEvmMaxInProgressAge = &maxAge
+		cfg.Overrides.EthTxInProgressResolutionPolicy = null.StringFrom("resend")
+		borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, nonce)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		inProgress := cltest.MustInsertInProgressEthTxWithAttempt(t, borm, nonce, fromAddress)
+		pgtest.MustExec(t, db, `UPDATE eth_tx_attempts SET created_at = $1 WHERE eth_tx_id = $2`, time.Now().Add(-1*time.Hour), inProgress.ID)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(nonce) && tx.GasPrice().Cmp(evmcfg.EvmGasPriceDefault()) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(inProgress.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.NotNil(t, etx.Nonce)
+		assert.Equal(t, nonce, *etx.Nonce)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.NotEqual(t, inProgress.EthTxAttempts[0].ID, etx.EthTxAttempts[0].ID)
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+		assert.Equal(t, evmcfg.EvmGasPriceDefault(), etx.EthTxAttempts[0].GasPrice.ToInt())
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_MaxInFlightValue(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmMaxInFlightValueWei = big.NewInt(200)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 2)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	// Two in-flight transactions worth 142 wei each exceed the value cap,
+	// while staying well below the count limit
+	etx0 := cltest.MustInsertUnconfirmedEthTx(t, borm, 0, fromAddress)
+	etx1 := cltest.MustInsertUnconfirmedEthTx(t, borm, 1, fromAddress)
+	etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+	chDone := make(chan error)
+	go func() {
+		chDone <- eb.ProcessUnstartedEthTxs(context.Background(), keyState)
+	}()
+
+	g := gomega.NewWithT(t)
+	g.Consistently(func() bulletprooftxmanager.EthTxState {
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		return etx.State
+	}, 2*bulletprooftxmanager.InFlightTransactionRecheckInterval).Should(gomega.Equal(bulletprooftxmanager.EthTxUnstarted))
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 2
+	})).Return(nil).Once()
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET state = 'confirmed' WHERE id IN ($1, $2)`, etx0.ID, etx1.ID)
+
+	select {
+	case err := <-chDone:
+		require.NoError(t, err)
+	case <-time.After(cltest.WaitTimeout(t)):
+		t.Fatal("timed out waiting for ProcessUnstartedEthTxs")
+	}
+	etx, err := borm.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_MaxInFlightValue_AtCap(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	// Exactly the value of the single in-flight transaction
+	cfg.Overrides.GlobalEvmMaxInFlightValueWei = big.NewInt(142)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 1)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	cltest.MustInsertUnconfirmedEthTx(t, borm, 0, fromAddress)
+	etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 1
+	})).Return(nil).Once()
+
+	// Reaching the cap does not throttle, only exceeding it does
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+	etx, err := borm.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Throttled_ContextCancelled(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmMaxInFlightValueWei = big.NewInt(100)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 1)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	cltest.MustInsertUnconfirmedEthTx(t, borm, 0, fromAddress)
+	etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	chDone := make(chan error)
+	go func() {
+		chDone <- eb.ProcessUnstartedEthTxs(ctx, keyState)
+	}()
+
+	gomega.NewWithT(t).Consistently(func() bulletprooftxmanager.EthTxState {
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		return etx.State
+	}, 2*bulletprooftxmanager.InFlightTransactionRecheckInterval).Should(gomega.Equal(bulletprooftxmanager.EthTxUnstarted))
+	cancel()
+
+	select {
+	case err := <-chDone:
+		require.NoError(t, err)
+	case <-time.After(cltest.WaitTimeout(t)):
+		t.Fatal("timed out waiting for ProcessUnstartedEthTxs")
+	}
+	etx, err := borm.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+}
+
+func TestEthBroadcaster_IncrementNextNonce(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, _ := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	// Cannot increment if supplied nonce doesn't match existing
+	require.Error(t, bulletprooftxmanager.IncrementNextNonce(db, keyState.Address.Address(), &cltest.FixtureChainID, int64(42)))
+
+	require.NoError(t, bulletprooftxmanager.IncrementNextNonce(db, keyState.Address.Address(), &cltest.FixtureChainID, int64(0)))
+
+	// Nonce bumped to 1
+	require.NoError(t, db.Get(&keyState, `SELECT * FROM eth_key_states LIMIT 1`))
+	require.NotNil(t, keyState.NextNonce)
+	require.Equal(t, int64(1), keyState.NextNonce)
+}
+
+func TestEthBroadcaster_Trigger(t *testing.T) {
+	t.Parallel()
+
+	// Simple sanity check to make sure it doesn't block
+	db := pgtest.NewSqlxDB(t)
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	eb := cltest.NewEthBroadcaster(t, db, cltest.NewEthClientMockWithDefaultChain(t), ethKeyStore, evmcfg, []ethkey.State{})
+
+	eb.Trigger(cltest.NewAddress())
+	eb.Trigger(cltest.NewAddress())
+	eb.Trigger(cltest.NewAddress())
+}
+
+func TestEthBroadcaster_PollDBInterval(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.SetTriggerFallbackDBPollInterval(10 * time.Second)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	eb := cltest.NewEthBroadcaster(t, db, cltest.NewEthClientMockWithDefaultChain(t), ethKeyStore, evmcfg, []ethkey.State{})
+
+	t.Run("applies jitter by default", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			interval := eb.PollDBInterval()
+			assert.GreaterOrEqual(t, interval, 9*time.Second)
+			assert.LessOrEqual(t, interval, 11*time.Second)
+		}
+	})
+
+	t.Run("uses the exact interval with jitter disabled", func(t *testing.T) {
+		cfg.Overrides.GlobalEvmBroadcastPollJitterDisabled = null.BoolFrom(true)
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, 10*time.Second, eb.PollDBInterval())
+		}
+	})
+}
+
+func TestEthBroadcaster_EthTxInsertEventCausesTriggerToFire(t *testing.T) {
+	// NOTE: Testing triggers requires committing transactions and does not work with transactional tests
+	cfg, db := heavyweight.FullTestDB(t, "eth_tx_triggers", true, true)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	eventBroadcaster := cltest.NewEventBroadcaster(t, evmcfg.DatabaseURL())
+	require.NoError(t, eventBroadcaster.Start())
+	t.Cleanup(func() { require.NoError(t, eventBroadcaster.Close()) })
+
+	ethTxInsertListener, err := eventBroadcaster.Subscribe(pg.ChannelInsertOnEthTx, "")
+	require.NoError(t, err)
+
+	// Give it some time to start listening
+	time.Sleep(100 * time.Millisecond)
+
+	mustInsertUnstartedEthTx(t, borm, fromAddress)
+	gomega.NewWithT(t).Eventually(ethTxInsertListener.Events()).Should(gomega.Receive())
+}
+
+func TestEthBroadcaster_HealthReport(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	// Retry immediately once funds arrive
+	noBackoff := time.Duration(0)
+	cfg.Overrides.EthTxInsufficientEthBackoffMax = &noBackoff
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	// Nothing has run yet
+	report := eb.HealthReport()
+	require.Contains(t, report, fromAddress.Hex())
+	assert.NoError(t, report[fromAddress.Hex()])
+
+	cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(0)
+	})).Return(errors.New("insufficient funds for transfer")).Once()
+
+	require.Error(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+	err := eb.HealthReport()[fromAddress.Hex()]
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has not yet succeeded for this key")
+	assert.Contains(t, err.Error(), "insufficient funds for transfer")
+
+	// Funds arrive
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(0)
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+	assert.NoError(t, eb.HealthReport()[fromAddress.Hex()])
+
+	// Fails again after having succeeded
+	cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(1)
+	})).Return(errors.New("insufficient funds for transfer")).Once()
+
+	require.Error(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+	err = eb.HealthReport()[fromAddress.Hex()]
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "EthBroadcaster last succeeded for this key at")
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_InsufficientEthBackoff(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.SetTriggerFallbackDBPollInterval(100 * time.Millisecond)
+	backoffMax := time.Hour
+	cfg.Overrides.EthTxInsufficientEthBackoffMax = &backoffMax
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(0)
+	})).Return(errors.New("insufficient funds for transfer")).Once()
+
+	err := eb.ProcessUnstartedEthTxs(context.Background(), keyState)
+	require.EqualError(t, err, "processUnstartedEthTxs failed: insufficient funds for transfer")
+
+	// Does not resend while backing off
+	err = eb.ProcessUnstartedEthTxs(context.Background(), keyState)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("transaction %d was rejected due to insufficient eth (insufficient funds for transfer), will not retry until", etx.ID))
+	ethClient.AssertExpectations(t)
+
+	// Resends once the backoff has elapsed
+	time.Sleep(150 * time.Millisecond)
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(0)
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+	etx, err = borm.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_AddKey_RemoveKey(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{})
+	require.NoError(t, eb.Start())
+	t.Cleanup(func() { assert.NoError(t, eb.Close()) })
+
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	otherKeyState, _ := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	etxState := func(id int64) func() bulletprooftxmanager.EthTxState {
+		return func() bulletprooftxmanager.EthTxState {
+			etx, err := borm.FindEthTxWithAttempts(id)
+			require.NoError(t, err)
+			return etx.State
+		}
+	}
+
+	t.Run("processes unstarted transactions for a key added after start", func(t *testing.T) {
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0
+		})).Return(nil).Once()
+
+		// Triggers must not race with the key being added
+		chDone := make(chan struct{})
+		go func() {
+			defer close(chDone)
+			for i := 0; i < 100; i++ {
+				eb.Trigger(fromAddress)
+			}
+		}()
+		require.NoError(t, eb.AddKey(keyState))
+		<-chDone
+
+		gomega.NewWithT(t).Eventually(etxState(etx.ID)).Should(gomega.Equal(bulletprooftxmanager.EthTxUnconfirmed))
+		assert.Contains(t, eb.HealthReport(), fromAddress.Hex())
+	})
+
+	t.Run("rejects a key that is already registered", func(t *testing.T) {
+		err := eb.AddKey(keyState)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already registered")
+	})
+
+	t.Run("rejects a key for another chain", func(t *testing.T) {
+		state := otherKeyState
+		state.EVMChainID = *utils.NewBigI(42)
+		err := eb.AddKey(state)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "for chain 42")
+	})
+
+	t.Run("stops processing transactions for a removed key", func(t *testing.T) {
+		require.NoError(t, eb.RemoveKey(fromAddress))
+		assert.NotContains(t, eb.HealthReport(), fromAddress.Hex())
+
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+		eb.Trigger(fromAddress)
+		gomega.NewWithT(t).Consistently(etxState(etx.ID)).Should(gomega.Equal(bulletprooftxmanager.EthTxUnstarted))
+
+		err := eb.RemoveKey(fromAddress)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not registered")
+	})
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_RemoveKey_Throttled(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+	cfg.Overrides.GlobalEvmMaxInFlightValueWei = big.NewInt(200)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 2)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+	require.NoError(t, eb.Start())
+	t.Cleanup(func() { assert.NoError(t, eb.Close()) })
+
+	// The key stays throttled for as long as these are in flight
+	cltest.MustInsertUnconfirmedEthTx(t, borm, 0, fromAddress)
+	cltest.MustInsertUnconfirmedEthTx(t, borm, 1, fromAddress)
+	etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+	eb.Trigger(fromAddress)
+
+	gomega.NewWithT(t).Consistently(func() bulletprooftxmanager.EthTxState {
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		return etx.State
+	}, 2*bulletprooftxmanager.InFlightTransactionRecheckInterval).Should(gomega.Equal(bulletprooftxmanager.EthTxUnstarted))
+
+	chDone := make(chan error)
+	go func() {
+		chDone <- eb.RemoveKey(fromAddress)
+	}()
+	select {
+	case err := <-chDone:
+		require.NoError(t, err)
+	case <-time.After(cltest.WaitTimeout(t)):
+		t.Fatal("timed out waiting for RemoveKey")
+	}
+	assert.NotContains(t, eb.HealthReport(), fromAddress.Hex())
+}
+
+func TestEthBroadcaster_ParksIdleKeys(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+	cfg.Overrides.GlobalEvmBroadcastPollJitterDisabled = null.BoolFrom(true)
+	cfg.Overrides.SetTriggerFallbackDBPollInterval(100 * time.Millisecond)
+	idleTimeout := 500 * time.Millisecond
+	cfg.Overrides.GlobalEvmKeyIdleTimeout = &idleTimeout
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+	require.NoError(t, eb.Start())
+	t.Cleanup(func() { assert.NoError(t, eb.Close()) })
+
+	etxState := func(id int64) func() bulletprooftxmanager.EthTxState {
+		return func() bulletprooftxmanager.EthTxState {
+			etx, err := borm.FindEthTxWithAttempts(id)
+			require.NoError(t, err)
+			return etx.State
+		}
+	}
+
+	g := gomega.NewWithT(t)
+	g.Eventually(func() bool { return eb.KeyParked(fromAddress) }).Should(gomega.BeTrue())
+	assert.Equal(t, map[string]error{fromAddress.Hex(): nil}, eb.HealthReport())
+
+	// The insert NOTIFY is never delivered inside the test transaction, so
+	// the transaction can only be picked up by polling or by a trigger
+	etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+	g.Consistently(etxState(etx.ID), 5*idleTimeout/2).Should(gomega.Equal(bulletprooftxmanager.EthTxUnstarted))
+	assert.True(t, eb.KeyParked(fromAddress))
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0
+	})).Return(nil).Once()
+	eb.Trigger(fromAddress)
+
+	g.Eventually(etxState(etx.ID)).Should(gomega.Equal(bulletprooftxmanager.EthTxUnconfirmed))
+	assert.False(t, eb.KeyParked(fromAddress))
+
+	// The unconfirmed transaction keeps the key active
+	g.Consistently(func() bool { return eb.KeyParked(fromAddress) }, 2*idleTimeout).Should(gomega.BeFalse())
+
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET state = 'confirmed' WHERE id = $1`, etx.ID)
+	g.Eventually(func() bool { return eb.KeyParked(fromAddress) }).Should(gomega.BeTrue())
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_SyncIdleNonces(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	idleState, idleAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	busyState, busyAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	cltest.MustInsertUnconfirmedEthTx(t, borm, 0, busyAddress)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{idleState, busyState})
+	// The skipped nonces are imported as placeholders
+	ethClient.On("CallContext", mock.Anything, mock.Anything, "txpool_contentFrom", mock.Anything).Maybe().Return(errors.New("the method txpool_contentFrom does not exist/is not available"))
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Maybe().Return(nil, errors.New("something exploded"))
+
+	t.Run("syncs idle keys and skips keys with pending transactions", func(t *testing.T) {
+		ethClient.On("PendingNonceAt", mock.Anything, idleAddress).Return(uint64(5), nil).Once()
+
+		eb.SyncIdleNonces(context.Background())
+
+		assertDatabaseNonce(t, db, idleAddress, 5)
+		assertDatabaseNonce(t, db, busyAddress, 0)
+		ethClient.AssertExpectations(t)
+		ethClient.AssertNotCalled(t, "PendingNonceAt", mock.Anything, busyAddress)
+	})
+
+	t.Run("SyncNonce syncs a key even if it has pending transactions", func(t *testing.T) {
+		ethClient.On("PendingNonceAt", mock.Anything, busyAddress).Return(uint64(3), nil).Once()
+
+		require.NoError(t, eb.SyncNonce(context.Background(), busyAddress))
+
+		assertDatabaseNonce(t, db, busyAddress, 3)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("SyncNonce errors for an unknown key", func(t *testing.T) {
+		err := eb.SyncNonce(context.Background(), cltest.NewAddress())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not registered")
+	})
+}
+
+func TestEthBroadcaster_Drain(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	t.Run("errors if not started", func(t *testing.T) {
+		err := eb.Drain(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not running")
+	})
+
+	require.NoError(t, eb.Start())
+	t.Cleanup(func() { assert.NoError(t, eb.Close()) })
+
+	t.Run("waits for a slow send to finish and stops picking up new transactions", func(t *testing.T) {
+		chSending := make(chan struct{})
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0
+		})).Run(func(mock.Arguments) {
+			close(chSending)
+			time.Sleep(500 * time.Millisecond)
+		}).Return(nil).Once()
+
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+		eb.Trigger(fromAddress)
+		<-chSending
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.NoError(t, eb.Drain(ctx))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+		cltest.AssertCount(t, db, "eth_tx_attempts", 1)
+
+		etx = cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+		eb.Trigger(fromAddress)
+		gomega.NewWithT(t).Consistently(func() bulletprooftxmanager.EthTxState {
+			etx, err := borm.FindEthTxWithAttempts(etx.ID)
+			require.NoError(t, err)
+			return etx.State
+		}).Should(gomega.Equal(bulletprooftxmanager.EthTxUnstarted))
+	})
+
+	t.Run("errors if already draining", func(t *testing.T) {
+		err := eb.Drain(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already draining")
+	})
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_Drain_Timeout(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+	require.NoError(t, eb.Start())
+	t.Cleanup(func() { assert.NoError(t, eb.Close()) })
+
+	chSending := make(chan struct{})
+	ethClient.On("SendTransaction", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		close(chSending)
+		time.Sleep(1 * time.Second)
+	}).Return(nil).Once()
+
+	etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+	eb.Trigger(fromAddress)
+	<-chSending
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := eb.Drain(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 key(s) still sending")
+
+	// The send is not interrupted, and still completes exactly once
+	gomega.NewWithT(t).Eventually(func() bulletprooftxmanager.EthTxState {
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		return etx.State
+	}, 5*time.Second).Should(gomega.Equal(bulletprooftxmanager.EthTxUnconfirmed))
+	cltest.AssertCount(t, db, "eth_tx_attempts", 1)
+}
+
+func TestEthBroadcaster_ChainHalt(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+	// Transactions are only picked up when triggered
+	cfg.Overrides.SetTriggerFallbackDBPollInterval(1 * time.Hour)
+	haltThreshold := 500 * time.Millisecond
+	cfg.Overrides.GlobalEvmChainHaltThreshold = &haltThreshold
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+	require.NoError(t, eb.Start())
+	t.Cleanup(func() { assert.NoError(t, eb.Close()) })
+
+	etxState := func(id int64) func() bulletprooftxmanager.EthTxState {
+		return func() bulletprooftxmanager.EthTxState {
+			etx, err := borm.FindEthTxWithAttempts(id)
+			require.NoError(t, err)
+			return etx.State
+		}
+	}
+	g := gomega.NewWithT(t)
+
+	// Heads arrive regularly until the chain halts
+	var blockNum int64
+	chHalt := make(chan struct{})
+	chHalted := make(chan struct{})
+	go func() {
+		defer close(chHalted)
+		ticker := time.NewTicker(haltThreshold / 5)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-chHalt:
+				return
+			case <-ticker.C:
+				blockNum++
+				eb.OnNewHead(cltest.Head(blockNum))
+			}
+		}
+	}()
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0
+	})).Return(nil).Once()
+	etx1 := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+	eb.Trigger(fromAddress)
+	g.Eventually(etxState(etx1.ID)).Should(gomega.Equal(bulletprooftxmanager.EthTxUnconfirmed))
+	assert.False(t, eb.ChainHalted())
+
+	close(chHalt)
+	<-chHalted
+	g.Eventually(eb.ChainHalted, 5*haltThreshold).Should(gomega.BeTrue())
+
+	// Broadcasting is paused while the chain is halted
+	etx2 := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+	eb.Trigger(fromAddress)
+	g.Consistently(etxState(etx2.ID), haltThreshold).Should(gomega.Equal(bulletprooftxmanager.EthTxUnstarted))
+
+	// A new head resumes broadcasting without needing another trigger
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 1
+	})).Return(nil).Once()
+	eb.OnNewHead(cltest.Head(blockNum + 1))
+	assert.False(t, eb.ChainHalted())
+	g.Eventually(etxState(etx2.ID)).Should(gomega.Equal(bulletprooftxmanager.EthTxUnconfirmed))
+
+	ethClient.AssertExpectations(t)
 }
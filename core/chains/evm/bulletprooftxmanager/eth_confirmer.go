@@ -93,9 +93,15 @@ type EthConfirmer struct {
 	ethClient evmclient.Client
 	ChainKeyStore
 	estimator      gas.Estimator
-	resumeCallback ResumeCallback
+	resumer        *resumer
+	failureWebhook *FailureWebhook
+	bumpDigester   *BumpDigester
+	outcomeEmitter *OutcomeEmitter
+	clock          utils.Nower
 
-	keyStates []ethkey.State
+	keyStates     []ethkey.State
+	keyLocks      *keyLocks
+	finalityHooks *finalityHooks
 
 	mb        *utils.Mailbox
 	ctx       context.Context
@@ -107,12 +113,22 @@ type EthConfirmer struct {
 
 // NewEthConfirmer instantiates a new eth confirmer
 func NewEthConfirmer(db *sqlx.DB, ethClient evmclient.Client, config Config, keystore KeyStore,
-	keyStates []ethkey.State, estimator gas.Estimator, resumeCallback ResumeCallback, lggr logger.Logger) *EthConfirmer {
+	keyStates []ethkey.State, estimator gas.Estimator, resumeCallback ResumeCallback, resumeBatchCallback ResumeBatchCallback,
+	lggr logger.Logger) *EthConfirmer {
 
 	context, cancel := context.WithCancel(context.Background())
 	lggr = lggr.Named("EthConfirmer")
 	q := pg.NewQ(db, lggr, config)
 
+	var failureWebhook *FailureWebhook
+	if u := config.EthTxFailureWebhookURL(); u != nil {
+		failureWebhook = NewFailureWebhook(lggr, *u)
+	}
+	var bumpDigester *BumpDigester
+	if interval := config.EthTxBumpDigestInterval(); interval > 0 {
+		bumpDigester = NewBumpDigester(lggr, interval, *ethClient.ChainID(), failureWebhook)
+	}
+
 	return &EthConfirmer{
 		utils.StartStopOnce{},
 		lggr,
@@ -125,8 +141,15 @@ func NewEthConfirmer(db *sqlx.DB, ethClient evmclient.Client, config Config, key
 			keystore,
 		},
 		estimator,
-		resumeCallback,
+		newResumer(config, resumeCallback, resumeBatchCallback),
+		failureWebhook,
+		bumpDigester,
+		NewOutcomeEmitter(lggr, NewOutcomeSink(lggr, config)),
+		utils.Clock{},
 		keyStates,
+		newKeyLocks(),
+		newFinalityHooks(),
+		nil,
 		utils.NewMailbox(1),
 		context,
 		cancel,
@@ -144,6 +167,14 @@ func (ec *EthConfirmer) Start() error {
 			ec.lggr.Infow(fmt.Sprintf("Gas bumping is enabled, unconfirmed transactions will have their gas price bumped every %d blocks", ec.config.EvmGasBumpThreshold()), "ethGasBumpThreshold", ec.config.EvmGasBumpThreshold())
 		}
 
+		if ec.failureWebhook != nil {
+			ec.failureWebhook.Start()
+		}
+		if ec.bumpDigester != nil {
+			ec.bumpDigester.Start()
+		}
+		ec.outcomeEmitter.Start()
+
 		ec.wg.Add(1)
 		go ec.runLoop()
 
@@ -157,6 +188,14 @@ func (ec *EthConfirmer) Close() error {
 		ec.ctxCancel()
 		ec.wg.Wait()
 
+		if ec.bumpDigester != nil {
+			ec.bumpDigester.Stop()
+		}
+		if ec.failureWebhook != nil {
+			ec.failureWebhook.Stop()
+		}
+		ec.outcomeEmitter.Stop()
+
 		return nil
 	})
 }
@@ -211,6 +250,13 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head *evmtypes.Head) er
 	ec.lggr.Debugw("Finished CheckForReceipts", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 	mark = time.Now()
 
+	if err := ec.CheckExternalNonces(ctx); err != nil {
+		return errors.Wrap(err, "CheckExternalNonces failed")
+	}
+
+	ec.lggr.Debugw("Finished CheckExternalNonces", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
+	mark = time.Now()
+
 	if err := ec.RebroadcastWhereNecessary(ctx, head.Number); err != nil {
 		return errors.Wrap(err, "RebroadcastWhereNecessary failed")
 	}
@@ -223,8 +269,15 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head *evmtypes.Head) er
 	}
 
 	ec.lggr.Debugw("Finished EnsureConfirmedTransactionsInLongestChain", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
+	mark = time.Now()
+
+	if err := ec.CheckForFinalizedTransactions(ctx, head); err != nil {
+		return errors.Wrap(err, "CheckForFinalizedTransactions failed")
+	}
+
+	ec.lggr.Debugw("Finished CheckForFinalizedTransactions", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 
-	if ec.resumeCallback != nil {
+	if ec.resumer.enabled() {
 		mark = time.Now()
 		if err := ec.ResumePendingTaskRuns(ctx, head); err != nil {
 			return errors.Wrap(err, "ResumePendingTaskRuns failed")
@@ -298,6 +351,33 @@ func (ec *EthConfirmer) CheckForReceipts(ctx context.Context, blockNum int64) er
 	return nil
 }
 
+// CheckExternalNonces marks nonces that were reserved for external
+// transactions (see ReserveNonce) as consumed once the chain nonce for the key
+// has moved past them. Consumed reservations can no longer be released.
+func (ec *EthConfirmer) CheckExternalNonces(ctx context.Context) error {
+	var addresses []gethCommon.Address
+	if err := ec.q.Select(&addresses, `SELECT DISTINCT from_address FROM eth_txes WHERE state = 'external' AND broadcast_at IS NULL AND evm_chain_id = $1`, ec.chainID.String()); err != nil {
+		return errors.Wrap(err, "failed to load addresses with external transactions")
+	}
+	for _, from := range addresses {
+		latestBlockNonce, err := ec.getNonceForLatestBlock(ctx, from)
+		if err != nil {
+			return errors.Wrapf(err, "unable to fetch latest nonce for address: %v", from)
+		}
+		var nonces []int64
+		if err := ec.q.Select(&nonces, `
+UPDATE eth_txes SET broadcast_at = NOW()
+WHERE state = 'external' AND broadcast_at IS NULL AND from_address = $1 AND evm_chain_id = $2 AND nonce < $3
+RETURNING nonce`, from, ec.chainID.String(), int64(latestBlockNonce)); err != nil {
+			return errors.Wrapf(err, "failed to mark external transactions as consumed for address: %v", from)
+		}
+		for _, n := range nonces {
+			ec.lggr.Infow("Nonce reserved for external transaction has been consumed on chain", "address", from, "nonce", n)
+		}
+	}
+	return nil
+}
+
 func (ec *EthConfirmer) separateLikelyConfirmedAttempts(from gethCommon.Address, attempts []EthTxAttempt, latestBlockNonce uint64) []EthTxAttempt {
 	if len(attempts) == 0 {
 		return attempts
@@ -348,11 +428,34 @@ func (ec *EthConfirmer) fetchAndSaveReceipts(ctx context.Context, attempts []Eth
 		if err := ec.saveFetchedReceipts(receipts); err != nil {
 			return errors.Wrap(err, "saveFetchedReceipts failed")
 		}
+		ec.recordConfirmations(batch, receipts)
 		promNumConfirmedTxs.WithLabelValues(ec.chainID.String()).Add(float64(len(receipts)))
 	}
 	return nil
 }
 
+// recordConfirmations feeds the gas prices paid by our newly confirmed
+// legacy transactions back to the estimator, if it wants them
+func (ec *EthConfirmer) recordConfirmations(attempts []EthTxAttempt, receipts []Receipt) {
+	recorder, ok := ec.estimator.(gas.ConfirmationRecorder)
+	if !ok {
+		return
+	}
+	gasPrices := make(map[gethCommon.Hash]*big.Int, len(attempts))
+	for _, attempt := range attempts {
+		if attempt.GasPrice != nil {
+			gasPrices[attempt.Hash] = attempt.GasPrice.ToInt()
+		}
+	}
+	for _, receipt := range receipts {
+		gasPrice, exists := gasPrices[receipt.TxHash]
+		if !exists || receipt.BlockNumber == nil {
+			continue
+		}
+		recorder.RecordConfirmation(receipt.BlockNumber.Int64(), gasPrice)
+	}
+}
+
 func (ec *EthConfirmer) findEthTxAttemptsRequiringReceiptFetch() (attempts []EthTxAttempt, err error) {
 	err = ec.q.Transaction(func(tx pg.Queryer) error {
 		err = tx.Select(&attempts, `
@@ -485,11 +588,18 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 	// attempt was left in_progress but the transaction was actually accepted
 	// and mined.
 	//
+	// The gas used and effective gas price are recorded on the attempt for
+	// cost accounting. Nodes that predate EIP-1559 don't return an effective
+	// gas price, in which case it is the gas price for legacy attempts, or
+	// derived from the block's base fee for dynamic fee attempts.
+	//
 	// # EthTxes update
 	// Should be self-explanatory. If we got a receipt, the eth_tx is confirmed.
+	// Transactions that were already confirmed are left as they are, so that
+	// only the newly confirmed ones are returned.
 	//
-	var valueStrs []string
-	var valueArgs []interface{}
+	var valueStrs, costStrs []string
+	var valueArgs, costArgs []interface{}
 	for _, r := range receipts {
 		var receiptJSON []byte
 		receiptJSON, err = json.Marshal(r)
@@ -498,8 +608,11 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 		}
 		valueStrs = append(valueStrs, "(?,?,?,?,?,NOW())")
 		valueArgs = append(valueArgs, r.TxHash, r.BlockHash, r.BlockNumber.Int64(), r.TransactionIndex, receiptJSON)
+		costStrs = append(costStrs, "(?::bytea,?::numeric,?::bigint)")
+		costArgs = append(costArgs, r.TxHash, utils.NewBig(r.EffectiveGasPrice), int64(r.GasUsed))
 	}
-	valueArgs = append(valueArgs, ec.chainID.String())
+	valueArgs = append(valueArgs, costArgs...)
+	valueArgs = append(valueArgs, ec.chainID.String(), ec.chainID.String())
 
 	/* #nosec G201 */
 	sql := `
@@ -510,30 +623,77 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 			block_number = EXCLUDED.block_number,
 			transaction_index = EXCLUDED.transaction_index,
 			receipt = EXCLUDED.receipt
-		RETURNING eth_receipts.tx_hash, eth_receipts.block_number
+		RETURNING eth_receipts.tx_hash, eth_receipts.block_hash, eth_receipts.block_number
+	),
+	receipt_costs (tx_hash, effective_gas_price, gas_used) AS (
+		VALUES %s
 	),
 	updated_eth_tx_attempts AS (
 		UPDATE eth_tx_attempts
 		SET
 			state = 'broadcast',
-			broadcast_before_block_num = COALESCE(eth_tx_attempts.broadcast_before_block_num, inserted_receipts.block_number)
+			broadcast_before_block_num = COALESCE(eth_tx_attempts.broadcast_before_block_num, inserted_receipts.block_number),
+			gas_used = receipt_costs.gas_used,
+			effective_gas_price = COALESCE(
+				receipt_costs.effective_gas_price,
+				eth_tx_attempts.gas_price,
+				(
+					SELECT LEAST(eth_tx_attempts.gas_fee_cap, heads.base_fee_per_gas + eth_tx_attempts.gas_tip_cap)
+					FROM heads
+					WHERE heads.hash = inserted_receipts.block_hash AND heads.base_fee_per_gas IS NOT NULL AND heads.evm_chain_id = ?
+				)
+			)
 		FROM inserted_receipts
+		JOIN receipt_costs ON receipt_costs.tx_hash = inserted_receipts.tx_hash
 		WHERE inserted_receipts.tx_hash = eth_tx_attempts.hash
-		RETURNING eth_tx_attempts.eth_tx_id
+		RETURNING eth_tx_attempts.eth_tx_id, eth_tx_attempts.hash
 	)
 	UPDATE eth_txes
 	SET state = 'confirmed'
 	FROM updated_eth_tx_attempts
 	WHERE updated_eth_tx_attempts.eth_tx_id = eth_txes.id
 	AND evm_chain_id = ?
+	AND eth_txes.state <> 'confirmed'
+	RETURNING eth_txes.*, updated_eth_tx_attempts.hash AS confirmed_tx_hash
 	`
 
-	stmt := fmt.Sprintf(sql, strings.Join(valueStrs, ","))
+	stmt := fmt.Sprintf(sql, strings.Join(valueStrs, ","), strings.Join(costStrs, ","))
 
 	stmt = sqlx.Rebind(sqlx.DOLLAR, stmt)
 
-	err = ec.q.ExecQ(stmt, valueArgs...)
-	return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
+	var confirmed []confirmedEthTx
+	err = ec.q.Select(&confirmed, stmt, valueArgs...)
+	if err != nil {
+		return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
+	}
+	ec.emitConfirmed(confirmed, receipts)
+	ec.refreshGasUsedStats(confirmed)
+	return nil
+}
+
+// confirmedEthTx is an eth_tx that was newly confirmed by
+// saveFetchedReceipts, along with the hash of the attempt that got the receipt
+type confirmedEthTx struct {
+	EthTx
+	ConfirmedTxHash gethCommon.Hash
+}
+
+// emitConfirmed emits an outcome record for each newly confirmed
+// transaction
+func (ec *EthConfirmer) emitConfirmed(confirmed []confirmedEthTx, receipts []Receipt) {
+	blockNumbers := make(map[gethCommon.Hash]int64, len(receipts))
+	for _, r := range receipts {
+		blockNumbers[r.TxHash] = r.BlockNumber.Int64()
+	}
+	for _, c := range confirmed {
+		record := NewOutcomeRecord(OutcomeConfirmed, c.EthTx)
+		hash := c.ConfirmedTxHash
+		record.TxHash = &hash
+		if blockNumber, exists := blockNumbers[hash]; exists {
+			record.BlockNumber = &blockNumber
+		}
+		ec.outcomeEmitter.Emit(record)
+	}
 }
 
 // markAllConfirmedMissingReceipt
@@ -598,7 +758,7 @@ func (ec *EthConfirmer) markOldTxesMissingReceiptAsErrored(blockNum int64) error
 
 	rows, err := ec.q.Query(`
 UPDATE eth_txes
-SET state='fatal_error', nonce=NULL, error=$1, broadcast_at=NULL
+SET state='fatal_error', nonce=NULL, error=$1, fatal_reason='missing_receipt', broadcast_at=NULL
 FROM (
 	SELECT e1.id, e1.nonce, e1.from_address FROM eth_txes AS e1 WHERE id IN (
 		SELECT e2.id FROM eth_txes AS e2
@@ -611,7 +771,7 @@ FROM (
 	FOR UPDATE OF e1
 ) e0
 WHERE e0.id = eth_txes.id
-RETURNING e0.id, e0.nonce, e0.from_address`, ErrCouldNotGetReceipt, cutoff, ec.chainID.String())
+RETURNING e0.id, e0.nonce, e0.from_address, eth_txes.to_address, eth_txes.pipeline_task_run_id, eth_txes.subject`, ErrCouldNotGetReceipt, cutoff, ec.chainID.String())
 
 	if err != nil {
 		return errors.Wrap(err, "markOldTxesMissingReceiptAsErrored failed to query")
@@ -621,8 +781,9 @@ RETURNING e0.id, e0.nonce, e0.from_address`, ErrCouldNotGetReceipt, cutoff, ec.c
 	for rows.Next() {
 		var ethTxID int64
 		var nonce null.Int64
-		var fromAddress gethCommon.Address
-		if err = rows.Scan(&ethTxID, &nonce, &fromAddress); err != nil {
+		var fromAddress, toAddress gethCommon.Address
+		var pipelineTaskRunID, subject uuid.NullUUID
+		if err = rows.Scan(&ethTxID, &nonce, &fromAddress, &toAddress, &pipelineTaskRunID, &subject); err != nil {
 			return errors.Wrap(err, "error scanning row")
 		}
 
@@ -633,6 +794,23 @@ RETURNING e0.id, e0.nonce, e0.from_address`, ErrCouldNotGetReceipt, cutoff, ec.c
 			" Please note that Chainlink requires exclusive ownership of it's private keys and sharing keys across multiple"+
 			" chainlink instances, or using the chainlink keys with an external wallet is NOT SUPPORTED and WILL lead to missed transactions",
 			ethTxID, blockNum, fromAddress.Hex(), nonce.Int64), "ethTxID", ethTxID, "nonce", nonce, "fromAddress", fromAddress)
+
+		reason := FatalReasonMissingReceipt
+		etx := EthTx{
+			ID:                ethTxID,
+			FromAddress:       fromAddress,
+			ToAddress:         toAddress,
+			EVMChainID:        *utils.NewBig(&ec.chainID),
+			PipelineTaskRunID: pipelineTaskRunID,
+			Subject:           subject,
+			FatalReason:       &reason,
+		}
+		if nonce.Valid {
+			etx.Nonce = &nonce.Int64
+		}
+		record := NewOutcomeRecord(OutcomeDeadLetter, etx)
+		record.Error = ErrCouldNotGetReceipt
+		ec.outcomeEmitter.Emit(record)
 	}
 
 	return rows.Err()
@@ -666,6 +844,9 @@ func (ec *EthConfirmer) RebroadcastWhereNecessary(ctx context.Context, blockHeig
 }
 
 func (ec *EthConfirmer) rebroadcastWhereNecessary(ctx context.Context, address gethCommon.Address, blockHeight int64) error {
+	unlock := ec.keyLocks.lock(address)
+	defer unlock()
+
 	if err := ec.handleAnyInProgressAttempts(ctx, address, blockHeight); err != nil {
 		return errors.Wrap(err, "handleAnyInProgressAttempts failed")
 	}
@@ -679,6 +860,18 @@ func (ec *EthConfirmer) rebroadcastWhereNecessary(ctx context.Context, address g
 	} else if err != nil {
 		return errors.Wrap(err, "FindEthTxsRequiringRebroadcast failed")
 	}
+	if curve := ec.config.EthTxDeadlineBoostCurve(); len(curve) > 0 {
+		boosted, err := FindEthTxsRequiringDeadlineBoost(ctx, ec.q, address, blockHeight, threshold, bumpDepth, curve, ec.clock.Now(), ec.chainID)
+		if ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "FindEthTxsRequiringDeadlineBoost failed")
+		}
+		if len(boosted) > 0 {
+			ec.lggr.Infow(fmt.Sprintf("Found %d transactions close to their deadline that will have their gas price bumped early", len(boosted)), "blockNum", blockHeight, "address", address)
+		}
+		etxs = mergeEthTxsForRebroadcast(etxs, boosted, maxInFlightTransactions)
+	}
 	for _, etx := range etxs {
 		attempt, err := ec.attemptForRebroadcast(ctx, *etx)
 		if err != nil {
@@ -868,7 +1061,8 @@ func loadEthTxesAttempts(q pg.Queryer, etxs []*EthTx) error {
 
 // FindEthTxsRequiringGasBump returns transactions that have all
 // attempts which are unconfirmed for at least gasBumpThreshold blocks,
-// limited by limit pending transactions
+// limited by limit pending transactions, except for strictly sequenced
+// transactions which are never limited
 //
 // It also returns eth_txes that are unconfirmed with no eth_tx_attempts
 func FindEthTxsRequiringGasBump(ctx context.Context, q pg.Q, lggr logger.Logger, address gethCommon.Address, blockNum, gasBumpThreshold, depth int64, chainID big.Int) (etxs []*EthTx, err error) {
@@ -881,7 +1075,7 @@ func FindEthTxsRequiringGasBump(ctx context.Context, q pg.Q, lggr logger.Logger,
 SELECT eth_txes.* FROM eth_txes
 LEFT JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id AND (broadcast_before_block_num > $4 OR broadcast_before_block_num IS NULL OR eth_tx_attempts.state != 'broadcast')
 WHERE eth_txes.state = 'unconfirmed' AND eth_tx_attempts.id IS NULL AND eth_txes.from_address = $1 AND eth_txes.evm_chain_id = $2
-	AND (($3 = 0) OR (eth_txes.id IN (SELECT id FROM eth_txes WHERE state = 'unconfirmed' AND from_address = $1 ORDER BY nonce ASC LIMIT $3)))
+	AND (($3 = 0) OR eth_txes.strict_sequencing OR (eth_txes.id IN (SELECT id FROM eth_txes WHERE state = 'unconfirmed' AND from_address = $1 ORDER BY nonce ASC LIMIT $3)))
 ORDER BY nonce ASC
 `
 		if err = tx.Select(&etxs, stmt, address, chainID.String(), depth, blockNum-gasBumpThreshold); err != nil {
@@ -907,9 +1101,13 @@ func (ec *EthConfirmer) attemptForRebroadcast(ctx context.Context, etx EthTx) (a
 		}
 		attempt, err = ec.bumpGas(previousAttempt)
 
-		if gas.IsBumpErr(err) {
+		if gas.IsBumpErr(err) || errors.Is(err, ErrTxMaxFeeExceeded) {
 			ec.lggr.Errorw("Failed to bump gas", append(logFields, "err", err)...)
-			// Do not create a new attempt if bumping gas would put us over the limit or cause some other problem
+			if errors.Cause(err) == gas.ErrBumpGasExceedsLimit && ec.failureWebhook != nil {
+				ec.failureWebhook.Notify(NewFailureEvent(FailureReasonGasBumpExceedsLimit, etx, err.Error()))
+			}
+			// Do not create a new attempt if bumping gas would put us over the limit (including the max fee of
+			// the transaction) or cause some other problem
 			// Instead try to resubmit the previous attempt, and keep resubmitting until its accepted
 			previousAttempt.BroadcastBeforeBlockNum = nil
 			previousAttempt.State = EthTxAttemptInProgress
@@ -936,26 +1134,45 @@ func (ec *EthConfirmer) logFieldsPreviousAttempt(attempt EthTxAttempt) []interfa
 
 func (ec *EthConfirmer) bumpGas(previousAttempt EthTxAttempt) (bumpedAttempt EthTxAttempt, err error) {
 	logFields := ec.logFieldsPreviousAttempt(previousAttempt)
+	boost := deadlineBoostFactor(ec.config.EthTxDeadlineBoostCurve(), previousAttempt.EthTx.Deadline, ec.clock.Now())
+	if boost > 1 {
+		logFields = append(logFields, "deadline", previousAttempt.EthTx.Deadline, "deadlineBoostFactor", boost)
+	}
 	switch previousAttempt.TxType {
 	case 0x0:
 		var bumpedGasPrice *big.Int
 		var bumpedGasLimit uint64
-		bumpedGasPrice, bumpedGasLimit, err = ec.estimator.BumpLegacyGas(previousAttempt.GasPrice.ToInt(), previousAttempt.EthTx.GasLimit)
+		bumpedGasPrice, bumpedGasLimit, err = ec.bumpLegacyGas(previousAttempt.GasPrice.ToInt(), previousAttempt.EthTx.GasLimit, boost)
 		if err == nil {
 			promNumGasBumps.WithLabelValues(ec.chainID.String()).Inc()
-			ec.lggr.Debugw("Rebroadcast bumping gas for Legacy tx", append(logFields, "bumpedGasPrice", bumpedGasPrice.String())...)
-			return ec.NewLegacyAttempt(previousAttempt.EthTx, bumpedGasPrice, bumpedGasLimit)
+			ec.logBump("Rebroadcast bumping gas for Legacy tx", previousAttempt.EthTx, bumpedGasPrice, append(logFields, "bumpedGasPrice", bumpedGasPrice.String()))
+			bumpedAttempt, err = ec.newEstimatedLegacyAttempt(previousAttempt.EthTx, bumpedGasPrice, bumpedGasLimit)
+			if err == nil && bumpedAttempt.GasPrice.ToInt().Cmp(previousAttempt.GasPrice.ToInt()) <= 0 {
+				// The bump was clamped away entirely by the max gas price of
+				// the transaction, so the node would reject it as a replacement
+				bumpedAttempt = EthTxAttempt{}
+				err = errors.Wrapf(gas.ErrBumpGasExceedsLimit, "cannot bump gas price of %s wei any further, the transaction's max gas price is %s wei", previousAttempt.GasPrice.String(), maxGasPrice(ec.config, previousAttempt.EthTx).String())
+				break
+			}
+			return withDeadlineBoost(bumpedAttempt, boost), err
 		}
 	case 0x2:
 		// BumpDynamicFee(original DynamicFee, gasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error)
 		var bumpedFee gas.DynamicFee
 		var bumpedGasLimit uint64
 		original := previousAttempt.DynamicFee()
-		bumpedFee, bumpedGasLimit, err = ec.estimator.BumpDynamicFee(original, previousAttempt.EthTx.GasLimit)
+		bumpedFee, bumpedGasLimit, err = ec.bumpDynamicFee(original, previousAttempt.EthTx.GasLimit, boost)
 		if err == nil {
 			promNumGasBumps.WithLabelValues(ec.chainID.String()).Inc()
-			ec.lggr.Debugw("Rebroadcast bumping gas for DynamicFee tx", append(logFields, "bumpedTipCap", bumpedFee.TipCap.String(), "bumpedFeeCap", bumpedFee.FeeCap.String())...)
-			return ec.NewDynamicFeeAttempt(previousAttempt.EthTx, bumpedFee, bumpedGasLimit)
+			ec.logBump("Rebroadcast bumping gas for DynamicFee tx", previousAttempt.EthTx, bumpedFee.FeeCap, append(logFields, "bumpedTipCap", bumpedFee.TipCap.String(), "bumpedFeeCap", bumpedFee.FeeCap.String()))
+			bumpedAttempt, err = ec.newEstimatedDynamicFeeAttempt(previousAttempt.EthTx, bumpedFee, bumpedGasLimit)
+			if err == nil && bumpedAttempt.GasFeeCap.ToInt().Cmp(original.FeeCap) <= 0 {
+				// The bump was clamped away entirely by the max fee (or max gas
+				// price) of the transaction, so the node would reject it as a
+				// replacement
+				return EthTxAttempt{}, errors.Wrapf(ErrTxMaxFeeExceeded, "cannot bump fee cap of %s wei any further", original.FeeCap.String())
+			}
+			return withDeadlineBoost(bumpedAttempt, boost), err
 		}
 	default:
 		err = errors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
@@ -969,6 +1186,52 @@ func (ec *EthConfirmer) bumpGas(previousAttempt EthTxAttempt) (bumpedAttempt Eth
 	return bumpedAttempt, errors.Wrap(err, "error bumping gas")
 }
 
+// bumpLegacyGas bumps the gas price once, and then boost-1 more times for a
+// transaction close to its deadline. Boosting stops early at the first bump
+// that fails, e.g. because it would exceed the max gas price.
+func (ec *EthConfirmer) bumpLegacyGas(gasPrice *big.Int, gasLimit uint64, boost uint32) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	bumpedGasPrice, chainSpecificGasLimit, err = ec.estimator.BumpLegacyGas(gasPrice, gasLimit)
+	for i := uint32(1); err == nil && i < boost; i++ {
+		price, limit, boostErr := ec.estimator.BumpLegacyGas(bumpedGasPrice, gasLimit)
+		if boostErr != nil {
+			break
+		}
+		bumpedGasPrice, chainSpecificGasLimit = price, limit
+	}
+	return
+}
+
+// bumpDynamicFee is the DynamicFee equivalent of bumpLegacyGas
+func (ec *EthConfirmer) bumpDynamicFee(fee gas.DynamicFee, gasLimit uint64, boost uint32) (bumpedFee gas.DynamicFee, chainSpecificGasLimit uint64, err error) {
+	bumpedFee, chainSpecificGasLimit, err = ec.estimator.BumpDynamicFee(fee, gasLimit)
+	for i := uint32(1); err == nil && i < boost; i++ {
+		boostedFee, limit, boostErr := ec.estimator.BumpDynamicFee(bumpedFee, gasLimit)
+		if boostErr != nil {
+			break
+		}
+		bumpedFee, chainSpecificGasLimit = boostedFee, limit
+	}
+	return
+}
+
+// withDeadlineBoost records the boost factor on a boosted attempt
+func withDeadlineBoost(attempt EthTxAttempt, boost uint32) EthTxAttempt {
+	if boost > 1 {
+		attempt.DeadlineBoostFactor = &boost
+	}
+	return attempt
+}
+
+// logBump logs a single gas bump, or adds it to the digest if bump digests
+// are enabled. For DynamicFee transactions the fee cap is used as the price.
+func (ec *EthConfirmer) logBump(msg string, etx EthTx, bumpedPrice *big.Int, logFields []interface{}) {
+	if ec.bumpDigester != nil {
+		ec.bumpDigester.Record(etx.FromAddress, etx.ID, bumpedPrice)
+		return
+	}
+	ec.lggr.Debugw(msg, logFields...)
+}
+
 // saveInProgressAttempt inserts or updates an attempt
 func (ec *EthConfirmer) saveInProgressAttempt(attempt *EthTxAttempt) error {
 	if attempt.State != EthTxAttemptInProgress {
@@ -1003,13 +1266,15 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx,
 	}
 
 	now := time.Now()
-	sendError := sendTransaction(ctx, ec.ethClient, attempt, etx, ec.lggr)
+	sendError := sendTransactionWithLog(ctx, ec.q, ec.ethClient, attempt, etx, ec.lggr)
 
-	if sendError.IsTerminallyUnderpriced() {
+	if sendError.IsTerminallyUnderpriced() || sendError.IsL2FeeTooLow() {
 		// This should really not ever happen in normal operation since we
 		// already bumped above the required minimum in ethBroadcaster.
 		//
-		// It could conceivably happen if the remote eth node changed its configuration.
+		// It could conceivably happen if the remote eth node changed its
+		// configuration, or on an L2 if the base fee has risen above our fee
+		// cap since the last attempt.
 		replacementAttempt, err := ec.bumpGas(attempt)
 		if err != nil {
 			return errors.Wrap(err, "could not bump gas for terminally underpriced transaction")
@@ -1080,7 +1345,7 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx,
 		return deleteInProgressAttempt(ec.q.WithOpts(pg.WithParentCtx(ctx)), attempt)
 	}
 
-	if sendError.IsNonceTooLowError() {
+	if sendError.IsNonceTooLowError() || sendError.IsTransactionAlreadyMined() {
 		// Nonce too low indicated that a transaction at this nonce was confirmed already.
 		// Mark confirmed_missing_receipt and wait for the next cycle to try to get a receipt
 		sendError = nil
@@ -1343,7 +1608,7 @@ func unbroadcastAttempt(q pg.Queryer, attempt EthTxAttempt) error {
 	if attempt.State != EthTxAttemptBroadcast {
 		return errors.New("expected eth_tx_attempt to be broadcast")
 	}
-	_, err := q.Exec(`UPDATE eth_tx_attempts SET broadcast_before_block_num = NULL, state = 'in_progress' WHERE id = $1`, attempt.ID)
+	_, err := q.Exec(`UPDATE eth_tx_attempts SET broadcast_before_block_num = NULL, state = 'in_progress', effective_gas_price = NULL, gas_used = NULL WHERE id = $1`, attempt.ID)
 	return errors.Wrap(err, "unbroadcastAttempt failed")
 }
 
@@ -1384,11 +1649,37 @@ func (ec *EthConfirmer) ForceRebroadcast(beginningNonce uint, endingNonce uint,
 				continue
 			}
 			ec.lggr.Infof("ForceRebroadcast: successfully rebroadcast eth_tx %v with hash: 0x%x", etx.ID, attempt.Hash)
+			if etx.State == EthTxUnconfirmed && !hasAttemptWithHash(*etx, attempt.Hash) {
+				if err := ec.saveManualAttempt(&attempt); err != nil {
+					ec.lggr.Errorw("ForceRebroadcast: failed to save manual attempt, it will not be tracked for a receipt", "ethTxID", etx.ID, "hash", attempt.Hash, "err", err)
+				}
+			}
 		}
 	}
 	return nil
 }
 
+// saveManualAttempt inserts an attempt that was already broadcast by
+// ForceRebroadcast or BumpAllUnconfirmed, so that the EthConfirmer will look for its receipt
+func (ec *EthConfirmer) saveManualAttempt(attempt *EthTxAttempt) error {
+	attempt.State = EthTxAttemptBroadcast
+	attempt.IsManual = true
+	query, args, err := ec.q.BindNamed(insertIntoEthTxAttemptsQuery, attempt)
+	if err != nil {
+		return errors.Wrap(err, "saveManualAttempt failed to BindNamed")
+	}
+	return errors.Wrap(ec.q.Get(attempt, query, args...), "saveManualAttempt failed to insert into eth_tx_attempts")
+}
+
+func hasAttemptWithHash(etx EthTx, hash gethCommon.Hash) bool {
+	for _, a := range etx.EthTxAttempts {
+		if a.Hash == hash {
+			return true
+		}
+	}
+	return false
+}
+
 func (ec *EthConfirmer) sendEmptyTransaction(ctx context.Context, fromAddress gethCommon.Address, nonce uint, overrideGasLimit uint64, gasPriceWei uint64) (gethCommon.Hash, error) {
 	gasLimit := overrideGasLimit
 	if gasLimit == 0 {
@@ -1424,7 +1715,8 @@ SELECT * FROM eth_txes WHERE from_address = $1 AND nonce = $2 AND state IN ('con
 	return
 }
 
-// ResumePendingTaskRuns issues callbacks to task runs that are pending waiting for receipts
+// ResumePendingTaskRuns issues callbacks to task runs that are pending waiting for receipts,
+// in batches if a ResumeBatchCallback was registered
 func (ec *EthConfirmer) ResumePendingTaskRuns(ctx context.Context, head *evmtypes.Head) error {
 	type x struct {
 		ID      uuid.UUID
@@ -1444,11 +1736,60 @@ func (ec *EthConfirmer) ResumePendingTaskRuns(ctx context.Context, head *evmtype
 		return err
 	}
 
-	for _, data := range receipts {
-		if err := ec.resumeCallback(data.ID, data.Receipt, nil); err != nil {
-			return err
-		}
+	reqs := make([]ResumeRequest, len(receipts))
+	for i, data := range receipts {
+		reqs[i] = ResumeRequest{ID: data.ID, Result: data.Receipt}
+	}
+	if err := ec.resumer.resume(reqs); err != nil {
+		return err
 	}
 
-	return nil
+	// Runs that asked to be resumed on broadcast are normally resumed by the
+	// EthBroadcaster, this picks up any that were missed e.g. due to a crash
+	// between saving the attempt and calling back
+	type y struct {
+		ID   uuid.UUID
+		Hash gethCommon.Hash
+	}
+	var broadcasts []y
+	if err := ec.q.Select(&broadcasts, `
+	SELECT DISTINCT ON (pipeline_task_runs.id) pipeline_task_runs.id, eth_tx_attempts.hash FROM pipeline_task_runs
+	INNER JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+	INNER JOIN eth_txes ON eth_txes.pipeline_task_run_id = pipeline_task_runs.id
+	INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id
+	WHERE pipeline_runs.state = 'suspended' AND eth_txes.state IN ('unconfirmed', 'confirmed', 'confirmed_missing_receipt')
+	AND eth_tx_attempts.state = 'broadcast' AND eth_txes.meta->>'ResumeOnBroadcast' = 'true' AND eth_txes.evm_chain_id = $1
+	ORDER BY pipeline_task_runs.id, eth_tx_attempts.id ASC
+	`, ec.chainID.String()); err != nil {
+		return err
+	}
+
+	reqs = make([]ResumeRequest, len(broadcasts))
+	for i, data := range broadcasts {
+		reqs[i] = ResumeRequest{ID: data.ID, Result: data.Hash}
+	}
+	if err := ec.resumer.resume(reqs); err != nil {
+		return err
+	}
+
+	// When resuming in batches, the EthBroadcaster resumes runs with a fatal
+	// error after saving it, this picks up any that were missed
+	if !ec.resumer.batched() {
+		return nil
+	}
+	var fatals []EthTx
+	if err := ec.q.Select(&fatals, `
+	SELECT eth_txes.* FROM pipeline_task_runs
+	INNER JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+	INNER JOIN eth_txes ON eth_txes.pipeline_task_run_id = pipeline_task_runs.id
+	WHERE pipeline_runs.state = 'suspended' AND eth_txes.state = 'fatal_error' AND eth_txes.evm_chain_id = $1
+	`, ec.chainID.String()); err != nil {
+		return err
+	}
+
+	reqs = make([]ResumeRequest, len(fatals))
+	for i, etx := range fatals {
+		reqs[i] = fatalErrorResumeRequest(etx)
+	}
+	return ec.resumer.resume(reqs)
 }
@@ -23,8 +23,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
@@ -506,6 +508,183 @@ func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_CheckForReceipts_records_transaction_costs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	config := newTestChainScopedConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	state := cltest.MustGetStateForKey(t, ethKeyStore, key)
+
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+	newBroadcastDynamicFeeEthTxAttempt := func(etxID int64) bulletprooftxmanager.EthTxAttempt {
+		attempt := cltest.NewDynamicFeeEthTxAttempt(t, etxID)
+		attempt.State = bulletprooftxmanager.EthTxAttemptBroadcast
+		attempt.GasTipCap = utils.NewBigI(2)
+		attempt.GasFeeCap = utils.NewBigI(100)
+		return attempt
+	}
+
+	// Legacy attempt, the node does not report an effective gas price
+	etx1 := cltest.MustInsertUnconfirmedEthTx(t, borm, 0, fromAddress)
+	attempt1 := newBroadcastLegacyEthTxAttempt(t, etx1.ID, 20)
+	require.NoError(t, borm.InsertEthTxAttempt(&attempt1))
+	// Dynamic fee attempt, the node reports an effective gas price
+	etx2 := cltest.MustInsertUnconfirmedEthTx(t, borm, 1, fromAddress)
+	attempt2 := newBroadcastDynamicFeeEthTxAttempt(etx2.ID)
+	require.NoError(t, borm.InsertEthTxAttempt(&attempt2))
+	// Dynamic fee attempt, the node does not report an effective gas price so
+	// it is derived from the base fee of the block it was mined in
+	etx3 := cltest.MustInsertUnconfirmedEthTx(t, borm, 2, fromAddress)
+	attempt3 := newBroadcastDynamicFeeEthTxAttempt(etx3.ID)
+	require.NoError(t, borm.InsertEthTxAttempt(&attempt3))
+	head := cltest.MustInsertHead(t, db, config, 42)
+	pgtest.MustExec(t, db, `UPDATE heads SET base_fee_per_gas = 40 WHERE hash = $1`, head.Hash)
+
+	receipts := map[gethCommon.Hash]*bulletprooftxmanager.Receipt{
+		attempt1.Hash: {TxHash: attempt1.Hash, BlockHash: utils.NewHash(), BlockNumber: big.NewInt(42), GasUsed: 21000},
+		attempt2.Hash: {TxHash: attempt2.Hash, BlockHash: utils.NewHash(), BlockNumber: big.NewInt(42), GasUsed: 50000, EffectiveGasPrice: big.NewInt(30)},
+		attempt3.Hash: {TxHash: attempt3.Hash, BlockHash: head.Hash, BlockNumber: big.NewInt(42), GasUsed: 30000},
+	}
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(10), nil)
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 3
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		for i := range elems {
+			for hash, receipt := range receipts {
+				if cltest.BatchElemMatchesHash(elems[i], hash) {
+					elems[i].Result = receipt
+				}
+			}
+		}
+	}).Once()
+
+	require.NoError(t, ec.CheckForReceipts(context.Background(), 42))
+	ethClient.AssertExpectations(t)
+
+	for _, tc := range []struct {
+		etxID                     int64
+		expectedGasUsed           int64
+		expectedEffectiveGasPrice int64
+	}{
+		{etx1.ID, 21000, 20},
+		{etx2.ID, 50000, 30},
+		// LEAST(fee cap, base fee + tip cap)
+		{etx3.ID, 30000, 42},
+	} {
+		etx, err := borm.FindEthTxWithAttempts(tc.etxID)
+		require.NoError(t, err)
+		require.Equal(t, bulletprooftxmanager.EthTxConfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 1)
+		attempt := etx.EthTxAttempts[0]
+		require.NotNil(t, attempt.GasUsed)
+		assert.Equal(t, tc.expectedGasUsed, *attempt.GasUsed)
+		require.NotNil(t, attempt.EffectiveGasPrice)
+		assert.Equal(t, tc.expectedEffectiveGasPrice, attempt.EffectiveGasPrice.ToInt().Int64())
+	}
+
+	t.Run("TransactionCosts totals the costs per subject", func(t *testing.T) {
+		subject := uuid.NewV4()
+		otherSubject := uuid.NewV4()
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET subject = $1 WHERE id IN ($2, $3)`, subject, etx1.ID, etx2.ID)
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET subject = $1 WHERE id = $2`, otherSubject, etx3.ID)
+
+		from, to := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+		costs, err := bulletprooftxmanager.LoadTransactionCosts(db, &cltest.FixtureChainID, subject, from, to)
+		require.NoError(t, err)
+		assert.Equal(t, subject, costs.Subject)
+		assert.Equal(t, int64(2), costs.TxCount)
+		assert.Equal(t, int64(71000), costs.TotalGasUsed)
+		// 20 * 21000 + 30 * 50000
+		assert.Equal(t, big.NewInt(1920000).String(), costs.TotalFeeWei.String())
+		assert.Equal(t, big.NewInt(1920000/71000).String(), costs.AverageGasPriceWei.String())
+
+		costs, err = bulletprooftxmanager.LoadTransactionCosts(db, &cltest.FixtureChainID, otherSubject, from, to)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), costs.TxCount)
+		assert.Equal(t, big.NewInt(42*30000).String(), costs.TotalFeeWei.String())
+		assert.Equal(t, big.NewInt(42).String(), costs.AverageGasPriceWei.String())
+
+		// Outside of the time window
+		costs, err = bulletprooftxmanager.LoadTransactionCosts(db, &cltest.FixtureChainID, subject, to, to.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), costs.TxCount)
+		assert.Equal(t, big.NewInt(0).String(), costs.TotalFeeWei.String())
+		assert.Equal(t, big.NewInt(0).String(), costs.AverageGasPriceWei.String())
+
+		// Different chain
+		costs, err = bulletprooftxmanager.LoadTransactionCosts(db, big.NewInt(42), subject, from, to)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), costs.TxCount)
+	})
+}
+
+func TestEthConfirmer_CheckForReceipts_EmitsConfirmedOutcome(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	config := newTestChainScopedConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, nil, nil)
+	sink := newFakeOutcomeSink()
+	bulletprooftxmanager.SetOutcomeSinkOnEthConfirmer(sink, ec)
+	require.NoError(t, ec.Start())
+	t.Cleanup(func() { assert.NoError(t, ec.Close()) })
+
+	ctx := context.Background()
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+	attempt := etx.EthTxAttempts[0]
+
+	bptxmReceipt := bulletprooftxmanager.Receipt{
+		TxHash:           attempt.Hash,
+		BlockHash:        utils.NewHash(),
+		BlockNumber:      big.NewInt(42),
+		TransactionIndex: uint(1),
+	}
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(1), nil)
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 1 && cltest.BatchElemMatchesHash(b[0], attempt.Hash)
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		elems[0].Result = &bptxmReceipt
+	}).Once()
+
+	require.NoError(t, ec.CheckForReceipts(ctx, 42))
+
+	record := sink.awaitRecord(t)
+	assert.Equal(t, bulletprooftxmanager.OutcomeConfirmed, record.Outcome)
+	assert.Equal(t, etx.ID, record.EthTxID)
+	assert.Equal(t, fromAddress, record.FromAddress)
+	assert.Equal(t, etx.ToAddress, record.ToAddress)
+	require.NotNil(t, record.Nonce)
+	assert.Equal(t, int64(0), *record.Nonce)
+	require.NotNil(t, record.TxHash)
+	assert.Equal(t, attempt.Hash, *record.TxHash)
+	require.NotNil(t, record.BlockNumber)
+	assert.Equal(t, int64(42), *record.BlockNumber)
+	assert.Empty(t, record.Error)
+
+	// Already confirmed, so checking again emits nothing
+	require.NoError(t, ec.CheckForReceipts(ctx, 43))
+	sink.assertNoRecords(t)
+
+	ethClient.AssertExpectations(t)
+}
+
 func TestEthConfirmer_CheckForReceipts_batching(t *testing.T) {
 	t.Parallel()
 
@@ -915,6 +1094,7 @@ func TestEthConfirmer_CheckForReceipts_confirmed_missing_receipt(t *testing.T) {
 		etx1, err = borm.FindEthTxWithAttempts(etx1.ID)
 		require.NoError(t, err)
 		require.Equal(t, bulletprooftxmanager.EthTxFatalError, etx1.State)
+		assertFatalReason(t, bulletprooftxmanager.FatalReasonMissingReceipt, etx1)
 		etx0, err = borm.FindEthTxWithAttempts(etx0.ID)
 		require.NoError(t, err)
 		require.Equal(t, bulletprooftxmanager.EthTxConfirmed, etx0.State)
@@ -1949,6 +2129,99 @@ func TestEthConfirmer_RebroadcastWhereNecessary_TerminallyUnderpriced_ThenGoesTh
 	})
 }
 
+func TestEthConfirmer_RebroadcastWhereNecessary_DeadlineBoost(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	cfg.Overrides.GlobalEvmMaxGasPriceWei = assets.GWei(500)
+	cfg.Overrides.GlobalEthTxDeadlineBoostCurve = null.StringFrom("2m:3")
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	kst := new(ksmocks.Eth)
+	kst.Test(t)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, kst, []ethkey.State{state}, nil)
+
+	now := time.Unix(1616509200, 0)
+	clock := new(mocks.AfterNower)
+	clock.On("Now").Return(now)
+	bulletprooftxmanager.SetClockOnEthConfirmer(clock, ec)
+
+	currentHead := int64(30)
+	// Too recent for the normal gas bump threshold of 3 blocks, but old
+	// enough for the threshold boosted by a factor of 3
+	recentEnough := int64(29)
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress, now.Add(-time.Minute))
+	attempt1_1 := etx.EthTxAttempts[0]
+	require.NoError(t, db.Get(&attempt1_1, `UPDATE eth_tx_attempts SET broadcast_before_block_num=$1 WHERE id=$2 RETURNING *`, recentEnough, attempt1_1.ID))
+	var err error
+
+	t.Run("does not bump early if the deadline has passed", func(t *testing.T) {
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET deadline=$1 WHERE id=$2`, now.Add(-time.Second), etx.ID)
+
+		require.NoError(t, ec.RebroadcastWhereNecessary(context.TODO(), currentHead))
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 1)
+	})
+
+	t.Run("does not bump early if the deadline is further away than the boost curve", func(t *testing.T) {
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET deadline=$1 WHERE id=$2`, now.Add(5*time.Minute), etx.ID)
+
+		require.NoError(t, ec.RebroadcastWhereNecessary(context.TODO(), currentHead))
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 1)
+	})
+
+	t.Run("bumps early and by several increments if the deadline is close", func(t *testing.T) {
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET deadline=$1 WHERE id=$2`, now.Add(time.Minute), etx.ID)
+
+		// 1 wei -> 20 gwei -> 25 gwei -> 30 gwei
+		expectedBumpedGasPrice := assets.GWei(30)
+
+		ethTx := *types.NewTx(&types.LegacyTx{})
+		kst.On("SignTx",
+			fromAddress,
+			mock.MatchedBy(func(tx *types.Transaction) bool {
+				if expectedBumpedGasPrice.Cmp(tx.GasPrice()) != 0 {
+					return false
+				}
+				ethTx = *tx
+				return true
+			}),
+			mock.Anything).Return(&ethTx, nil).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return expectedBumpedGasPrice.Cmp(tx.GasPrice()) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, ec.RebroadcastWhereNecessary(context.TODO(), currentHead))
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+
+		attempt1_2 := etx.EthTxAttempts[0]
+		assert.Equal(t, expectedBumpedGasPrice.Int64(), attempt1_2.GasPrice.ToInt().Int64())
+		require.NotNil(t, attempt1_2.DeadlineBoostFactor)
+		assert.Equal(t, uint32(3), *attempt1_2.DeadlineBoostFactor)
+		assert.Nil(t, etx.EthTxAttempts[1].DeadlineBoostFactor)
+
+		kst.AssertExpectations(t)
+		ethClient.AssertExpectations(t)
+	})
+}
+
 func TestEthConfirmer_RebroadcastWhereNecessary_WhenOutOfEth(t *testing.T) {
 	t.Parallel()
 
@@ -2308,6 +2581,19 @@ func TestEthConfirmer_ForceRebroadcast(t *testing.T) {
 		ethClient.AssertExpectations(t)
 	})
 
+	t.Run("saves the rebroadcast attempt for an unconfirmed eth_tx as manual", func(t *testing.T) {
+		etx, err := borm.FindEthTxWithAttempts(etx1.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+
+		attempt := etx.EthTxAttempts[0]
+		assert.True(t, attempt.IsManual)
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, attempt.State)
+		assert.Equal(t, gasPriceWei, attempt.GasPrice.ToInt().Uint64())
+		assert.Equal(t, overrideGasLimit, attempt.ChainSpecificGasLimit)
+		assert.False(t, etx.EthTxAttempts[1].IsManual)
+	})
+
 	t.Run("uses default gas limit if overrideGasLimit is 0", func(t *testing.T) {
 		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
 		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
@@ -2455,6 +2741,38 @@ func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 
 	})
 
+	t.Run("processes broadcast eth_txes that asked to be resumed on broadcast", func(t *testing.T) {
+		ch := make(chan interface{})
+		ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, value interface{}, err error) error {
+			require.Nil(t, err)
+			ch <- value
+			return nil
+		})
+
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		pgtest.MustExec(t, db, `UPDATE pipeline_runs SET state = 'suspended' WHERE id = $1`, run.ID)
+
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 4, fromAddress)
+		attempt := etx.EthTxAttempts[0]
+
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET pipeline_task_run_id = $1, meta = '{"ResumeOnBroadcast": true}' WHERE id = $2`, &tr.ID, etx.ID)
+
+		go func() {
+			err := ec.ResumePendingTaskRuns(context.Background(), &head)
+			require.NoError(t, err)
+		}()
+
+		select {
+		case data := <-ch:
+			require.Equal(t, attempt.Hash, data)
+		case <-time.After(time.Second):
+			t.Fatal("no value received")
+		}
+
+		pgtest.MustExec(t, db, `UPDATE pipeline_runs SET state = 'completed' WHERE id = $1`, run.ID)
+	})
+
 	t.Run("processes eth_txes with receipts older than minConfirmations", func(t *testing.T) {
 		ch := make(chan interface{})
 		ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, value interface{}, err error) error {
@@ -2493,3 +2811,132 @@ func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 	})
 
 }
+
+func TestEthConfirmer_CheckExternalNonces(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	config := newTestChainScopedConfig(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+	q := pg.NewQ(db, logger.TestLogger(t), config)
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, nil, nil)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := bulletprooftxmanager.ReserveNonce(q, fromAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+	}
+	// Not external, must be ignored
+	cltest.MustInsertUnconfirmedEthTx(t, cltest.NewBulletproofTxManagerORM(t, db, config), 0, otherAddress)
+
+	consumed := func(t *testing.T, nonce int64) bool {
+		var etx bulletprooftxmanager.EthTx
+		require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE from_address = $1 AND nonce = $2`, fromAddress, nonce))
+		require.Equal(t, bulletprooftxmanager.EthTxExternal, etx.State)
+		return etx.BroadcastAt != nil
+	}
+
+	ethClient.On("NonceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(uint64(1), nil).Once()
+	require.NoError(t, ec.CheckExternalNonces(ctx))
+	assert.True(t, consumed(t, 0))
+	assert.False(t, consumed(t, 1))
+
+	ethClient.On("NonceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(uint64(2), nil).Once()
+	require.NoError(t, ec.CheckExternalNonces(ctx))
+	assert.True(t, consumed(t, 1))
+
+	// Nothing left to check, so no more calls to the eth node
+	require.NoError(t, ec.CheckExternalNonces(ctx))
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthConfirmer_ResumePendingTaskRuns_Batched(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	config := configtest.NewTestGeneralConfig(t)
+	config.Overrides.EthTxResumeBatchSize = null.IntFrom(2)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, config)
+	lggr := logger.TestLogger(t)
+
+	pgtest.MustExec(t, db, `SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`)
+
+	head := evmtypes.Head{Hash: utils.NewHash(), Number: 10}
+
+	// Drain a backlog of 5 confirmed transactions waiting to resume their runs
+	expected := make(map[uuid.UUID]gethCommon.Hash)
+	for i := int64(0); i < 5; i++ {
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		pgtest.MustExec(t, db, `UPDATE pipeline_runs SET state = 'suspended' WHERE id = $1`, run.ID)
+
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, i, 1, fromAddress)
+		receipt := cltest.MustInsertEthReceipt(t, borm, head.Number-1, head.Hash, etx.EthTxAttempts[0].Hash)
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET pipeline_task_run_id = $1, min_confirmations = 1 WHERE id = $2`, &tr.ID, etx.ID)
+		expected[tr.ID] = receipt.TxHash
+	}
+
+	var batches [][]bulletprooftxmanager.ResumeRequest
+	ec := bulletprooftxmanager.NewEthConfirmer(db, ethClient, evmcfg, ethKeyStore, nil, gas.NewFixedPriceEstimator(evmcfg, lggr),
+		func(uuid.UUID, interface{}, error) error {
+			t.Fatal("per-tx callback must not be used when a batch callback is registered")
+			return nil
+		},
+		func(reqs []bulletprooftxmanager.ResumeRequest) error {
+			batches = append(batches, reqs)
+			return nil
+		}, lggr)
+
+	require.NoError(t, ec.ResumePendingTaskRuns(context.Background(), &head))
+
+	require.Len(t, batches, 3)
+	assert.Len(t, batches[0], 2)
+	assert.Len(t, batches[1], 2)
+	assert.Len(t, batches[2], 1)
+
+	resumed := make(map[uuid.UUID]gethCommon.Hash)
+	for _, batch := range batches {
+		for _, req := range batch {
+			require.NoError(t, req.Err)
+			require.IsType(t, []byte{}, req.Result)
+			var r bulletprooftxmanager.Receipt
+			require.NoError(t, json.Unmarshal(req.Result.([]byte), &r))
+			resumed[req.ID] = r.TxHash
+		}
+	}
+	assert.Equal(t, expected, resumed)
+
+	t.Run("resumes runs of fatally errored transactions that the EthBroadcaster missed", func(t *testing.T) {
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		pgtest.MustExec(t, db, `UPDATE pipeline_runs SET state = 'suspended' WHERE id = $1`, run.ID)
+		etx := cltest.MustInsertFatalErrorEthTx(t, borm, fromAddress)
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET pipeline_task_run_id = $1 WHERE id = $2`, &tr.ID, etx.ID)
+
+		batches = nil
+		require.NoError(t, ec.ResumePendingTaskRuns(context.Background(), &head))
+
+		// The earlier runs are resumed again too, since the callback did not
+		// actually resume them
+		var found bool
+		for _, batch := range batches {
+			for _, req := range batch {
+				if req.ID != tr.ID {
+					continue
+				}
+				found = true
+				require.Error(t, req.Err)
+				assert.Contains(t, req.Err.Error(), "fatal error while sending transaction: something exploded")
+			}
+		}
+		assert.True(t, found)
+	})
+}
@@ -116,38 +116,18 @@ func (er *EthResender) resendUnconfirmed() error {
 
 	er.logger.Infow(fmt.Sprintf("Re-sending %d unconfirmed transactions that were last sent over %s ago. These transactions are taking longer than usual to be mined. %s", len(attempts), ageThreshold, static.EthNodeConnectivityProblemLabel), "n", len(attempts))
 
-	reqs := make([]rpc.BatchElem, len(attempts))
 	ethTxIDs := make([]int64, len(attempts))
 	for i, attempt := range attempts {
 		ethTxIDs[i] = attempt.EthTxID
-		req := rpc.BatchElem{
-			Method: "eth_sendRawTransaction",
-			Args:   []interface{}{hexutil.Encode(attempt.SignedRawTx)},
-			Result: &common.Hash{},
-		}
-		reqs[i] = req
 	}
 
 	now := time.Now()
-	batchSize := int(er.config.EvmRPCDefaultBatchSize())
-	if batchSize == 0 {
-		batchSize = len(reqs)
+	reqs, err := batchSendTransactions(er.ctx, er.logger, er.ethClient, er.config.EvmRPCDefaultBatchSize(), attempts)
+	if err != nil {
+		return errors.Wrap(err, "failed to re-send transactions")
 	}
-	for i := 0; i < len(reqs); i += batchSize {
-		j := i + batchSize
-		if j > len(reqs) {
-			j = len(reqs)
-		}
-
-		er.logger.Debugw(fmt.Sprintf("Batch resending transactions %v thru %v", i, j))
-
-		if err := er.ethClient.BatchCallContext(er.ctx, reqs[i:j]); err != nil {
-			return errors.Wrap(err, "failed to re-send transactions")
-		}
-
-		if err := er.updateBroadcastAts(now, ethTxIDs[i:j]); err != nil {
-			return errors.Wrap(err, "failed to update last succeeded on attempts")
-		}
+	if err := er.updateBroadcastAts(now, ethTxIDs); err != nil {
+		return errors.Wrap(err, "failed to update last succeeded on attempts")
 	}
 
 	logResendResult(er.logger, reqs)
@@ -186,6 +166,39 @@ func (er *EthResender) updateBroadcastAts(now time.Time, etxIDs []int64) error {
 	return errors.Wrap(err, "updateBroadcastAts failed to update eth_txes")
 }
 
+// batchSendTransactions sends the signed raw transactions of the attempts in
+// batches of batchSize (all at once if it is 0). The result of each send is
+// set on the returned request at the same index as its attempt; use
+// evmclient.NewSendError to classify req.Error.
+func batchSendTransactions(ctx context.Context, lggr logger.Logger, ethClient evmclient.Client, batchSize uint32, attempts []EthTxAttempt) ([]rpc.BatchElem, error) {
+	reqs := make([]rpc.BatchElem, len(attempts))
+	for i, attempt := range attempts {
+		reqs[i] = rpc.BatchElem{
+			Method: "eth_sendRawTransaction",
+			Args:   []interface{}{hexutil.Encode(attempt.SignedRawTx)},
+			Result: &common.Hash{},
+		}
+	}
+
+	size := int(batchSize)
+	if size == 0 {
+		size = len(reqs)
+	}
+	for i := 0; i < len(reqs); i += size {
+		j := i + size
+		if j > len(reqs) {
+			j = len(reqs)
+		}
+
+		lggr.Debugw(fmt.Sprintf("Batch sending transactions %v thru %v", i, j))
+
+		if err := ethClient.BatchCallContext(ctx, reqs[i:j]); err != nil {
+			return reqs, errors.Wrap(err, "failed to batch send transactions")
+		}
+	}
+	return reqs, nil
+}
+
 func logResendResult(lggr logger.Logger, reqs []rpc.BatchElem) {
 	var nNew int
 	var nFatal int
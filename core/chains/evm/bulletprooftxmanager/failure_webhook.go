@@ -0,0 +1,209 @@
+package bulletprooftxmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jpillora/backoff"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// FailureReasonFatalError is sent when a transaction is marked fatal_error
+	FailureReasonFatalError = "fatal_error"
+	// FailureReasonInsufficientEth is sent when a transaction is rejected
+	// because its key has insufficient eth
+	FailureReasonInsufficientEth = "insufficient_eth"
+	// FailureReasonGasBumpExceedsLimit is sent when a transaction can no
+	// longer be bumped because it has hit the max gas price
+	FailureReasonGasBumpExceedsLimit = "gas_bump_exceeds_limit"
+
+	failureWebhookQueueSize   = 100
+	failureWebhookMaxAttempts = 3
+	failureWebhookTimeout     = 10 * time.Second
+)
+
+// FailureEvent is the JSON payload POSTed to ETH_TX_FAILURE_WEBHOOK_URL
+type FailureEvent struct {
+	Reason      string         `json:"reason"`
+	Error       string         `json:"error"`
+	EthTxID     int64          `json:"ethTxID"`
+	FromAddress common.Address `json:"fromAddress"`
+	ToAddress   common.Address `json:"toAddress"`
+	EVMChainID  string         `json:"evmChainID"`
+	// FatalReason is only set for FailureReasonFatalError
+	FatalReason FatalReason `json:"fatalReason,omitempty"`
+	// CorrelationID is the pipeline task run ID if the transaction was
+	// created by a pipeline run, otherwise the transaction's subject (if any)
+	CorrelationID string `json:"correlationID,omitempty"`
+	// Origin is what created the transaction, if known
+	Origin    *EthTxOrigin `json:"origin,omitempty"`
+	Timestamp time.Time    `json:"timestamp"`
+}
+
+// NewFailureEvent builds a FailureEvent for the given transaction
+func NewFailureEvent(reason string, etx EthTx, err string) FailureEvent {
+	var correlationID string
+	if etx.PipelineTaskRunID.Valid {
+		correlationID = etx.PipelineTaskRunID.UUID.String()
+	} else if etx.Subject.Valid {
+		correlationID = etx.Subject.UUID.String()
+	}
+	var fatalReason FatalReason
+	if etx.FatalReason != nil {
+		fatalReason = *etx.FatalReason
+	}
+	return FailureEvent{
+		Reason:        reason,
+		FatalReason:   fatalReason,
+		Error:         err,
+		EthTxID:       etx.ID,
+		FromAddress:   etx.FromAddress,
+		ToAddress:     etx.ToAddress,
+		EVMChainID:    etx.EVMChainID.String(),
+		CorrelationID: correlationID,
+		Origin:        etx.Origin().OrNil(),
+		Timestamp:     time.Now(),
+	}
+}
+
+// FailureWebhook asynchronously delivers transaction failure events and gas
+// bump digests to an operator-configured URL. Delivery never blocks the
+// caller; if the queue is full the event is dropped and logged instead.
+type FailureWebhook struct {
+	url      url.URL
+	client   *http.Client
+	log      logger.Logger
+	chEvents chan interface{}
+	chStop   chan struct{}
+	chDone   chan struct{}
+}
+
+// NewFailureWebhook instantiates a new failure webhook that POSTs to u
+func NewFailureWebhook(lggr logger.Logger, u url.URL) *FailureWebhook {
+	return &FailureWebhook{
+		u,
+		&http.Client{Timeout: failureWebhookTimeout},
+		lggr.Named("FailureWebhook"),
+		make(chan interface{}, failureWebhookQueueSize),
+		make(chan struct{}),
+		make(chan struct{}),
+	}
+}
+
+// Start the webhook. Should only be called once.
+func (w *FailureWebhook) Start() {
+	w.log.Debugw("FailureWebhook: started", "url", w.url.Redacted())
+	go w.runLoop()
+}
+
+// Stop the webhook. Should only be called once. Events that have not yet
+// been delivered are discarded.
+func (w *FailureWebhook) Stop() {
+	w.log.Debug("FailureWebhook: stopping")
+	close(w.chStop)
+	<-w.chDone
+}
+
+// Notify queues the event for delivery
+func (w *FailureWebhook) Notify(event FailureEvent) {
+	w.enqueue(event)
+}
+
+// NotifyBumpDigest queues the digest for delivery
+func (w *FailureWebhook) NotifyBumpDigest(digest BumpDigest) {
+	w.enqueue(digest)
+}
+
+func (w *FailureWebhook) enqueue(event interface{}) {
+	select {
+	case w.chEvents <- event:
+	default:
+		w.log.Errorw("FailureWebhook: queue is full, dropping event", "event", event)
+	}
+}
+
+func (w *FailureWebhook) runLoop() {
+	defer close(w.chDone)
+	ctx, cancel := utils.ContextFromChan(w.chStop)
+	defer cancel()
+
+	// Some failures are hit repeatedly for the same transaction (e.g.
+	// insufficient eth in retry mode is retried on every poll, and a
+	// transaction at the gas bump ceiling is resent on every block), so only
+	// notify the first time for each transaction
+	type lastKey struct {
+		reason string
+		from   common.Address
+	}
+	last := make(map[lastKey]int64)
+	for {
+		select {
+		case <-w.chStop:
+			return
+		case event := <-w.chEvents:
+			if e, ok := event.(FailureEvent); ok {
+				k := lastKey{e.Reason, e.FromAddress}
+				if id, exists := last[k]; exists && id == e.EthTxID {
+					continue
+				}
+				last[k] = e.EthTxID
+			}
+			w.deliver(ctx, event)
+		}
+	}
+}
+
+func (w *FailureWebhook) deliver(ctx context.Context, event interface{}) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		w.log.Errorw("FailureWebhook: failed to marshal event", "event", event, "err", err)
+		return
+	}
+	b := backoff.Backoff{
+		Min:    1 * time.Second,
+		Max:    10 * time.Second,
+		Jitter: true,
+	}
+	for attempt := 1; ; attempt++ {
+		err = w.post(ctx, body)
+		if err == nil {
+			return
+		}
+		if attempt >= failureWebhookMaxAttempts {
+			w.log.Errorw("FailureWebhook: giving up delivering event", "event", event, "attempts", attempt, "err", err)
+			return
+		}
+		w.log.Warnw("FailureWebhook: failed to deliver event, will retry", "event", event, "attempt", attempt, "err", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(b.Duration()):
+		}
+	}
+}
+
+func (w *FailureWebhook) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url.String(), bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer w.log.ErrorIfClosing(resp.Body, "FailureWebhook response body")
+	if resp.StatusCode >= 300 {
+		return errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
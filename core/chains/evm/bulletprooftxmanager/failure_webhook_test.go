@@ -0,0 +1,116 @@
+package bulletprooftxmanager_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func newWebhookServer(t *testing.T, handler func(w http.ResponseWriter, body []byte)) *url.URL {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		handler(w, body)
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func newFailureWebhookServer(t *testing.T, handler func(w http.ResponseWriter, event bulletprooftxmanager.FailureEvent)) *url.URL {
+	return newWebhookServer(t, func(w http.ResponseWriter, body []byte) {
+		var event bulletprooftxmanager.FailureEvent
+		if !assert.NoError(t, json.Unmarshal(body, &event)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		handler(w, event)
+	})
+}
+
+func TestFailureWebhook(t *testing.T) {
+	t.Parallel()
+
+	fromAddress := cltest.NewAddress()
+
+	t.Run("delivers events and retries on failure", func(t *testing.T) {
+		var calls int32
+		events := make(chan bulletprooftxmanager.FailureEvent, 10)
+		u := newFailureWebhookServer(t, func(w http.ResponseWriter, event bulletprooftxmanager.FailureEvent) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			events <- event
+		})
+
+		w := bulletprooftxmanager.NewFailureWebhook(logger.TestLogger(t), *u)
+		w.Start()
+		t.Cleanup(w.Stop)
+
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.ID = 42
+		w.Notify(bulletprooftxmanager.NewFailureEvent(bulletprooftxmanager.FailureReasonFatalError, etx, "something exploded"))
+
+		select {
+		case event := <-events:
+			assert.Equal(t, bulletprooftxmanager.FailureReasonFatalError, event.Reason)
+			assert.Equal(t, "something exploded", event.Error)
+			assert.Equal(t, int64(42), event.EthTxID)
+			assert.Equal(t, fromAddress, event.FromAddress)
+		case <-time.After(cltest.WaitTimeout(t)):
+			t.Fatal("timed out waiting for failure event")
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("only notifies insufficient eth once per transaction", func(t *testing.T) {
+		events := make(chan bulletprooftxmanager.FailureEvent, 10)
+		u := newFailureWebhookServer(t, func(w http.ResponseWriter, event bulletprooftxmanager.FailureEvent) {
+			events <- event
+		})
+
+		w := bulletprooftxmanager.NewFailureWebhook(logger.TestLogger(t), *u)
+		w.Start()
+		t.Cleanup(w.Stop)
+
+		etx1 := cltest.NewEthTx(t, fromAddress)
+		etx1.ID = 1
+		etx2 := cltest.NewEthTx(t, fromAddress)
+		etx2.ID = 2
+		w.Notify(bulletprooftxmanager.NewFailureEvent(bulletprooftxmanager.FailureReasonInsufficientEth, etx1, "insufficient funds for transfer"))
+		w.Notify(bulletprooftxmanager.NewFailureEvent(bulletprooftxmanager.FailureReasonInsufficientEth, etx1, "insufficient funds for transfer"))
+		w.Notify(bulletprooftxmanager.NewFailureEvent(bulletprooftxmanager.FailureReasonInsufficientEth, etx2, "insufficient funds for transfer"))
+
+		for _, id := range []int64{1, 2} {
+			select {
+			case event := <-events:
+				assert.Equal(t, id, event.EthTxID)
+			case <-time.After(cltest.WaitTimeout(t)):
+				t.Fatal("timed out waiting for failure event")
+			}
+		}
+		select {
+		case event := <-events:
+			t.Fatalf("unexpected failure event: %v", event)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// FinalizedCallback is called once a confirmed transaction's receipt is
+// buried under ETH_FINALITY_DEPTH blocks. blockNumber is the block the
+// receipt is in. It is called from the EthConfirmer's head processing, so it
+// should return quickly.
+type FinalizedCallback func(etx EthTx, blockNumber uint64)
+
+// finalityHooks holds the callbacks registered with OnFinalized, and the
+// highest block number whose transactions they have been called for.
+//
+// Like keyLocks, the BulletproofTxManager shares its finalityHooks with every
+// EthConfirmer it creates, so that callbacks are neither lost nor called
+// twice when the EthConfirmer is replaced.
+type finalityHooks struct {
+	mu        sync.Mutex
+	callbacks []FinalizedCallback
+	// lastFinalized is -1 until the first head is processed
+	lastFinalized int64
+}
+
+func newFinalityHooks() *finalityHooks {
+	return &finalityHooks{lastFinalized: -1}
+}
+
+func (f *finalityHooks) register(fn FinalizedCallback) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.callbacks = append(f.callbacks, fn)
+}
+
+func (f *finalityHooks) enabled() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.callbacks) > 0
+}
+
+// CheckForFinalizedTransactions calls the FinalizedCallbacks for every
+// confirmed transaction whose receipt became final since the previous head,
+// i.e. is in a block after the previous head's finalized block, up to and
+// including head.Number - ETH_FINALITY_DEPTH.
+//
+// Transactions that were already final when the node started are never
+// passed to the callbacks, and a transaction that is passed to them may only
+// become final again (e.g. if ETH_FINALITY_DEPTH is lowered) after a restart.
+func (ec *EthConfirmer) CheckForFinalizedTransactions(ctx context.Context, head *evmtypes.Head) error {
+	if !ec.finalityHooks.enabled() {
+		return nil
+	}
+	finalized := head.Number - int64(ec.config.EvmFinalityDepth())
+
+	ec.finalityHooks.mu.Lock()
+	defer ec.finalityHooks.mu.Unlock()
+	if ec.finalityHooks.lastFinalized < 0 {
+		ec.finalityHooks.lastFinalized = finalized
+		return nil
+	}
+	if finalized <= ec.finalityHooks.lastFinalized {
+		return nil
+	}
+
+	etxs, err := findEthTxsFinalizedBetween(ec.q.WithOpts(pg.WithParentCtx(ctx)), ec.chainID.String(), ec.finalityHooks.lastFinalized, finalized)
+	if err != nil {
+		return errors.Wrap(err, "CheckForFinalizedTransactions failed")
+	}
+	if len(etxs) > 0 {
+		ec.lggr.Debugw("Calling finalized callbacks", "count", len(etxs), "fromBlock", ec.finalityHooks.lastFinalized+1, "toBlock", finalized)
+	}
+	for _, etx := range etxs {
+		for _, fn := range ec.finalityHooks.callbacks {
+			fn(etx.EthTx, uint64(etx.BlockNumber))
+		}
+	}
+	ec.finalityHooks.lastFinalized = finalized
+	return nil
+}
+
+type finalizedEthTx struct {
+	EthTx
+	BlockNumber int64
+}
+
+// findEthTxsFinalizedBetween returns the confirmed transactions with a
+// receipt in a block after fromBlock up to and including toBlock, in block
+// then nonce order
+func findEthTxsFinalizedBetween(q pg.Q, chainID string, fromBlock, toBlock int64) (etxs []finalizedEthTx, err error) {
+	err = q.Select(&etxs, `
+SELECT eth_txes.*, eth_receipts.block_number FROM eth_txes
+INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id
+INNER JOIN eth_receipts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+WHERE eth_txes.state = 'confirmed' AND eth_txes.evm_chain_id = $1
+AND eth_receipts.block_number > $2 AND eth_receipts.block_number <= $3
+ORDER BY eth_receipts.block_number ASC, eth_txes.nonce ASC
+`, chainID, fromBlock, toBlock)
+	return etxs, errors.Wrap(err, "findEthTxsFinalizedBetween failed")
+}
@@ -0,0 +1,75 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestEthConfirmer_CheckForFinalizedTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmFinalityDepth = null.IntFrom(10)
+	config := evmtest.NewChainScopedConfig(t, cfg)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+	type finalized struct {
+		ethTxID     int64
+		blockNumber uint64
+	}
+	var calls []finalized
+	ec.OnFinalized(func(etx bulletprooftxmanager.EthTx, blockNumber uint64) {
+		calls = append(calls, finalized{etx.ID, blockNumber})
+	})
+
+	// already final when the first head is processed
+	etx0 := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, fromAddress)
+	cltest.MustInsertEthReceipt(t, borm, 85, utils.NewHash(), etx0.EthTxAttempts[0].Hash)
+	etx1 := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 1, 1, fromAddress)
+	cltest.MustInsertEthReceipt(t, borm, 95, utils.NewHash(), etx1.EthTxAttempts[0].Hash)
+	etx2 := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 2, 1, fromAddress)
+	cltest.MustInsertEthReceipt(t, borm, 97, utils.NewHash(), etx2.EthTxAttempts[0].Hash)
+	// unconfirmed transactions are never final
+	etx3 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 3, fromAddress)
+	cltest.MustInsertEthReceipt(t, borm, 96, utils.NewHash(), etx3.EthTxAttempts[0].Hash)
+
+	ctx := context.Background()
+	require.NoError(t, ec.CheckForFinalizedTransactions(ctx, cltest.Head(100)))
+	assert.Empty(t, calls)
+
+	require.NoError(t, ec.CheckForFinalizedTransactions(ctx, cltest.Head(104)))
+	assert.Empty(t, calls)
+
+	require.NoError(t, ec.CheckForFinalizedTransactions(ctx, cltest.Head(105)))
+	require.Len(t, calls, 1)
+	assert.Equal(t, finalized{etx1.ID, 95}, calls[0])
+
+	// the same head again, or an older one, does not call back twice
+	require.NoError(t, ec.CheckForFinalizedTransactions(ctx, cltest.Head(105)))
+	require.NoError(t, ec.CheckForFinalizedTransactions(ctx, cltest.Head(103)))
+	require.Len(t, calls, 1)
+
+	// skipping several blocks calls back for every block in between
+	require.NoError(t, ec.CheckForFinalizedTransactions(ctx, cltest.Head(110)))
+	require.Len(t, calls, 2)
+	assert.Equal(t, finalized{etx2.ID, 97}, calls[1])
+}
@@ -0,0 +1,143 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+//go:generate mockery --name FundsRecoveryConfig --output ./mocks/ --case=underscore
+
+// FundsRecoveryConfig is the config subset used by the funds recovery checker
+type FundsRecoveryConfig interface {
+	EthTxFundsRecoveryBatchSize() uint32
+	EthTxFundsRecoveryCheckInterval() time.Duration
+	EvmGasPriceDefault() *big.Int
+	LogSQL() bool
+}
+
+// FundsRecoveryChecker periodically checks the balance of keys that have
+// awaiting_funds transactions, and moves those transactions back to unstarted
+// once the key can afford to send them
+type FundsRecoveryChecker struct {
+	q         pg.Q
+	ethClient evmclient.Client
+	config    FundsRecoveryConfig
+	chainID   utils.Big
+	log       logger.Logger
+	trigger   func(common.Address)
+	chStop    chan struct{}
+	chDone    chan struct{}
+}
+
+// NewFundsRecoveryChecker instantiates a new funds recovery checker. trigger
+// is called for every key that had transactions moved back to unstarted.
+func NewFundsRecoveryChecker(lggr logger.Logger, db *sqlx.DB, ethClient evmclient.Client, config FundsRecoveryConfig, chainID big.Int, trigger func(common.Address)) *FundsRecoveryChecker {
+	lggr = lggr.Named("FundsRecoveryChecker")
+	return &FundsRecoveryChecker{
+		pg.NewQ(db, lggr, config),
+		ethClient,
+		config,
+		*utils.NewBig(&chainID),
+		lggr,
+		trigger,
+		make(chan struct{}),
+		make(chan struct{}),
+	}
+}
+
+// Start the checker. Should only be called once.
+func (c *FundsRecoveryChecker) Start() {
+	c.log.Debugf("FundsRecoveryChecker: started with interval %v and batch size %d", c.config.EthTxFundsRecoveryCheckInterval(), c.config.EthTxFundsRecoveryBatchSize())
+	go c.runLoop()
+}
+
+// Stop the checker. Should only be called once.
+func (c *FundsRecoveryChecker) Stop() {
+	c.log.Debug("FundsRecoveryChecker: stopping")
+	close(c.chStop)
+	<-c.chDone
+}
+
+func (c *FundsRecoveryChecker) runLoop() {
+	defer close(c.chDone)
+	ctx, cancel := utils.ContextFromChan(c.chStop)
+	defer cancel()
+	ticker := time.NewTicker(c.config.EthTxFundsRecoveryCheckInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.chStop:
+			return
+		case <-ticker.C:
+			if err := c.CheckAwaitingFunds(ctx); err != nil {
+				c.log.Errorw("FundsRecoveryChecker: failed to check awaiting_funds transactions", "err", err)
+			}
+		}
+	}
+}
+
+// CheckAwaitingFunds moves awaiting_funds transactions back to unstarted for
+// every key whose balance covers at least the cheapest of them
+func (c *FundsRecoveryChecker) CheckAwaitingFunds(ctx context.Context) error {
+	var addresses []common.Address
+	if err := c.q.Select(&addresses, `SELECT DISTINCT from_address FROM eth_txes WHERE state = 'awaiting_funds' AND evm_chain_id = $1`, c.chainID); err != nil {
+		return errors.Wrap(err, "CheckAwaitingFunds failed to load addresses")
+	}
+	for _, address := range addresses {
+		if err := c.checkAddress(ctx, address); err != nil {
+			c.log.Errorw("FundsRecoveryChecker: failed to check address", "address", address, "err", err)
+		}
+	}
+	return nil
+}
+
+func (c *FundsRecoveryChecker) checkAddress(ctx context.Context, address common.Address) error {
+	var etxs []EthTx
+	if err := c.q.Select(&etxs, `SELECT * FROM eth_txes WHERE state = 'awaiting_funds' AND from_address = $1 AND evm_chain_id = $2 ORDER BY value ASC, id ASC LIMIT $3`, address, c.chainID, c.config.EthTxFundsRecoveryBatchSize()); err != nil {
+		return errors.Wrap(err, "failed to load awaiting_funds transactions")
+	}
+	if len(etxs) == 0 {
+		return nil
+	}
+
+	balance, err := c.ethClient.BalanceAt(ctx, address, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch balance")
+	}
+
+	// Gas is estimated at the default gas price, the real cost is only known
+	// once the broadcaster builds the attempt so this is a best guess
+	var cheapest *big.Int
+	ids := make([]int64, len(etxs))
+	for i, etx := range etxs {
+		ids[i] = etx.ID
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(etx.GasLimit), c.config.EvmGasPriceDefault())
+		cost.Add(cost, etx.Value.ToInt())
+		if cheapest == nil || cost.Cmp(cheapest) < 0 {
+			cheapest = cost
+		}
+	}
+	if balance.Cmp(cheapest) < 0 {
+		c.log.Debugw("FundsRecoveryChecker: key still has insufficient funds", "address", address, "balance", balance, "cheapestTxCost", cheapest)
+		return nil
+	}
+
+	if _, err := c.q.Exec(`UPDATE eth_txes SET state = 'unstarted' WHERE state = 'awaiting_funds' AND id = ANY($1)`, pq.Array(ids)); err != nil {
+		return errors.Wrap(err, "failed to move awaiting_funds transactions to unstarted")
+	}
+	c.log.Infow(fmt.Sprintf("FundsRecoveryChecker: key has been refunded, moved %d awaiting_funds transactions back to unstarted", len(ids)), "address", address, "balance", balance)
+	c.trigger(address)
+	return nil
+}
@@ -0,0 +1,115 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestFundsRecoveryChecker_CheckAwaitingFunds(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	_, otherAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+	config := new(mocks.FundsRecoveryConfig)
+	config.On("EthTxFundsRecoveryBatchSize").Return(uint32(10))
+	config.On("EthTxFundsRecoveryCheckInterval").Return(1 * time.Minute)
+	config.On("EvmGasPriceDefault").Return(big.NewInt(1))
+	config.On("LogSQL").Return(false)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	var triggered []common.Address
+	checker := bulletprooftxmanager.NewFundsRecoveryChecker(logger.TestLogger(t), db, ethClient, config, cltest.FixtureChainID, func(addr common.Address) {
+		triggered = append(triggered, addr)
+	})
+
+	insertAwaitingFunds := func(t *testing.T, from common.Address) bulletprooftxmanager.EthTx {
+		etx := cltest.NewEthTx(t, from)
+		etx.State = bulletprooftxmanager.EthTxAwaitingFunds
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+
+	etx1 := insertAwaitingFunds(t, fromAddress)
+	etx2 := insertAwaitingFunds(t, fromAddress)
+	otherEtx := insertAwaitingFunds(t, otherAddress)
+
+	// Cheapest tx costs 142 wei of value plus 1000000000 gas at 1 wei
+	cheapest := big.NewInt(1000000142)
+
+	t.Run("leaves transactions awaiting funds while balance is too low", func(t *testing.T) {
+		ethClient.On("BalanceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(new(big.Int).Sub(cheapest, big.NewInt(1)), nil).Once()
+		ethClient.On("BalanceAt", mock.Anything, otherAddress, (*big.Int)(nil)).Return(big.NewInt(0), nil).Once()
+
+		require.NoError(t, checker.CheckAwaitingFunds(context.Background()))
+
+		for _, id := range []int64{etx1.ID, etx2.ID, otherEtx.ID} {
+			etx, err := borm.FindEthTxWithAttempts(id)
+			require.NoError(t, err)
+			assert.Equal(t, bulletprooftxmanager.EthTxAwaitingFunds, etx.State)
+		}
+		assert.Empty(t, triggered)
+	})
+
+	t.Run("moves transactions back to unstarted once the key is refunded", func(t *testing.T) {
+		ethClient.On("BalanceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(cheapest, nil).Once()
+		ethClient.On("BalanceAt", mock.Anything, otherAddress, (*big.Int)(nil)).Return(big.NewInt(0), nil).Once()
+
+		require.NoError(t, checker.CheckAwaitingFunds(context.Background()))
+
+		for _, id := range []int64{etx1.ID, etx2.ID} {
+			etx, err := borm.FindEthTxWithAttempts(id)
+			require.NoError(t, err)
+			assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+		}
+		etx, err := borm.FindEthTxWithAttempts(otherEtx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxAwaitingFunds, etx.State)
+
+		assert.Equal(t, []common.Address{fromAddress}, triggered)
+	})
+
+	t.Run("respects the batch size", func(t *testing.T) {
+		triggered = nil
+		config := new(mocks.FundsRecoveryConfig)
+		config.On("EthTxFundsRecoveryBatchSize").Return(uint32(1))
+		config.On("EvmGasPriceDefault").Return(big.NewInt(1))
+		config.On("LogSQL").Return(false)
+		checker := bulletprooftxmanager.NewFundsRecoveryChecker(logger.TestLogger(t), db, ethClient, config, cltest.FixtureChainID, func(addr common.Address) {
+			triggered = append(triggered, addr)
+		})
+		otherEtx2 := insertAwaitingFunds(t, otherAddress)
+
+		ethClient.On("BalanceAt", mock.Anything, otherAddress, (*big.Int)(nil)).Return(cheapest, nil).Once()
+
+		require.NoError(t, checker.CheckAwaitingFunds(context.Background()))
+
+		etx, err := borm.FindEthTxWithAttempts(otherEtx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+		etx, err = borm.FindEthTxWithAttempts(otherEtx2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxAwaitingFunds, etx.State)
+		assert.Equal(t, []common.Address{otherAddress}, triggered)
+	})
+
+	ethClient.AssertExpectations(t)
+}
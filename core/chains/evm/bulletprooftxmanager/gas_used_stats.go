@@ -0,0 +1,230 @@
+package bulletprooftxmanager
+
+import (
+	"database/sql"
+	"math/big"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// gasUsedStatsWindow is how many of the most recent confirmed transactions
+// to a contract method its gas used statistics are aggregated over
+const gasUsedStatsWindow = 1000
+
+// minGasUsedSamples is how many successful transactions to a contract method
+// there must be before its gas used statistics are used to pick gas limits
+const minGasUsedSamples = 5
+
+// gasLimitKey identifies a contract method by the address it lives at and
+// its 4-byte function selector
+type gasLimitKey struct {
+	to       gethCommon.Address
+	selector [4]byte
+}
+
+func newGasLimitKey(to gethCommon.Address, payload []byte) (key gasLimitKey, ok bool) {
+	if len(payload) < 4 {
+		return key, false
+	}
+	key.to = to
+	copy(key.selector[:], payload[:4])
+	return key, true
+}
+
+// GasUsedStats are rolling statistics of the gas used by recent successful
+// transactions to a contract method, identified by the address it lives at
+// and its 4-byte function selector. They are used to pick the gas limit of
+// transactions that opt in with NewTx.UseLearnedGasLimit, see
+// ETH_GAS_LIMIT_LEARNING_ENABLED.
+//
+// Only methods that a transaction opted in for are tracked. They are
+// aggregated from stored receipts as transactions to those methods are
+// confirmed, and are persisted, so they survive restarts.
+type GasUsedStats struct {
+	EVMChainID utils.Big
+	ToAddress  gethCommon.Address
+	Selector   []byte
+	// Samples is the number of successful transactions the statistics are
+	// over, out of the most recent 1000 confirmed ones
+	Samples    int32
+	P50GasUsed int64 `db:"p50_gas_used"`
+	P99GasUsed int64 `db:"p99_gas_used"`
+	MaxGasUsed int64
+	UpdatedAt  time.Time
+}
+
+// registerGasUsedStats starts tracking the gas used statistics of payload's
+// method on to, with no samples yet
+func registerGasUsedStats(q pg.Queryer, chainID big.Int, key gasLimitKey) error {
+	_, err := q.Exec(`
+INSERT INTO eth_gas_used_stats (evm_chain_id, to_address, selector, samples, p50_gas_used, p99_gas_used, max_gas_used, updated_at)
+VALUES ($1, $2, $3, 0, 0, 0, 0, NOW())
+ON CONFLICT (evm_chain_id, to_address, selector) DO NOTHING
+`, chainID.String(), key.to, key.selector[:])
+	return errors.Wrap(err, "registerGasUsedStats failed")
+}
+
+// refreshGasUsedStats recomputes the gas used statistics of those of the
+// given contract methods that are tracked, from their most recent confirmed
+// transactions. Reverted transactions are ignored, since they stop short of
+// a full execution.
+func refreshGasUsedStats(q pg.Queryer, chainID big.Int, keys []gasLimitKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	addresses := make([][]byte, len(keys))
+	selectors := make([][]byte, len(keys))
+	for i, key := range keys {
+		addresses[i] = key.to.Bytes()
+		selectors[i] = append([]byte(nil), key.selector[:]...)
+	}
+	// The most recent transactions to each method are found with
+	// idx_eth_txes_confirmed_method
+	_, err := q.Exec(`
+INSERT INTO eth_gas_used_stats (evm_chain_id, to_address, selector, samples, p50_gas_used, p99_gas_used, max_gas_used, updated_at)
+SELECT $1, tracked.to_address, tracked.selector, count(*),
+	percentile_disc(0.5) WITHIN GROUP (ORDER BY recent.gas_used),
+	percentile_disc(0.99) WITHIN GROUP (ORDER BY recent.gas_used),
+	max(recent.gas_used), NOW()
+FROM eth_gas_used_stats tracked
+CROSS JOIN LATERAL (
+	SELECT eth_tx_attempts.gas_used
+	FROM (
+		SELECT id FROM eth_txes
+		WHERE evm_chain_id = $1 AND state = 'confirmed' AND to_address = tracked.to_address
+		AND substring(encoded_payload FROM 1 FOR 4) = tracked.selector
+		ORDER BY id DESC LIMIT $4
+	) recent_txes
+	INNER JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = recent_txes.id
+	WHERE eth_tx_attempts.gas_used IS NOT NULL
+	AND EXISTS (SELECT 1 FROM eth_receipts WHERE eth_receipts.tx_hash = eth_tx_attempts.hash AND eth_receipts.receipt->>'status' = '0x1')
+) recent
+WHERE tracked.evm_chain_id = $1
+AND (tracked.to_address, tracked.selector) IN (SELECT * FROM unnest($2::bytea[], $3::bytea[]))
+GROUP BY tracked.to_address, tracked.selector
+ON CONFLICT (evm_chain_id, to_address, selector) DO UPDATE SET
+	samples = EXCLUDED.samples,
+	p50_gas_used = EXCLUDED.p50_gas_used,
+	p99_gas_used = EXCLUDED.p99_gas_used,
+	max_gas_used = EXCLUDED.max_gas_used,
+	updated_at = EXCLUDED.updated_at
+`, chainID.String(), pq.Array(addresses), pq.Array(selectors), gasUsedStatsWindow)
+	return errors.Wrap(err, "refreshGasUsedStats failed")
+}
+
+// FindGasUsedStats returns the gas used statistics of every contract method
+// on the given chain
+func FindGasUsedStats(q pg.Queryer, chainID big.Int) (stats []GasUsedStats, err error) {
+	err = q.Select(&stats, `SELECT * FROM eth_gas_used_stats WHERE evm_chain_id = $1 ORDER BY to_address, selector`, chainID.String())
+	return stats, errors.Wrap(err, "FindGasUsedStats failed")
+}
+
+// findGasUsedStats returns the gas used statistics of payload's method on
+// to, or false if there are none
+func findGasUsedStats(q pg.Queryer, chainID big.Int, to gethCommon.Address, payload []byte) (stats GasUsedStats, ok bool, err error) {
+	key, ok := newGasLimitKey(to, payload)
+	if !ok {
+		return stats, false, nil
+	}
+	err = q.Get(&stats, `SELECT * FROM eth_gas_used_stats WHERE evm_chain_id = $1 AND to_address = $2 AND selector = $3`, chainID.String(), to, key.selector[:])
+	if errors.Is(err, sql.ErrNoRows) {
+		return stats, false, nil
+	} else if err != nil {
+		return stats, false, errors.Wrap(err, "findGasUsedStats failed")
+	}
+	return stats, true, nil
+}
+
+// refreshGasUsedStats updates the gas used statistics of the methods of the
+// newly confirmed transactions. Failing to do so is logged, rather than
+// failing the confirmation.
+func (ec *EthConfirmer) refreshGasUsedStats(confirmed []confirmedEthTx) {
+	if len(confirmed) == 0 || !ec.config.EvmGasLimitLearningEnabled() {
+		return
+	}
+	seen := make(map[gasLimitKey]struct{})
+	var keys []gasLimitKey
+	for _, c := range confirmed {
+		key, ok := newGasLimitKey(c.ToAddress, c.EncodedPayload)
+		if !ok {
+			continue
+		}
+		if _, exists := seen[key]; !exists {
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	if err := refreshGasUsedStats(ec.q, ec.chainID, keys); err != nil {
+		ec.lggr.Errorw("Failed to refresh gas used statistics", "err", err)
+	}
+}
+
+// intrinsicGas is the gas a transaction with payload uses before any
+// execution, which its gas limit can never be below
+func intrinsicGas(payload []byte) uint64 {
+	gas := uint64(21000)
+	for _, b := range payload {
+		if b == 0 {
+			gas += 4
+		} else {
+			gas += 16
+		}
+	}
+	return gas
+}
+
+// learnedP99GasLimit returns the gas limit to use for newTx if it opted in
+// with UseLearnedGasLimit and its method has gas used statistics. It is the
+// p99 gas used plus ETH_GAS_LIMIT_LEARNING_MARGIN_PERCENT, clamped between
+// ETH_GAS_LIMIT_LEARNING_MIN and ETH_GAS_LIMIT_MAX, and never below the
+// intrinsic gas of the transaction. It is only used once there are at least
+// minGasUsedSamples samples, so that one cheap execution does not starve
+// later ones. A method that is opted in for the first time starts being
+// tracked.
+func (b *BulletproofTxManager) learnedP99GasLimit(q pg.Queryer, newTx NewTx) (gasLimit uint64, ok bool, err error) {
+	if !newTx.UseLearnedGasLimit || !b.config.EvmGasLimitLearningEnabled() {
+		return 0, false, nil
+	}
+	key, ok := newGasLimitKey(newTx.ToAddress, newTx.EncodedPayload)
+	if !ok {
+		return 0, false, nil
+	}
+	stats, ok, err := findGasUsedStats(q, b.chainID, newTx.ToAddress, newTx.EncodedPayload)
+	if err != nil {
+		return 0, false, err
+	} else if !ok {
+		return 0, false, registerGasUsedStats(q, b.chainID, key)
+	} else if stats.Samples < minGasUsedSamples {
+		return 0, false, nil
+	}
+	gasLimit = uint64(stats.P99GasUsed) * (100 + uint64(b.config.EvmGasLimitLearningMarginPercent())) / 100
+	if min := b.config.EvmGasLimitLearningMin(); gasLimit < min {
+		gasLimit = min
+	}
+	if max := b.config.EvmGasLimitMax(); max > 0 && gasLimit > max {
+		gasLimit = max
+	}
+	if intrinsic := intrinsicGas(newTx.EncodedPayload); gasLimit < intrinsic {
+		gasLimit = intrinsic
+	}
+	return gasLimit, true, nil
+}
+
+// GasUsedStats returns the gas used statistics of every contract method on
+// this chain, see GasUsedStats
+func (b *BulletproofTxManager) GasUsedStats() ([]GasUsedStats, error) {
+	return FindGasUsedStats(b.q, b.chainID)
+}
+
+// ResetGasUsedStats forgets the gas used statistics of every contract method
+// on this chain. Methods are tracked again once a transaction opts in.
+func (b *BulletproofTxManager) ResetGasUsedStats() error {
+	_, err := b.q.Exec(`DELETE FROM eth_gas_used_stats WHERE evm_chain_id = $1`, b.chainID.String())
+	return errors.Wrap(err, "ResetGasUsedStats failed")
+}
@@ -0,0 +1,166 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestBulletproofTxManager_GasUsedStats(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmGasLimitLearningEnabled = null.BoolFrom(true)
+	cfg.Overrides.GlobalEvmGasLimitLearningMarginPercent = null.IntFrom(10)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(100), nil)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, evmcfg, ethKeyStore, nil, logger.TestLogger(t))
+	ec := bptxm.NewEthConfirmer([]ethkey.State{keyState})
+
+	toAddress := cltest.NewAddress()
+	transmit := []byte{0xc9, 0x80, 0x75, 0x39, 0x01}
+	otherMethod := []byte{0x4e, 0x71, 0xd9, 0x2d, 0x01}
+	unknownMethod := []byte{0xde, 0xad, 0xbe, 0xef, 0x01}
+	untrackedMethod := []byte{0x12, 0x34, 0x56, 0x78, 0x01}
+	rareMethod := []byte{0xa9, 0x05, 0x9c, 0xbb, 0x01}
+	blockNum := int64(42)
+
+	// confirm makes a transaction to payload's method confirm with a receipt
+	// with the given gasUsed and status
+	nonce := int64(0)
+	confirm := func(t *testing.T, payload []byte, gasUsed uint64, status uint64) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, nonce, fromAddress)
+		nonce++
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET to_address = $1, encoded_payload = $2 WHERE id = $3`, toAddress, payload, etx.ID)
+		attempt := etx.EthTxAttempts[0]
+
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 && cltest.BatchElemMatchesHash(b[0], attempt.Hash)
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			elems[0].Result = &bulletprooftxmanager.Receipt{
+				TxHash:      attempt.Hash,
+				BlockHash:   utils.NewHash(),
+				BlockNumber: big.NewInt(blockNum),
+				GasUsed:     gasUsed,
+				Status:      status,
+			}
+		}).Once()
+
+		require.NoError(t, ec.CheckForReceipts(context.Background(), blockNum))
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Equal(t, bulletprooftxmanager.EthTxConfirmed, etx.State)
+	}
+	create := func(t *testing.T, payload []byte, useLearned bool) bulletprooftxmanager.EthTx {
+		etx, err := bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:        fromAddress,
+			ToAddress:          toAddress,
+			EncodedPayload:     payload,
+			GasLimit:           500000,
+			UseLearnedGasLimit: useLearned,
+			Strategy:           bulletprooftxmanager.SendEveryStrategy{},
+		})
+		require.NoError(t, err)
+		return etx
+	}
+
+	// Methods are only tracked once a transaction opts in
+	assert.Equal(t, uint64(500000), create(t, transmit, true).GasLimit)
+	assert.Equal(t, uint64(500000), create(t, otherMethod, true).GasLimit)
+	assert.Equal(t, uint64(500000), create(t, rareMethod, true).GasLimit)
+
+	confirm(t, transmit, 100000, 1)
+	confirm(t, transmit, 120000, 1)
+	confirm(t, transmit, 90000, 1)
+	confirm(t, transmit, 110000, 1)
+	confirm(t, transmit, 95000, 1)
+	// Reverted transactions are ignored
+	confirm(t, transmit, 200000, 0)
+	for i := 0; i < 5; i++ {
+		confirm(t, otherMethod, 50000, 1)
+	}
+	confirm(t, rareMethod, 30000, 1)
+	confirm(t, untrackedMethod, 70000, 1)
+
+	t.Run("aggregates the stats per method", func(t *testing.T) {
+		stats, err := bptxm.GasUsedStats()
+		require.NoError(t, err)
+		require.Len(t, stats, 3)
+		byMethod := make(map[string]bulletprooftxmanager.GasUsedStats)
+		for _, s := range stats {
+			byMethod[string(s.Selector)] = s
+		}
+
+		s := byMethod[string(transmit[:4])]
+		assert.Equal(t, toAddress, s.ToAddress)
+		assert.Equal(t, int32(5), s.Samples)
+		assert.Equal(t, int64(100000), s.P50GasUsed)
+		assert.Equal(t, int64(120000), s.P99GasUsed)
+		assert.Equal(t, int64(120000), s.MaxGasUsed)
+
+		s = byMethod[string(otherMethod[:4])]
+		assert.Equal(t, int32(5), s.Samples)
+		assert.Equal(t, int64(50000), s.P99GasUsed)
+
+		s = byMethod[string(rareMethod[:4])]
+		assert.Equal(t, int32(1), s.Samples)
+	})
+
+	t.Run("uses the p99 gas used plus the margin for an opted in tx", func(t *testing.T) {
+		assert.Equal(t, uint64(132000), create(t, transmit, true).GasLimit)
+		assert.Equal(t, uint64(55000), create(t, otherMethod, true).GasLimit)
+	})
+
+	t.Run("uses the requested gas limit otherwise", func(t *testing.T) {
+		assert.Equal(t, uint64(500000), create(t, transmit, false).GasLimit)
+		assert.Equal(t, uint64(500000), create(t, unknownMethod, true).GasLimit)
+		// Too few samples yet
+		assert.Equal(t, uint64(500000), create(t, rareMethod, true).GasLimit)
+	})
+
+	t.Run("uses the requested gas limit if learning is disabled", func(t *testing.T) {
+		cfg.Overrides.GlobalEvmGasLimitLearningEnabled = null.BoolFrom(false)
+		t.Cleanup(func() { cfg.Overrides.GlobalEvmGasLimitLearningEnabled = null.BoolFrom(true) })
+
+		assert.Equal(t, uint64(500000), create(t, transmit, true).GasLimit)
+	})
+
+	t.Run("is clamped between ETH_GAS_LIMIT_LEARNING_MIN and ETH_GAS_LIMIT_MAX", func(t *testing.T) {
+		cfg.Overrides.GlobalEvmGasLimitLearningMin = null.IntFrom(60000)
+		cfg.Overrides.GlobalEvmGasLimitMax = null.IntFrom(125000)
+		t.Cleanup(func() {
+			cfg.Overrides.GlobalEvmGasLimitLearningMin = null.Int{}
+			cfg.Overrides.GlobalEvmGasLimitMax = null.Int{}
+		})
+
+		assert.Equal(t, uint64(125000), create(t, transmit, true).GasLimit)
+		assert.Equal(t, uint64(60000), create(t, otherMethod, true).GasLimit)
+	})
+
+	t.Run("can be reset", func(t *testing.T) {
+		require.NoError(t, bptxm.ResetGasUsedStats())
+		stats, err := bptxm.GasUsedStats()
+		require.NoError(t, err)
+		assert.Empty(t, stats)
+		assert.Equal(t, uint64(500000), create(t, transmit, true).GasLimit)
+	})
+}
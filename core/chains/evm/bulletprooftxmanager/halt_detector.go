@@ -0,0 +1,71 @@
+package bulletprooftxmanager
+
+import (
+	"sync"
+	"time"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// haltDetector considers the chain halted if no new head has arrived for
+// ETH_CHAIN_HALT_THRESHOLD. Broadcasting while the chain is halted is
+// wasteful, since nothing can be mined.
+type haltDetector struct {
+	config haltDetectorConfig
+	logger logger.Logger
+
+	mu         sync.Mutex
+	lastHeadAt time.Time
+	lastHead   int64
+	isHalted   bool
+}
+
+type haltDetectorConfig interface {
+	EvmChainHaltThreshold() time.Duration
+}
+
+func newHaltDetector(config haltDetectorConfig, lggr logger.Logger) *haltDetector {
+	return &haltDetector{
+		config: config,
+		logger: lggr,
+		// Give the head tracker until the threshold to deliver the first head
+		lastHeadAt: time.Now(),
+	}
+}
+
+// onNewHead records that a new head arrived. Returns true if the chain was
+// halted and has now resumed.
+func (d *haltDetector) onNewHead(head *evmtypes.Head) (resumed bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.isHalted {
+		d.logger.Warnw("Chain has resumed producing heads, resuming broadcasting", "blockNum", head.Number, "haltedFor", time.Since(d.lastHeadAt))
+		d.isHalted = false
+		resumed = true
+	}
+	d.lastHeadAt = time.Now()
+	d.lastHead = head.Number
+	return
+}
+
+// halted returns true if no new head has arrived for longer than the halt
+// threshold. The halt is logged once, when it is first detected.
+func (d *haltDetector) halted() bool {
+	threshold := d.config.EvmChainHaltThreshold()
+	if threshold <= 0 {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.isHalted {
+		return true
+	}
+	if time.Since(d.lastHeadAt) <= threshold {
+		return false
+	}
+	d.isHalted = true
+	d.logger.CriticalW("No new heads received for longer than ETH_CHAIN_HALT_THRESHOLD, the chain appears to be halted. Broadcasting is paused until heads resume",
+		"lastHeadAt", d.lastHeadAt, "lastBlockNum", d.lastHead, "threshold", threshold)
+	return true
+}
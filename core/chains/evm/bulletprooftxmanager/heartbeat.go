@@ -0,0 +1,139 @@
+package bulletprooftxmanager
+
+import (
+	"math/big"
+	"os"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// BroadcasterHeartbeatInterval is how often a running EthBroadcaster records
+// a heartbeat for each of its keys. A heartbeat is recent for three times
+// this interval.
+var BroadcasterHeartbeatInterval = 10 * time.Second
+
+// ErrDuplicateBroadcaster is returned by EthBroadcaster.Start if
+// ETH_TX_DUPLICATE_INSTANCE_POLICY is refuse and another instance has
+// recently sent a heartbeat for the same chain and keys
+var ErrDuplicateBroadcaster = errors.New("another EthBroadcaster is running for the same keys")
+
+// broadcasterHeartbeat is a recent heartbeat of another EthBroadcaster
+type broadcasterHeartbeat struct {
+	InstanceID  uuid.UUID
+	Address     gethCommon.Address
+	Hostname    string
+	HeartbeatAt time.Time
+}
+
+// heartbeats records the heartbeats of an EthBroadcaster in
+// eth_broadcaster_heartbeats. Unlike the advisory and lease locks, which only
+// stop two nodes sharing a database from both running, heartbeats are per
+// chain and key, and so also catch e.g. two deployments with different
+// locking configurations broadcasting for the same keys, which would corrupt
+// their nonces.
+type heartbeats struct {
+	q          pg.Q
+	chainID    big.Int
+	instanceID uuid.UUID
+	hostname   string
+}
+
+func newHeartbeats(q pg.Q, chainID big.Int) *heartbeats {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return &heartbeats{q: q, chainID: chainID, instanceID: uuid.NewV4(), hostname: hostname}
+}
+
+// findOthers returns the heartbeats of other instances for any of addresses
+// within the last three heartbeat intervals
+func (h *heartbeats) findOthers(addresses []gethCommon.Address) (others []broadcasterHeartbeat, err error) {
+	err = h.q.Select(&others, `
+SELECT instance_id, address, hostname, heartbeat_at FROM eth_broadcaster_heartbeats
+WHERE evm_chain_id = $1 AND instance_id <> $2 AND address = ANY($3) AND heartbeat_at > now() - make_interval(secs => $4::float8)
+ORDER BY heartbeat_at DESC
+`, utils.NewBig(&h.chainID), h.instanceID, pq.Array(addressesToBytes(addresses)), (3 * BroadcasterHeartbeatInterval).Seconds())
+	return others, errors.Wrap(err, "failed to find broadcaster heartbeats")
+}
+
+// beat records a heartbeat for addresses, and removes this instance's
+// heartbeats for any other keys, e.g. ones that have since been removed
+func (h *heartbeats) beat(addresses []gethCommon.Address) error {
+	addrs := pq.Array(addressesToBytes(addresses))
+	return h.q.Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(`
+DELETE FROM eth_broadcaster_heartbeats WHERE instance_id = $1 AND evm_chain_id = $2 AND NOT (address = ANY($3))
+`, h.instanceID, utils.NewBig(&h.chainID), addrs); err != nil {
+			return errors.Wrap(err, "failed to delete stale broadcaster heartbeats")
+		}
+		_, err := tx.Exec(`
+INSERT INTO eth_broadcaster_heartbeats (instance_id, evm_chain_id, address, hostname, heartbeat_at)
+SELECT $1, $2, unnest($3::bytea[]), $4, now()
+ON CONFLICT (evm_chain_id, address, instance_id) DO UPDATE SET heartbeat_at = EXCLUDED.heartbeat_at
+`, h.instanceID, utils.NewBig(&h.chainID), addrs, h.hostname)
+		return errors.Wrap(err, "failed to record broadcaster heartbeats")
+	})
+}
+
+// clear removes all heartbeats of this instance, so that restarting doesn't
+// look like a duplicate instance
+func (h *heartbeats) clear() error {
+	_, err := h.q.Exec(`DELETE FROM eth_broadcaster_heartbeats WHERE instance_id = $1`, h.instanceID)
+	return errors.Wrap(err, "failed to clear broadcaster heartbeats")
+}
+
+func addressesToBytes(addresses []gethCommon.Address) [][]byte {
+	b := make([][]byte, len(addresses))
+	for i, address := range addresses {
+		b[i] = address.Bytes()
+	}
+	return b
+}
+
+// checkForDuplicateInstances logs every other instance that has recently
+// sent a heartbeat for the same chain and keys, and returns
+// ErrDuplicateBroadcaster if ETH_TX_DUPLICATE_INSTANCE_POLICY is refuse
+func (eb *EthBroadcaster) checkForDuplicateInstances(addresses []gethCommon.Address) error {
+	others, err := eb.heartbeats.findOthers(addresses)
+	if err != nil {
+		return err
+	}
+	if len(others) == 0 {
+		return nil
+	}
+	refuse := eb.config.EthTxDuplicateInstancePolicy() == "refuse"
+	for _, other := range others {
+		eb.logger.Errorw("Another EthBroadcaster has recently sent a heartbeat for the same key. Two instances broadcasting for the same keys will corrupt their nonces, make sure only one node is running for these keys",
+			"address", other.Address, "instanceID", other.InstanceID, "hostname", other.Hostname, "heartbeatAt", other.HeartbeatAt, "refuse", refuse)
+	}
+	if refuse {
+		return errors.Wrapf(ErrDuplicateBroadcaster, "instance %s on %s sent a heartbeat for key %s at %s", others[0].InstanceID, others[0].Hostname, others[0].Address.Hex(), others[0].HeartbeatAt)
+	}
+	return nil
+}
+
+// heartbeatLoop records a heartbeat for the registered keys every
+// BroadcasterHeartbeatInterval until the EthBroadcaster is closed
+func (eb *EthBroadcaster) heartbeatLoop() {
+	defer eb.wg.Done()
+	ticker := time.NewTicker(BroadcasterHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-eb.chStop:
+			return
+		case <-ticker.C:
+			if err := eb.heartbeats.beat(eb.addresses()); err != nil {
+				eb.logger.Errorw("Failed to record heartbeat", "err", err)
+			}
+		}
+	}
+}
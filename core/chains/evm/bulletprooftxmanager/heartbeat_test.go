@@ -0,0 +1,91 @@
+package bulletprooftxmanager_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestEthBroadcaster_DuplicateInstanceHeartbeat(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	otherKeyState, _ := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	// insertHeartbeat simulates another instance running for fromAddress
+	insertHeartbeat := func(t *testing.T, heartbeatAt time.Time) {
+		_, err := db.Exec(`DELETE FROM eth_broadcaster_heartbeats`)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO eth_broadcaster_heartbeats (instance_id, evm_chain_id, address, hostname, heartbeat_at) VALUES ($1, $2, $3, 'other-host', $4)`,
+			uuid.NewV4(), utils.NewBig(ethClient.ChainID()), fromAddress, heartbeatAt)
+		require.NoError(t, err)
+	}
+	start := func(t *testing.T, policy string, keyStates ...ethkey.State) error {
+		cfg.Overrides.EthTxDuplicateInstancePolicy = null.StringFrom(policy)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, keyStates)
+		err := eb.Start()
+		if err == nil {
+			t.Cleanup(func() { assert.NoError(t, eb.Close()) })
+		}
+		return err
+	}
+
+	t.Run("starts with a warning if another instance has a recent heartbeat", func(t *testing.T) {
+		insertHeartbeat(t, time.Now())
+		require.NoError(t, start(t, "warn", keyState))
+	})
+
+	t.Run("refuses to start if another instance has a recent heartbeat", func(t *testing.T) {
+		insertHeartbeat(t, time.Now())
+		err := start(t, "refuse", keyState)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrDuplicateBroadcaster))
+		assert.Contains(t, err.Error(), "other-host")
+	})
+
+	t.Run("starts if the other instance's heartbeat is old", func(t *testing.T) {
+		insertHeartbeat(t, time.Now().Add(-time.Hour))
+		require.NoError(t, start(t, "refuse", keyState))
+	})
+
+	t.Run("starts if the other instance has no overlapping keys", func(t *testing.T) {
+		insertHeartbeat(t, time.Now())
+		require.NoError(t, start(t, "refuse", otherKeyState))
+	})
+
+	t.Run("records a heartbeat while running and clears it on close", func(t *testing.T) {
+		_, err := db.Exec(`DELETE FROM eth_broadcaster_heartbeats`)
+		require.NoError(t, err)
+
+		cfg.Overrides.EthTxDuplicateInstancePolicy = null.StringFrom("refuse")
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+		require.NoError(t, eb.Start())
+		cltest.AssertCount(t, db, "eth_broadcaster_heartbeats", 1)
+
+		// a second instance for the same key refuses to start
+		assert.True(t, errors.Is(start(t, "refuse", keyState), bulletprooftxmanager.ErrDuplicateBroadcaster))
+
+		require.NoError(t, eb.Close())
+		cltest.AssertCount(t, db, "eth_broadcaster_heartbeats", 0)
+
+		// so that a restart isn't mistaken for a duplicate instance
+		require.NoError(t, start(t, "refuse", keyState))
+	})
+}
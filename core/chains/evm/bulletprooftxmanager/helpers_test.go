@@ -1,11 +1,76 @@
 package bulletprooftxmanager
 
-import evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
 
 func SetEthClientOnEthConfirmer(ethClient evmclient.Client, ethConfirmer *EthConfirmer) {
 	ethConfirmer.ethClient = ethClient
 }
 
+// LockKey acquires the lock the EthConfirmer holds while bumping gas for
+// address
+func (ec *EthConfirmer) LockKey(address common.Address) (unlock func()) {
+	return ec.keyLocks.lock(address)
+}
+
+// OnFinalized registers fn with the EthConfirmer's finality hooks
+func (ec *EthConfirmer) OnFinalized(fn FinalizedCallback) {
+	ec.finalityHooks.register(fn)
+}
+
+func SetClockOnEthConfirmer(clock utils.Nower, ethConfirmer *EthConfirmer) {
+	ethConfirmer.clock = clock
+}
+
 func SetResumeCallbackOnEthBroadcaster(resumeCallback ResumeCallback, ethBroadcaster *EthBroadcaster) {
-	ethBroadcaster.resumeCallback = resumeCallback
+	ethBroadcaster.resumer = newResumer(ethBroadcaster.config, resumeCallback, nil)
+}
+
+func SetResumeBatchCallbackOnEthBroadcaster(resumeBatchCallback ResumeBatchCallback, ethBroadcaster *EthBroadcaster) {
+	ethBroadcaster.resumer = newResumer(ethBroadcaster.config, nil, resumeBatchCallback)
+}
+
+func (eb *EthBroadcaster) PollDBInterval() time.Duration {
+	return eb.pollDBInterval()
+}
+
+func (eb *EthBroadcaster) ChainHalted() bool {
+	return eb.haltDetector.halted()
+}
+
+func (c *ChainKeyStore) NewEstimatedLegacyAttempt(etx EthTx, gasPrice *big.Int, gasLimit uint64) (EthTxAttempt, error) {
+	return c.newEstimatedLegacyAttempt(etx, gasPrice, gasLimit)
+}
+
+func SetOutcomeSinkOnEthBroadcaster(sink OutcomeSink, ethBroadcaster *EthBroadcaster) {
+	ethBroadcaster.outcomeEmitter = NewOutcomeEmitter(ethBroadcaster.logger, sink)
+}
+
+func SetOutcomeSinkOnEthConfirmer(sink OutcomeSink, ethConfirmer *EthConfirmer) {
+	ethConfirmer.outcomeEmitter = NewOutcomeEmitter(ethConfirmer.lggr, sink)
+}
+
+// NewEthConfirmer instantiates an EthConfirmer that shares the per-key locks,
+// finality hooks and outcome hooks of b
+func (b *BulletproofTxManager) NewEthConfirmer(keyStates []ethkey.State) *EthConfirmer {
+	return b.newEthConfirmer(keyStates)
+}
+
+// NewEthBroadcaster instantiates an EthBroadcaster that shares the outcome
+// hooks of b
+func (b *BulletproofTxManager) NewEthBroadcaster(keyStates []ethkey.State) *EthBroadcaster {
+	return b.newEthBroadcaster(keyStates)
+}
+
+// PendingOutcomeCallbacks returns the number of OutcomeCallbacks that have
+// not been called yet
+func (b *BulletproofTxManager) PendingOutcomeCallbacks() int {
+	return b.outcomeHooks.len()
 }
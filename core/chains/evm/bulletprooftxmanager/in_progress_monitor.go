@@ -0,0 +1,133 @@
+package bulletprooftxmanager
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var promStuckInProgressTxs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "tx_manager_stuck_in_progress_txes",
+	Help: "The number of transactions that have been in_progress for longer than ETH_IN_PROGRESS_TX_ALERT_THRESHOLD",
+}, []string{"evmChainID"})
+
+//go:generate mockery --name InProgressMonitorConfig --output ./mocks/ --case=underscore
+
+// InProgressMonitorConfig is the config subset used by the in_progress monitor
+type InProgressMonitorConfig interface {
+	EvmInProgressTxAlertThreshold() time.Duration
+	TriggerFallbackDBPollInterval() time.Duration
+	LogSQL() bool
+}
+
+// InProgressMonitor periodically looks for transactions that have been
+// in_progress for too long, e.g. because the eth node hung during
+// SendTransaction. It is purely for observability and never changes state.
+type InProgressMonitor struct {
+	q       pg.Q
+	config  InProgressMonitorConfig
+	chainID utils.Big
+	log     logger.Logger
+	chStop  chan struct{}
+	chDone  chan struct{}
+
+	stuckMu sync.RWMutex
+	stuck   []EthTx
+}
+
+// NewInProgressMonitor instantiates a new in_progress monitor
+func NewInProgressMonitor(lggr logger.Logger, db *sqlx.DB, config InProgressMonitorConfig, chainID big.Int) *InProgressMonitor {
+	lggr = lggr.Named("InProgressMonitor")
+	return &InProgressMonitor{
+		q:       pg.NewQ(db, lggr, config),
+		config:  config,
+		chainID: *utils.NewBig(&chainID),
+		log:     lggr,
+		chStop:  make(chan struct{}),
+		chDone:  make(chan struct{}),
+	}
+}
+
+// Start the monitor. Should only be called once.
+func (m *InProgressMonitor) Start() {
+	m.log.Debugf("InProgressMonitor: started with alert threshold %v", m.config.EvmInProgressTxAlertThreshold())
+	go m.runLoop()
+}
+
+// Stop the monitor. Should only be called once.
+func (m *InProgressMonitor) Stop() {
+	m.log.Debug("InProgressMonitor: stopping")
+	close(m.chStop)
+	<-m.chDone
+}
+
+func (m *InProgressMonitor) runLoop() {
+	defer close(m.chDone)
+	ticker := time.NewTicker(utils.WithJitter(m.config.TriggerFallbackDBPollInterval()))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.chStop:
+			return
+		case <-ticker.C:
+			if err := m.CheckInProgress(); err != nil {
+				m.log.Errorw("InProgressMonitor: failed to check for stuck in_progress transactions", "err", err)
+			}
+		}
+	}
+}
+
+// CheckInProgress loads every transaction whose in_progress attempt is older
+// than the alert threshold, logs them and records them for StuckInProgress
+func (m *InProgressMonitor) CheckInProgress() error {
+	threshold := m.config.EvmInProgressTxAlertThreshold()
+	var etxs []*EthTx
+	err := m.q.Select(&etxs, `
+SELECT DISTINCT eth_txes.* FROM eth_txes
+INNER JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id AND eth_tx_attempts.state = 'in_progress'
+WHERE eth_txes.state = 'in_progress' AND eth_txes.evm_chain_id = $1 AND eth_tx_attempts.created_at < $2
+ORDER BY eth_txes.id ASC`, m.chainID, time.Now().Add(-threshold))
+	if err != nil {
+		return errors.Wrap(err, "CheckInProgress failed to load in_progress eth_txes")
+	}
+	if len(etxs) > 0 {
+		if err = loadEthTxesAttempts(m.q, etxs); err != nil {
+			return errors.Wrap(err, "CheckInProgress failed to load attempts")
+		}
+	}
+
+	stuck := make([]EthTx, len(etxs))
+	for i, etx := range etxs {
+		stuck[i] = *etx
+		var hash string
+		if len(etx.EthTxAttempts) > 0 {
+			hash = etx.EthTxAttempts[0].Hash.Hex()
+		}
+		m.log.CriticalW(fmt.Sprintf("Transaction has been in_progress for longer than %v. The eth node may be hanging on SendTransaction", threshold),
+			"ethTxID", etx.ID, "nonce", etx.Nonce, "txHash", hash, "fromAddress", etx.FromAddress)
+	}
+	promStuckInProgressTxs.WithLabelValues(m.chainID.String()).Set(float64(len(stuck)))
+
+	m.stuckMu.Lock()
+	m.stuck = stuck
+	m.stuckMu.Unlock()
+	return nil
+}
+
+// StuckInProgress returns the transactions found to be stuck in_progress on
+// the most recent check
+func (m *InProgressMonitor) StuckInProgress() []EthTx {
+	m.stuckMu.RLock()
+	defer m.stuckMu.RUnlock()
+	return m.stuck
+}
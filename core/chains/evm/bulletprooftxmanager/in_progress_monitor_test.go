@@ -0,0 +1,66 @@
+package bulletprooftxmanager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestInProgressMonitor_CheckInProgress(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	_, otherAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+	config := new(mocks.InProgressMonitorConfig)
+	config.On("EvmInProgressTxAlertThreshold").Return(5 * time.Minute)
+	config.On("LogSQL").Return(false)
+
+	m := bulletprooftxmanager.NewInProgressMonitor(logger.TestLogger(t), db, config, cltest.FixtureChainID)
+
+	t.Run("with nothing in progress, reports nothing", func(t *testing.T) {
+		require.NoError(t, m.CheckInProgress())
+		assert.Empty(t, m.StuckInProgress())
+	})
+
+	stuckEtx := cltest.MustInsertInProgressEthTxWithAttempt(t, borm, 0, fromAddress)
+	pgtest.MustExec(t, db, `UPDATE eth_tx_attempts SET created_at = $1 WHERE eth_tx_id = $2`, time.Now().Add(-1*time.Hour), stuckEtx.ID)
+	cltest.MustInsertInProgressEthTxWithAttempt(t, borm, 0, otherAddress)
+
+	t.Run("reports only transactions in_progress for longer than the threshold", func(t *testing.T) {
+		require.NoError(t, m.CheckInProgress())
+
+		stuck := m.StuckInProgress()
+		require.Len(t, stuck, 1)
+		assert.Equal(t, stuckEtx.ID, stuck[0].ID)
+		require.Len(t, stuck[0].EthTxAttempts, 1)
+		assert.Equal(t, stuckEtx.EthTxAttempts[0].Hash, stuck[0].EthTxAttempts[0].Hash)
+	})
+
+	t.Run("does not change any state", func(t *testing.T) {
+		etx, err := borm.FindEthTxWithAttempts(stuckEtx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxInProgress, etx.State)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptInProgress, etx.EthTxAttempts[0].State)
+	})
+
+	t.Run("clears once the transaction is no longer in_progress", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM eth_txes WHERE id = $1`, stuckEtx.ID)
+
+		require.NoError(t, m.CheckInProgress())
+		assert.Empty(t, m.StuckInProgress())
+	})
+}
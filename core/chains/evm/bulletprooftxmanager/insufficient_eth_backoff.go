@@ -0,0 +1,103 @@
+package bulletprooftxmanager
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jpillora/backoff"
+)
+
+// errInsufficientEthBackoff is returned instead of resending an in_progress
+// transaction that was recently rejected due to insufficient eth
+type errInsufficientEthBackoff struct {
+	etxID   int64
+	lastErr string
+	until   time.Time
+}
+
+func (e errInsufficientEthBackoff) Error() string {
+	return fmt.Sprintf("transaction %d was rejected due to insufficient eth (%s), will not retry until %s", e.etxID, e.lastErr, e.until.Format(time.RFC3339))
+}
+
+type keyBackoff struct {
+	etxID   int64
+	lastErr string
+	b       backoff.Backoff
+	until   time.Time
+}
+
+// insufficientEthBackoff tracks, per key, when an in_progress transaction
+// that was rejected due to insufficient eth may next be resent. This avoids
+// hammering the eth node with the same doomed transaction on every poll
+// while the key remains underfunded.
+type insufficientEthBackoff struct {
+	config insufficientEthBackoffConfig
+
+	mu   sync.Mutex
+	keys map[common.Address]*keyBackoff
+}
+
+type insufficientEthBackoffConfig interface {
+	EthTxInsufficientEthBackoffMax() time.Duration
+	TriggerFallbackDBPollInterval() time.Duration
+}
+
+// newInsufficientEthBackoff returns a backoff starting at the DB poll
+// interval and capped at ETH_TX_INSUFFICIENT_ETH_BACKOFF_MAX
+func newInsufficientEthBackoff(config insufficientEthBackoffConfig) *insufficientEthBackoff {
+	return &insufficientEthBackoff{
+		config: config,
+		keys:   make(map[common.Address]*keyBackoff),
+	}
+}
+
+// failed records that etx was rejected due to insufficient eth, and returns
+// how long to wait before resending it
+func (i *insufficientEthBackoff) failed(etx EthTx, sendErr error) time.Duration {
+	max := i.config.EthTxInsufficientEthBackoffMax()
+	if max <= 0 {
+		return 0
+	}
+	min := i.config.TriggerFallbackDBPollInterval()
+	if min > max {
+		min = max
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	k, exists := i.keys[etx.FromAddress]
+	if !exists || k.etxID != etx.ID {
+		k = &keyBackoff{
+			etxID: etx.ID,
+			b: backoff.Backoff{
+				Min:    min,
+				Max:    max,
+				Factor: 2,
+			},
+		}
+		i.keys[etx.FromAddress] = k
+	}
+	wait := k.b.Duration()
+	k.lastErr = sendErr.Error()
+	k.until = time.Now().Add(wait)
+	return wait
+}
+
+// check returns errInsufficientEthBackoff if etx should not be resent yet
+func (i *insufficientEthBackoff) check(etx EthTx) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	k, exists := i.keys[etx.FromAddress]
+	if !exists || k.etxID != etx.ID || !time.Now().Before(k.until) {
+		return nil
+	}
+	return errInsufficientEthBackoff{k.etxID, k.lastErr, k.until}
+}
+
+// reset clears the backoff for the key, e.g. once a send has succeeded
+func (i *insufficientEthBackoff) reset(fromAddress common.Address) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.keys, fromAddress)
+}
@@ -0,0 +1,38 @@
+package bulletprooftxmanager
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// keyLocks holds a mutex per key, which is held while the attempts of the
+// key's unconfirmed transactions are being replaced, so that an
+// administrative bump (see BumpAllUnconfirmed) never races with the
+// EthConfirmer's own gas bumping for the same key.
+//
+// The BulletproofTxManager shares its keyLocks with every EthConfirmer it
+// creates, since the EthConfirmer is replaced whenever keys change.
+type keyLocks struct {
+	mu    sync.Mutex
+	locks map[common.Address]*sync.Mutex
+}
+
+func newKeyLocks() *keyLocks {
+	return &keyLocks{locks: make(map[common.Address]*sync.Mutex)}
+}
+
+// lock blocks until the lock for address is acquired, and returns the func
+// that releases it
+func (kl *keyLocks) lock(address common.Address) (unlock func()) {
+	kl.mu.Lock()
+	l, exists := kl.locks[address]
+	if !exists {
+		l = new(sync.Mutex)
+		kl.locks[address] = l
+	}
+	kl.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
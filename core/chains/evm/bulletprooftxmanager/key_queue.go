@@ -0,0 +1,101 @@
+package bulletprooftxmanager
+
+import (
+	"sync"
+
+	"go.uber.org/atomic"
+)
+
+// keyQueue serializes the work done for a single key. Triggers are coalesced,
+// so that any number of calls to enqueue before the worker picks them up
+// result in a single pending trigger, and process never runs two pieces of
+// work for the same key at once, regardless of how many goroutines call it.
+//
+// The worker that consumes triggered must call done when it exits, so that
+// drain can return.
+type keyQueue struct {
+	triggerCh chan struct{}
+	chStop    chan struct{}
+	chDone    chan struct{}
+	stopOnce  sync.Once
+	doneOnce  sync.Once
+
+	// mu is held while work is being processed
+	mu   sync.Mutex
+	busy atomic.Bool
+}
+
+func newKeyQueue() *keyQueue {
+	return &keyQueue{
+		triggerCh: make(chan struct{}, 1),
+		chStop:    make(chan struct{}),
+		chDone:    make(chan struct{}),
+	}
+}
+
+// enqueue asks the worker to do another round of work. It never blocks.
+// Returns false if the trigger was coalesced with one that is already
+// pending, or if the queue has been drained.
+func (q *keyQueue) enqueue() bool {
+	select {
+	case <-q.chStop:
+		return false
+	default:
+	}
+	select {
+	case q.triggerCh <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// triggered receives once for each pending trigger
+func (q *keyQueue) triggered() <-chan struct{} {
+	return q.triggerCh
+}
+
+// pending returns true if a trigger is waiting to be picked up
+func (q *keyQueue) pending() bool {
+	return len(q.triggerCh) > 0
+}
+
+// stopped is closed once drain has been called
+func (q *keyQueue) stopped() <-chan struct{} {
+	return q.chStop
+}
+
+// process runs fn, unless the queue has been drained. Work for the queue is
+// strictly serialized: concurrent calls wait for each other. Returns true if
+// fn was run.
+func (q *keyQueue) process(fn func()) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	select {
+	case <-q.chStop:
+		return false
+	default:
+	}
+	q.busy.Store(true)
+	defer q.busy.Store(false)
+	fn()
+	return true
+}
+
+// isBusy returns true while work is being processed
+func (q *keyQueue) isBusy() bool {
+	return q.busy.Load()
+}
+
+// done is called by the worker when it exits
+func (q *keyQueue) done() {
+	q.doneOnce.Do(func() { close(q.chDone) })
+}
+
+// drain stops the queue from accepting triggers or starting new work, and
+// blocks until the worker has exited. Work already in progress is allowed to
+// finish. Safe to call more than once.
+func (q *keyQueue) drain() {
+	q.stopOnce.Do(func() { close(q.chStop) })
+	<-q.chDone
+}
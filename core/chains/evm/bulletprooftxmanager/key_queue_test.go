@@ -0,0 +1,122 @@
+package bulletprooftxmanager
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func Test_keyQueue_Enqueue(t *testing.T) {
+	t.Parallel()
+
+	kq := newKeyQueue()
+	assert.False(t, kq.pending())
+
+	assert.True(t, kq.enqueue())
+	// Coalesced with the pending trigger
+	assert.False(t, kq.enqueue())
+	assert.True(t, kq.pending())
+
+	select {
+	case <-kq.triggered():
+	default:
+		t.Fatal("expected a pending trigger")
+	}
+	assert.False(t, kq.pending())
+	assert.True(t, kq.enqueue())
+}
+
+func Test_keyQueue_Process(t *testing.T) {
+	t.Parallel()
+
+	kq := newKeyQueue()
+
+	var running, maxRunning, processed atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			kq.process(func() {
+				assert.True(t, kq.isBusy())
+				n := running.Inc()
+				if n > maxRunning.Load() {
+					maxRunning.Store(n)
+				}
+				time.Sleep(time.Millisecond)
+				running.Dec()
+				processed.Inc()
+			})
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), maxRunning.Load(), "work for a key must never run concurrently")
+	assert.Equal(t, int32(20), processed.Load())
+	assert.False(t, kq.isBusy())
+}
+
+func Test_keyQueue_Drain(t *testing.T) {
+	t.Parallel()
+
+	kq := newKeyQueue()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var processed atomic.Int32
+
+	go func() {
+		defer kq.done()
+		for {
+			select {
+			case <-kq.stopped():
+				return
+			case <-kq.triggered():
+				kq.process(func() {
+					if processed.Inc() == 1 {
+						close(started)
+						<-release
+					}
+				})
+			}
+		}
+	}()
+
+	require.True(t, kq.enqueue())
+	<-started
+	assert.True(t, kq.isBusy())
+	// Queued behind the work in progress
+	require.True(t, kq.enqueue())
+
+	drained := make(chan struct{})
+	go func() {
+		kq.drain()
+		close(drained)
+	}()
+
+	// Draining waits for the work in progress
+	select {
+	case <-drained:
+		t.Fatal("drain returned while work was in progress")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-drained:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for drain")
+	}
+
+	// No new work is started once drained
+	assert.False(t, kq.isBusy())
+	assert.False(t, kq.enqueue())
+	assert.False(t, kq.process(func() { t.Fatal("processed after drain") }))
+	assert.LessOrEqual(t, processed.Load(), int32(2))
+
+	// Draining again is a no-op
+	kq.drain()
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	config "github.com/smartcontractkit/chainlink/core/chains/evm/config"
+
 	big "math/big"
 
 	chains "github.com/smartcontractkit/chainlink/core/chains"
@@ -12,6 +14,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
+
+	url "net/url"
 )
 
 // Config is an autogenerated mock type for the Config type
@@ -89,6 +93,180 @@ func (_m *Config) ChainType() chains.ChainType {
 	return r0
 }
 
+// EthTxBumpDigestInterval provides a mock function with given fields:
+func (_m *Config) EthTxBumpDigestInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthTxDeadlineBoostCurve provides a mock function with given fields:
+func (_m *Config) EthTxDeadlineBoostCurve() []config.DeadlineBoostStage {
+	ret := _m.Called()
+
+	var r0 []config.DeadlineBoostStage
+	if rf, ok := ret.Get(0).(func() []config.DeadlineBoostStage); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.DeadlineBoostStage)
+		}
+	}
+
+	return r0
+}
+
+// EthTxDuplicateInstancePolicy provides a mock function with given fields:
+func (_m *Config) EthTxDuplicateInstancePolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthTxFailureWebhookURL provides a mock function with given fields:
+func (_m *Config) EthTxFailureWebhookURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// EthTxFundsRecoveryBatchSize provides a mock function with given fields:
+func (_m *Config) EthTxFundsRecoveryBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EthTxFundsRecoveryCheckInterval provides a mock function with given fields:
+func (_m *Config) EthTxFundsRecoveryCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthTxInProgressResolutionPolicy provides a mock function with given fields:
+func (_m *Config) EthTxInProgressResolutionPolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthTxInsufficientEthBackoffMax provides a mock function with given fields:
+func (_m *Config) EthTxInsufficientEthBackoffMax() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthTxInsufficientEthMode provides a mock function with given fields:
+func (_m *Config) EthTxInsufficientEthMode() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthTxMaxFeeMode provides a mock function with given fields:
+func (_m *Config) EthTxMaxFeeMode() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthTxOutcomeKafkaTopic provides a mock function with given fields:
+func (_m *Config) EthTxOutcomeKafkaTopic() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthTxOutcomeKafkaURL provides a mock function with given fields:
+func (_m *Config) EthTxOutcomeKafkaURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // EthTxReaperInterval provides a mock function with given fields:
 func (_m *Config) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -131,6 +309,62 @@ func (_m *Config) EthTxResendAfterThreshold() time.Duration {
 	return r0
 }
 
+// EthTxResumeBatchSize provides a mock function with given fields:
+func (_m *Config) EthTxResumeBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmBroadcastMaxInitialBumps provides a mock function with given fields:
+func (_m *Config) EvmBroadcastMaxInitialBumps() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmBroadcastPollJitterDisabled provides a mock function with given fields:
+func (_m *Config) EvmBroadcastPollJitterDisabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmChainHaltThreshold provides a mock function with given fields:
+func (_m *Config) EvmChainHaltThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmEIP1559DynamicFees provides a mock function with given fields:
 func (_m *Config) EvmEIP1559DynamicFees() bool {
 	ret := _m.Called()
@@ -173,6 +407,20 @@ func (_m *Config) EvmGasBumpPercent() uint16 {
 	return r0
 }
 
+// EvmGasBumpStrategy provides a mock function with given fields:
+func (_m *Config) EvmGasBumpStrategy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmGasBumpThreshold provides a mock function with given fields:
 func (_m *Config) EvmGasBumpThreshold() uint64 {
 	ret := _m.Called()
@@ -247,6 +495,62 @@ func (_m *Config) EvmGasLimitDefault() uint64 {
 	return r0
 }
 
+// EvmGasLimitLearningEnabled provides a mock function with given fields:
+func (_m *Config) EvmGasLimitLearningEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmGasLimitLearningMarginPercent provides a mock function with given fields:
+func (_m *Config) EvmGasLimitLearningMarginPercent() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EvmGasLimitLearningMin provides a mock function with given fields:
+func (_m *Config) EvmGasLimitLearningMin() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// EvmGasLimitMax provides a mock function with given fields:
+func (_m *Config) EvmGasLimitMax() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // EvmGasLimitMultiplier provides a mock function with given fields:
 func (_m *Config) EvmGasLimitMultiplier() float32 {
 	ret := _m.Called()
@@ -309,6 +613,48 @@ func (_m *Config) EvmGasTipCapMinimum() *big.Int {
 	return r0
 }
 
+// EvmInProgressTxAlertThreshold provides a mock function with given fields:
+func (_m *Config) EvmInProgressTxAlertThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmKeyIdleTimeout provides a mock function with given fields:
+func (_m *Config) EvmKeyIdleTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmMaxGasPriceExceededPolicy provides a mock function with given fields:
+func (_m *Config) EvmMaxGasPriceExceededPolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmMaxGasPriceWei provides a mock function with given fields:
 func (_m *Config) EvmMaxGasPriceWei() *big.Int {
 	ret := _m.Called()
@@ -339,6 +685,50 @@ func (_m *Config) EvmMaxInFlightTransactions() uint32 {
 	return r0
 }
 
+// EvmMaxInFlightValueWei provides a mock function with given fields:
+func (_m *Config) EvmMaxInFlightValueWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmMaxInProgressAge provides a mock function with given fields:
+func (_m *Config) EvmMaxInProgressAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmMaxNonceHoles provides a mock function with given fields:
+func (_m *Config) EvmMaxNonceHoles() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // EvmMaxQueuedTransactions provides a mock function with given fields:
 func (_m *Config) EvmMaxQueuedTransactions() uint64 {
 	ret := _m.Called()
@@ -383,6 +773,20 @@ func (_m *Config) EvmNonceAutoSync() bool {
 	return r0
 }
 
+// EvmNonceAutoSyncInterval provides a mock function with given fields:
+func (_m *Config) EvmNonceAutoSyncInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmRPCDefaultBatchSize provides a mock function with given fields:
 func (_m *Config) EvmRPCDefaultBatchSize() uint32 {
 	ret := _m.Called()
@@ -397,6 +801,34 @@ func (_m *Config) EvmRPCDefaultBatchSize() uint32 {
 	return r0
 }
 
+// EvmRejectSelfTransactions provides a mock function with given fields:
+func (_m *Config) EvmRejectSelfTransactions() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmTxQueueTiebreak provides a mock function with given fields:
+func (_m *Config) EvmTxQueueTiebreak() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GasEstimatorMode provides a mock function with given fields:
 func (_m *Config) GasEstimatorMode() string {
 	ret := _m.Called()
@@ -411,6 +843,48 @@ func (_m *Config) GasEstimatorMode() string {
 	return r0
 }
 
+// GasEstimatorOwnConfirmationsBlockWindow provides a mock function with given fields:
+func (_m *Config) GasEstimatorOwnConfirmationsBlockWindow() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// GasEstimatorOwnConfirmationsMinBlocks provides a mock function with given fields:
+func (_m *Config) GasEstimatorOwnConfirmationsMinBlocks() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// GasEstimatorRecordInputs provides a mock function with given fields:
+func (_m *Config) GasEstimatorRecordInputs() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // KeySpecificMaxGasPriceWei provides a mock function with given fields: addr
 func (_m *Config) KeySpecificMaxGasPriceWei(addr common.Address) *big.Int {
 	ret := _m.Called(addr)
@@ -427,6 +901,34 @@ func (_m *Config) KeySpecificMaxGasPriceWei(addr common.Address) *big.Int {
 	return r0
 }
 
+// KeySpecificStrictSequencing provides a mock function with given fields: addr
+func (_m *Config) KeySpecificStrictSequencing(addr common.Address) bool {
+	ret := _m.Called(addr)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Address) bool); ok {
+		r0 = rf(addr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// KeySpecificTxQueueOrdering provides a mock function with given fields: addr
+func (_m *Config) KeySpecificTxQueueOrdering(addr common.Address) string {
+	ret := _m.Called(addr)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(common.Address) string); ok {
+		r0 = rf(addr)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // LogSQL provides a mock function with given fields:
 func (_m *Config) LogSQL() bool {
 	ret := _m.Called()
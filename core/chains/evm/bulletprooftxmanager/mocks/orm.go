@@ -3,10 +3,16 @@
 package mocks
 
 import (
+	big "math/big"
+
 	common "github.com/ethereum/go-ethereum/common"
 	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 
 	mock "github.com/stretchr/testify/mock"
+
+	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+
+	uuid "github.com/satori/go.uuid"
 )
 
 // ORM is an autogenerated mock type for the ORM type
@@ -14,6 +20,64 @@ type ORM struct {
 	mock.Mock
 }
 
+// CountEthTxesByState provides a mock function with given fields: chainID, qopts
+func (_m *ORM) CountEthTxesByState(chainID *big.Int, qopts ...pg.QOpt) (map[bulletprooftxmanager.EthTxState]int64, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 map[bulletprooftxmanager.EthTxState]int64
+	if rf, ok := ret.Get(0).(func(*big.Int, ...pg.QOpt) map[bulletprooftxmanager.EthTxState]int64); ok {
+		r0 = rf(chainID, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[bulletprooftxmanager.EthTxState]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int, ...pg.QOpt) error); ok {
+		r1 = rf(chainID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountUnresumedPipelineCallbacks provides a mock function with given fields: chainID, qopts
+func (_m *ORM) CountUnresumedPipelineCallbacks(chainID *big.Int, qopts ...pg.QOpt) (int64, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*big.Int, ...pg.QOpt) int64); ok {
+		r0 = rf(chainID, qopts...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int, ...pg.QOpt) error); ok {
+		r1 = rf(chainID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EthTransactions provides a mock function with given fields: offset, limit
 func (_m *ORM) EthTransactions(offset int, limit int) ([]bulletprooftxmanager.EthTx, int, error) {
 	ret := _m.Called(offset, limit)
@@ -104,6 +168,36 @@ func (_m *ORM) EthTxAttempts(offset int, limit int) ([]bulletprooftxmanager.EthT
 	return r0, r1, r2
 }
 
+// EthTxesForMeta provides a mock function with given fields: filter, qopts
+func (_m *ORM) EthTxesForMeta(filter bulletprooftxmanager.EthTxMeta, qopts ...pg.QOpt) ([]bulletprooftxmanager.EthTx, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, filter)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []bulletprooftxmanager.EthTx
+	if rf, ok := ret.Get(0).(func(bulletprooftxmanager.EthTxMeta, ...pg.QOpt) []bulletprooftxmanager.EthTx); ok {
+		r0 = rf(filter, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bulletprooftxmanager.EthTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(bulletprooftxmanager.EthTxMeta, ...pg.QOpt) error); ok {
+		r1 = rf(filter, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindEthTxAttempt provides a mock function with given fields: hash
 func (_m *ORM) FindEthTxAttempt(hash common.Hash) (*bulletprooftxmanager.EthTxAttempt, error) {
 	ret := _m.Called(hash)
@@ -150,6 +244,29 @@ func (_m *ORM) FindEthTxAttemptsByEthTxIDs(ids []int64) ([]bulletprooftxmanager.
 	return r0, r1
 }
 
+// FindEthTxAttemptsByHashes provides a mock function with given fields: hashes
+func (_m *ORM) FindEthTxAttemptsByHashes(hashes []common.Hash) ([]bulletprooftxmanager.EthTxAttempt, error) {
+	ret := _m.Called(hashes)
+
+	var r0 []bulletprooftxmanager.EthTxAttempt
+	if rf, ok := ret.Get(0).(func([]common.Hash) []bulletprooftxmanager.EthTxAttempt); ok {
+		r0 = rf(hashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bulletprooftxmanager.EthTxAttempt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]common.Hash) error); ok {
+		r1 = rf(hashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindEthTxByHash provides a mock function with given fields: hash
 func (_m *ORM) FindEthTxByHash(hash common.Hash) (*bulletprooftxmanager.EthTx, error) {
 	ret := _m.Called(hash)
@@ -235,3 +352,33 @@ func (_m *ORM) InsertEthTxAttempt(attempt *bulletprooftxmanager.EthTxAttempt) er
 
 	return r0
 }
+
+// TxQueueSummaries provides a mock function with given fields: subjects, qopts
+func (_m *ORM) TxQueueSummaries(subjects []uuid.UUID, qopts ...pg.QOpt) (map[uuid.UUID]bulletprooftxmanager.TxQueueSummary, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, subjects)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 map[uuid.UUID]bulletprooftxmanager.TxQueueSummary
+	if rf, ok := ret.Get(0).(func([]uuid.UUID, ...pg.QOpt) map[uuid.UUID]bulletprooftxmanager.TxQueueSummary); ok {
+		r0 = rf(subjects, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]bulletprooftxmanager.TxQueueSummary)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]uuid.UUID, ...pg.QOpt) error); ok {
+		r1 = rf(subjects, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -10,11 +10,17 @@ import (
 
 	gas "github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 
+	big "math/big"
+
 	mock "github.com/stretchr/testify/mock"
 
 	pg "github.com/smartcontractkit/chainlink/core/services/pg"
 
+	time "time"
+
 	types "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+
+	uuid "github.com/satori/go.uuid"
 )
 
 // TxManager is an autogenerated mock type for the TxManager type
@@ -22,6 +28,29 @@ type TxManager struct {
 	mock.Mock
 }
 
+// BumpAllUnconfirmed provides a mock function with given fields: ctx, address, gasPriceOrFeeCapWei, tipCapWei, dryRun
+func (_m *TxManager) BumpAllUnconfirmed(ctx context.Context, address common.Address, gasPriceOrFeeCapWei *big.Int, tipCapWei *big.Int, dryRun bool) ([]bulletprooftxmanager.BumpResult, error) {
+	ret := _m.Called(ctx, address, gasPriceOrFeeCapWei, tipCapWei, dryRun)
+
+	var r0 []bulletprooftxmanager.BumpResult
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, *big.Int, bool) []bulletprooftxmanager.BumpResult); ok {
+		r0 = rf(ctx, address, gasPriceOrFeeCapWei, tipCapWei, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bulletprooftxmanager.BumpResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, *big.Int, bool) error); ok {
+		r1 = rf(ctx, address, gasPriceOrFeeCapWei, tipCapWei, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxManager) Close() error {
 	ret := _m.Called()
@@ -64,6 +93,57 @@ func (_m *TxManager) CreateEthTransaction(newTx bulletprooftxmanager.NewTx, qopt
 	return r0, r1
 }
 
+// Drain provides a mock function with given fields: ctx
+func (_m *TxManager) Drain(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ForceRebroadcast provides a mock function with given fields: beginNonce, endNonce, gasPriceWei, address, overrideGasLimit
+func (_m *TxManager) ForceRebroadcast(beginNonce int64, endNonce int64, gasPriceWei *big.Int, address common.Address, overrideGasLimit uint64) error {
+	ret := _m.Called(beginNonce, endNonce, gasPriceWei, address, overrideGasLimit)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64, *big.Int, common.Address, uint64) error); ok {
+		r0 = rf(beginNonce, endNonce, gasPriceWei, address, overrideGasLimit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GasUsedStats provides a mock function with given fields:
+func (_m *TxManager) GasUsedStats() ([]bulletprooftxmanager.GasUsedStats, error) {
+	ret := _m.Called()
+
+	var r0 []bulletprooftxmanager.GasUsedStats
+	if rf, ok := ret.Get(0).(func() []bulletprooftxmanager.GasUsedStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bulletprooftxmanager.GasUsedStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetGasEstimator provides a mock function with given fields:
 func (_m *TxManager) GetGasEstimator() gas.Estimator {
 	ret := _m.Called()
@@ -94,6 +174,25 @@ func (_m *TxManager) Healthy() error {
 	return r0
 }
 
+// KeyParked provides a mock function with given fields: address
+func (_m *TxManager) KeyParked(address common.Address) bool {
+	ret := _m.Called(address)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Address) bool); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// OnFinalized provides a mock function with given fields: fn
+func (_m *TxManager) OnFinalized(fn bulletprooftxmanager.FinalizedCallback) {
+	_m.Called(fn)
+}
+
 // OnNewLongestChain provides a mock function with given fields: ctx, head
 func (_m *TxManager) OnNewLongestChain(ctx context.Context, head *types.Head) {
 	_m.Called(ctx, head)
@@ -113,11 +212,51 @@ func (_m *TxManager) Ready() error {
 	return r0
 }
 
+// RegisterResumeBatchCallback provides a mock function with given fields: fn
+func (_m *TxManager) RegisterResumeBatchCallback(fn bulletprooftxmanager.ResumeBatchCallback) {
+	_m.Called(fn)
+}
+
 // RegisterResumeCallback provides a mock function with given fields: fn
 func (_m *TxManager) RegisterResumeCallback(fn bulletprooftxmanager.ResumeCallback) {
 	_m.Called(fn)
 }
 
+// ReleaseNonce provides a mock function with given fields: address, nonce
+func (_m *TxManager) ReleaseNonce(address common.Address, nonce int64) error {
+	ret := _m.Called(address, nonce)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, int64) error); ok {
+		r0 = rf(address, nonce)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReserveNonce provides a mock function with given fields: address
+func (_m *TxManager) ReserveNonce(address common.Address) (int64, error) {
+	ret := _m.Called(address)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(common.Address) int64); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields:
 func (_m *TxManager) Start() error {
 	ret := _m.Called()
@@ -132,6 +271,57 @@ func (_m *TxManager) Start() error {
 	return r0
 }
 
+// StuckInProgress provides a mock function with given fields:
+func (_m *TxManager) StuckInProgress() []bulletprooftxmanager.EthTx {
+	ret := _m.Called()
+
+	var r0 []bulletprooftxmanager.EthTx
+	if rf, ok := ret.Get(0).(func() []bulletprooftxmanager.EthTx); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bulletprooftxmanager.EthTx)
+		}
+	}
+
+	return r0
+}
+
+// SyncNonce provides a mock function with given fields: ctx, address
+func (_m *TxManager) SyncNonce(ctx context.Context, address common.Address) error {
+	ret := _m.Called(ctx, address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address) error); ok {
+		r0 = rf(ctx, address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionCosts provides a mock function with given fields: subject, from, to
+func (_m *TxManager) TransactionCosts(subject uuid.UUID, from time.Time, to time.Time) (bulletprooftxmanager.TransactionCosts, error) {
+	ret := _m.Called(subject, from, to)
+
+	var r0 bulletprooftxmanager.TransactionCosts
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time, time.Time) bulletprooftxmanager.TransactionCosts); ok {
+		r0 = rf(subject, from, to)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.TransactionCosts)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = rf(subject, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Trigger provides a mock function with given fields: addr
 func (_m *TxManager) Trigger(addr common.Address) {
 	_m.Called(addr)
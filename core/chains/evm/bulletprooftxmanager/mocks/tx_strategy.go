@@ -3,9 +3,12 @@
 package mocks
 
 import (
-	uuid "github.com/satori/go.uuid"
-	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	mock "github.com/stretchr/testify/mock"
+
+	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+
+	uuid "github.com/satori/go.uuid"
 )
 
 // TxStrategy is an autogenerated mock type for the TxStrategy type
@@ -48,6 +51,20 @@ func (_m *TxStrategy) Simulate() bool {
 	return r0
 }
 
+// SimulationMode provides a mock function with given fields:
+func (_m *TxStrategy) SimulationMode() bulletprooftxmanager.SimulationMode {
+	ret := _m.Called()
+
+	var r0 bulletprooftxmanager.SimulationMode
+	if rf, ok := ret.Get(0).(func() bulletprooftxmanager.SimulationMode); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.SimulationMode)
+	}
+
+	return r0
+}
+
 // Subject provides a mock function with given fields:
 func (_m *TxStrategy) Subject() uuid.NullUUID {
 	ret := _m.Called()
@@ -15,6 +15,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	cnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/pg/datatypes"
@@ -31,6 +32,63 @@ type EthTxMeta struct {
 	// Used for the VRFv2 - the subscription ID of the
 	// requester of the VRF.
 	SubID uint64 `json:"SubId"`
+	// Used for Keepers - the upkeep this tx performs
+	UpkeepID *int64 `json:",omitempty"`
+	// Used for Flux Monitor - the aggregator round this tx submits to
+	RoundID *uint32 `json:",omitempty"`
+	// Used for OCR - the epoch and round of the report this tx transmits
+	Epoch *uint32 `json:",omitempty"`
+	Round *uint8  `json:",omitempty"`
+	// Used for OCR - the external ID of the job this tx transmits for
+	ExternalJobID *uuid.UUID `json:",omitempty"`
+	// Used for OCR transmissions sent through a forwarder - the contract the
+	// forwarder forwards this tx to
+	ForwarderDestAddress *common.Address `json:",omitempty"`
+	// Set on broadcast transactions that are known to revert once mined,
+	// e.g. a keeper perform for an upkeep that has since been canceled
+	ExpectedToRevert bool `json:",omitempty"`
+	// Used for pipeline ethtx tasks with minConfirmations=0 that should
+	// resume as soon as the transaction is broadcast
+	ResumeOnBroadcast bool `json:",omitempty"`
+	// Maps 4 byte hex-encoded custom error selectors to their names, used
+	// to decode the revert reason if the transaction reverts in simulation
+	RevertErrors map[string]string `json:",omitempty"`
+}
+
+// EthTxOrigin identifies what created a transaction: a job, or a user
+// through the API
+type EthTxOrigin struct {
+	// JobID and JobName are set for transactions created by a job
+	JobID   int32  `json:"jobID,omitempty"`
+	JobName string `json:"jobName,omitempty"`
+	// User and Credential are set for transactions created through the API,
+	// Credential being how the user authenticated, see OriginCredentialSession
+	// and OriginCredentialAPIToken
+	User       string `json:"user,omitempty"`
+	Credential string `json:"credential,omitempty"`
+}
+
+const (
+	OriginCredentialSession  = "session"
+	OriginCredentialAPIToken = "api_token"
+)
+
+// JobOrigin returns the origin of transactions created by the given job
+func JobOrigin(jobID int32, jobName string) EthTxOrigin {
+	return EthTxOrigin{JobID: jobID, JobName: jobName}
+}
+
+// IsZero returns true if the origin is unknown
+func (o EthTxOrigin) IsZero() bool {
+	return o == EthTxOrigin{}
+}
+
+// OrNil returns nil if the origin is unknown, for omitting it from JSON
+func (o EthTxOrigin) OrNil() *EthTxOrigin {
+	if o.IsZero() {
+		return nil
+	}
+	return &o
 }
 
 type EthTxState string
@@ -43,12 +101,54 @@ const (
 	EthTxUnconfirmed             = EthTxState("unconfirmed")
 	EthTxConfirmed               = EthTxState("confirmed")
 	EthTxConfirmedMissingReceipt = EthTxState("confirmed_missing_receipt")
+	// EthTxAwaitingFunds is only used when ETH_TX_INSUFFICIENT_ETH_MODE is skip
+	// or defer_value
+	EthTxAwaitingFunds = EthTxState("awaiting_funds")
+	// EthTxExternal is a placeholder for a nonce used by a transaction sent
+	// from outside of the node, either reserved by ReserveNonce, or imported
+	// by the NonceSyncer
+	EthTxExternal = EthTxState("external")
 
 	EthTxAttemptInProgress      = EthTxAttemptState("in_progress")
 	EthTxAttemptInsufficientEth = EthTxAttemptState("insufficient_eth")
 	EthTxAttemptBroadcast       = EthTxAttemptState("broadcast")
 )
 
+// FatalReason is a machine readable code for why an eth_tx ended up in
+// fatal_error. The human readable detail is in EthTx.Error.
+type FatalReason string
+
+const (
+	// FatalReasonSimulationReverted means the transaction reverted when
+	// simulated before its initial send
+	FatalReasonSimulationReverted = FatalReason("simulation_reverted")
+	// FatalReasonTooExpensive means the eth node refused the transaction for
+	// exceeding its configured fee cap (RPCTxFeeCap)
+	FatalReasonTooExpensive = FatalReason("too_expensive")
+	// FatalReasonSendFatal means the eth node returned an error that no
+	// amount of retrying can fix, e.g. "exceeds block gas limit"
+	FatalReasonSendFatal = FatalReason("send_fatal")
+	// FatalReasonInProgressMaxAge means the transaction was in_progress for
+	// longer than ETH_MAX_IN_PROGRESS_AGE with
+	// ETH_TX_IN_PROGRESS_RESOLUTION_POLICY=fatal
+	FatalReasonInProgressMaxAge = FatalReason("in_progress_max_age")
+	// FatalReasonMaxFeeExceeded means the transaction could not be sent
+	// within its MaxFeeWei with ETH_TX_MAX_FEE_MODE=fatal
+	FatalReasonMaxFeeExceeded = FatalReason("max_fee_exceeded")
+	// FatalReasonGasLimitMaxExceeded means the eth node required a higher gas
+	// limit than ETH_GAS_LIMIT_MAX
+	FatalReasonGasLimitMaxExceeded = FatalReason("gas_limit_max_exceeded")
+	// FatalReasonMissingReceipt means the transaction's nonce was used but no
+	// receipt was ever found for any of its attempts
+	FatalReasonMissingReceipt = FatalReason("missing_receipt")
+	// FatalReasonMaxGasPriceExceeded means the estimated gas price exceeded
+	// the max gas price of the key with ETH_MAX_GAS_PRICE_EXCEEDED_POLICY=fail
+	FatalReasonMaxGasPriceExceeded = FatalReason("max_gas_price_exceeded")
+	// FatalReasonMaxInitialBumps means the eth node kept rejecting the
+	// initial send as underpriced after ETH_BROADCAST_MAX_INITIAL_BUMPS bumps
+	FatalReasonMaxInitialBumps = FatalReason("max_initial_bumps")
+)
+
 type NullableEIP2930AccessList struct {
 	AccessList types.AccessList
 	Valid      bool
@@ -138,6 +238,84 @@ type EthTx struct {
 	// Simulate if set to true will cause this eth_tx to be simulated before
 	// initial send and aborted on revert
 	Simulate bool
+	// SimulationMode controls whether a reverted simulation is fatal. An
+	// empty mode is treated as SimulationModeFatalOnRevert.
+	SimulationMode SimulationMode
+
+	// Priority is only used when ETH_TX_QUEUE_ORDERING=priority, in which
+	// case higher priority transactions are broadcast first
+	Priority cnull.Int64
+
+	// FatalReason is only set in fatal_error, and not on transactions that
+	// errored before it was added or outside of the bulletprooftxmanager
+	FatalReason *FatalReason
+
+	// MaxFeeWei is optional and only has an effect on DynamicFee
+	// transactions. It caps the worst case cost (GasFeeCap * GasLimit) of
+	// every attempt; see ETH_TX_MAX_FEE_MODE.
+	MaxFeeWei *utils.Big
+	// MaxGasPriceWei is optional, and lowers the max gas price (or fee cap)
+	// of the key for this transaction only
+	MaxGasPriceWei *utils.Big
+
+	// SkipReason is set while an unstarted transaction is being held back
+	// rather than sent, e.g. with ETH_MAX_GAS_PRICE_EXCEEDED_POLICY=defer.
+	// SkippedAt is when it was last skipped.
+	SkipReason null.String
+	SkippedAt  *time.Time
+
+	// Deadline is optional and is when a time critical transaction must be
+	// mined by. As it approaches, gas bumping accelerates according to
+	// ETH_TX_DEADLINE_BOOST_CURVE.
+	Deadline *time.Time
+
+	// InitialBumps is how many times gas was bumped because the eth node
+	// rejected the initial send as underpriced; see
+	// ETH_BROADCAST_MAX_INITIAL_BUMPS.
+	InitialBumps uint32
+
+	// PacingInterval is optional and is the least time between the initial
+	// broadcasts of transactions with the same PacingTag (or Subject, if it
+	// has no tag), across all keys
+	PacingTag      null.String
+	PacingInterval time.Duration
+
+	// StrictSequencing holds the transaction back from broadcast while any
+	// other transaction from the same key with the same Subject is in
+	// flight, so that transactions for the subject are confirmed strictly in
+	// order. See also ChainCfg.EvmStrictSequencing, which does the same for
+	// every transaction of a key.
+	StrictSequencing bool
+
+	// The origin of the transaction, see EthTxOrigin. All are null if it is
+	// unknown, e.g. for transactions created before it was recorded.
+	OriginJobID      null.Int
+	OriginJobName    null.String
+	OriginUser       null.String
+	OriginCredential null.String
+
+	// ExternalHash is only set in external, on transactions sent from
+	// outside of the node that the NonceSyncer found on chain or in the
+	// mempool. It is null for reserved nonces, and for nonces whose
+	// transaction could not be located.
+	ExternalHash *common.Hash
+}
+
+// Origin returns what created the transaction
+func (e EthTx) Origin() EthTxOrigin {
+	return EthTxOrigin{
+		JobID:      int32(e.OriginJobID.Int64),
+		JobName:    e.OriginJobName.String,
+		User:       e.OriginUser.String,
+		Credential: e.OriginCredential.String,
+	}
+}
+
+func (e *EthTx) setOrigin(o EthTxOrigin) {
+	e.OriginJobID = null.NewInt(int64(o.JobID), o.JobID != 0)
+	e.OriginJobName = null.NewString(o.JobName, o.JobName != "")
+	e.OriginUser = null.NewString(o.User, o.User != "")
+	e.OriginCredential = null.NewString(o.Credential, o.Credential != "")
 }
 
 func (e EthTx) GetError() error {
@@ -147,6 +325,18 @@ func (e EthTx) GetError() error {
 	return nil
 }
 
+// GetMeta returns the unmarshalled EthTxMeta, or nil if no meta was set
+func (e EthTx) GetMeta() (*EthTxMeta, error) {
+	if e.Meta == nil {
+		return nil, nil
+	}
+	var m EthTxMeta
+	if err := json.Unmarshal(*e.Meta, &m); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal EthTx meta")
+	}
+	return &m, nil
+}
+
 // GetID allows EthTx to be used as jsonapi.MarshalIdentifier
 func (e EthTx) GetID() string {
 	return fmt.Sprintf("%d", e.ID)
@@ -170,6 +360,81 @@ type EthTxAttempt struct {
 	State                   EthTxAttemptState
 	EthReceipts             []EthReceipt `json:"-"`
 	TxType                  int
+	// IsManual is set on attempts created by ForceRebroadcast or BumpAllUnconfirmed
+	IsManual bool
+	// EffectiveGasPrice and GasUsed are set from the receipt once the attempt
+	// is mined. EffectiveGasPrice is the price per gas actually paid, which
+	// for DynamicFeeTx is usually lower than GasFeeCap.
+	EffectiveGasPrice *utils.Big
+	GasUsed           *int64
+	// UnclampedGasPrice is the estimated gas price (or fee cap) if it had to
+	// be lowered to the max gas price of the key, in which case
+	// MaxGasPricePolicy is the ETH_MAX_GAS_PRICE_EXCEEDED_POLICY at the time
+	UnclampedGasPrice *utils.Big
+	MaxGasPricePolicy null.String
+	// EstimatorInputs is only set if GAS_ESTIMATOR_RECORD_INPUTS is enabled
+	EstimatorInputs *EstimatorInputs
+	// DeadlineBoostFactor is set on an attempt created by a bump that was
+	// boosted because its transaction was close to its deadline
+	DeadlineBoostFactor *uint32
+	// SendLog records which eth nodes each send of the attempt went to and
+	// how they responded, oldest first
+	SendLog SendLog
+}
+
+// SendLog records how each eth node handled the sends of an attempt
+type SendLog []evmclient.SendResult
+
+// Value returns this instance serialized for database storage
+func (l SendLog) Value() (driver.Value, error) {
+	if l == nil {
+		return nil, nil
+	}
+	return json.Marshal(l)
+}
+
+// Scan reads the database value and returns an instance
+func (l *SendLog) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*l = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, l)
+	default:
+		return errors.Errorf("unable to convert %v of %T to SendLog", value, value)
+	}
+}
+
+// EstimatorInputs records what the gas estimator was given and returned when
+// pricing an attempt
+type EstimatorInputs struct {
+	// Estimator is the GAS_ESTIMATOR_MODE in effect
+	Estimator string `json:"estimator"`
+	// GasLimit is the gas limit of the transaction that was given to the
+	// estimator, ChainSpecificGasLimit is the one it returned
+	GasLimit              uint64 `json:"gasLimit"`
+	ChainSpecificGasLimit uint64 `json:"chainSpecificGasLimit"`
+	// GasPrice is set for legacy attempts, TipCap and FeeCap for dynamic
+	// fee attempts
+	GasPrice *utils.Big `json:"gasPrice,omitempty"`
+	TipCap   *utils.Big `json:"tipCap,omitempty"`
+	FeeCap   *utils.Big `json:"feeCap,omitempty"`
+}
+
+// Value returns this instance serialized for database storage
+func (e EstimatorInputs) Value() (driver.Value, error) {
+	return json.Marshal(e)
+}
+
+// Scan reads the database value and returns an instance
+func (e *EstimatorInputs) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, e)
+	default:
+		return errors.Errorf("unable to convert %v of %T to EstimatorInputs", value, value)
+	}
 }
 
 // GetSignedTx decodes the SignedRawTx into a types.Transaction struct
@@ -0,0 +1,144 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// nonceHolesVar publishes the NonceHoleState of every key on /debug/vars,
+// keyed by chain ID and address
+var nonceHolesVar = expvar.NewMap("bptxm_nonce_holes")
+
+// errNonceHolesPaused is returned by ProcessUnstartedEthTxs instead of
+// broadcasting while a key has more than ETH_MAX_NONCE_HOLES nonce holes
+type errNonceHolesPaused struct {
+	address gethCommon.Address
+	holes   int64
+	max     uint32
+}
+
+func (e errNonceHolesPaused) Error() string {
+	return fmt.Sprintf("key %s has %d nonce holes, more than ETH_MAX_NONCE_HOLES (%d), new transactions will not be broadcast until they are filled", e.address.Hex(), e.holes, e.max)
+}
+
+// NonceHoleState is the most recent nonce hole count of a key, and whether
+// the EthBroadcaster has paused broadcasting for it because of them
+type NonceHoleState struct {
+	Holes     int64     `json:"holes"`
+	Paused    bool      `json:"paused"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// nonceHoles tracks the NonceHoleState of every key of an EthBroadcaster
+type nonceHoles struct {
+	chainID big.Int
+
+	mu   sync.RWMutex
+	keys map[gethCommon.Address]NonceHoleState
+}
+
+func newNonceHoles(chainID big.Int) *nonceHoles {
+	return &nonceHoles{chainID: chainID, keys: make(map[gethCommon.Address]NonceHoleState)}
+}
+
+// record saves the latest count for address, and returns whether it was
+// paused before
+func (n *nonceHoles) record(address gethCommon.Address, holes int64, paused bool) (wasPaused bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	prev, exists := n.keys[address]
+	n.keys[address] = NonceHoleState{Holes: holes, Paused: paused, CheckedAt: time.Now()}
+	if !exists {
+		nonceHolesVar.Set(n.varKey(address), expvar.Func(func() interface{} { return n.get(address) }))
+	}
+	return prev.Paused
+}
+
+func (n *nonceHoles) get(address gethCommon.Address) NonceHoleState {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.keys[address]
+}
+
+// remove stops tracking address, e.g. when its key is removed
+func (n *nonceHoles) remove(address gethCommon.Address) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if _, exists := n.keys[address]; exists {
+		delete(n.keys, address)
+		nonceHolesVar.Delete(n.varKey(address))
+	}
+}
+
+func (n *nonceHoles) varKey(address gethCommon.Address) string {
+	return fmt.Sprintf("%s/%s", n.chainID.String(), address.Hex())
+}
+
+// CountNonceHoles returns how many nonces of address, between its highest
+// mined nonce and its highest unconfirmed one, are not held by any
+// transaction that can still be mined. Such a hole is left behind by a
+// transaction that was fatally errored after its nonce had been passed, or
+// by a nonce reserved for an external transaction that was never sent, and
+// every unconfirmed transaction above it is stuck until it is filled.
+func CountNonceHoles(q pg.Q, address gethCommon.Address, chainID big.Int) (holes int64, err error) {
+	err = q.Get(&holes, `
+WITH bounds AS (
+	SELECT
+		COALESCE(
+			MAX(nonce) FILTER (WHERE state IN ('confirmed', 'confirmed_missing_receipt') OR (state = 'external' AND broadcast_at IS NOT NULL)),
+			MIN(nonce) - 1
+		) AS mined,
+		MAX(nonce) FILTER (WHERE state = 'unconfirmed') AS highest
+	FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND nonce IS NOT NULL
+)
+SELECT COUNT(*) FROM bounds, generate_series(bounds.mined + 1, bounds.highest - 1) AS n
+WHERE NOT EXISTS (
+	SELECT 1 FROM eth_txes
+	WHERE from_address = $1 AND evm_chain_id = $2 AND nonce = n AND state IN ('unconfirmed', 'confirmed', 'confirmed_missing_receipt')
+)`, address, chainID.String())
+	return holes, errors.Wrap(err, "CountNonceHoles failed")
+}
+
+// checkNonceHoles returns errNonceHolesPaused if address has more than
+// ETH_MAX_NONCE_HOLES nonce holes, since broadcasting higher nonces would
+// only strand more transactions behind them. Broadcasting resumes on its own
+// once enough of them are filled.
+func (eb *EthBroadcaster) checkNonceHoles(ctx context.Context, address gethCommon.Address) error {
+	max := eb.config.EvmMaxNonceHoles()
+	if max == 0 {
+		return nil
+	}
+	holes, err := CountNonceHoles(eb.q.WithOpts(pg.WithParentCtx(ctx)), address, eb.chainID)
+	if err != nil {
+		return errors.Wrap(err, "checkNonceHoles failed")
+	}
+	paused := holes > int64(max)
+	wasPaused := eb.nonceHoles.record(address, holes, paused)
+	if paused && !wasPaused {
+		eb.logger.CriticalW("Key has more nonce holes than ETH_MAX_NONCE_HOLES, pausing broadcasting of new transactions for it. "+
+			"Every transaction above a hole is stuck until it is filled, e.g. with `chainlink local rebroadcast-transactions`, which sends an empty transaction for each missing nonce, "+
+			"or by sending or releasing (`chainlink local releasenonce`) any nonces reserved for external transactions",
+			"address", address, "holes", holes, "maxNonceHoles", max)
+	} else if !paused && wasPaused {
+		eb.logger.Warnw("Nonce holes have been filled, resuming broadcasting", "address", address, "holes", holes, "maxNonceHoles", max)
+	}
+	if paused {
+		return errNonceHolesPaused{address, holes, max}
+	}
+	return nil
+}
+
+// NonceHoles returns the most recent NonceHoleState of address. It is only
+// kept up to date while ETH_MAX_NONCE_HOLES is set.
+func (eb *EthBroadcaster) NonceHoles(address gethCommon.Address) NonceHoleState {
+	return eb.nonceHoles.get(address)
+}
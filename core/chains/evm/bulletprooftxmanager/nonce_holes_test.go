@@ -0,0 +1,115 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"testing"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+func TestEthBroadcaster_NonceHoles(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmMaxNonceHoles = null.IntFrom(1)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	q := pg.NewQ(db, logger.TestLogger(t), cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, int64(5))
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	// Nonce 0 has been mined, and 1 to 4 have been broadcast
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, fromAddress)
+	for nonce := int64(1); nonce < 5; nonce++ {
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, nonce, fromAddress)
+	}
+	countHoles := func(t *testing.T) int64 {
+		holes, err := bulletprooftxmanager.CountNonceHoles(q, fromAddress, cltest.FixtureChainID)
+		require.NoError(t, err)
+		return holes
+	}
+	require.Equal(t, int64(0), countHoles(t))
+
+	etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+	t.Run("pauses broadcasting once fatal errors leave more holes than ETH_MAX_NONCE_HOLES", func(t *testing.T) {
+		// Fatal errors clear the nonces of 2 and 3, which nothing will ever
+		// mine, so 4 is stuck behind them
+		_, err := db.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id IN (SELECT id FROM eth_txes WHERE from_address = $1 AND nonce = ANY($2))`, fromAddress, pq.Array([]int64{2, 3}))
+		require.NoError(t, err)
+		_, err = db.Exec(`UPDATE eth_txes SET state = 'fatal_error', nonce = NULL, error = 'something exploded', broadcast_at = NULL WHERE from_address = $1 AND nonce = ANY($2)`, fromAddress, pq.Array([]int64{2, 3}))
+		require.NoError(t, err)
+		require.Equal(t, int64(2), countHoles(t))
+
+		err = eb.ProcessUnstartedEthTxs(context.Background(), keyState)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has 2 nonce holes, more than ETH_MAX_NONCE_HOLES (1)")
+
+		// Nothing was broadcast
+		ethClient.AssertNotCalled(t, "SendTransaction", mock.Anything, mock.Anything)
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+
+		state := eb.NonceHoles(fromAddress)
+		assert.Equal(t, int64(2), state.Holes)
+		assert.True(t, state.Paused)
+		require.Error(t, eb.HealthReport()[fromAddress.Hex()])
+		assert.Contains(t, eb.HealthReport()[fromAddress.Hex()].Error(), "nonce holes")
+
+		v := expvar.Get("bptxm_nonce_holes").(*expvar.Map).Get(fmt.Sprintf("%s/%s", cltest.FixtureChainID.String(), fromAddress.Hex()))
+		require.NotNil(t, v)
+		assert.Contains(t, v.String(), `"holes":2`)
+		assert.Contains(t, v.String(), `"paused":true`)
+	})
+
+	t.Run("resumes broadcasting once the holes are filled", func(t *testing.T) {
+		// Empty transactions were mined for 2 and 3, so 4 was confirmed
+		_, err := db.Exec(`UPDATE eth_txes SET state = 'confirmed' WHERE from_address = $1 AND nonce = 4`, fromAddress)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), countHoles(t))
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(5)
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		ethClient.AssertExpectations(t)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+
+		state := eb.NonceHoles(fromAddress)
+		assert.Equal(t, int64(0), state.Holes)
+		assert.False(t, state.Paused)
+		assert.NoError(t, eb.HealthReport()[fromAddress.Hex()])
+	})
+
+	t.Run("counts nonces reserved for external transactions that were never sent", func(t *testing.T) {
+		nonce, err := bulletprooftxmanager.ReserveNonce(q, fromAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		require.Equal(t, int64(6), nonce)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 7, fromAddress)
+
+		assert.Equal(t, int64(1), countHoles(t))
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		assert.False(t, eb.NonceHoles(fromAddress).Paused)
+	})
+}
@@ -0,0 +1,100 @@
+package bulletprooftxmanager
+
+import (
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// ReserveNonce takes the next nonce for the given key so that it can be used
+// to sign and send a transaction from outside of the node (e.g. a manual
+// transaction sent with an external wallet).
+//
+// A placeholder eth_tx is saved in the 'external' state to hold the nonce.
+// The EthBroadcaster will never send it, but it keeps the nonce from being
+// reused, and the EthConfirmer will mark it once the nonce has been consumed
+// on chain.
+func ReserveNonce(q pg.Q, address common.Address, chainID *big.Int) (nonce int64, err error) {
+	err = q.Transaction(func(tx pg.Queryer) error {
+		if err := tx.Get(&nonce, `SELECT next_nonce FROM eth_key_states WHERE address = $1 AND evm_chain_id = $2 FOR UPDATE`, address, chainID.String()); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.Errorf("no key found matching address %s for chain %s", address.Hex(), chainID.String())
+			}
+			return errors.Wrap(err, "ReserveNonce failed to load next_nonce")
+		}
+		_, err := tx.Exec(`
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, nonce, evm_chain_id, created_at)
+VALUES ($1, $1, '\x', 0, 0, 'external', $2, $3, NOW())
+`, address, nonce, chainID.String())
+		if err != nil {
+			switch e := err.(type) {
+			case *pgconn.PgError:
+				if e.ConstraintName == "idx_eth_txes_nonce_from_address_per_evm_chain_id" {
+					return errors.Errorf("nonce %d for key %s is already held by a transaction that is in the process of being sent, please try again", nonce, address.Hex())
+				}
+			}
+			return errors.Wrap(err, "ReserveNonce failed to insert eth_tx")
+		}
+		return errors.Wrap(IncrementNextNonce(tx, address, chainID, nonce), "ReserveNonce failed")
+	})
+	return
+}
+
+// ReleaseNonce undoes a reservation made by ReserveNonce that turned out not
+// to be needed. This is only possible while the reserved nonce is still the
+// highest one used by the key and it has not been consumed on chain.
+func ReleaseNonce(q pg.Q, address common.Address, chainID *big.Int, nonce int64) error {
+	return q.Transaction(func(tx pg.Queryer) error {
+		var nextNonce int64
+		if err := tx.Get(&nextNonce, `SELECT next_nonce FROM eth_key_states WHERE address = $1 AND evm_chain_id = $2 FOR UPDATE`, address, chainID.String()); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.Errorf("no key found matching address %s for chain %s", address.Hex(), chainID.String())
+			}
+			return errors.Wrap(err, "ReleaseNonce failed to load next_nonce")
+		}
+		var higher bool
+		if err := tx.Get(&higher, `SELECT EXISTS (SELECT 1 FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND nonce > $3)`, address, chainID.String(), nonce); err != nil {
+			return errors.Wrap(err, "ReleaseNonce failed to check for higher nonces")
+		}
+		if higher || nextNonce != nonce+1 {
+			return errors.Errorf("cannot release nonce %d for key %s, it is no longer the highest nonce in use (next nonce is %d)", nonce, address.Hex(), nextNonce)
+		}
+		res, err := tx.Exec(`DELETE FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND nonce = $3 AND state = 'external' AND broadcast_at IS NULL`, address, chainID.String(), nonce)
+		if err != nil {
+			return errors.Wrap(err, "ReleaseNonce failed to delete eth_tx")
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "ReleaseNonce failed to get RowsAffected")
+		}
+		if rowsAffected == 0 {
+			return errors.Errorf("no unused reservation found for nonce %d on key %s", nonce, address.Hex())
+		}
+		_, err = tx.Exec(`UPDATE eth_key_states SET next_nonce = next_nonce - 1, updated_at = NOW() WHERE address = $1 AND evm_chain_id = $2`, address, chainID.String())
+		return errors.Wrap(err, "ReleaseNonce failed to update next_nonce")
+	})
+}
+
+// ReserveNonce reserves the next nonce for the given key, see ReserveNonce
+func (b *BulletproofTxManager) ReserveNonce(address common.Address) (int64, error) {
+	nonce, err := ReserveNonce(b.q, address, &b.chainID)
+	if err != nil {
+		return 0, err
+	}
+	b.logger.Infow("Reserved nonce for external transaction", "address", address, "nonce", nonce)
+	return nonce, nil
+}
+
+// ReleaseNonce releases an unused nonce reservation, see ReleaseNonce
+func (b *BulletproofTxManager) ReleaseNonce(address common.Address, nonce int64) error {
+	if err := ReleaseNonce(b.q, address, &b.chainID, nonce); err != nil {
+		return err
+	}
+	b.logger.Infow("Released nonce reservation for external transaction", "address", address, "nonce", nonce)
+	return nil
+}
@@ -0,0 +1,93 @@
+package bulletprooftxmanager_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestReserveNonce_ReleaseNonce(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	q := pg.NewQ(db, logger.TestLogger(t), cfg)
+	chainID := &cltest.FixtureChainID
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 3)
+
+	nextNonce := func(t *testing.T) int64 {
+		nonce, err := bulletprooftxmanager.GetNextNonce(q, fromAddress, chainID)
+		require.NoError(t, err)
+		return nonce
+	}
+
+	t.Run("reserves the next nonce with an external eth_tx", func(t *testing.T) {
+		nonce, err := bulletprooftxmanager.ReserveNonce(q, fromAddress, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), nonce)
+		assert.Equal(t, int64(4), nextNonce(t))
+
+		var etx bulletprooftxmanager.EthTx
+		require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE from_address = $1 AND nonce = 3`, fromAddress))
+		assert.Equal(t, bulletprooftxmanager.EthTxExternal, etx.State)
+		assert.Nil(t, etx.BroadcastAt)
+
+		nonce, err = bulletprooftxmanager.ReserveNonce(q, fromAddress, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), nonce)
+		assert.Equal(t, int64(5), nextNonce(t))
+	})
+
+	t.Run("does not release a nonce that is no longer the highest", func(t *testing.T) {
+		err := bulletprooftxmanager.ReleaseNonce(q, fromAddress, chainID, 3)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot release nonce 3")
+		assert.Equal(t, int64(5), nextNonce(t))
+	})
+
+	t.Run("releases the highest nonce", func(t *testing.T) {
+		require.NoError(t, bulletprooftxmanager.ReleaseNonce(q, fromAddress, chainID, 4))
+		assert.Equal(t, int64(4), nextNonce(t))
+		cltest.AssertCount(t, db, "eth_txes", 1)
+
+		require.Error(t, bulletprooftxmanager.ReleaseNonce(q, fromAddress, chainID, 4))
+	})
+
+	t.Run("does not release a nonce that has been consumed on chain", func(t *testing.T) {
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET broadcast_at = NOW() WHERE nonce = 3 AND from_address = $1`, fromAddress)
+
+		err := bulletprooftxmanager.ReleaseNonce(q, fromAddress, chainID, 3)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no unused reservation found for nonce 3")
+		assert.Equal(t, int64(4), nextNonce(t))
+	})
+
+	t.Run("does not reserve a nonce held by an in_progress transaction", func(t *testing.T) {
+		cltest.MustInsertInProgressEthTxWithAttempt(t, borm, 4, fromAddress)
+
+		_, err := bulletprooftxmanager.ReserveNonce(q, fromAddress, chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "nonce 4 for key")
+		assert.Equal(t, int64(4), nextNonce(t))
+	})
+
+	t.Run("errors for unknown key", func(t *testing.T) {
+		_, err := bulletprooftxmanager.ReserveNonce(q, cltest.NewAddress(), chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no key found matching address")
+
+		_, err = bulletprooftxmanager.ReserveNonce(q, fromAddress, utils.NewBigI(42).ToInt())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no key found matching address")
+	})
+}
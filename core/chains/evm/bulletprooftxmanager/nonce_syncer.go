@@ -11,9 +11,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/sqlx"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 )
@@ -39,23 +41,28 @@ type (
 	// we run the risk of being stuck with a gap in the nonce sequence that
 	// will never be filled.
 	//
-	// The solution is to query the chain for our own transactions and take
-	// ownership of them by writing them to the database and letting the
-	// EthConfirmer handle them as it would any other transaction.
+	// So that the database at least reflects what happened, the skipped
+	// nonces are imported as external eth_txes when fast-forwarding. Their
+	// transactions are looked for in the eth node's mempool with
+	// txpool_contentFrom, if it supports it, and in the last scanDepth
+	// blocks. We cannot query transactions from our account to infinite
+	// depth (geth does not support this), so those that are not found are
+	// imported as placeholders without a hash.
 	//
-	// This is not quite as straightforward as one might expect. We cannot
-	// query transactions from our account to infinite depth (geth does not
-	// support this). The best we can do is to query for all transactions sent
-	// within the past ETH_FINALITY_DEPTH blocks and find the ones sent by our
-	// address(es).
+	// Imported transactions are not handled by the EthConfirmer, and so are
+	// not re-org protected.
 	//
-	// This gives us re-org protection up to ETH_FINALITY_DEPTH deep in the
-	// worst case, which is in line with our other guarantees.
+	// The transactions found for the last on-chain nonce seen ahead of the
+	// local one are remembered per address, so that retrying a sync which
+	// failed to save them does not scan the same blocks again while the
+	// on-chain nonce hasn't moved.
 	NonceSyncer struct {
 		q         pg.Q
 		ethClient evmclient.Client
 		chainID   *big.Int
 		logger    logger.Logger
+		scanDepth uint32
+		scans     *externalScans
 	}
 	// NSinserttx represents an EthTx and Attempt to be inserted together
 	NSinserttx struct {
@@ -64,6 +71,17 @@ type (
 	}
 )
 
+const (
+	// defaultNonceSyncerScanDepth is how many blocks a NonceSyncer looks back
+	// for external transactions, unless created with the chain's finality
+	// depth
+	defaultNonceSyncerScanDepth = 50
+	// maxExternalImports is the most nonces imported for a key in one sync.
+	// When more were skipped, e.g. after restoring from an old backup, only
+	// the latest are imported.
+	maxExternalImports = 100
+)
+
 // NewNonceSyncer returns a new syncer
 func NewNonceSyncer(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, ethClient evmclient.Client) *NonceSyncer {
 	lggr = lggr.Named("NonceSyncer")
@@ -73,9 +91,19 @@ func NewNonceSyncer(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, ethClient
 		ethClient,
 		ethClient.ChainID(),
 		lggr,
+		defaultNonceSyncerScanDepth,
+		&externalScans{scans: make(map[common.Address]externalScan)},
 	}
 }
 
+// newChainNonceSyncer returns a syncer that looks for external transactions
+// within the chain's ETH_FINALITY_DEPTH
+func newChainNonceSyncer(db *sqlx.DB, lggr logger.Logger, cfg Config, ethClient evmclient.Client) *NonceSyncer {
+	s := NewNonceSyncer(db, lggr, cfg, ethClient)
+	s.scanDepth = cfg.EvmFinalityDepth()
+	return s
+}
+
 // SyncAll syncs nonces for all keys in parallel
 //
 // This should only be called once, before the EthBroadcaster has started.
@@ -84,11 +112,20 @@ func (s NonceSyncer) SyncAll(ctx context.Context, keyStates []ethkey.State) (mer
 	var wg sync.WaitGroup
 	var errMu sync.Mutex
 
+	addresses := make([]common.Address, len(keyStates))
+	for i, k := range keyStates {
+		addresses[i] = k.Address.Address()
+	}
+	keyNextNonces, err := GetNextNonces(s.q.WithOpts(pg.WithParentCtx(ctx)), addresses, s.chainID)
+	if err != nil {
+		return errors.Wrap(err, "NonceSyncer#SyncAll failed to load local nonces")
+	}
+
 	wg.Add(len(keyStates))
 	for _, keyState := range keyStates {
 		go func(k ethkey.State) {
 			defer wg.Done()
-			if err := s.fastForwardNonceIfNecessary(ctx, k.Address.Address()); err != nil {
+			if err := s.fastForwardNonceIfNecessary(ctx, k.Address.Address(), keyNextNonces); err != nil {
 				errMu.Lock()
 				defer errMu.Unlock()
 				merr = multierr.Combine(merr, err)
@@ -101,7 +138,22 @@ func (s NonceSyncer) SyncAll(ctx context.Context, keyStates []ethkey.State) (mer
 	return errors.Wrap(merr, "NonceSyncer#fastForwardNoncesIfNecessary failed")
 }
 
-func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address common.Address) error {
+// Sync syncs the nonce for a single key
+//
+// Like SyncAll, it only ever moves the local nonce forward. It may be called
+// while the EthBroadcaster is running, but the caller must make sure that the
+// key is not being processed concurrently, e.g. by calling it from within the
+// EthBroadcaster's own processing loop for that key.
+func (s NonceSyncer) Sync(ctx context.Context, keyState ethkey.State) error {
+	address := keyState.Address.Address()
+	keyNextNonces, err := GetNextNonces(s.q.WithOpts(pg.WithParentCtx(ctx)), []common.Address{address}, s.chainID)
+	if err != nil {
+		return errors.Wrap(err, "NonceSyncer#Sync failed to load local nonce")
+	}
+	return errors.Wrap(s.fastForwardNonceIfNecessary(ctx, address, keyNextNonces), "NonceSyncer#fastForwardNonceIfNecessary failed")
+}
+
+func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address common.Address, keyNextNonces map[common.Address]int64) error {
 	chainNonce, err := s.pendingNonceFromEthClient(ctx, address)
 	if err != nil {
 		return errors.Wrap(err, "GetNextNonce failed to loadInitialNonceFromEthClient")
@@ -111,9 +163,9 @@ func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address co
 	}
 
 	q := s.q.WithOpts(pg.WithParentCtx(ctx))
-	keyNextNonce, err := GetNextNonce(q, address, s.chainID)
-	if err != nil {
-		return err
+	keyNextNonce, exists := keyNextNonces[address]
+	if !exists {
+		return errors.Errorf("no key state found for address %s on chain %s", address.Hex(), s.chainID.String())
 	}
 
 	localNonce := keyNextNonce
@@ -142,9 +194,24 @@ func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address co
 	if hasInProgressTransaction {
 		newNextNonce--
 	}
+	// Nonces skipped while a transaction was in_progress can't be told
+	// apart from the one it will be sent with, so they are not imported
+	var imports []externalImport
+	if !hasInProgressTransaction {
+		var cached bool
+		imports, cached = s.scans.get(address, localNonce, int64(chainNonce))
+		if !cached {
+			imports = s.findExternalTransactions(ctx, address, localNonce, int64(chainNonce))
+			s.scans.set(address, localNonce, int64(chainNonce), imports)
+		}
+	}
+
 	//  We pass in next_nonce here as an optimistic lock to make sure it
 	//  didn't get changed out from under us. Shouldn't happen but can't hurt.
-	return q.Transaction(func(tx pg.Queryer) error {
+	err = q.Transaction(func(tx pg.Queryer) error {
+		if err := s.insertExternalTransactions(tx, address, imports); err != nil {
+			return err
+		}
 		res, err := tx.Exec(`UPDATE eth_key_states SET next_nonce = $1, updated_at = $2 WHERE address = $3 AND next_nonce = $4 AND evm_chain_id = $5`, newNextNonce, time.Now(), address, keyNextNonce, s.chainID.String())
 		if err != nil {
 			return errors.Wrap(err, "NonceSyncer#fastForwardNonceIfNecessary failed to update keys.next_nonce")
@@ -158,6 +225,173 @@ func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address co
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	s.scans.delete(address)
+	s.logger.Infow(fmt.Sprintf("Fast-forwarded next nonce for address %s from %v to %v", address.Hex(), keyNextNonce, newNextNonce), "address", address.Hex(), "oldNextNonce", keyNextNonce, "newNextNonce", newNextNonce)
+	if len(imports) > 0 {
+		var unknown []int64
+		for _, imp := range imports {
+			if imp.tx == nil {
+				unknown = append(unknown, imp.nonce)
+			}
+		}
+		s.logger.Infow(fmt.Sprintf("Imported %d external transactions for address %s, %d of which could not be located", len(imports), address.Hex(), len(unknown)), "address", address.Hex(), "imported", len(imports), "unknownNonces", unknown)
+	}
+	return nil
+}
+
+// externalImport is a nonce used outside of the node, and its transaction if
+// it was located
+type externalImport struct {
+	nonce int64
+	tx    *types.Transaction
+}
+
+// externalScan is the result of looking for the external transactions with
+// nonces in [from, to)
+type externalScan struct {
+	from, to int64
+	imports  []externalImport
+}
+
+// externalScans remembers the last externalScan of each address
+type externalScans struct {
+	mu    sync.Mutex
+	scans map[common.Address]externalScan
+}
+
+// get returns the imports found by the last scan of address, and false if
+// there was none or it was for different nonces
+func (e *externalScans) get(address common.Address, from, to int64) ([]externalImport, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	scan, exists := e.scans[address]
+	if !exists || scan.from != from || scan.to != to {
+		return nil, false
+	}
+	return scan.imports, true
+}
+
+func (e *externalScans) set(address common.Address, from, to int64, imports []externalImport) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.scans[address] = externalScan{from, to, imports}
+}
+
+func (e *externalScans) delete(address common.Address) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.scans, address)
+}
+
+// findExternalTransactions looks for the transactions from address with
+// nonces in [from, to). Failing to find them is not an error, their imports
+// are returned without a transaction instead.
+func (s NonceSyncer) findExternalTransactions(ctx context.Context, address common.Address, from, to int64) []externalImport {
+	if to-from > maxExternalImports {
+		s.logger.Warnw(fmt.Sprintf("Skipped %d nonces for address %s, only importing the latest %d", to-from, address.Hex(), maxExternalImports), "address", address.Hex(), "from", from, "to", to)
+		from = to - maxExternalImports
+	}
+	found := make(map[int64]*types.Transaction)
+	s.findInTxPool(ctx, address, from, to, found)
+	if int64(len(found)) < to-from {
+		s.findInBlocks(ctx, address, from, to, found)
+	}
+
+	imports := make([]externalImport, 0, to-from)
+	for nonce := from; nonce < to; nonce++ {
+		imports = append(imports, externalImport{nonce, found[nonce]})
+	}
+	return imports
+}
+
+// findInTxPool looks in the eth node's mempool, which not all eth nodes
+// expose
+func (s NonceSyncer) findInTxPool(ctx context.Context, address common.Address, from, to int64, found map[int64]*types.Transaction) {
+	// Pending and queued transactions, by nonce
+	var content map[string]map[string]*types.Transaction
+	if err := s.ethClient.CallContext(ctx, &content, "txpool_contentFrom", address); err != nil {
+		s.logger.Debugw("Could not look for external transactions in the mempool, txpool_contentFrom is likely not supported", "address", address.Hex(), "err", err)
+		return
+	}
+	for _, txes := range content {
+		for _, tx := range txes {
+			if tx == nil {
+				continue
+			}
+			if nonce := int64(tx.Nonce()); nonce >= from && nonce < to {
+				found[nonce] = tx
+			}
+		}
+	}
+}
+
+// findInBlocks looks in the last scanDepth blocks, latest first
+func (s NonceSyncer) findInBlocks(ctx context.Context, address common.Address, from, to int64, found map[int64]*types.Transaction) {
+	head, err := s.ethClient.HeadByNumber(ctx, nil)
+	if err != nil || head == nil {
+		s.logger.Warnw("Could not look for external transactions on chain, failed to get the latest head", "address", address.Hex(), "err", err)
+		return
+	}
+	signer := types.LatestSignerForChainID(s.chainID)
+	for n := head.Number; n >= 0 && n > head.Number-int64(s.scanDepth); n-- {
+		if int64(len(found)) == to-from {
+			return
+		}
+		block, err := s.ethClient.BlockByNumber(ctx, big.NewInt(n))
+		if err != nil {
+			s.logger.Warnw(fmt.Sprintf("Could not look for external transactions on chain, failed to get block %d", n), "address", address.Hex(), "err", err)
+			return
+		}
+		for _, tx := range block.Transactions() {
+			nonce := int64(tx.Nonce())
+			// Recovering the sender is expensive, so it's done last
+			if _, exists := found[nonce]; exists || nonce < from || nonce >= to {
+				continue
+			}
+			if sender, err := types.Sender(signer, tx); err == nil && sender == address {
+				found[nonce] = tx
+			}
+		}
+	}
+}
+
+// insertExternalTransactions inserts the imports as external eth_txes, with
+// placeholders for those without a transaction. Nonces that already have an
+// eth_tx, e.g. a reserved one, are left alone.
+func (s NonceSyncer) insertExternalTransactions(q pg.Queryer, address common.Address, imports []externalImport) error {
+	for _, imp := range imports {
+		var err error
+		if imp.tx == nil {
+			_, err = q.Exec(`
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, nonce, evm_chain_id, broadcast_at, created_at)
+VALUES ($1, $1, '\x', 0, 0, 'external', $2, $3, NOW(), NOW())
+ON CONFLICT DO NOTHING
+`, address, imp.nonce, s.chainID.String())
+		} else {
+			// A contract creation has no recipient, so it is recorded as
+			// being sent to itself like placeholders are
+			toAddress := address
+			if imp.tx.To() != nil {
+				toAddress = *imp.tx.To()
+			}
+			payload := imp.tx.Data()
+			if payload == nil {
+				payload = []byte{}
+			}
+			_, err = q.Exec(`
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, nonce, evm_chain_id, broadcast_at, created_at, external_hash)
+VALUES ($1, $2, $3, $4, $5, 'external', $6, $7, NOW(), NOW(), $8)
+ON CONFLICT DO NOTHING
+`, address, toAddress, payload, utils.NewBig(imp.tx.Value()), imp.tx.Gas(), imp.nonce, s.chainID.String(), imp.tx.Hash())
+		}
+		if err != nil {
+			return errors.Wrapf(err, "NonceSyncer#insertExternalTransactions failed to insert eth_tx for nonce %d", imp.nonce)
+		}
+	}
+	return nil
 }
 
 func (s NonceSyncer) pendingNonceFromEthClient(ctx context.Context, account common.Address) (nextNonce uint64, err error) {
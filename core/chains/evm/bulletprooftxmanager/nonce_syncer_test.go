@@ -2,10 +2,14 @@ package bulletprooftxmanager_test
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
@@ -115,6 +119,9 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 			// key1 has chain nonce of 5 which is ahead of local nonce 0
 			return key1 == addr
 		})).Return(uint64(5), nil)
+		// The skipped nonces can't be located, so placeholders are imported
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "txpool_contentFrom", key1).Return(errors.New("the method txpool_contentFrom does not exist/is not available"))
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("something exploded"))
 
 		ns := bulletprooftxmanager.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient)
 
@@ -123,6 +130,16 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 
 		assertDatabaseNonce(t, db, key1, 5)
 
+		var etxs []bulletprooftxmanager.EthTx
+		require.NoError(t, db.Select(&etxs, `SELECT * FROM eth_txes WHERE from_address = $1 ORDER BY nonce ASC`, key1))
+		require.Len(t, etxs, 5)
+		for i, etx := range etxs {
+			assert.Equal(t, bulletprooftxmanager.EthTxExternal, etx.State)
+			assert.Equal(t, int64(i), *etx.Nonce)
+			assert.Nil(t, etx.ExternalHash)
+			assert.NotNil(t, etx.BroadcastAt)
+		}
+
 		ethClient.AssertExpectations(t)
 	})
 
@@ -165,6 +182,179 @@ func Test_NonceSyncer_SyncAll(t *testing.T) {
 	})
 }
 
+func Test_NonceSyncer_Sync(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fast forwards only the given key if chain nonce is ahead of local nonce", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+		k1, key1 := cltest.MustInsertRandomKey(t, ethKeyStore, int64(0))
+		_, key2 := cltest.MustInsertRandomKey(t, ethKeyStore, int64(0))
+
+		ethClient.On("PendingNonceAt", mock.Anything, mock.MatchedBy(func(addr common.Address) bool {
+			return key1 == addr
+		})).Return(uint64(5), nil)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "txpool_contentFrom", key1).Return(errors.New("the method txpool_contentFrom does not exist/is not available"))
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("something exploded"))
+
+		ns := bulletprooftxmanager.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient)
+
+		require.NoError(t, ns.Sync(context.Background(), cltest.MustGetStateForKey(t, ethKeyStore, k1)))
+
+		assertDatabaseNonce(t, db, key1, 5)
+		assertDatabaseNonce(t, db, key2, 0)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("never decreases the local nonce if chain nonce is behind", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+		k1, key1 := cltest.MustInsertRandomKey(t, ethKeyStore, int64(32))
+
+		ethClient.On("PendingNonceAt", mock.Anything, mock.MatchedBy(func(addr common.Address) bool {
+			return key1 == addr
+		})).Return(uint64(31), nil)
+
+		ns := bulletprooftxmanager.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient)
+
+		require.NoError(t, ns.Sync(context.Background(), cltest.MustGetStateForKey(t, ethKeyStore, k1)))
+
+		assertDatabaseNonce(t, db, key1, 32)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("returns error if PendingNonceAt fails", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+		k1, key1 := cltest.MustInsertRandomKey(t, ethKeyStore, int64(0))
+
+		ethClient.On("PendingNonceAt", mock.Anything, mock.MatchedBy(func(addr common.Address) bool {
+			return key1 == addr
+		})).Return(uint64(0), errors.New("something exploded"))
+
+		ns := bulletprooftxmanager.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient)
+
+		err := ns.Sync(context.Background(), cltest.MustGetStateForKey(t, ethKeyStore, k1))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "something exploded")
+
+		assertDatabaseNonce(t, db, key1, 0)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("does not look for external transactions again while the on-chain nonce is unchanged", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+		k1, key1 := cltest.MustInsertRandomKey(t, ethKeyStore, int64(0))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		ethClient.On("PendingNonceAt", mock.Anything, key1).Return(uint64(2), nil)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "txpool_contentFrom", key1).Return(errors.New("the method txpool_contentFrom does not exist/is not available")).Once()
+		// Cancelling the context makes the first sync fail to save what it
+		// found
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("something exploded")).Run(func(mock.Arguments) { cancel() }).Once()
+
+		ns := bulletprooftxmanager.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient)
+
+		require.Error(t, ns.Sync(ctx, cltest.MustGetStateForKey(t, ethKeyStore, k1)))
+		assertDatabaseNonce(t, db, key1, 0)
+		cltest.AssertCount(t, db, "eth_txes", 0)
+
+		require.NoError(t, ns.Sync(context.Background(), cltest.MustGetStateForKey(t, ethKeyStore, k1)))
+		assertDatabaseNonce(t, db, key1, 2)
+		cltest.AssertCount(t, db, "eth_txes", 2)
+
+		ethClient.AssertExpectations(t)
+		ethClient.AssertNumberOfCalls(t, "CallContext", 1)
+		ethClient.AssertNumberOfCalls(t, "HeadByNumber", 1)
+		ethClient.AssertNotCalled(t, "BlockByNumber", mock.Anything, mock.Anything)
+	})
+}
+
+func Test_NonceSyncer_ImportsExternalTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	key := cltest.MustGenerateRandomKey(t)
+	cltest.MustAddKeyToKeystore(t, key, big.NewInt(cltest.SimulatedBackendEVMChainID), ethKeyStore)
+	address := key.Address.Address()
+
+	backend := cltest.NewSimulatedBackend(t, core.GenesisAlloc{address: {Balance: assets.Ether(10)}}, 10e6)
+	ethClient := cltest.NewSimulatedBackendClient(t, backend)
+
+	// An operator sends two transactions from the key with another wallet,
+	// the first too long ago to be found
+	signer := types.LatestSignerForChainID(ethClient.ChainID())
+	toAddress := cltest.NewAddress()
+	var sent []*types.Transaction
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		gasPrice, err := backend.SuggestGasPrice(context.Background())
+		require.NoError(t, err)
+		tx, err := types.SignTx(types.NewTransaction(nonce, toAddress, big.NewInt(142), 21000, gasPrice, []byte{1, 2, 3}), signer, key.ToEcdsaPrivKey())
+		require.NoError(t, err)
+		require.NoError(t, backend.SendTransaction(context.Background(), tx))
+		backend.Commit()
+		sent = append(sent, tx)
+		if nonce == 0 {
+			for i := 0; i < 60; i++ {
+				backend.Commit()
+			}
+		}
+	}
+
+	ns := bulletprooftxmanager.NewNonceSyncer(db, logger.TestLogger(t), cfg, ethClient)
+	require.NoError(t, ns.Sync(context.Background(), cltest.MustGetStateForKey(t, ethKeyStore, key)))
+
+	assertDatabaseNonce(t, db, address, 2)
+
+	var etxs []bulletprooftxmanager.EthTx
+	require.NoError(t, db.Select(&etxs, `SELECT * FROM eth_txes WHERE from_address = $1 ORDER BY nonce ASC`, address))
+	require.Len(t, etxs, 2)
+	for i, etx := range etxs {
+		assert.Equal(t, bulletprooftxmanager.EthTxExternal, etx.State)
+		assert.Equal(t, int64(i), *etx.Nonce)
+		assert.NotNil(t, etx.BroadcastAt)
+	}
+
+	// The first is a placeholder
+	assert.Nil(t, etxs[0].ExternalHash)
+	assert.Equal(t, address, etxs[0].ToAddress)
+
+	// The second was found on chain
+	require.NotNil(t, etxs[1].ExternalHash)
+	assert.Equal(t, sent[1].Hash(), *etxs[1].ExternalHash)
+	assert.Equal(t, toAddress, etxs[1].ToAddress)
+	assert.Equal(t, []byte{1, 2, 3}, etxs[1].EncodedPayload)
+	assert.Equal(t, assets.NewEthValue(142), etxs[1].Value)
+	assert.Equal(t, uint64(21000), etxs[1].GasLimit)
+
+	// Syncing again does nothing
+	require.NoError(t, ns.Sync(context.Background(), cltest.MustGetStateForKey(t, ethKeyStore, key)))
+	cltest.AssertCount(t, db, "eth_txes", 2)
+}
+
 func assertDatabaseNonce(t *testing.T, db *sqlx.DB, address common.Address, nonce int64) {
 	t.Helper()
 
@@ -1,11 +1,14 @@
 package bulletprooftxmanager
 
 import (
+	"encoding/json"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -20,11 +23,16 @@ type ORM interface {
 	EthTxAttempts(offset, limit int) ([]EthTxAttempt, int, error)
 	FindEthTxAttempt(hash common.Hash) (*EthTxAttempt, error)
 	FindEthTxAttemptsByEthTxIDs(ids []int64) ([]EthTxAttempt, error)
+	FindEthTxAttemptsByHashes(hashes []common.Hash) ([]EthTxAttempt, error)
 	FindEthTxByHash(hash common.Hash) (*EthTx, error)
 	InsertEthTxAttempt(attempt *EthTxAttempt) error
 	InsertEthTx(etx *EthTx) error
 	InsertEthReceipt(receipt *EthReceipt) error
 	FindEthTxWithAttempts(etxID int64) (etx EthTx, err error)
+	CountEthTxesByState(chainID *big.Int, qopts ...pg.QOpt) (map[EthTxState]int64, error)
+	CountUnresumedPipelineCallbacks(chainID *big.Int, qopts ...pg.QOpt) (int64, error)
+	TxQueueSummaries(subjects []uuid.UUID, qopts ...pg.QOpt) (map[uuid.UUID]TxQueueSummary, error)
+	EthTxesForMeta(filter EthTxMeta, qopts ...pg.QOpt) ([]EthTx, error)
 }
 
 type orm struct {
@@ -172,6 +180,21 @@ func (o *orm) FindEthTxAttemptsByEthTxIDs(ids []int64) ([]EthTxAttempt, error) {
 	return attempts, nil
 }
 
+// FindEthTxAttemptsByHashes returns the attempts with the given hashes, with
+// their EthTx preloaded. Hashes without an attempt are left out.
+func (o *orm) FindEthTxAttemptsByHashes(hashes []common.Hash) ([]EthTxAttempt, error) {
+	hashBytes := make([][]byte, len(hashes))
+	for i, hash := range hashes {
+		hashBytes[i] = hash.Bytes()
+	}
+	var attempts []EthTxAttempt
+	if err := o.q.Select(&attempts, `SELECT * FROM eth_tx_attempts WHERE hash = ANY($1) ORDER BY id ASC`, pq.Array(hashBytes)); err != nil {
+		return nil, errors.Wrap(err, "FindEthTxAttemptsByHashes failed to load eth_tx_attempts")
+	}
+	err := o.preloadTxes(attempts)
+	return attempts, errors.Wrap(err, "FindEthTxAttemptsByHashes failed to load eth_txes")
+}
+
 func (o *orm) FindEthTxByHash(hash common.Hash) (*EthTx, error) {
 	var etx EthTx
 
@@ -187,21 +210,55 @@ func (o *orm) FindEthTxByHash(hash common.Hash) (*EthTx, error) {
 	return &etx, errors.Wrap(err, "FindEthTxByHash failed")
 }
 
+// EthTxesForMeta returns every transaction whose meta matches all the fields
+// set on filter, oldest first. Only JobID, RequestID, UpkeepID, RoundID,
+// Epoch and Round are matched on; a zero JobID or RequestID matches anything.
+func (o *orm) EthTxesForMeta(filter EthTxMeta, qopts ...pg.QOpt) (etxs []EthTx, err error) {
+	match := make(map[string]interface{})
+	if filter.JobID != 0 {
+		match["JobID"] = filter.JobID
+	}
+	if filter.RequestID != (common.Hash{}) {
+		match["RequestID"] = filter.RequestID
+	}
+	if filter.UpkeepID != nil {
+		match["UpkeepID"] = *filter.UpkeepID
+	}
+	if filter.RoundID != nil {
+		match["RoundID"] = *filter.RoundID
+	}
+	if filter.Epoch != nil {
+		match["Epoch"] = *filter.Epoch
+	}
+	if filter.Round != nil {
+		match["Round"] = *filter.Round
+	}
+	if len(match) == 0 {
+		return nil, errors.New("EthTxesForMeta: filter must set at least one of JobID, RequestID, UpkeepID, RoundID, Epoch or Round")
+	}
+	b, err := json.Marshal(match)
+	if err != nil {
+		return nil, errors.Wrap(err, "EthTxesForMeta failed to marshal filter")
+	}
+	err = o.q.WithOpts(qopts...).Select(&etxs, `SELECT * FROM eth_txes WHERE meta @> $1::jsonb ORDER BY id ASC`, string(b))
+	return etxs, errors.Wrap(err, "EthTxesForMeta failed")
+}
+
 // InsertEthTxAttempt inserts a new txAttempt into the database
 func (o *orm) InsertEthTx(etx *EthTx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO eth_txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, access_list, simulate) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :access_list, :simulate
+	const insertEthTxSQL = `INSERT INTO eth_txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, access_list, simulate, simulation_mode, priority, max_fee_wei, fatal_reason, deadline, pacing_tag, pacing_interval, max_gas_price_wei, strict_sequencing) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :access_list, :simulate, :simulation_mode, :priority, :max_fee_wei, :fatal_reason, :deadline, :pacing_tag, :pacing_interval, :max_gas_price_wei, :strict_sequencing
 ) RETURNING *`
 	err := o.q.GetNamed(insertEthTxSQL, etx, etx)
 	return errors.Wrap(err, "InsertEthTx failed")
 }
 
 func (o *orm) InsertEthTxAttempt(attempt *EthTxAttempt) error {
-	const insertEthTxAttemptSQL = `INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap) VALUES (
-:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap
+	const insertEthTxAttemptSQL = `INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_manual, unclamped_gas_price, max_gas_price_policy, estimator_inputs, deadline_boost_factor) VALUES (
+:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_manual, :unclamped_gas_price, :max_gas_price_policy, :estimator_inputs, :deadline_boost_factor
 ) RETURNING *`
 	err := o.q.GetNamed(insertEthTxAttemptSQL, attempt, attempt)
 	return errors.Wrap(err, "InsertEthTxAttempt failed")
@@ -263,3 +320,112 @@ func loadEthTxesAttemptsReceipts(q pg.Queryer, etxs []*EthTx) (err error) {
 	}
 	return nil
 }
+
+// CountEthTxesByState returns the number of eth_txes on the given chain in
+// each state. States with no transactions are omitted.
+func (o *orm) CountEthTxesByState(chainID *big.Int, qopts ...pg.QOpt) (map[EthTxState]int64, error) {
+	var rows []struct {
+		State EthTxState
+		Count int64
+	}
+	err := o.q.WithOpts(qopts...).Select(&rows, `SELECT state, count(*) FROM eth_txes WHERE evm_chain_id = $1 GROUP BY state`, chainID.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "CountEthTxesByState failed")
+	}
+	counts := make(map[EthTxState]int64, len(rows))
+	for _, r := range rows {
+		counts[r.State] = r.Count
+	}
+	return counts, nil
+}
+
+// CountUnresumedPipelineCallbacks returns the number of suspended pipeline
+// runs on the given chain that are waiting on an eth_tx to resume them
+func (o *orm) CountUnresumedPipelineCallbacks(chainID *big.Int, qopts ...pg.QOpt) (count int64, err error) {
+	err = o.q.WithOpts(qopts...).Get(&count, `
+SELECT count(DISTINCT pipeline_task_runs.id) FROM pipeline_task_runs
+INNER JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+INNER JOIN eth_txes ON eth_txes.pipeline_task_run_id = pipeline_task_runs.id
+WHERE pipeline_runs.state = 'suspended' AND eth_txes.evm_chain_id = $1`, chainID.String())
+	return count, errors.Wrap(err, "CountUnresumedPipelineCallbacks failed")
+}
+
+// TxQueueSummary summarizes the transactions queued under a single subject
+// (see TxStrategy#Subject)
+type TxQueueSummary struct {
+	Subject     uuid.UUID
+	Unstarted   int64
+	Unconfirmed int64
+	// OldestPendingAt is the creation time of the oldest unstarted,
+	// in_progress or unconfirmed transaction, if any
+	OldestPendingAt *time.Time
+	// LastConfirmedTxHash and LastConfirmedAt are the hash and receipt time
+	// of the most recently confirmed transaction, if any
+	LastConfirmedTxHash *common.Hash
+	LastConfirmedAt     *time.Time
+}
+
+// TxQueueSummaries returns a summary of the transaction queue for each of
+// the given subjects. Subjects without any transactions are included with
+// zero counts.
+func (o *orm) TxQueueSummaries(subjects []uuid.UUID, qopts ...pg.QOpt) (map[uuid.UUID]TxQueueSummary, error) {
+	summaries := make(map[uuid.UUID]TxQueueSummary, len(subjects))
+	if len(subjects) == 0 {
+		return summaries, nil
+	}
+	ids := make([]string, len(subjects))
+	for i, s := range subjects {
+		ids[i] = s.String()
+		summaries[s] = TxQueueSummary{Subject: s}
+	}
+	q := o.q.WithOpts(qopts...)
+
+	var pending []struct {
+		Subject         uuid.UUID
+		Unstarted       int64
+		Unconfirmed     int64
+		OldestPendingAt *time.Time
+	}
+	err := q.Select(&pending, `
+SELECT subject,
+	count(*) FILTER (WHERE state = 'unstarted') AS unstarted,
+	count(*) FILTER (WHERE state = 'unconfirmed') AS unconfirmed,
+	min(created_at) FILTER (WHERE state IN ('unstarted', 'in_progress', 'unconfirmed')) AS oldest_pending_at
+FROM eth_txes
+WHERE subject = ANY($1::uuid[])
+GROUP BY subject`, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "TxQueueSummaries failed to load pending transactions")
+	}
+	for _, p := range pending {
+		s := summaries[p.Subject]
+		s.Unstarted = p.Unstarted
+		s.Unconfirmed = p.Unconfirmed
+		s.OldestPendingAt = p.OldestPendingAt
+		summaries[p.Subject] = s
+	}
+
+	var confirmed []struct {
+		Subject   uuid.UUID
+		TxHash    common.Hash
+		CreatedAt time.Time
+	}
+	err = q.Select(&confirmed, `
+SELECT DISTINCT ON (eth_txes.subject) eth_txes.subject, eth_receipts.tx_hash, eth_receipts.created_at
+FROM eth_txes
+INNER JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+INNER JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+WHERE eth_txes.subject = ANY($1::uuid[]) AND eth_txes.state = 'confirmed'
+ORDER BY eth_txes.subject, eth_receipts.created_at DESC, eth_receipts.id DESC`, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "TxQueueSummaries failed to load confirmed transactions")
+	}
+	for _, c := range confirmed {
+		c := c
+		s := summaries[c.Subject]
+		s.LastConfirmedTxHash = &c.TxHash
+		s.LastConfirmedAt = &c.CreatedAt
+		summaries[c.Subject] = s
+	}
+	return summaries, nil
+}
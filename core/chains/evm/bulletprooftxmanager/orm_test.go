@@ -1,12 +1,18 @@
 package bulletprooftxmanager_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	uuid "github.com/satori/go.uuid"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/pg/datatypes"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/stretchr/testify/assert"
@@ -101,6 +107,28 @@ func TestORM_EthTransactions(t *testing.T) {
 	assert.Len(t, txs[1].EthTxAttempts, 0)
 }
 
+func TestORM_FindEthTxAttemptsByHashes(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	tx1 := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, orm, 0, 1, from)
+	tx2 := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, orm, 1, 2, from)
+
+	attempts, err := orm.FindEthTxAttemptsByHashes([]common.Hash{tx2.EthTxAttempts[0].Hash, utils.NewHash(), tx1.EthTxAttempts[0].Hash})
+	require.NoError(t, err)
+	require.Len(t, attempts, 2, "hashes without an attempt are left out")
+	assert.Equal(t, tx1.ID, attempts[0].EthTx.ID, "eth txes are preloaded")
+	assert.Equal(t, tx2.ID, attempts[1].EthTx.ID)
+
+	attempts, err = orm.FindEthTxAttemptsByHashes(nil)
+	require.NoError(t, err)
+	assert.Len(t, attempts, 0)
+}
+
 func TestORM(t *testing.T) {
 	t.Parallel()
 
@@ -171,3 +199,105 @@ func TestORM(t *testing.T) {
 		assert.Equal(t, r.BlockHash, etx.EthTxAttempts[0].EthReceipts[0].BlockHash)
 	})
 }
+
+func TestORM_TxQueueSummaries(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	queued := uuid.NewV4()
+	confirmed := uuid.NewV4()
+	empty := uuid.NewV4()
+
+	cltest.MustInsertUnstartedEthTx(t, orm, from, queued)
+	cltest.MustInsertUnstartedEthTx(t, orm, from, queued)
+	unconfirmed := cltest.MustInsertUnconfirmedEthTx(t, orm, 0, from)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET subject = $1, created_at = '2000-01-01 00:00:00+00' WHERE id = $2`, queued, unconfirmed.ID)
+
+	etx1 := cltest.MustInsertConfirmedEthTxWithReceipt(t, orm, from, 1, 1)
+	etx2 := cltest.MustInsertConfirmedEthTxWithReceipt(t, orm, from, 2, 2)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET subject = $1 WHERE id IN ($2, $3)`, confirmed, etx1.ID, etx2.ID)
+	// Transactions without a subject are ignored
+	cltest.MustInsertUnstartedEthTx(t, orm, from)
+
+	summaries, err := orm.TxQueueSummaries([]uuid.UUID{queued, confirmed, empty})
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+
+	s := summaries[queued]
+	assert.Equal(t, queued, s.Subject)
+	assert.Equal(t, int64(2), s.Unstarted)
+	assert.Equal(t, int64(1), s.Unconfirmed)
+	require.NotNil(t, s.OldestPendingAt)
+	assert.True(t, s.OldestPendingAt.Equal(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Nil(t, s.LastConfirmedTxHash)
+	assert.Nil(t, s.LastConfirmedAt)
+
+	s = summaries[confirmed]
+	assert.Equal(t, int64(0), s.Unstarted)
+	assert.Equal(t, int64(0), s.Unconfirmed)
+	assert.Nil(t, s.OldestPendingAt)
+	require.NotNil(t, s.LastConfirmedTxHash)
+	assert.Equal(t, etx2.EthTxAttempts[0].Hash, *s.LastConfirmedTxHash)
+	assert.NotNil(t, s.LastConfirmedAt)
+
+	assert.Equal(t, bulletprooftxmanager.TxQueueSummary{Subject: empty}, summaries[empty])
+
+	summaries, err = orm.TxQueueSummaries(nil)
+	require.NoError(t, err)
+	assert.Len(t, summaries, 0)
+}
+
+func TestORM_EthTxesForMeta(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	upkeepID := int64(7)
+	round1, round2 := uint32(1), uint32(2)
+	insert := func(meta bulletprooftxmanager.EthTxMeta) bulletprooftxmanager.EthTx {
+		etx := cltest.NewEthTx(t, from)
+		b, err := json.Marshal(meta)
+		require.NoError(t, err)
+		m := datatypes.JSON(b)
+		etx.Meta = &m
+		require.NoError(t, orm.InsertEthTx(&etx))
+		return etx
+	}
+	fmTx1 := insert(bulletprooftxmanager.EthTxMeta{JobID: 1, RoundID: &round1})
+	fmTx2 := insert(bulletprooftxmanager.EthTxMeta{JobID: 1, RoundID: &round2})
+	keeperTx := insert(bulletprooftxmanager.EthTxMeta{JobID: 2, UpkeepID: &upkeepID})
+
+	t.Run("matches on every set field", func(t *testing.T) {
+		etxs, err := orm.EthTxesForMeta(bulletprooftxmanager.EthTxMeta{JobID: 1})
+		require.NoError(t, err)
+		require.Len(t, etxs, 2)
+		assert.Equal(t, fmTx1.ID, etxs[0].ID)
+		assert.Equal(t, fmTx2.ID, etxs[1].ID)
+
+		etxs, err = orm.EthTxesForMeta(bulletprooftxmanager.EthTxMeta{JobID: 1, RoundID: &round2})
+		require.NoError(t, err)
+		require.Len(t, etxs, 1)
+		assert.Equal(t, fmTx2.ID, etxs[0].ID)
+
+		etxs, err = orm.EthTxesForMeta(bulletprooftxmanager.EthTxMeta{UpkeepID: &upkeepID})
+		require.NoError(t, err)
+		require.Len(t, etxs, 1)
+		assert.Equal(t, keeperTx.ID, etxs[0].ID)
+
+		etxs, err = orm.EthTxesForMeta(bulletprooftxmanager.EthTxMeta{JobID: 2, RoundID: &round1})
+		require.NoError(t, err)
+		assert.Len(t, etxs, 0)
+	})
+
+	t.Run("errors on an empty filter", func(t *testing.T) {
+		_, err := orm.EthTxesForMeta(bulletprooftxmanager.EthTxMeta{})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,178 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Outcome is the terminal outcome of a transaction
+type Outcome string
+
+const (
+	// OutcomeConfirmed is emitted when a receipt is saved for the transaction
+	OutcomeConfirmed = Outcome("confirmed")
+	// OutcomeFatalError is emitted when the transaction is marked fatal_error
+	// before it was ever broadcast
+	OutcomeFatalError = Outcome("fatal_error")
+	// OutcomeDeadLetter is emitted when the transaction was broadcast, but is
+	// given up on because no receipt was found for any of its attempts
+	// before they passed the finality depth
+	OutcomeDeadLetter = Outcome("dead_letter")
+
+	outcomeEmitterQueueSize = 1000
+	outcomeEmitterTimeout   = 10 * time.Second
+)
+
+// OutcomeRecord is the record emitted for a transaction that reached a
+// terminal outcome
+type OutcomeRecord struct {
+	Outcome     Outcome        `json:"outcome"`
+	EthTxID     int64          `json:"ethTxID"`
+	EVMChainID  string         `json:"evmChainID"`
+	FromAddress common.Address `json:"fromAddress"`
+	ToAddress   common.Address `json:"toAddress"`
+	Nonce       *int64         `json:"nonce,omitempty"`
+	// TxHash and BlockNumber are only set for OutcomeConfirmed
+	TxHash      *common.Hash `json:"txHash,omitempty"`
+	BlockNumber *int64       `json:"blockNumber,omitempty"`
+	// Error and FatalReason are only set for OutcomeFatalError and
+	// OutcomeDeadLetter
+	Error       string      `json:"error,omitempty"`
+	FatalReason FatalReason `json:"fatalReason,omitempty"`
+	// CorrelationID is the pipeline task run ID if the transaction was
+	// created by a pipeline run, otherwise the transaction's subject (if any)
+	CorrelationID string `json:"correlationID,omitempty"`
+	// Origin is what created the transaction, if known
+	Origin    *EthTxOrigin `json:"origin,omitempty"`
+	Timestamp time.Time    `json:"timestamp"`
+}
+
+// NewOutcomeRecord builds an OutcomeRecord for the given transaction
+func NewOutcomeRecord(outcome Outcome, etx EthTx) OutcomeRecord {
+	var correlationID string
+	if etx.PipelineTaskRunID.Valid {
+		correlationID = etx.PipelineTaskRunID.UUID.String()
+	} else if etx.Subject.Valid {
+		correlationID = etx.Subject.UUID.String()
+	}
+	var fatalReason FatalReason
+	if etx.FatalReason != nil {
+		fatalReason = *etx.FatalReason
+	}
+	return OutcomeRecord{
+		Outcome:       outcome,
+		EthTxID:       etx.ID,
+		EVMChainID:    etx.EVMChainID.String(),
+		FromAddress:   etx.FromAddress,
+		ToAddress:     etx.ToAddress,
+		Nonce:         etx.Nonce,
+		Error:         etx.Error.String,
+		FatalReason:   fatalReason,
+		CorrelationID: correlationID,
+		Origin:        etx.Origin().OrNil(),
+		Timestamp:     time.Now(),
+	}
+}
+
+// OutcomeSink publishes transaction outcome records to a downstream system
+type OutcomeSink interface {
+	Publish(ctx context.Context, record OutcomeRecord) error
+}
+
+// NoopOutcomeSink discards all records. It is used unless another sink is
+// configured.
+type NoopOutcomeSink struct{}
+
+// Publish does nothing
+func (NoopOutcomeSink) Publish(context.Context, OutcomeRecord) error { return nil }
+
+// NewOutcomeSink returns the sink configured by ETH_TX_OUTCOME_KAFKA_URL, or
+// a NoopOutcomeSink if none is configured
+func NewOutcomeSink(lggr logger.Logger, config Config) OutcomeSink {
+	if u := config.EthTxOutcomeKafkaURL(); u != nil {
+		return NewKafkaOutcomeSink(lggr, *u, config.EthTxOutcomeKafkaTopic())
+	}
+	return NoopOutcomeSink{}
+}
+
+// OutcomeEmitter asynchronously publishes transaction outcome records to a
+// sink. Emitting never blocks the caller and sink failures are only logged,
+// so a slow or broken sink can never hold up transactions. If the queue is
+// full the record is dropped and logged instead.
+type OutcomeEmitter struct {
+	sink      OutcomeSink
+	log       logger.Logger
+	chRecords chan OutcomeRecord
+	chStop    chan struct{}
+	chDone    chan struct{}
+	// hooks is optional, see NewTx.OnOutcome
+	hooks *outcomeHooks
+}
+
+// NewOutcomeEmitter instantiates a new emitter that publishes to sink
+func NewOutcomeEmitter(lggr logger.Logger, sink OutcomeSink) *OutcomeEmitter {
+	return &OutcomeEmitter{
+		sink,
+		lggr.Named("OutcomeEmitter"),
+		make(chan OutcomeRecord, outcomeEmitterQueueSize),
+		make(chan struct{}),
+		make(chan struct{}),
+		nil,
+	}
+}
+
+// Start the emitter. Should only be called once.
+func (e *OutcomeEmitter) Start() {
+	go e.runLoop()
+}
+
+// Stop the emitter. Should only be called once. Records that have not yet
+// been published are discarded.
+func (e *OutcomeEmitter) Stop() {
+	close(e.chStop)
+	<-e.chDone
+}
+
+// Emit calls the OutcomeCallback of the record's transaction, if any, and
+// queues the record for publishing
+func (e *OutcomeEmitter) Emit(record OutcomeRecord) {
+	if e.hooks != nil {
+		e.hooks.notify(record)
+	}
+	if _, ok := e.sink.(NoopOutcomeSink); ok {
+		return
+	}
+	select {
+	case e.chRecords <- record:
+	default:
+		e.log.Errorw("OutcomeEmitter: queue is full, dropping record", "record", record)
+	}
+}
+
+func (e *OutcomeEmitter) runLoop() {
+	defer close(e.chDone)
+	ctx, cancel := utils.ContextFromChan(e.chStop)
+	defer cancel()
+
+	for {
+		select {
+		case <-e.chStop:
+			return
+		case record := <-e.chRecords:
+			e.publish(ctx, record)
+		}
+	}
+}
+
+func (e *OutcomeEmitter) publish(ctx context.Context, record OutcomeRecord) {
+	ctx, cancel := context.WithTimeout(ctx, outcomeEmitterTimeout)
+	defer cancel()
+	if err := e.sink.Publish(ctx, record); err != nil {
+		e.log.Errorw("OutcomeEmitter: failed to publish record", "record", record, "err", err)
+	}
+}
@@ -0,0 +1,196 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+// fakeOutcomeSink collects the published records, failing the first
+// nFailures times
+type fakeOutcomeSink struct {
+	records   chan bulletprooftxmanager.OutcomeRecord
+	nFailures int
+}
+
+func newFakeOutcomeSink() *fakeOutcomeSink {
+	return &fakeOutcomeSink{records: make(chan bulletprooftxmanager.OutcomeRecord, 10)}
+}
+
+func (s *fakeOutcomeSink) Publish(_ context.Context, record bulletprooftxmanager.OutcomeRecord) error {
+	if s.nFailures > 0 {
+		s.nFailures--
+		return errors.New("sink is down")
+	}
+	s.records <- record
+	return nil
+}
+
+func (s *fakeOutcomeSink) awaitRecord(t *testing.T) bulletprooftxmanager.OutcomeRecord {
+	t.Helper()
+	select {
+	case record := <-s.records:
+		return record
+	case <-time.After(cltest.WaitTimeout(t)):
+		t.Fatal("timed out waiting for outcome record")
+	}
+	return bulletprooftxmanager.OutcomeRecord{}
+}
+
+func (s *fakeOutcomeSink) assertNoRecords(t *testing.T) {
+	t.Helper()
+	select {
+	case record := <-s.records:
+		t.Fatalf("unexpected outcome record: %v", record)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestOutcomeEmitter(t *testing.T) {
+	t.Parallel()
+
+	fromAddress := cltest.NewAddress()
+
+	t.Run("publishes records to the sink", func(t *testing.T) {
+		sink := newFakeOutcomeSink()
+		e := bulletprooftxmanager.NewOutcomeEmitter(logger.TestLogger(t), sink)
+		e.Start()
+		t.Cleanup(e.Stop)
+
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.ID = 42
+		e.Emit(bulletprooftxmanager.NewOutcomeRecord(bulletprooftxmanager.OutcomeConfirmed, etx))
+
+		record := sink.awaitRecord(t)
+		assert.Equal(t, bulletprooftxmanager.OutcomeConfirmed, record.Outcome)
+		assert.Equal(t, int64(42), record.EthTxID)
+		assert.Equal(t, fromAddress, record.FromAddress)
+		assert.Equal(t, etx.ToAddress, record.ToAddress)
+	})
+
+	t.Run("keeps publishing after the sink fails", func(t *testing.T) {
+		sink := newFakeOutcomeSink()
+		sink.nFailures = 1
+		e := bulletprooftxmanager.NewOutcomeEmitter(logger.TestLogger(t), sink)
+		e.Start()
+		t.Cleanup(e.Stop)
+
+		etx1 := cltest.NewEthTx(t, fromAddress)
+		etx1.ID = 1
+		etx2 := cltest.NewEthTx(t, fromAddress)
+		etx2.ID = 2
+		e.Emit(bulletprooftxmanager.NewOutcomeRecord(bulletprooftxmanager.OutcomeFatalError, etx1))
+		e.Emit(bulletprooftxmanager.NewOutcomeRecord(bulletprooftxmanager.OutcomeFatalError, etx2))
+
+		// The first record is lost, the emitter does not retry
+		assert.Equal(t, int64(2), sink.awaitRecord(t).EthTxID)
+		sink.assertNoRecords(t)
+	})
+}
+
+func TestBulletproofTxManager_OnOutcome(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, evmcfg, ethKeyStore, nil, logger.TestLogger(t))
+	// Started without keys so that transactions are only processed when we ask
+	eb := bptxm.NewEthBroadcaster([]ethkey.State{})
+	require.NoError(t, eb.Start())
+	t.Cleanup(func() { assert.NoError(t, eb.Close()) })
+
+	var records []bulletprooftxmanager.OutcomeRecord
+	etx, err := bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+		FromAddress:    fromAddress,
+		ToAddress:      cltest.NewAddress(),
+		EncodedPayload: []byte{1, 2, 3},
+		GasLimit:       21000,
+		Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+		OnOutcome: func(record bulletprooftxmanager.OutcomeRecord) {
+			records = append(records, record)
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, bptxm.PendingOutcomeCallbacks())
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(0)
+	})).Return(errors.New("exceeds block gas limit")).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+	require.Len(t, records, 1)
+	assert.Equal(t, bulletprooftxmanager.OutcomeFatalError, records[0].Outcome)
+	assert.Equal(t, bulletprooftxmanager.FatalReasonSendFatal, records[0].FatalReason)
+	assert.Equal(t, etx.ID, records[0].EthTxID)
+	// Callbacks are only called once
+	assert.Equal(t, 0, bptxm.PendingOutcomeCallbacks())
+}
+
+func TestKafkaOutcomeSink(t *testing.T) {
+	t.Parallel()
+
+	type produceRequest struct {
+		Records []struct {
+			Key   string                             `json:"key"`
+			Value bulletprooftxmanager.OutcomeRecord `json:"value"`
+		} `json:"records"`
+	}
+	requests := make(chan produceRequest, 1)
+	status := int32(http.StatusOK)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/kafka/topics/tx-outcomes", r.URL.Path)
+		assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req produceRequest
+		require.NoError(t, json.Unmarshal(body, &req))
+		requests <- req
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL + "/kafka")
+	require.NoError(t, err)
+
+	sink := bulletprooftxmanager.NewKafkaOutcomeSink(logger.TestLogger(t), *u, "tx-outcomes")
+
+	etx := cltest.NewEthTx(t, cltest.NewAddress())
+	etx.ID = 42
+	record := bulletprooftxmanager.NewOutcomeRecord(bulletprooftxmanager.OutcomeConfirmed, etx)
+	require.NoError(t, sink.Publish(context.Background(), record))
+
+	req := <-requests
+	require.Len(t, req.Records, 1)
+	assert.Equal(t, "42", req.Records[0].Key)
+	assert.Equal(t, bulletprooftxmanager.OutcomeConfirmed, req.Records[0].Value.Outcome)
+	assert.Equal(t, int64(42), req.Records[0].Value.EthTxID)
+	assert.Equal(t, etx.FromAddress, req.Records[0].Value.FromAddress)
+
+	atomic.StoreInt32(&status, http.StatusInternalServerError)
+	require.EqualError(t, sink.Publish(context.Background(), record), "failed to produce to topic tx-outcomes: unexpected status code 500")
+	<-requests
+}
@@ -0,0 +1,67 @@
+package bulletprooftxmanager
+
+import (
+	"sync"
+	"time"
+)
+
+// outcomeHookMaxAge is how long an OutcomeCallback is kept for. Callbacks
+// whose transaction never reaches a terminal outcome in this time, e.g.
+// because the database transaction it was created in was rolled back, are
+// dropped.
+const outcomeHookMaxAge = 24 * time.Hour
+
+// OutcomeCallback is called once a transaction created with NewTx.OnOutcome
+// reaches a terminal outcome. It is called from the EthBroadcaster or
+// EthConfirmer, so it should return quickly.
+type OutcomeCallback func(record OutcomeRecord)
+
+type outcomeHook struct {
+	fn           OutcomeCallback
+	registeredAt time.Time
+}
+
+// outcomeHooks holds the OutcomeCallbacks of transactions that have not yet
+// reached a terminal outcome, by eth_tx ID. They are only kept in memory, so
+// callbacks are lost on restart.
+//
+// Like finalityHooks, the BulletproofTxManager shares its outcomeHooks with
+// every EthBroadcaster and EthConfirmer it creates, so that callbacks survive
+// their restarts.
+type outcomeHooks struct {
+	mu    sync.Mutex
+	hooks map[int64]outcomeHook
+}
+
+func newOutcomeHooks() *outcomeHooks {
+	return &outcomeHooks{hooks: make(map[int64]outcomeHook)}
+}
+
+func (h *outcomeHooks) register(ethTxID int64, fn OutcomeCallback) {
+	now := time.Now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for id, hook := range h.hooks {
+		if now.Sub(hook.registeredAt) > outcomeHookMaxAge {
+			delete(h.hooks, id)
+		}
+	}
+	h.hooks[ethTxID] = outcomeHook{fn, now}
+}
+
+// notify calls and forgets the callback of the record's transaction, if any
+func (h *outcomeHooks) notify(record OutcomeRecord) {
+	h.mu.Lock()
+	hook, exists := h.hooks[record.EthTxID]
+	delete(h.hooks, record.EthTxID)
+	h.mu.Unlock()
+	if exists {
+		hook.fn(record)
+	}
+}
+
+func (h *outcomeHooks) len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.hooks)
+}
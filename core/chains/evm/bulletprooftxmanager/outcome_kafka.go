@@ -0,0 +1,71 @@
+package bulletprooftxmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// kafkaRESTContentType is the content type of JSON records produced through
+// the Kafka REST proxy v2 API
+const kafkaRESTContentType = "application/vnd.kafka.json.v2+json"
+
+// KafkaOutcomeSink publishes outcome records to a Kafka topic through a
+// Kafka REST proxy. Records are keyed by eth_tx ID, so that all records for
+// the same transaction end up in the same partition.
+type KafkaOutcomeSink struct {
+	url    url.URL
+	topic  string
+	client *http.Client
+	log    logger.Logger
+}
+
+var _ OutcomeSink = (*KafkaOutcomeSink)(nil)
+
+// NewKafkaOutcomeSink instantiates a sink that produces to topic through the
+// Kafka REST proxy at u
+func NewKafkaOutcomeSink(lggr logger.Logger, u url.URL, topic string) *KafkaOutcomeSink {
+	u.Path = path.Join(u.Path, "topics", url.PathEscape(topic))
+	return &KafkaOutcomeSink{u, topic, &http.Client{}, lggr.Named("KafkaOutcomeSink")}
+}
+
+type kafkaRecord struct {
+	Key   string        `json:"key"`
+	Value OutcomeRecord `json:"value"`
+}
+
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// Publish produces the record to the topic
+func (s *KafkaOutcomeSink) Publish(ctx context.Context, record OutcomeRecord) error {
+	body, err := json.Marshal(kafkaProduceRequest{
+		Records: []kafkaRecord{{Key: strconv.FormatInt(record.EthTxID, 10), Value: record}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal record")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url.String(), bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", kafkaRESTContentType)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to produce to topic %s", s.topic)
+	}
+	defer s.log.ErrorIfClosing(resp.Body, "Kafka REST proxy response body")
+	if resp.StatusCode >= 300 {
+		return errors.Errorf("failed to produce to topic %s: unexpected status code %d", s.topic, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package bulletprooftxmanager
+
+import (
+	"sync"
+	"time"
+)
+
+// pacer spaces out the initial broadcasts of transactions that share a
+// pacing tag, e.g. several keeper keys performing upkeeps on the same
+// registry, which would otherwise land in the same block and revert due to
+// ordering constraints in the contract.
+//
+// It is shared by the monitors of every key of an EthBroadcaster, and is
+// consulted when a key selects its next unstarted transaction, before a nonce
+// is assigned, so that a transaction that has to wait doesn't hold a nonce.
+type pacer struct {
+	mu sync.Mutex
+	// next is when the next transaction for each tag may be selected
+	next map[string]time.Time
+}
+
+func newPacer() *pacer {
+	return &pacer{next: make(map[string]time.Time)}
+}
+
+// reserve takes the broadcast slot for tag if it is due, and returns zero.
+// Otherwise it returns how long until the slot is due.
+func (p *pacer) reserve(tag string, interval time.Duration) (wait time.Duration) {
+	now := time.Now()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if next, exists := p.next[tag]; exists && now.Before(next) {
+		return next.Sub(now)
+	}
+	for t, next := range p.next {
+		if !now.Before(next) {
+			delete(p.next, t)
+		}
+	}
+	p.next[tag] = now.Add(interval)
+	return 0
+}
+
+// pacingTag returns the tag that etx is paced by, which is its PacingTag or
+// otherwise its Subject, or false if it isn't paced
+func pacingTag(etx EthTx) (string, bool) {
+	if etx.PacingInterval <= 0 {
+		return "", false
+	}
+	if etx.PacingTag.Valid && etx.PacingTag.String != "" {
+		return etx.PacingTag.String, true
+	}
+	if etx.Subject.Valid {
+		return etx.Subject.UUID.String(), true
+	}
+	return "", false
+}
+
+// pace returns how long etx has to wait before it may be broadcast, and
+// triggers its key once it may. Zero means it may be broadcast now, and the
+// slot has been taken.
+func (eb *EthBroadcaster) pace(etx EthTx) time.Duration {
+	tag, paced := pacingTag(etx)
+	if !paced {
+		return 0
+	}
+	wait := eb.pacer.reserve(tag, etx.PacingInterval)
+	if wait > 0 {
+		eb.logger.Debugw("Transaction is paced, delaying broadcast", "ethTxID", etx.ID, "address", etx.FromAddress, "pacingTag", tag, "pacingInterval", etx.PacingInterval, "wait", wait)
+		time.AfterFunc(wait, func() { eb.Trigger(etx.FromAddress) })
+	}
+	return wait
+}
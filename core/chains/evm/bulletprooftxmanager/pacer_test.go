@@ -0,0 +1,75 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+func TestEthBroadcaster_Pacing(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState1, fromAddress1 := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	keyState2, fromAddress2 := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState1, keyState2})
+
+	var mu sync.Mutex
+	var sentAt []time.Time
+	ethClient.On("SendTransaction", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		mu.Lock()
+		defer mu.Unlock()
+		sentAt = append(sentAt, time.Now())
+	}).Return(nil)
+
+	insertPaced := func(t *testing.T, fromAddress gethCommon.Address) bulletprooftxmanager.EthTx {
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.State = bulletprooftxmanager.EthTxUnstarted
+		etx.PacingTag = null.StringFrom("registry")
+		etx.PacingInterval = time.Second
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+	state := func(t *testing.T, etx bulletprooftxmanager.EthTx) bulletprooftxmanager.EthTxState {
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		return etx.State
+	}
+
+	etx1 := insertPaced(t, fromAddress1)
+	etx2 := insertPaced(t, fromAddress2)
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState1))
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, state(t, etx1))
+
+	// The other key shares the tag, so it has to wait out the interval
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState2))
+	assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, state(t, etx2))
+
+	require.Eventually(t, func() bool {
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState2))
+		return state(t, etx2) == bulletprooftxmanager.EthTxUnconfirmed
+	}, 5*time.Second, 50*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, sentAt, 2)
+	// Some leeway for the time between reserving the slot and sending
+	assert.GreaterOrEqual(t, sentAt[1].Sub(sentAt[0]), 900*time.Millisecond)
+}
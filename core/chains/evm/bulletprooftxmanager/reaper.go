@@ -162,6 +162,28 @@ AND evm_chain_id = $2`, timeThreshold, r.chainID)
 	if err != nil {
 		return errors.Wrap(err, "BPTXMReaper#reapEthTxes batch delete of fatally errored eth_txes failed")
 	}
+	// Delete old 'external' eth_txes whose nonce is known to have been
+	// consumed on chain. Reservations made with ReserveNonce that are still
+	// unused have no broadcast_at and are kept, since they hold their nonce.
+	err = pg.Batch(func(_, limit uint) (count uint, err error) {
+		res, err := r.db.Exec(`
+DELETE FROM eth_txes
+WHERE created_at < $1
+AND state = 'external'
+AND broadcast_at IS NOT NULL
+AND evm_chain_id = $2`, timeThreshold, r.chainID)
+		if err != nil {
+			return count, errors.Wrap(err, "ReapEthTxes failed to delete old external eth_txes")
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return count, errors.Wrap(err, "ReapEthTxes failed to get rows affected")
+		}
+		return uint(rowsAffected), err
+	})
+	if err != nil {
+		return errors.Wrap(err, "BPTXMReaper#reapEthTxes batch delete of external eth_txes failed")
+	}
 
 	r.log.Debugf("BPTXMReaper: ReapEthTxes completed in %v", time.Since(mark))
 
@@ -128,4 +128,32 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 		// Deleted because it is old enough now
 		cltest.AssertCount(t, db, "eth_txes", 0)
 	})
+
+	pgtest.MustExec(t, db, `INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, nonce, evm_chain_id, broadcast_at, created_at)
+VALUES ($1, $1, '\x', 0, 0, 'external', $2, $3, NOW(), NOW())`, from, nonce, cltest.FixtureChainID.String())
+	// An unused reservation, which holds its nonce
+	pgtest.MustExec(t, db, `INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, nonce, evm_chain_id, created_at)
+VALUES ($1, $1, '\x', 0, 0, 'external', $2, $3, NOW())`, from, nonce+1, cltest.FixtureChainID.String())
+
+	t.Run("deletes external eth_txes consumed on chain that exceed the age threshold", func(t *testing.T) {
+		config := new(mocks.ReaperConfig)
+		config.On("EvmFinalityDepth").Return(uint32(10))
+		config.On("EthTxReaperThreshold").Return(1 * time.Hour)
+		config.On("EthTxReaperInterval").Return(1 * time.Hour)
+
+		r := newReaper(t, db, config)
+
+		err := r.ReapEthTxes(42)
+		assert.NoError(t, err)
+		// Didn't delete because eth_tx was not old enough
+		cltest.AssertCount(t, db, "eth_txes", 2)
+
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET created_at=$1`, oneDayAgo)
+
+		err = r.ReapEthTxes(42)
+		assert.NoError(t, err)
+		// Deleted the consumed one because it is old enough now, but kept
+		// the reservation
+		cltest.AssertCount(t, db, "eth_txes", 1)
+	})
 }
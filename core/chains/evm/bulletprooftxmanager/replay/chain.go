@@ -0,0 +1,246 @@
+package replay
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var _ bulletprooftxmanager.KeyStore = &keyStore{}
+
+// keyStore signs on behalf of the snapshot's keys. The private keys of the
+// original node are not available, so each key is given a throwaway signing
+// key instead.
+type keyStore struct {
+	chainID *big.Int
+	keys    map[common.Address]*ecdsa.PrivateKey
+	// senders maps the address of each signing key back to the snapshot key
+	senders map[common.Address]common.Address
+}
+
+func newKeyStore(chainID *big.Int) *keyStore {
+	return &keyStore{
+		chainID: chainID,
+		keys:    make(map[common.Address]*ecdsa.PrivateKey),
+		senders: make(map[common.Address]common.Address),
+	}
+}
+
+func (ks *keyStore) add(address common.Address) error {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate signing key")
+	}
+	ks.keys[address] = key
+	ks.senders[crypto.PubkeyToAddress(key.PublicKey)] = address
+	return nil
+}
+
+func (ks *keyStore) GetStatesForChain(chainID *big.Int) ([]ethkey.State, error) {
+	return nil, errors.New("GetStatesForChain is not supported in replay")
+}
+
+func (ks *keyStore) SignTx(fromAddress common.Address, tx *gethTypes.Transaction, chainID *big.Int) (*gethTypes.Transaction, error) {
+	key, exists := ks.keys[fromAddress]
+	if !exists {
+		return nil, errors.Errorf("no key for %s", fromAddress.Hex())
+	}
+	return gethTypes.SignTx(tx, gethTypes.LatestSignerForChainID(chainID), key)
+}
+
+func (ks *keyStore) SubscribeToKeyChanges() (ch chan struct{}, unsub func()) {
+	return make(chan struct{}), func() {}
+}
+
+// sender returns the snapshot key that signed tx
+func (ks *keyStore) sender(tx *gethTypes.Transaction) (common.Address, error) {
+	signer, err := gethTypes.Sender(gethTypes.LatestSignerForChainID(ks.chainID), tx)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to recover sender")
+	}
+	from, exists := ks.senders[signer]
+	if !exists {
+		return common.Address{}, errors.Errorf("transaction %s was not signed by a snapshot key", tx.Hash().Hex())
+	}
+	return from, nil
+}
+
+type nonceKey struct {
+	from  common.Address
+	nonce uint64
+}
+
+type minedTx struct {
+	nonceKey
+	head     int
+	reverted bool
+}
+
+// chain is an evmclient.Client that answers from a Scenario. It implements
+// only the RPCs used by the EthBroadcaster and EthConfirmer; calling anything
+// else panics, so that a scenario relying on an unscripted RPC fails loudly.
+type chain struct {
+	evmclient.Client
+
+	chainID  *big.Int
+	scenario Scenario
+	keyStore *keyStore
+	heads    []*evmtypes.Head
+
+	mu   sync.Mutex
+	head int
+	// sent holds the distinct transactions seen at each nonce, in the order
+	// they were first sent
+	sent       map[nonceKey][]common.Hash
+	mined      map[common.Hash]minedTx
+	baseNonces map[common.Address]uint64
+}
+
+func newChain(chainID *big.Int, scenario Scenario, ks *keyStore, baseNonces map[common.Address]uint64) *chain {
+	c := &chain{
+		chainID:    chainID,
+		scenario:   scenario,
+		keyStore:   ks,
+		sent:       make(map[nonceKey][]common.Hash),
+		mined:      make(map[common.Hash]minedTx),
+		baseNonces: baseNonces,
+	}
+	var parent *evmtypes.Head
+	for i := 0; i < scenario.Heads; i++ {
+		number := scenario.StartBlock + int64(i)
+		var parentHash common.Hash
+		if parent != nil {
+			parentHash = parent.Hash
+		}
+		h := evmtypes.NewHead(big.NewInt(number), common.BigToHash(big.NewInt(number+1)), parentHash, uint64(number), utils.NewBig(chainID))
+		h.Parent = parent
+		c.heads = append(c.heads, &h)
+		parent = &h
+	}
+	return c
+}
+
+func (c *chain) setHead(i int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head = i
+}
+
+func (c *chain) ChainID() *big.Int {
+	return c.chainID
+}
+
+func (c *chain) SendTransaction(ctx context.Context, tx *gethTypes.Transaction) error {
+	from, err := c.keyStore.sender(tx)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := nonceKey{from, tx.Nonce()}
+	attempt := -1
+	for i, hash := range c.sent[key] {
+		if hash == tx.Hash() {
+			attempt = i
+			break
+		}
+	}
+	if attempt == -1 {
+		attempt = len(c.sent[key])
+		c.sent[key] = append(c.sent[key], tx.Hash())
+	}
+
+	r := c.scenario.response(from, tx.Nonce(), attempt)
+	if r.MinedAtHead != nil {
+		c.mined[tx.Hash()] = minedTx{key, *r.MinedAtHead, r.Reverted}
+	}
+	if r.SendError != "" {
+		return errors.New(r.SendError)
+	}
+	return nil
+}
+
+func (c *chain) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.nonce(account), nil
+}
+
+func (c *chain) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return c.NonceAt(ctx, account, nil)
+}
+
+// nonce returns the nonce of account as of the current head
+//
+// caller must hold lock!
+func (c *chain) nonce(account common.Address) uint64 {
+	nonce := c.baseNonces[account]
+	for _, m := range c.mined {
+		if m.from == account && m.head <= c.head && m.nonce >= nonce {
+			nonce = m.nonce + 1
+		}
+	}
+	return nonce
+}
+
+func (c *chain) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, req := range b {
+		if req.Method != "eth_getTransactionReceipt" {
+			b[i].Error = errors.Errorf("%s is not supported in replay", req.Method)
+			continue
+		}
+		hash, is := req.Args[0].(common.Hash)
+		if !is {
+			b[i].Error = errors.Errorf("expected a transaction hash, got %T", req.Args[0])
+			continue
+		}
+		m, exists := c.mined[hash]
+		if !exists || m.head > c.head {
+			// Leaving the result empty means there is no receipt yet
+			continue
+		}
+		head := c.heads[m.head]
+		status := uint64(1)
+		if m.reverted {
+			status = 0
+		}
+		*req.Result.(*bulletprooftxmanager.Receipt) = bulletprooftxmanager.Receipt{
+			TxHash:      hash,
+			BlockHash:   head.Hash,
+			BlockNumber: big.NewInt(head.Number),
+			Status:      status,
+		}
+	}
+	return nil
+}
+
+// minedHashes returns every transaction mined as of the current head
+func (c *chain) minedHashes() []common.Hash {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var hashes []common.Hash
+	for hash, m := range c.mined {
+		if m.head <= c.head {
+			hashes = append(hashes, hash)
+		}
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i].Hex() < hashes[j].Hex() })
+	return hashes
+}
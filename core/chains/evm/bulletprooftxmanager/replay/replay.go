@@ -0,0 +1,265 @@
+// Package replay replays a snapshot of a transaction queue through the
+// EthBroadcaster and EthConfirmer against a scripted chain, so that state
+// machine bugs seen in production can be reproduced deterministically.
+//
+// See Snapshot and Scenario for the input formats.
+package replay
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// Report is the outcome of a replay
+type Report struct {
+	Scenario string
+	// EthTxes are the final states of the snapshot's transactions, in
+	// snapshot order
+	EthTxes []EthTxReport
+	// Errors are the errors returned by the EthBroadcaster and EthConfirmer.
+	// These are not necessarily bugs; e.g. a scripted send error the node
+	// cannot handle will show up here on every head.
+	Errors []StepError
+	// Violations are broken invariants, each reported at the first head it
+	// was seen
+	Violations []Violation
+}
+
+// EthTxReport is the final state of a transaction
+type EthTxReport struct {
+	ID          int64
+	FromAddress common.Address
+	Nonce       *int64
+	State       bulletprooftxmanager.EthTxState
+	Error       string
+	Attempts    int
+}
+
+// StepError is an error returned while processing a head
+type StepError struct {
+	BlockNumber int64
+	Service     string
+	Err         string
+}
+
+// Violation is a broken invariant
+type Violation struct {
+	BlockNumber int64
+	Message     string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("block %d: %s", v.BlockNumber, v.Message)
+}
+
+// Run seeds db with snapshot and replays scenario against it. db is written
+// to, so it must be an ephemeral database and never a node's own.
+//
+// A fixed price gas estimator is always used, so that bumping does not depend
+// on anything outside the scenario.
+func Run(ctx context.Context, db *sqlx.DB, cfg bulletprooftxmanager.Config, lggr logger.Logger, snapshot Snapshot, scenario Scenario) (report Report, err error) {
+	if err = snapshot.Validate(); err != nil {
+		return report, errors.Wrap(err, "invalid snapshot")
+	}
+	if err = scenario.Validate(); err != nil {
+		return report, errors.Wrap(err, "invalid scenario")
+	}
+	lggr = lggr.Named("Replay")
+	report.Scenario = scenario.Name
+
+	q := pg.NewQ(db, lggr, cfg)
+	chainID := snapshot.EVMChainID.ToInt()
+	ks := newKeyStore(chainID)
+	ids, err := snapshot.seed(q, bulletprooftxmanager.NewORM(db, lggr, cfg), ks)
+	if err != nil {
+		return report, errors.Wrap(err, "failed to seed snapshot")
+	}
+
+	var keyStates []ethkey.State
+	for _, k := range snapshot.Keys {
+		var state ethkey.State
+		if err = q.Get(&state, `SELECT * FROM eth_key_states WHERE address = $1`, k.Address); err != nil {
+			return report, errors.Wrapf(err, "failed to load key %s", k.Address.Hex())
+		}
+		keyStates = append(keyStates, state)
+	}
+
+	c := newChain(chainID, scenario, ks, baseNonces(snapshot, scenario))
+	estimator := gas.NewFixedPriceEstimator(cfg, lggr)
+	eb := bulletprooftxmanager.NewEthBroadcaster(db, c, cfg, ks, pg.NewNullEventBroadcaster(), keyStates, estimator, nil, nil, lggr)
+	ec := bulletprooftxmanager.NewEthConfirmer(db, c, cfg, ks, keyStates, estimator, nil, nil, lggr)
+
+	seen := make(map[string]bool)
+	for i, head := range c.heads {
+		c.setHead(i)
+		for _, state := range keyStates {
+			if err := eb.ProcessUnstartedEthTxs(ctx, state); err != nil {
+				report.Errors = append(report.Errors, StepError{head.Number, "EthBroadcaster", err.Error()})
+			}
+		}
+		if err := ec.ProcessHead(ctx, head); err != nil {
+			report.Errors = append(report.Errors, StepError{head.Number, "EthConfirmer", err.Error()})
+		}
+
+		messages, err := checkInvariants(q, snapshot)
+		if err != nil {
+			return report, errors.Wrap(err, "failed to check invariants")
+		}
+		if i == len(c.heads)-1 {
+			minedMessages, err := checkMined(q, c.minedHashes())
+			if err != nil {
+				return report, errors.Wrap(err, "failed to check mined transactions")
+			}
+			messages = append(messages, minedMessages...)
+		}
+		for _, msg := range messages {
+			if !seen[msg] {
+				seen[msg] = true
+				report.Violations = append(report.Violations, Violation{head.Number, msg})
+			}
+		}
+	}
+
+	report.EthTxes, err = loadEthTxReports(q, ids)
+	return report, errors.Wrap(err, "failed to load final transaction states")
+}
+
+// baseNonces returns the chain nonce of each key before anything is mined
+func baseNonces(snapshot Snapshot, scenario Scenario) map[common.Address]uint64 {
+	nonces := make(map[common.Address]uint64)
+	for _, k := range snapshot.Keys {
+		nonces[k.Address] = uint64(k.NextNonce)
+	}
+	for _, etx := range snapshot.EthTxes {
+		if etx.Nonce != nil && uint64(*etx.Nonce) < nonces[etx.FromAddress] {
+			nonces[etx.FromAddress] = uint64(*etx.Nonce)
+		}
+	}
+	for address, nonce := range scenario.ChainNonces {
+		nonces[address] = nonce
+	}
+	return nonces
+}
+
+// checkInvariants returns a message for every invariant the database
+// currently violates
+func checkInvariants(q pg.Q, snapshot Snapshot) (messages []string, err error) {
+	var rows []struct {
+		FromAddress common.Address
+		Nonce       int64
+		Count       int
+	}
+	if err = q.Select(&rows, `SELECT from_address, nonce, COUNT(*) AS count FROM eth_txes WHERE nonce IS NOT NULL AND evm_chain_id = $1 GROUP BY from_address, nonce HAVING COUNT(*) > 1`, snapshot.EVMChainID.String()); err != nil {
+		return nil, errors.Wrap(err, "failed to check for duplicate nonces")
+	}
+	for _, r := range rows {
+		messages = append(messages, fmt.Sprintf("%d transactions from %s share nonce %d", r.Count, r.FromAddress.Hex(), r.Nonce))
+	}
+
+	var nonces []struct {
+		Address   common.Address
+		NextNonce int64
+		MaxNonce  int64
+	}
+	if err = q.Select(&nonces, `SELECT eth_key_states.address, eth_key_states.next_nonce, MAX(eth_txes.nonce) AS max_nonce FROM eth_key_states
+JOIN eth_txes ON eth_txes.from_address = eth_key_states.address AND eth_txes.state IN ('unconfirmed', 'confirmed', 'confirmed_missing_receipt')
+WHERE eth_key_states.evm_chain_id = $1
+GROUP BY eth_key_states.address, eth_key_states.next_nonce
+HAVING MAX(eth_txes.nonce) >= eth_key_states.next_nonce`, snapshot.EVMChainID.String()); err != nil {
+		return nil, errors.Wrap(err, "failed to check next nonces")
+	}
+	for _, n := range nonces {
+		messages = append(messages, fmt.Sprintf("key %s has next nonce %d but has already broadcast nonce %d", n.Address.Hex(), n.NextNonce, n.MaxNonce))
+	}
+
+	var inProgress []struct {
+		ID        int64
+		Nonce     int64
+		NextNonce int64
+	}
+	if err = q.Select(&inProgress, `SELECT eth_txes.id, eth_txes.nonce, eth_key_states.next_nonce FROM eth_txes
+JOIN eth_key_states ON eth_key_states.address = eth_txes.from_address
+WHERE eth_txes.state = 'in_progress' AND eth_txes.nonce != eth_key_states.next_nonce AND eth_txes.evm_chain_id = $1`, snapshot.EVMChainID.String()); err != nil {
+		return nil, errors.Wrap(err, "failed to check in_progress nonces")
+	}
+	for _, etx := range inProgress {
+		messages = append(messages, fmt.Sprintf("in_progress eth_tx %d has nonce %d but its key's next nonce is %d", etx.ID, etx.Nonce, etx.NextNonce))
+	}
+
+	var unbroadcast []struct {
+		ID    int64
+		State bulletprooftxmanager.EthTxState
+	}
+	if err = q.Select(&unbroadcast, `SELECT id, state FROM eth_txes
+WHERE state IN ('unconfirmed', 'confirmed', 'confirmed_missing_receipt') AND evm_chain_id = $1
+AND NOT EXISTS (SELECT 1 FROM eth_tx_attempts WHERE eth_tx_attempts.eth_tx_id = eth_txes.id AND eth_tx_attempts.state = 'broadcast')`, snapshot.EVMChainID.String()); err != nil {
+		return nil, errors.Wrap(err, "failed to check for transactions without a broadcast attempt")
+	}
+	for _, etx := range unbroadcast {
+		messages = append(messages, fmt.Sprintf("%s eth_tx %d has no broadcast attempt", etx.State, etx.ID))
+	}
+
+	var unreceipted []int64
+	if err = q.Select(&unreceipted, `SELECT id FROM eth_txes
+WHERE state = 'confirmed' AND evm_chain_id = $1
+AND NOT EXISTS (SELECT 1 FROM eth_tx_attempts JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash WHERE eth_tx_attempts.eth_tx_id = eth_txes.id)`, snapshot.EVMChainID.String()); err != nil {
+		return nil, errors.Wrap(err, "failed to check for confirmed transactions without a receipt")
+	}
+	for _, id := range unreceipted {
+		messages = append(messages, fmt.Sprintf("confirmed eth_tx %d has no receipt", id))
+	}
+	return messages, nil
+}
+
+// checkMined returns a message for every transaction the chain has mined that
+// the node has not marked as confirmed
+func checkMined(q pg.Q, hashes []common.Hash) (messages []string, err error) {
+	for _, hash := range hashes {
+		var etx struct {
+			ID    int64
+			State bulletprooftxmanager.EthTxState
+		}
+		err = q.Get(&etx, `SELECT eth_txes.id, eth_txes.state FROM eth_txes JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id WHERE eth_tx_attempts.hash = $1`, hash)
+		if errors.Is(err, sql.ErrNoRows) {
+			messages = append(messages, fmt.Sprintf("transaction %s was mined but no attempt has its hash", hash.Hex()))
+			continue
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "failed to load eth_tx for %s", hash.Hex())
+		}
+		if etx.State != bulletprooftxmanager.EthTxConfirmed {
+			messages = append(messages, fmt.Sprintf("eth_tx %d was mined but is %s", etx.ID, etx.State))
+		}
+	}
+	return messages, nil
+}
+
+func loadEthTxReports(q pg.Q, ids []int64) (reports []EthTxReport, err error) {
+	for _, id := range ids {
+		var r EthTxReport
+		var etx bulletprooftxmanager.EthTx
+		if err = q.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1`, id); err != nil {
+			return nil, errors.Wrapf(err, "failed to load eth_tx %d", id)
+		}
+		if err = q.Get(&r.Attempts, `SELECT COUNT(*) FROM eth_tx_attempts WHERE eth_tx_id = $1`, id); err != nil {
+			return nil, errors.Wrapf(err, "failed to count attempts for eth_tx %d", id)
+		}
+		r.ID = etx.ID
+		r.FromAddress = etx.FromAddress
+		r.Nonce = etx.Nonce
+		r.State = etx.State
+		r.Error = etx.Error.String
+		reports = append(reports, r)
+	}
+	return reports, nil
+}
@@ -0,0 +1,129 @@
+package replay_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager/replay"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+const scenariosDir = "../../../../testdata/replay"
+
+func TestReplay_BundledScenarios(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name           string
+		expectedStates []bulletprooftxmanager.EthTxState
+	}{
+		{
+			// The node crashed after sending nonce 5, which was mined. On
+			// resume the node says "nonce too low" and the EthBroadcaster
+			// must hand it to the EthConfirmer rather than give up on it.
+			name:           "nonce_too_low_crash_resume",
+			expectedStates: []bulletprooftxmanager.EthTxState{bulletprooftxmanager.EthTxConfirmed, bulletprooftxmanager.EthTxConfirmed},
+		},
+		{
+			// The node rejects the initial sends as underpriced, then a
+			// bump as an underpriced replacement, before the transaction is
+			// eventually mined.
+			name:           "prolonged_underpriced_congestion",
+			expectedStates: []bulletprooftxmanager.EthTxState{bulletprooftxmanager.EthTxConfirmed},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			snapshot, err := replay.LoadSnapshot(filepath.Join(scenariosDir, test.name, "snapshot.json"))
+			require.NoError(t, err)
+			scenario, err := replay.LoadScenario(filepath.Join(scenariosDir, test.name, "scenario.json"))
+			require.NoError(t, err)
+
+			db := pgtest.NewSqlxDB(t)
+			cfg := evmtest.NewChainScopedConfig(t, configtest.NewTestGeneralConfig(t))
+
+			report, err := replay.Run(context.Background(), db, cfg, logger.TestLogger(t), snapshot, scenario)
+			require.NoError(t, err)
+
+			assert.Empty(t, report.Violations)
+			assert.Empty(t, report.Errors)
+			require.Len(t, report.EthTxes, len(test.expectedStates))
+			for i, etx := range report.EthTxes {
+				assert.Equal(t, test.expectedStates[i], etx.State, "ethTxes[%d]", i)
+			}
+		})
+	}
+}
+
+func TestReplay_ParseScenario(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name        string
+		json        string
+		expectedErr string
+	}{
+		{"valid", `{"name": "x", "heads": 2, "responses": [{"nonce": 1, "attempt": 0, "minedAtHead": 1}]}`, ""},
+		{"unknown field", `{"name": "x", "heads": 2, "blocks": 3}`, `unknown field "blocks"`},
+		{"no heads", `{"name": "x", "heads": 0}`, "heads must be positive"},
+		{"mined after last head", `{"heads": 2, "responses": [{"nonce": 1, "attempt": 0, "minedAtHead": 2}]}`, "minedAtHead must be between 0 and 1"},
+		{"reverted but not mined", `{"heads": 2, "responses": [{"nonce": 1, "attempt": 0, "reverted": true}]}`, "reverted requires minedAtHead"},
+		{"duplicate response", `{"heads": 2, "responses": [{"nonce": 1, "attempt": 0}, {"nonce": 1, "attempt": 0}]}`, "duplicate response for nonce 1 attempt 0"},
+		{"two attempts mined", `{"heads": 2, "responses": [{"nonce": 1, "attempt": 0, "minedAtHead": 0}, {"nonce": 1, "attempt": 1, "minedAtHead": 1}]}`, "more than one attempt at nonce 1 is mined"},
+		{"mined default", `{"heads": 2, "default": {"minedAtHead": 0}}`, "default must not set from, minedAtHead or reverted"},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := replay.ParseScenario([]byte(test.json))
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestReplay_ParseSnapshot(t *testing.T) {
+	t.Parallel()
+
+	const from = "0x2ad9b1a3c4e2f0d7e6b5a4c3d2e1f0a9b8c7d6e5"
+
+	for _, test := range []struct {
+		name        string
+		json        string
+		expectedErr string
+	}{
+		{"valid", `{"evmChainID": "0", "keys": [{"address": "` + from + `", "nextNonce": 1}], "ethTxes": [{"fromAddress": "` + from + `", "state": "unconfirmed", "nonce": 0, "attempts": [{"gasPrice": "1", "state": "broadcast"}]}]}`, ""},
+		{"no keys", `{"evmChainID": "0"}`, "no keys"},
+		{"unknown key", `{"evmChainID": "0", "keys": [{"address": "` + from + `"}], "ethTxes": [{"fromAddress": "0x0000000000000000000000000000000000000001", "state": "unstarted"}]}`, "is not one of the keys"},
+		{"unstarted with nonce", `{"evmChainID": "0", "keys": [{"address": "` + from + `"}], "ethTxes": [{"fromAddress": "` + from + `", "state": "unstarted", "nonce": 0}]}`, "unstarted transaction must not have a nonce"},
+		{"in_progress at wrong nonce", `{"evmChainID": "0", "keys": [{"address": "` + from + `", "nextNonce": 2}], "ethTxes": [{"fromAddress": "` + from + `", "state": "in_progress", "nonce": 1, "attempts": [{"gasPrice": "1", "state": "in_progress"}]}]}`, "in_progress transaction must have the key's next nonce of 2"},
+		{"unconfirmed without broadcast attempt", `{"evmChainID": "0", "keys": [{"address": "` + from + `", "nextNonce": 1}], "ethTxes": [{"fromAddress": "` + from + `", "state": "unconfirmed", "nonce": 0, "attempts": [{"gasPrice": "1", "state": "in_progress"}]}]}`, "must have at least one broadcast attempt"},
+		{"duplicate nonce", `{"evmChainID": "0", "keys": [{"address": "` + from + `", "nextNonce": 1}], "ethTxes": [{"fromAddress": "` + from + `", "state": "unconfirmed", "nonce": 0, "attempts": [{"gasPrice": "1", "state": "broadcast"}]}, {"fromAddress": "` + from + `", "state": "confirmed_missing_receipt", "nonce": 0, "attempts": [{"gasPrice": "1", "state": "broadcast"}]}]}`, "nonce 0 is used more than once"},
+		{"confirmed", `{"evmChainID": "0", "keys": [{"address": "` + from + `", "nextNonce": 1}], "ethTxes": [{"fromAddress": "` + from + `", "state": "confirmed", "nonce": 0}]}`, `unsupported state "confirmed"`},
+		{"dynamic fee attempt without caps", `{"evmChainID": "0", "keys": [{"address": "` + from + `", "nextNonce": 1}], "ethTxes": [{"fromAddress": "` + from + `", "state": "unconfirmed", "nonce": 0, "attempts": [{"txType": 2, "gasPrice": "1", "state": "broadcast"}]}]}`, "dynamic fee attempt must have a gasTipCap and gasFeeCap"},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := replay.ParseSnapshot([]byte(test.json))
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// Scenario scripts how the chain behaves while a snapshot is replayed.
+//
+// A scenario is a JSON document:
+//
+//	{
+//	  "name": "nonce too low crash resume",
+//	  "heads": 3,
+//	  "startBlock": 100,
+//	  "chainNonces": {"0x...": 5},
+//	  "default": {"sendError": ""},
+//	  "responses": [
+//	    {"nonce": 5, "attempt": 0, "sendError": "nonce too low", "minedAtHead": 0},
+//	    {"nonce": 6, "attempt": 0, "minedAtHead": 1}
+//	  ]
+//	}
+//
+// heads is the number of heads the chain produces, numbered from startBlock.
+// For each head, the EthBroadcaster first processes every key and then the
+// EthConfirmer processes the head.
+//
+// responses script the node's reply to SendTransaction for a nonce and
+// attempt. Attempts are numbered from 0 in the order the chain first sees
+// each distinct signed transaction at that nonce. Attempts seeded from the
+// snapshot are not counted until they are sent. Re-sending a transaction the
+// chain has already seen gets the same response. Setting from restricts a
+// response to one key; otherwise it applies to every key.
+//
+// sendError is returned as the error from SendTransaction, so it should be a
+// message a real node returns, e.g. "nonce too low" or "replacement
+// transaction underpriced". An empty sendError means the node accepted the
+// transaction. If minedAtHead is set, the transaction is included in that
+// head, given as an index into heads. From then on its receipt is returned
+// and the chain nonce for the key moves past it. A transaction can be mined
+// even if sending it returned an error, e.g. to model a node that reports
+// "nonce too low" because the transaction was already mined before a crash.
+// reverted makes the receipt report that the transaction failed.
+//
+// Sends that match no response get default, which must not set from,
+// minedAtHead or reverted.
+//
+// chainNonces sets the chain nonce for a key before anything is mined. If a
+// key is missing, its chain nonce is the lowest nonce of its in_progress or
+// unconfirmed transactions in the snapshot, i.e. everything before them is
+// assumed to have been mined.
+type Scenario struct {
+	Name        string                    `json:"name"`
+	Heads       int                       `json:"heads"`
+	StartBlock  int64                     `json:"startBlock"`
+	ChainNonces map[common.Address]uint64 `json:"chainNonces,omitempty"`
+	Default     Response                  `json:"default"`
+	Responses   []Response                `json:"responses"`
+}
+
+// Response is the scripted reply to sending an attempt
+type Response struct {
+	From        *common.Address `json:"from,omitempty"`
+	Nonce       uint64          `json:"nonce"`
+	Attempt     int             `json:"attempt"`
+	SendError   string          `json:"sendError,omitempty"`
+	MinedAtHead *int            `json:"minedAtHead,omitempty"`
+	Reverted    bool            `json:"reverted,omitempty"`
+}
+
+// LoadScenario reads and validates the scenario at path
+func LoadScenario(path string) (s Scenario, err error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return s, errors.Wrap(err, "failed to read scenario")
+	}
+	return ParseScenario(b)
+}
+
+// ParseScenario decodes and validates a JSON scenario
+func ParseScenario(b []byte) (s Scenario, err error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&s); err != nil {
+		return s, errors.Wrap(err, "failed to decode scenario")
+	}
+	return s, errors.Wrap(s.Validate(), "invalid scenario")
+}
+
+// Validate checks that the scenario is self-consistent
+func (s Scenario) Validate() error {
+	if s.Heads <= 0 {
+		return errors.New("heads must be positive")
+	}
+	if s.StartBlock < 0 {
+		return errors.New("startBlock must not be negative")
+	}
+	if s.Default.From != nil || s.Default.MinedAtHead != nil || s.Default.Reverted {
+		return errors.New("default must not set from, minedAtHead or reverted")
+	}
+
+	type attemptKey struct {
+		from    common.Address
+		nonce   uint64
+		attempt int
+	}
+	type nonceKey struct {
+		from  common.Address
+		nonce uint64
+	}
+	seen := make(map[attemptKey]bool)
+	mined := make(map[nonceKey]bool)
+	for i, r := range s.Responses {
+		if r.Attempt < 0 {
+			return errors.Errorf("responses[%d]: attempt must not be negative", i)
+		}
+		if r.MinedAtHead != nil && (*r.MinedAtHead < 0 || *r.MinedAtHead >= s.Heads) {
+			return errors.Errorf("responses[%d]: minedAtHead must be between 0 and %d", i, s.Heads-1)
+		}
+		if r.Reverted && r.MinedAtHead == nil {
+			return errors.Errorf("responses[%d]: reverted requires minedAtHead", i)
+		}
+		// The zero address stands for responses that apply to every key
+		var from common.Address
+		if r.From != nil {
+			from = *r.From
+		}
+		ak := attemptKey{from, r.Nonce, r.Attempt}
+		if seen[ak] {
+			return errors.Errorf("responses[%d]: duplicate response for nonce %d attempt %d", i, r.Nonce, r.Attempt)
+		}
+		seen[ak] = true
+		if r.MinedAtHead != nil {
+			nk := nonceKey{from, r.Nonce}
+			if mined[nk] {
+				return errors.Errorf("responses[%d]: more than one attempt at nonce %d is mined", i, r.Nonce)
+			}
+			mined[nk] = true
+		}
+	}
+	return nil
+}
+
+// response returns the scripted response for sending the given attempt
+func (s Scenario) response(from common.Address, nonce uint64, attempt int) Response {
+	var fallback *Response
+	for i, r := range s.Responses {
+		if r.Nonce != nonce || r.Attempt != attempt {
+			continue
+		}
+		if r.From == nil {
+			fallback = &s.Responses[i]
+		} else if *r.From == from {
+			return r
+		}
+	}
+	if fallback != nil {
+		return *fallback
+	}
+	return s.Default
+}
@@ -0,0 +1,311 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Snapshot is a copy of the transaction queue of one or more keys on a chain.
+//
+// A snapshot is a JSON document:
+//
+//	{
+//	  "evmChainID": "1",
+//	  "keys": [{"address": "0x...", "nextNonce": 5}],
+//	  "ethTxes": [
+//	    {
+//	      "fromAddress": "0x...",
+//	      "toAddress": "0x...",
+//	      "encodedPayload": "0x...",
+//	      "value": "0",
+//	      "gasLimit": 21000,
+//	      "state": "in_progress",
+//	      "nonce": 5,
+//	      "attempts": [{"gasPrice": "20000000000", "state": "in_progress"}]
+//	    }
+//	  ]
+//	}
+//
+// Transactions may be unstarted, in_progress, unconfirmed,
+// confirmed_missing_receipt or fatal_error. Confirmed transactions should be
+// left out, since their receipts are not part of the snapshot.
+//
+// Attempts are legacy unless txType is 2, in which case they have gasTipCap
+// and gasFeeCap instead of gasPrice. They are re-signed when the snapshot is
+// seeded, so their hashes differ from the ones the snapshot was taken from.
+type Snapshot struct {
+	EVMChainID utils.Big       `json:"evmChainID"`
+	Keys       []SnapshotKey   `json:"keys"`
+	EthTxes    []SnapshotEthTx `json:"ethTxes"`
+}
+
+// SnapshotKey is the state of a key when the snapshot was taken
+type SnapshotKey struct {
+	Address   common.Address `json:"address"`
+	NextNonce int64          `json:"nextNonce"`
+}
+
+// SnapshotEthTx is an eth_tx along with its attempts
+type SnapshotEthTx struct {
+	FromAddress    common.Address                  `json:"fromAddress"`
+	ToAddress      common.Address                  `json:"toAddress"`
+	EncodedPayload hexutil.Bytes                   `json:"encodedPayload"`
+	Value          utils.Big                       `json:"value"`
+	GasLimit       uint64                          `json:"gasLimit"`
+	State          bulletprooftxmanager.EthTxState `json:"state"`
+	Nonce          *int64                          `json:"nonce,omitempty"`
+	Error          string                          `json:"error,omitempty"`
+	Attempts       []SnapshotAttempt               `json:"attempts,omitempty"`
+}
+
+// SnapshotAttempt is an eth_tx_attempt
+type SnapshotAttempt struct {
+	TxType                  int                                    `json:"txType,omitempty"`
+	GasPrice                *utils.Big                             `json:"gasPrice,omitempty"`
+	GasTipCap               *utils.Big                             `json:"gasTipCap,omitempty"`
+	GasFeeCap               *utils.Big                             `json:"gasFeeCap,omitempty"`
+	State                   bulletprooftxmanager.EthTxAttemptState `json:"state"`
+	BroadcastBeforeBlockNum *int64                                 `json:"broadcastBeforeBlockNum,omitempty"`
+}
+
+// LoadSnapshot reads and validates the snapshot at path
+func LoadSnapshot(path string) (s Snapshot, err error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return s, errors.Wrap(err, "failed to read snapshot")
+	}
+	return ParseSnapshot(b)
+}
+
+// ParseSnapshot decodes and validates a JSON snapshot
+func ParseSnapshot(b []byte) (s Snapshot, err error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&s); err != nil {
+		return s, errors.Wrap(err, "failed to decode snapshot")
+	}
+	return s, errors.Wrap(s.Validate(), "invalid snapshot")
+}
+
+// Validate checks that the snapshot describes a queue the EthBroadcaster and
+// EthConfirmer could have left behind
+func (s Snapshot) Validate() error {
+	if len(s.Keys) == 0 {
+		return errors.New("no keys")
+	}
+	keys := make(map[common.Address]SnapshotKey)
+	for _, k := range s.Keys {
+		if _, exists := keys[k.Address]; exists {
+			return errors.Errorf("duplicate key %s", k.Address.Hex())
+		}
+		if k.NextNonce < 0 {
+			return errors.Errorf("key %s: nextNonce must not be negative", k.Address.Hex())
+		}
+		keys[k.Address] = k
+	}
+
+	type nonceKey struct {
+		from  common.Address
+		nonce int64
+	}
+	nonces := make(map[nonceKey]bool)
+	inProgress := make(map[common.Address]bool)
+	for i, etx := range s.EthTxes {
+		key, exists := keys[etx.FromAddress]
+		if !exists {
+			return errors.Errorf("ethTxes[%d]: fromAddress %s is not one of the keys", i, etx.FromAddress.Hex())
+		}
+		if err := etx.validate(key); err != nil {
+			return errors.Wrapf(err, "ethTxes[%d]", i)
+		}
+		if etx.Nonce != nil {
+			nk := nonceKey{etx.FromAddress, *etx.Nonce}
+			if nonces[nk] {
+				return errors.Errorf("ethTxes[%d]: nonce %d is used more than once by %s", i, *etx.Nonce, etx.FromAddress.Hex())
+			}
+			nonces[nk] = true
+		}
+		if etx.State == bulletprooftxmanager.EthTxInProgress {
+			if inProgress[etx.FromAddress] {
+				return errors.Errorf("ethTxes[%d]: %s has more than one in_progress transaction", i, etx.FromAddress.Hex())
+			}
+			inProgress[etx.FromAddress] = true
+		}
+	}
+	return nil
+}
+
+func (etx SnapshotEthTx) validate(key SnapshotKey) error {
+	switch etx.State {
+	case bulletprooftxmanager.EthTxUnstarted, bulletprooftxmanager.EthTxFatalError:
+		if etx.Nonce != nil {
+			return errors.Errorf("%s transaction must not have a nonce", etx.State)
+		}
+		if len(etx.Attempts) > 0 {
+			return errors.Errorf("%s transaction must not have attempts", etx.State)
+		}
+		if etx.State == bulletprooftxmanager.EthTxFatalError && etx.Error == "" {
+			return errors.New("fatal_error transaction must have an error")
+		}
+	case bulletprooftxmanager.EthTxInProgress:
+		if etx.Nonce == nil || *etx.Nonce != key.NextNonce {
+			return errors.Errorf("in_progress transaction must have the key's next nonce of %d", key.NextNonce)
+		}
+		if len(etx.Attempts) != 1 || etx.Attempts[0].State != bulletprooftxmanager.EthTxAttemptInProgress {
+			return errors.New("in_progress transaction must have exactly one in_progress attempt")
+		}
+	case bulletprooftxmanager.EthTxUnconfirmed, bulletprooftxmanager.EthTxConfirmedMissingReceipt:
+		if etx.Nonce == nil || *etx.Nonce >= key.NextNonce {
+			return errors.Errorf("%s transaction must have a nonce below the key's next nonce of %d", etx.State, key.NextNonce)
+		}
+		var broadcast bool
+		for _, a := range etx.Attempts {
+			broadcast = broadcast || a.State == bulletprooftxmanager.EthTxAttemptBroadcast
+		}
+		if !broadcast {
+			return errors.Errorf("%s transaction must have at least one broadcast attempt", etx.State)
+		}
+	default:
+		return errors.Errorf("unsupported state %q", etx.State)
+	}
+	for i, a := range etx.Attempts {
+		if err := a.validate(); err != nil {
+			return errors.Wrapf(err, "attempts[%d]", i)
+		}
+	}
+	return nil
+}
+
+func (a SnapshotAttempt) validate() error {
+	switch a.TxType {
+	case 0x0:
+		if a.GasPrice == nil {
+			return errors.New("legacy attempt must have a gasPrice")
+		}
+	case 0x2:
+		if a.GasTipCap == nil || a.GasFeeCap == nil {
+			return errors.New("dynamic fee attempt must have a gasTipCap and gasFeeCap")
+		}
+	default:
+		return errors.Errorf("unsupported txType %d", a.TxType)
+	}
+	switch a.State {
+	case bulletprooftxmanager.EthTxAttemptInProgress, bulletprooftxmanager.EthTxAttemptBroadcast, bulletprooftxmanager.EthTxAttemptInsufficientEth:
+	default:
+		return errors.Errorf("unsupported state %q", a.State)
+	}
+	return nil
+}
+
+// seed inserts the snapshot's keys and transactions, returning the ID of
+// each inserted eth_tx in snapshot order
+func (s Snapshot) seed(q pg.Q, orm bulletprooftxmanager.ORM, ks *keyStore) (ids []int64, err error) {
+	if _, err = q.Exec(`INSERT INTO evm_chains (id, created_at, updated_at) VALUES ($1, NOW(), NOW()) ON CONFLICT DO NOTHING`, s.EVMChainID.String()); err != nil {
+		return nil, errors.Wrap(err, "failed to insert evm chain")
+	}
+	for _, k := range s.Keys {
+		if _, err = q.Exec(`INSERT INTO eth_key_states (address, next_nonce, is_funding, evm_chain_id, created_at, updated_at) VALUES ($1, $2, false, $3, NOW(), NOW())`,
+			k.Address, k.NextNonce, s.EVMChainID.String()); err != nil {
+			return nil, errors.Wrapf(err, "failed to insert key %s", k.Address.Hex())
+		}
+		if err = ks.add(k.Address); err != nil {
+			return nil, err
+		}
+	}
+	for i, setx := range s.EthTxes {
+		etx := bulletprooftxmanager.EthTx{
+			Nonce:          setx.Nonce,
+			FromAddress:    setx.FromAddress,
+			ToAddress:      setx.ToAddress,
+			EncodedPayload: setx.EncodedPayload,
+			Value:          assets.Eth(*setx.Value.ToInt()),
+			GasLimit:       setx.GasLimit,
+			State:          setx.State,
+			EVMChainID:     s.EVMChainID,
+		}
+		if setx.Error != "" {
+			etx.Error = null.StringFrom(setx.Error)
+		}
+		if setx.State != bulletprooftxmanager.EthTxUnstarted && setx.State != bulletprooftxmanager.EthTxFatalError {
+			// Anything that got as far as being sent must have a broadcast_at
+			now := time.Now()
+			etx.BroadcastAt = &now
+		}
+		if err = orm.InsertEthTx(&etx); err != nil {
+			return nil, errors.Wrapf(err, "ethTxes[%d]", i)
+		}
+		for j, sa := range setx.Attempts {
+			attempt, err := s.newAttempt(ks, etx, sa)
+			if err != nil {
+				return nil, errors.Wrapf(err, "ethTxes[%d].attempts[%d]", i, j)
+			}
+			if err = orm.InsertEthTxAttempt(&attempt); err != nil {
+				return nil, errors.Wrapf(err, "ethTxes[%d].attempts[%d]", i, j)
+			}
+		}
+		ids = append(ids, etx.ID)
+	}
+	return ids, nil
+}
+
+func (s Snapshot) newAttempt(ks *keyStore, etx bulletprooftxmanager.EthTx, sa SnapshotAttempt) (attempt bulletprooftxmanager.EthTxAttempt, err error) {
+	var tx *gethTypes.Transaction
+	to := etx.ToAddress
+	value := big.Int(etx.Value)
+	switch sa.TxType {
+	case 0x2:
+		tx = gethTypes.NewTx(&gethTypes.DynamicFeeTx{
+			ChainID:   s.EVMChainID.ToInt(),
+			Nonce:     uint64(*etx.Nonce),
+			GasTipCap: sa.GasTipCap.ToInt(),
+			GasFeeCap: sa.GasFeeCap.ToInt(),
+			Gas:       etx.GasLimit,
+			To:        &to,
+			Value:     &value,
+			Data:      etx.EncodedPayload,
+		})
+	default:
+		tx = gethTypes.NewTx(&gethTypes.LegacyTx{
+			Nonce:    uint64(*etx.Nonce),
+			GasPrice: sa.GasPrice.ToInt(),
+			Gas:      etx.GasLimit,
+			To:       &to,
+			Value:    &value,
+			Data:     etx.EncodedPayload,
+		})
+	}
+	signedTx, err := ks.SignTx(etx.FromAddress, tx, s.EVMChainID.ToInt())
+	if err != nil {
+		return attempt, err
+	}
+	rlp := new(bytes.Buffer)
+	if err = signedTx.EncodeRLP(rlp); err != nil {
+		return attempt, errors.Wrap(err, "failed to encode attempt")
+	}
+	return bulletprooftxmanager.EthTxAttempt{
+		EthTxID:                 etx.ID,
+		GasPrice:                sa.GasPrice,
+		GasTipCap:               sa.GasTipCap,
+		GasFeeCap:               sa.GasFeeCap,
+		ChainSpecificGasLimit:   etx.GasLimit,
+		SignedRawTx:             rlp.Bytes(),
+		Hash:                    signedTx.Hash(),
+		BroadcastBeforeBlockNum: sa.BroadcastBeforeBlockNum,
+		State:                   sa.State,
+		TxType:                  sa.TxType,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package bulletprooftxmanager
+
+import (
+	"sync"
+)
+
+// resumer delivers results to the pipeline task runs waiting on transactions.
+//
+// If a ResumeBatchCallback was registered, results are delivered in batches of
+// up to ETH_TX_RESUME_BATCH_SIZE, which is much cheaper than one at a time when
+// e.g. draining a large backlog. Otherwise they are delivered one at a time
+// via the ResumeCallback.
+type resumer struct {
+	callback      ResumeCallback
+	batchCallback ResumeBatchCallback
+	config        resumerConfig
+
+	// pending holds requests queued by enqueue until the next flush
+	mu      sync.Mutex
+	pending []ResumeRequest
+}
+
+type resumerConfig interface {
+	EthTxResumeBatchSize() uint32
+}
+
+func newResumer(config resumerConfig, callback ResumeCallback, batchCallback ResumeBatchCallback) *resumer {
+	return &resumer{
+		callback:      callback,
+		batchCallback: batchCallback,
+		config:        config,
+	}
+}
+
+// enabled returns true if any callback was registered
+func (r *resumer) enabled() bool {
+	return r.callback != nil || r.batchCallback != nil
+}
+
+// batched returns true if requests are delivered in batches
+func (r *resumer) batched() bool {
+	return r.batchCallback != nil
+}
+
+func (r *resumer) batchSize() int {
+	return int(r.config.EthTxResumeBatchSize())
+}
+
+// resume delivers reqs in order, stopping at the first error
+func (r *resumer) resume(reqs []ResumeRequest) error {
+	if !r.batched() {
+		for _, req := range reqs {
+			if err := r.callback(req.ID, req.Result, req.Err); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	size := r.batchSize()
+	if size <= 0 {
+		size = len(reqs)
+	}
+	for len(reqs) > 0 {
+		n := size
+		if n > len(reqs) {
+			n = len(reqs)
+		}
+		if err := r.batchCallback(reqs[:n]); err != nil {
+			return err
+		}
+		reqs = reqs[n:]
+	}
+	return nil
+}
+
+// enqueue queues req for delivery on the next flush. Returns true if a full
+// batch is now waiting and should be flushed.
+func (r *resumer) enqueue(req ResumeRequest) (full bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending = append(r.pending, req)
+	return len(r.pending) >= r.batchSize()
+}
+
+// flush delivers all queued requests
+func (r *resumer) flush() error {
+	r.mu.Lock()
+	reqs := r.pending
+	r.pending = nil
+	r.mu.Unlock()
+	if len(reqs) == 0 {
+		return nil
+	}
+	return r.resume(reqs)
+}
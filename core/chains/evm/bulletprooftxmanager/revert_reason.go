@@ -0,0 +1,112 @@
+package bulletprooftxmanager
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+)
+
+var (
+	// Error(string)
+	revertErrorSelector = [4]byte{0x08, 0xc3, 0x79, 0xa0}
+	// Panic(uint256)
+	revertPanicSelector = [4]byte{0x4e, 0x48, 0x7b, 0x71}
+
+	revertStringArgs  = mustNewArguments("string")
+	revertUint256Args = mustNewArguments("uint256")
+
+	revertDataRegex = regexp.MustCompile(`0x[0-9a-fA-F]*`)
+
+	// See: https://docs.soliditylang.org/en/v0.8.11/control-structures.html#panic-via-assert-and-error-via-require
+	panicCodes = map[uint64]string{
+		0x00: "generic compiler inserted panic",
+		0x01: "assertion failed",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "invalid enum value",
+		0x22: "invalid storage byte array encoding",
+		0x31: "pop on empty array",
+		0x32: "array index out of bounds",
+		0x41: "out of memory",
+		0x51: "call to zero-initialized function",
+	}
+)
+
+func mustNewArguments(types ...string) abi.Arguments {
+	var args abi.Arguments
+	for _, t := range types {
+		typ, err := abi.NewType(t, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		args = append(args, abi.Argument{Type: typ})
+	}
+	return args
+}
+
+// extractRevertData returns the raw revert payload from a JSON-RPC error, if
+// the node included one in the "data" field
+func extractRevertData(jErr *evmclient.JsonError) []byte {
+	dataStr, ok := jErr.Data.(string)
+	if !ok {
+		return nil
+	}
+	match := revertDataRegex.FindString(dataStr)
+	if len(match) < 2 {
+		return nil
+	}
+	b, err := hex.DecodeString(match[2:])
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// DecodeRevertReason decodes a revert payload returned from a reverted call.
+// The standard Error(string) and Panic(uint256) payloads are always decoded.
+// customErrors optionally maps 4 byte hex-encoded selectors (e.g.
+// "0x1234abcd") to the name of a custom error declared by the contract.
+// Returns false if the payload could not be decoded.
+func DecodeRevertReason(data []byte, customErrors map[string]string) (string, bool) {
+	if len(data) < 4 {
+		return "", false
+	}
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	switch selector {
+	case revertErrorSelector:
+		vals, err := revertStringArgs.Unpack(data[4:])
+		if err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("Error(%q)", vals[0].(string)), true
+	case revertPanicSelector:
+		vals, err := revertUint256Args.Unpack(data[4:])
+		if err != nil {
+			return "", false
+		}
+		code := vals[0].(*big.Int)
+		if code.IsUint64() {
+			if desc, exists := panicCodes[code.Uint64()]; exists {
+				return fmt.Sprintf("Panic(0x%x): %s", code, desc), true
+			}
+		}
+		return fmt.Sprintf("Panic(0x%x)", code), true
+	}
+	hexSelector := "0x" + hex.EncodeToString(selector[:])
+	for s, name := range customErrors {
+		if strings.EqualFold(s, hexSelector) {
+			if len(data) > 4 {
+				return fmt.Sprintf("%s (data: 0x%x)", name, data[4:]), true
+			}
+			return name, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,62 @@
+package bulletprooftxmanager_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+)
+
+func Test_DecodeRevertReason(t *testing.T) {
+	t.Parallel()
+
+	customErrors := map[string]string{
+		"0xAABBCCDD": "InsufficientBalance(uint256,uint256)",
+	}
+
+	tests := []struct {
+		name   string
+		data   string
+		reason string
+		ok     bool
+	}{
+		{"empty", "0x", "", false},
+		{"too short", "0x08c379", "", false},
+		{
+			"Error(string)",
+			"0x08c379a0" +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"0000000000000000000000000000000000000000000000000000000000000005" +
+				"68656c6c6f000000000000000000000000000000000000000000000000000000",
+			`Error("hello")`,
+			true,
+		},
+		{"malformed Error(string)", "0x08c379a00000", "", false},
+		{
+			"Panic(uint256) with known code",
+			"0x4e487b710000000000000000000000000000000000000000000000000000000000000011",
+			"Panic(0x11): arithmetic underflow or overflow",
+			true,
+		},
+		{
+			"Panic(uint256) with unknown code",
+			"0x4e487b710000000000000000000000000000000000000000000000000000000000000099",
+			"Panic(0x99)",
+			true,
+		},
+		{"custom error without args", "0xaabbccdd", "InsufficientBalance(uint256,uint256)", true},
+		{"custom error with args", "0xaabbccdd01", "InsufficientBalance(uint256,uint256) (data: 0x01)", true},
+		{"unknown selector", "0x11223344", "", false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			reason, ok := bulletprooftxmanager.DecodeRevertReason(hexutil.MustDecode(test.data), customErrors)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.reason, reason)
+		})
+	}
+}
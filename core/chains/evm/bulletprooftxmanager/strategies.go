@@ -19,8 +19,35 @@ type TxStrategy interface {
 	// they can call arbitrary user-specified code, because there could be a case where
 	// it would erroneously fail during simulation but would succeed for real
 	Simulate() bool
+	// SimulationMode controls what happens if the simulation reverts. It
+	// has no effect unless Simulate returns true.
+	SimulationMode() SimulationMode
 }
 
+// SimulationMode is saved to eth_txes.simulation_mode
+type SimulationMode string
+
+const (
+	// SimulationModeFatalOnRevert marks the transaction as fatally errored
+	// without sending it if the simulation reverts. This is the default.
+	SimulationModeFatalOnRevert = SimulationMode("fatal_on_revert")
+	// SimulationModeSendOnRevert logs the revert reason but sends the
+	// transaction anyway
+	SimulationModeSendOnRevert = SimulationMode("send_on_revert")
+)
+
+// WithSimulationMode wraps strategy, overriding its simulation mode
+func WithSimulationMode(strategy TxStrategy, mode SimulationMode) TxStrategy {
+	return simulationModeStrategy{strategy, mode}
+}
+
+type simulationModeStrategy struct {
+	TxStrategy
+	mode SimulationMode
+}
+
+func (s simulationModeStrategy) SimulationMode() SimulationMode { return s.mode }
+
 var _ TxStrategy = SendEveryStrategy{}
 
 func NewQueueingTxStrategy(subject uuid.UUID, queueSize uint32, simulate bool) (strategy TxStrategy) {
@@ -44,6 +71,7 @@ type SendEveryStrategy struct {
 func (SendEveryStrategy) Subject() uuid.NullUUID               { return uuid.NullUUID{} }
 func (SendEveryStrategy) PruneQueue(pg.Queryer) (int64, error) { return 0, nil }
 func (s SendEveryStrategy) Simulate() bool                     { return s.simulate }
+func (SendEveryStrategy) SimulationMode() SimulationMode       { return SimulationModeFatalOnRevert }
 
 var _ TxStrategy = DropOldestStrategy{}
 
@@ -87,3 +115,7 @@ id < (
 func (s DropOldestStrategy) Simulate() bool {
 	return s.simulate
 }
+
+func (DropOldestStrategy) SimulationMode() SimulationMode {
+	return SimulationModeFatalOnRevert
+}
@@ -21,6 +21,18 @@ func Test_SendEveryStrategy(t *testing.T) {
 	n, err := s.PruneQueue(nil)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), n)
+	assert.Equal(t, bulletprooftxmanager.SimulationModeFatalOnRevert, s.SimulationMode())
+}
+
+func Test_WithSimulationMode(t *testing.T) {
+	t.Parallel()
+
+	subject := uuid.NewV4()
+	s := bulletprooftxmanager.WithSimulationMode(bulletprooftxmanager.NewDropOldestStrategy(subject, 1, true), bulletprooftxmanager.SimulationModeSendOnRevert)
+
+	assert.Equal(t, bulletprooftxmanager.SimulationModeSendOnRevert, s.SimulationMode())
+	assert.True(t, s.Simulate())
+	assert.Equal(t, subject, s.Subject().UUID)
 }
 
 func Test_DropOldestStrategy_Subject(t *testing.T) {
@@ -0,0 +1,64 @@
+package bulletprooftxmanager
+
+import (
+	"math/big"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// Strict sequencing guarantees that a transaction is never broadcast before
+// the one that precedes it is confirmed, for target contracts where a later
+// transaction landing first (e.g. because bumping got it mined while an
+// earlier one was stuck) causes on-chain failures. It holds transactions back
+// while they are unstarted, before a nonce is assigned, so it effectively
+// limits the scope to one transaction in flight.
+//
+// It is scoped either to a whole key, with ChainCfg.EvmStrictSequencing, or to
+// the transactions of a key with the same subject, with
+// NewTx.StrictSequencing. The latter is applied when selecting the next
+// unstarted transaction, so transactions for other subjects on the key are
+// not held up by it.
+//
+// Strictly sequenced transactions are always considered for gas bumping,
+// regardless of ETH_GAS_BUMP_TX_DEPTH, since nothing else in their scope can
+// make progress until they are confirmed.
+
+// inFlightStates are the states in which a transaction holds back strictly
+// sequenced transactions in its scope
+const inFlightStates = `('in_progress', 'unconfirmed', 'confirmed_missing_receipt')`
+
+// strictSequencingHeldSubjectsClause excludes unstarted strictly sequenced
+// transactions whose subject has a transaction in flight from the same key
+const strictSequencingHeldSubjectsClause = `NOT (eth_txes.strict_sequencing AND EXISTS (
+	SELECT 1 FROM eth_txes AS in_flight
+	WHERE in_flight.from_address = eth_txes.from_address AND in_flight.evm_chain_id = eth_txes.evm_chain_id
+	AND in_flight.subject = eth_txes.subject AND in_flight.state IN ` + inFlightStates + `
+))`
+
+// countInFlightTransactions returns the number of transactions from
+// fromAddress that have been assigned a nonce but are not yet confirmed
+func countInFlightTransactions(q pg.Q, fromAddress gethCommon.Address, chainID big.Int) (count uint32, err error) {
+	err = q.Get(&count, `SELECT count(*) FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND state IN `+inFlightStates, fromAddress, chainID.String())
+	return count, errors.Wrap(err, "failed to countInFlightTransactions")
+}
+
+// strictlySequencedKeyHeld returns true if fromAddress is strictly sequenced
+// and has a transaction in flight, in which case none of its unstarted
+// transactions may be broadcast yet
+func (eb *EthBroadcaster) strictlySequencedKeyHeld(fromAddress gethCommon.Address) (bool, error) {
+	if !eb.config.KeySpecificStrictSequencing(fromAddress) {
+		return false, nil
+	}
+	n, err := countInFlightTransactions(eb.q, fromAddress, eb.chainID)
+	if err != nil {
+		return false, err
+	}
+	if n > 0 {
+		eb.logger.Debugw("Key is strictly sequenced and has a transaction in flight, holding back unstarted transactions", "address", fromAddress, "nInFlight", n)
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,162 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"testing"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+func TestEthBroadcaster_StrictSequencing(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	insertTx := func(t *testing.T, fromAddress gethCommon.Address, subject uuid.UUID, strict bool) bulletprooftxmanager.EthTx {
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.Subject = uuid.NullUUID{UUID: subject, Valid: subject != uuid.Nil}
+		etx.StrictSequencing = strict
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+	find := func(t *testing.T, etx bulletprooftxmanager.EthTx) bulletprooftxmanager.EthTx {
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		return etx
+	}
+	// confirm stands in for the confirmer finally getting a stuck transaction
+	// mined
+	confirm := func(t *testing.T, etx bulletprooftxmanager.EthTx) {
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET state = 'confirmed' WHERE id = $1`, etx.ID)
+	}
+
+	t.Run("per key, holds every unstarted tx while the key has one in flight", func(t *testing.T) {
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		require.NoError(t, evmcfg.Configure(evmtypes.ChainCfg{
+			KeySpecific: map[string]evmtypes.ChainCfg{
+				fromAddress.Hex(): {EvmStrictSequencing: null.BoolFrom(true)},
+			},
+		}))
+		t.Cleanup(func() { require.NoError(t, evmcfg.Configure(evmtypes.ChainCfg{})) })
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Times(2)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+		first := insertTx(t, fromAddress, uuid.Nil, false)
+		second := insertTx(t, fromAddress, uuid.Nil, false)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, find(t, first).State)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, find(t, second).State)
+
+		// The first is stuck, so the second is held however often the key
+		// is processed
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, find(t, second).State)
+		assert.Nil(t, find(t, second).Nonce)
+
+		confirm(t, first)
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		second = find(t, second)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, second.State)
+		require.NotNil(t, second.Nonce)
+		assert.Equal(t, *find(t, first).Nonce+1, *second.Nonce)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("per subject, holds only the subject's txes and lets other subjects proceed", func(t *testing.T) {
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Times(4)
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+		strictSubject := uuid.NewV4()
+		otherSubject := uuid.NewV4()
+
+		first := insertTx(t, fromAddress, strictSubject, true)
+		second := insertTx(t, fromAddress, strictSubject, true)
+		other1 := insertTx(t, fromAddress, otherSubject, false)
+		other2 := insertTx(t, fromAddress, uuid.Nil, false)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, find(t, first).State)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, find(t, second).State)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, find(t, other1).State)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, find(t, other2).State)
+
+		confirm(t, first)
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		second = find(t, second)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, second.State)
+		require.NotNil(t, second.Nonce)
+		assert.Greater(t, *second.Nonce, *find(t, first).Nonce)
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthConfirmer_FindEthTxsRequiringRebroadcast_StrictSequencing(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	q := pg.NewQ(db, logger.TestLogger(t), cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	currentHead := int64(30)
+	gasBumpThreshold := int64(10)
+
+	etx0 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+	etx1 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, fromAddress)
+	pgtest.MustExec(t, db, `UPDATE eth_tx_attempts SET broadcast_before_block_num = 15`)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET strict_sequencing = true WHERE id = $1`, etx1.ID)
+
+	// A depth of 1 would normally only bump the lowest nonce, but a strictly
+	// sequenced tx is always bumped
+	etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(context.Background(), q, logger.TestLogger(t), fromAddress, currentHead, gasBumpThreshold, 1, 0, cltest.FixtureChainID)
+	require.NoError(t, err)
+	require.Len(t, etxs, 2)
+	assert.Equal(t, etx0.ID, etxs[0].ID)
+	assert.Equal(t, etx1.ID, etxs[1].ID)
+}
+
+func TestBulletproofTxManager_CreateEthTransaction_StrictSequencingRequiresSubject(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, evmcfg, ethKeyStore, nil, logger.TestLogger(t))
+
+	newTx := bulletprooftxmanager.NewTx{
+		FromAddress:      fromAddress,
+		ToAddress:        cltest.NewAddress(),
+		EncodedPayload:   []byte{1, 2, 3},
+		GasLimit:         500000,
+		StrictSequencing: true,
+		Strategy:         bulletprooftxmanager.SendEveryStrategy{},
+	}
+	_, err := bptxm.CreateEthTransaction(newTx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires a strategy with a subject")
+
+	newTx.Strategy = bulletprooftxmanager.NewQueueingTxStrategy(uuid.NewV4(), 10, false)
+	etx, err := bptxm.CreateEthTransaction(newTx)
+	require.NoError(t, err)
+	assert.True(t, etx.StrictSequencing)
+}
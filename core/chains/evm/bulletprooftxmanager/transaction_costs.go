@@ -0,0 +1,67 @@
+package bulletprooftxmanager
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// TransactionCosts summarizes what was paid to send the confirmed
+// transactions of a single subject (see TxStrategy#Subject)
+type TransactionCosts struct {
+	Subject uuid.UUID
+	// TxCount is the number of confirmed transactions whose cost is known
+	TxCount int64
+	// TotalGasUsed and TotalFeeWei are summed over those transactions
+	TotalGasUsed int64
+	TotalFeeWei  *big.Int
+	// AverageGasPriceWei is the effective gas price paid on average, weighted
+	// by gas used. It is zero if there are no transactions.
+	AverageGasPriceWei *big.Int
+}
+
+// LoadTransactionCosts totals the gas used and fees paid by the subject's
+// transactions on the given chain that were created in [from, to). Only
+// confirmed transactions whose receipt recorded the gas used are included.
+func LoadTransactionCosts(q pg.Queryer, chainID *big.Int, subject uuid.UUID, from, to time.Time) (costs TransactionCosts, err error) {
+	var row struct {
+		TxCount      int64
+		TotalGasUsed int64
+		TotalFeeWei  utils.Big
+	}
+	err = q.Get(&row, `
+SELECT count(*) AS tx_count,
+	COALESCE(sum(eth_tx_attempts.gas_used), 0) AS total_gas_used,
+	COALESCE(sum(eth_tx_attempts.effective_gas_price * eth_tx_attempts.gas_used), 0) AS total_fee_wei
+FROM eth_txes
+INNER JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+WHERE eth_txes.subject = $1 AND eth_txes.evm_chain_id = $2 AND eth_txes.state = 'confirmed'
+AND eth_tx_attempts.gas_used IS NOT NULL AND eth_tx_attempts.effective_gas_price IS NOT NULL
+AND eth_txes.created_at >= $3 AND eth_txes.created_at < $4
+`, subject, chainID.String(), from, to)
+	if err != nil {
+		return costs, errors.Wrap(err, "LoadTransactionCosts failed")
+	}
+	costs = TransactionCosts{
+		Subject:            subject,
+		TxCount:            row.TxCount,
+		TotalGasUsed:       row.TotalGasUsed,
+		TotalFeeWei:        row.TotalFeeWei.ToInt(),
+		AverageGasPriceWei: big.NewInt(0),
+	}
+	if row.TotalGasUsed > 0 {
+		costs.AverageGasPriceWei.Div(costs.TotalFeeWei, big.NewInt(row.TotalGasUsed))
+	}
+	return costs, nil
+}
+
+// TransactionCosts totals what the subject's transactions created in
+// [from, to) cost on this chain, see LoadTransactionCosts
+func (b *BulletproofTxManager) TransactionCosts(subject uuid.UUID, from, to time.Time) (TransactionCosts, error) {
+	return LoadTransactionCosts(b.q, &b.chainID, subject, from, to)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/leader"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -38,6 +39,8 @@ type Chain interface {
 	HeadTracker() httypes.HeadTracker
 	Logger() logger.Logger
 	BalanceMonitor() balancemonitor.BalanceMonitor
+	LeaderElector() *leader.Elector
+	SimulationCache() *evmclient.SimulationCache
 }
 
 var _ Chain = &chain{}
@@ -48,12 +51,15 @@ type chain struct {
 	cfg             evmconfig.ChainScopedConfig
 	client          evmclient.Client
 	txm             bulletprooftxmanager.TxManager
+	txmService      services.Service
 	logger          logger.Logger
 	headBroadcaster httypes.HeadBroadcaster
 	headTracker     httypes.HeadTracker
 	logBroadcaster  log.Broadcaster
 	balanceMonitor  balancemonitor.BalanceMonitor
 	keyStore        keystore.Eth
+	leaderElector   *leader.Elector
+	simulationCache *evmclient.SimulationCache
 }
 
 func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
@@ -107,17 +113,29 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 		headTracker = opts.GenHeadTracker(dbchain)
 	}
 
+	// Results of eth_calls against the latest block are shared by transaction
+	// simulation and jobs, e.g. the keeper's checkUpkeep, until the next head
+	simulationCache := evmclient.NewSimulationCache(chainID, evmclient.DefaultSimulationCacheSize)
+	headBroadcaster.Subscribe(simulationCache)
+
 	var txm bulletprooftxmanager.TxManager
 	if cfg.EthereumDisabled() {
 		txm = &bulletprooftxmanager.NullTxManager{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
 	} else if opts.GenTxManager == nil {
-		txm = bulletprooftxmanager.NewBulletproofTxManager(db, client, cfg, opts.KeyStore, opts.EventBroadcaster, l)
+		btxm := bulletprooftxmanager.NewBulletproofTxManager(db, client, cfg, opts.KeyStore, opts.EventBroadcaster, l)
+		btxm.SetSimulationCache(simulationCache)
+		txm = btxm
 	} else {
 		txm = opts.GenTxManager(dbchain)
 	}
 
 	headBroadcaster.Subscribe(txm)
 
+	// On a standby node the txm is only started once this node becomes the
+	// leader, so that only one node broadcasts and confirms transactions
+	leaderElector := opts.LeaderElectors.For(chainID)
+	txmService := leaderElector.Gate("BulletproofTxManager", txm)
+
 	// Highest seen head height is used as part of the start of LogBroadcaster backfill range
 	highestSeenHead, err := headSaver.LatestHeadFromDB(context.Background())
 	if err != nil {
@@ -126,7 +144,7 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 
 	var balanceMonitor balancemonitor.BalanceMonitor
 	if !cfg.EthereumDisabled() && cfg.BalanceMonitorEnabled() {
-		balanceMonitor = balancemonitor.NewBalanceMonitor(client, opts.KeyStore, l)
+		balanceMonitor = balancemonitor.NewBalanceMonitor(client, opts.KeyStore, cfg.BalanceMonitorTokens(), l)
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
@@ -153,12 +171,15 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 		cfg,
 		client,
 		txm,
+		txmService,
 		l,
 		headBroadcaster,
 		headTracker,
 		logBroadcaster,
 		balanceMonitor,
 		opts.KeyStore,
+		leaderElector,
+		simulationCache,
 	}
 	return &c, nil
 }
@@ -174,7 +195,7 @@ func (c *chain) Start() error {
 			return errors.Wrap(err, "failed to dial ethclient")
 		}
 		merr = multierr.Combine(
-			c.txm.Start(),
+			c.txmService.Start(),
 			c.headBroadcaster.Start(),
 			c.headTracker.Start(),
 			c.logBroadcaster.Start(),
@@ -236,7 +257,7 @@ func (c *chain) Close() error {
 		c.logger.Debug("Chain: stopping headBroadcaster")
 		merr = multierr.Combine(merr, c.headBroadcaster.Close())
 		c.logger.Debug("Chain: stopping txm")
-		merr = multierr.Combine(merr, c.txm.Close())
+		merr = multierr.Combine(merr, c.txmService.Close())
 		c.logger.Debug("Chain: stopping client")
 		c.client.Close()
 		c.logger.Debug("Chain: stopped")
@@ -247,7 +268,7 @@ func (c *chain) Close() error {
 func (c *chain) Ready() (merr error) {
 	merr = multierr.Combine(
 		c.StartStopOnce.Ready(),
-		c.txm.Ready(),
+		c.txmService.Ready(),
 		c.headBroadcaster.Ready(),
 		c.headTracker.Ready(),
 		c.logBroadcaster.Ready(),
@@ -261,7 +282,7 @@ func (c *chain) Ready() (merr error) {
 func (c *chain) Healthy() (merr error) {
 	merr = multierr.Combine(
 		c.StartStopOnce.Healthy(),
-		c.txm.Healthy(),
+		c.txmService.Healthy(),
 		c.headBroadcaster.Healthy(),
 		c.headTracker.Healthy(),
 		c.logBroadcaster.Healthy(),
@@ -278,9 +299,11 @@ func (c *chain) Config() evmconfig.ChainScopedConfig           { return c.cfg }
 func (c *chain) LogBroadcaster() log.Broadcaster               { return c.logBroadcaster }
 func (c *chain) HeadBroadcaster() httypes.HeadBroadcaster      { return c.headBroadcaster }
 func (c *chain) TxManager() bulletprooftxmanager.TxManager     { return c.txm }
+func (c *chain) LeaderElector() *leader.Elector                { return c.leaderElector }
 func (c *chain) HeadTracker() httypes.HeadTracker              { return c.headTracker }
 func (c *chain) Logger() logger.Logger                         { return c.logger }
 func (c *chain) BalanceMonitor() balancemonitor.BalanceMonitor { return c.balanceMonitor }
+func (c *chain) SimulationCache() *evmclient.SimulationCache   { return c.simulationCache }
 
 func newEthClientFromChain(lggr logger.Logger, chain types.Chain) (evmclient.Client, error) {
 	nodes := chain.Nodes
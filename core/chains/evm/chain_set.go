@@ -1,10 +1,12 @@
 package evm
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -20,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/leader"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -39,55 +42,111 @@ type ChainSet interface {
 	Default() (Chain, error)
 	Configure(id *big.Int, enabled bool, config types.ChainCfg) (types.Chain, error)
 	UpdateConfig(id *big.Int, updaters ...ChainConfigUpdater) error
+	ValidateConfig(ctx context.Context, id *big.Int, updaters ...ChainConfigUpdater) (ConfigValidationReport, error)
+	ApplyConfigProfile(ctx context.Context, id *big.Int, name string, force bool) (types.Chain, ConfigValidationReport, error)
+	ConfigProfileDrift(id *big.Int) (ConfigProfileDrift, error)
 	Chains() []Chain
 	ChainCount() int
 	ORM() types.ORM
+	Status(id *big.Int) (ChainStatus, error)
+	Running(id *big.Int) (<-chan struct{}, error)
+	OnEachChain(fn func(Chain))
 }
 
 type chainSet struct {
-	defaultID     *big.Int
-	chains        map[string]*chain
-	startedChains []Chain
-	chainsMu      sync.RWMutex
-	logger        logger.Logger
-	orm           types.ORM
-	opts          ChainSetOpts
+	defaultID *big.Int
+	chains    map[string]*chain
+	// statuses and hooks are guarded by chainsMu
+	statuses map[string]*chainStatus
+	hooks    []func(Chain)
+	chainsMu sync.RWMutex
+	logger   logger.Logger
+	orm      types.ORM
+	opts     ChainSetOpts
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
 }
 
+// Start starts every chain concurrently, each bounded by
+// ChainSetOpts.StartTimeout, so that one chain with a misbehaving RPC does
+// not hold up the others. Chains that fail to start are marked errored
+// without failing the application, and are retried in the background with
+// backoff, see ChainStatus.
 func (cll *chainSet) Start() error {
 	if cll.opts.Config.EVMDisabled() {
 		cll.logger.Warn("EVM is disabled, no EVM-based chains will be started")
 		return nil
 	}
-	for _, c := range cll.Chains() {
-		if err := c.Start(); err != nil {
-			cll.logger.Errorw(fmt.Sprintf("EVM: Chain with ID %s failed to start. You will need to fix this issue and restart the Chainlink node before any services that use this chain will work properly. Got error: %v", c.ID(), err), "evmChainID", c.ID(), "err", err)
-			continue
-		}
-		cll.startedChains = append(cll.startedChains, c)
-	}
-	evmChainIDs := make([]*big.Int, len(cll.startedChains))
-	for i, c := range cll.startedChains {
-		evmChainIDs[i] = c.ID()
+	cll.chainsMu.RLock()
+	chains := make([]*chain, 0, len(cll.chains))
+	for _, c := range cll.chains {
+		chains = append(chains, c)
 	}
-	cll.logger.Infow(fmt.Sprintf("EVM: Started %d/%d chains, default chain ID is %s", len(cll.startedChains), len(cll.Chains()), cll.defaultID.String()), "startedEvmChainIDs", evmChainIDs)
+	cll.chainsMu.RUnlock()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var evmChainIDs []*big.Int
+	for _, c := range chains {
+		wg.Add(1)
+		go func(c *chain) {
+			defer wg.Done()
+			if cll.startChain(c) {
+				mu.Lock()
+				evmChainIDs = append(evmChainIDs, c.ID())
+				mu.Unlock()
+			}
+		}(c)
+	}
+	wg.Wait()
+	cll.logger.Infow(fmt.Sprintf("EVM: Started %d/%d chains, default chain ID is %s", len(evmChainIDs), len(chains), cll.defaultID.String()), "startedEvmChainIDs", evmChainIDs)
 	return nil
 }
 func (cll *chainSet) Close() (err error) {
 	cll.logger.Debug("EVM: stopping")
-	for _, c := range cll.startedChains {
-		err = multierr.Combine(err, c.Close())
+	close(cll.chStop)
+	cll.wg.Wait()
+	cll.chainsMu.RLock()
+	defer cll.chainsMu.RUnlock()
+	for cid, c := range cll.chains {
+		if status, exists := cll.statuses[cid]; exists && status.State == ChainRunning {
+			err = multierr.Combine(err, c.Close())
+		}
 	}
 	return
 }
+
+// Healthy reports chains that are not running, with their last error, as
+// well as unhealthy running chains
 func (cll *chainSet) Healthy() (err error) {
-	for _, c := range cll.Chains() {
+	cll.chainsMu.RLock()
+	defer cll.chainsMu.RUnlock()
+	for cid, c := range cll.chains {
+		status, exists := cll.statuses[cid]
+		switch {
+		case !exists:
+		case status.State == ChainErrored:
+			err = multierr.Combine(err, errors.Errorf("chain %s failed to start: %s", cid, status.LastError))
+			continue
+		case status.State == ChainStarting:
+			err = multierr.Combine(err, errors.Errorf("chain %s is starting", cid))
+			continue
+		}
 		err = multierr.Combine(err, c.Healthy())
 	}
 	return
 }
+
+// Ready only considers running chains, so that one errored chain does not
+// keep the whole node from being ready
 func (cll *chainSet) Ready() (err error) {
-	for _, c := range cll.Chains() {
+	cll.chainsMu.RLock()
+	defer cll.chainsMu.RUnlock()
+	for cid, c := range cll.chains {
+		if status, exists := cll.statuses[cid]; exists && status.State != ChainRunning {
+			continue
+		}
 		err = multierr.Combine(err, c.Ready())
 	}
 	return
@@ -135,10 +194,16 @@ func (cll *chainSet) initializeChain(dbchain *types.Chain) error {
 	if err != nil {
 		return errors.Wrapf(err, "initializeChain: failed to instantiate chain %s", dbchain.ID.String())
 	}
+	for _, fn := range cll.hooks {
+		fn(chain)
+	}
 	if err = chain.Start(); err != nil {
 		return errors.Wrapf(err, "initializeChain: failed to start chain %s", dbchain.ID.String())
 	}
 	cll.chains[cid] = chain
+	status := newChainStatus(*dbchain)
+	status.setRunning()
+	cll.statuses[cid] = status
 	return nil
 }
 
@@ -167,13 +232,26 @@ func (cll *chainSet) Remove(id *big.Int) error {
 		return err
 	}
 
-	cid := id.String()
+	// If a chain was removed from the DB that wasn't loaded into the memory set we're done.
+	return cll.removeChain(id.String())
+}
+
+// removeChain closes the chain with ID cid, if it is running, and removes it
+// from the set. Chains that are not running are closed by whichever attempt
+// at starting them gave up on them, see tryStartChain.
+//
+// Requires a lock on chainsMu
+func (cll *chainSet) removeChain(cid string) error {
 	chain, exists := cll.chains[cid]
 	if !exists {
-		// If a chain was removed from the DB that wasn't loaded into the memory set we're done.
 		return nil
 	}
+	status := cll.statuses[cid]
 	delete(cll.chains, cid)
+	delete(cll.statuses, cid)
+	if status != nil && status.State != ChainRunning {
+		return nil
+	}
 	return chain.Close()
 }
 
@@ -195,8 +273,7 @@ func (cll *chainSet) Configure(id *big.Int, enabled bool, config types.ChainCfg)
 	switch {
 	case exists && !enabled:
 		// Chain was toggled to disabled
-		delete(cll.chains, cid)
-		return types.Chain{}, chain.Close()
+		return types.Chain{}, cll.removeChain(cid)
 	case !exists && enabled:
 		// Chain was toggled to enabled
 		return dbchain, cll.initializeChain(&dbchain)
@@ -205,6 +282,10 @@ func (cll *chainSet) Configure(id *big.Int, enabled bool, config types.ChainCfg)
 		if err = chain.Config().Configure(config); err != nil {
 			return dbchain, err
 		}
+		if status, ok := cll.statuses[cid]; ok {
+			// An errored chain is recreated from this when retried
+			status.dbchain.Cfg = dbchain.Cfg
+		}
 		// TODO: recreate ethClient etc if node set changed
 		// https://app.shortcut.com/chainlinklabs/story/17044/chainset-should-update-chains-when-nodes-are-changed
 	}
@@ -242,10 +323,15 @@ func (cll *chainSet) UpdateConfig(id *big.Int, updaters ...ChainConfigUpdater) e
 	return err
 }
 
+// Chains returns every chain in the set, except errored ones, which have
+// been closed and are replaced by a new chain when retried
 func (cll *chainSet) Chains() (c []Chain) {
 	cll.chainsMu.RLock()
 	defer cll.chainsMu.RUnlock()
-	for _, chain := range cll.chains {
+	for cid, chain := range cll.chains {
+		if status, exists := cll.statuses[cid]; exists && status.State == ChainErrored {
+			continue
+		}
 		c = append(c, chain)
 	}
 	return c
@@ -268,12 +354,22 @@ type ChainSetOpts struct {
 	KeyStore         keystore.Eth
 	EventBroadcaster pg.EventBroadcaster
 	ORM              types.ORM
+	LeaderElectors   *leader.Electors
 
 	// Gen-functions are useful for dependency injection by tests
 	GenEthClient      func(types.Chain) evmclient.Client
 	GenLogBroadcaster func(types.Chain) log.Broadcaster
 	GenHeadTracker    func(types.Chain) httypes.HeadTracker
 	GenTxManager      func(types.Chain) bulletprooftxmanager.TxManager
+
+	// StartTimeout bounds how long starting a chain may take before it is
+	// marked errored and retried, it defaults to 1 minute
+	StartTimeout time.Duration
+	// StartRetryBackoffMin and StartRetryBackoffMax bound the backoff between
+	// attempts to start an errored chain, they default to 10 seconds and 5
+	// minutes
+	StartRetryBackoffMin time.Duration
+	StartRetryBackoffMax time.Duration
 }
 
 func LoadChainSet(opts ChainSetOpts) (ChainSet, error) {
@@ -300,7 +396,15 @@ func NewChainSet(opts ChainSetOpts, dbchains []types.Chain) (ChainSet, error) {
 		}
 	}
 	var err error
-	cll := &chainSet{defaultChainID, make(map[string]*chain), make([]Chain, 0), sync.RWMutex{}, lggr, opts.ORM, opts}
+	cll := &chainSet{
+		defaultID: defaultChainID,
+		chains:    make(map[string]*chain),
+		statuses:  make(map[string]*chainStatus),
+		logger:    lggr,
+		orm:       opts.ORM,
+		opts:      opts,
+		chStop:    make(chan struct{}),
+	}
 	for i := range dbchains {
 		cid := dbchains[i].ID.String()
 		lggr.Infow(fmt.Sprintf("EVM: Loading chain %s", cid), "evmChainID", cid)
@@ -313,6 +417,7 @@ func NewChainSet(opts ChainSetOpts, dbchains []types.Chain) (ChainSet, error) {
 			return nil, errors.Errorf("duplicate chain with ID %s", cid)
 		}
 		cll.chains[cid] = chain
+		cll.statuses[cid] = newChainStatus(dbchains[i])
 	}
 	return cll, err
 }
@@ -327,6 +432,15 @@ func checkOpts(opts *ChainSetOpts) error {
 	if opts.ORM == nil {
 		opts.ORM = NewORM(opts.DB)
 	}
+	if opts.StartTimeout == 0 {
+		opts.StartTimeout = time.Minute
+	}
+	if opts.StartRetryBackoffMin == 0 {
+		opts.StartRetryBackoffMin = 10 * time.Second
+	}
+	if opts.StartRetryBackoffMax == 0 {
+		opts.StartRetryBackoffMax = 5 * time.Minute
+	}
 	return nil
 }
 
@@ -0,0 +1,273 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/jpillora/backoff"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+)
+
+// ChainState is where a chain in a ChainSet is in starting up
+type ChainState string
+
+const (
+	// ChainStarting chains have not finished starting yet
+	ChainStarting ChainState = "starting"
+	// ChainRunning chains have started
+	ChainRunning ChainState = "running"
+	// ChainErrored chains failed, or timed out, starting, and are retried in
+	// the background with backoff
+	ChainErrored ChainState = "errored"
+)
+
+// ChainStatus is the startup status of a chain in a ChainSet
+type ChainStatus struct {
+	State ChainState `json:"state"`
+	// LastError is why the chain last failed to start, if it is errored
+	LastError string `json:"lastError,omitempty"`
+	// Attempts is how often starting the chain was attempted
+	Attempts  int       `json:"attempts"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type chainStatus struct {
+	ChainStatus
+	// dbchain is what the chain is recreated from on every retry
+	dbchain types.Chain
+	// running is closed once the chain is running
+	running chan struct{}
+	// abandoned is closed once the chain from the last failed attempt has
+	// returned from Start and been closed
+	abandoned <-chan struct{}
+}
+
+func newChainStatus(dbchain types.Chain) *chainStatus {
+	return &chainStatus{
+		ChainStatus: ChainStatus{State: ChainStarting, UpdatedAt: time.Now()},
+		dbchain:     dbchain,
+		running:     make(chan struct{}),
+	}
+}
+
+func (s *chainStatus) setRunning() {
+	s.Attempts++
+	s.State = ChainRunning
+	s.LastError = ""
+	s.UpdatedAt = time.Now()
+	close(s.running)
+}
+
+func (s *chainStatus) setErrored(err error, abandoned <-chan struct{}) {
+	s.abandoned = abandoned
+	s.Attempts++
+	s.State = ChainErrored
+	s.LastError = err.Error()
+	s.UpdatedAt = time.Now()
+}
+
+// Status returns the startup status of the chain with the given ID, or of
+// the default chain if id is nil
+func (cll *chainSet) Status(id *big.Int) (ChainStatus, error) {
+	s, err := cll.status(id)
+	if err != nil {
+		return ChainStatus{}, err
+	}
+	cll.chainsMu.RLock()
+	defer cll.chainsMu.RUnlock()
+	return s.ChainStatus, nil
+}
+
+// Running returns a channel that is closed once the chain with the given ID,
+// or the default chain if id is nil, is running. Services that depend on a
+// chain that is not running yet can wait on it, rather than fail, see
+// job.NewDormantService.
+func (cll *chainSet) Running(id *big.Int) (<-chan struct{}, error) {
+	s, err := cll.status(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.running, nil
+}
+
+func (cll *chainSet) status(id *big.Int) (*chainStatus, error) {
+	if id == nil {
+		id = cll.defaultID
+		if id == nil {
+			return nil, errors.New("no default chain ID specified")
+		}
+	}
+	cll.chainsMu.RLock()
+	defer cll.chainsMu.RUnlock()
+	s, exists := cll.statuses[id.String()]
+	if !exists {
+		return nil, errors.Errorf("chain not found with id %v", id.String())
+	}
+	return s, nil
+}
+
+// OnEachChain calls fn with every chain in the set, and with every chain
+// created later, before it is started. Chains are created later when they
+// are added or enabled, and when a chain that errored is retried, since that
+// replaces it with a new chain.
+func (cll *chainSet) OnEachChain(fn func(Chain)) {
+	cll.chainsMu.Lock()
+	defer cll.chainsMu.Unlock()
+	cll.hooks = append(cll.hooks, fn)
+	for _, c := range cll.chains {
+		fn(c)
+	}
+}
+
+// startChain makes a single attempt at starting c, and returns true if it
+// started. Otherwise the chain is marked errored, and retried in the
+// background until it starts or the chain set is closed.
+func (cll *chainSet) startChain(c *chain) bool {
+	cid := c.ID().String()
+	abandoned, err := cll.tryStartChain(c)
+
+	cll.chainsMu.Lock()
+	defer cll.chainsMu.Unlock()
+	status, exists := cll.statuses[cid]
+	if !exists || cll.chains[cid] != c {
+		// The chain was removed or replaced while starting
+		if err == nil {
+			cll.closeAbandonedChain(c)
+		}
+		return false
+	}
+	if err == nil {
+		status.setRunning()
+		return true
+	}
+	cll.logger.Errorw(fmt.Sprintf("EVM: Chain with ID %s failed to start, services that use this chain will wait for it to start, and it will be retried in the background. Got error: %v", cid, err), "evmChainID", cid, "err", err)
+	status.setErrored(err, abandoned)
+	cll.wg.Add(1)
+	go cll.retryChain(cid)
+	return false
+}
+
+// tryStartChain starts c, giving up after ChainSetOpts.StartTimeout. A chain
+// that failed, or was given up on, is closed once its Start returns, and the
+// returned channel is closed after that. Its replacement must not be started
+// before then, or both would send transactions from the same keys.
+func (cll *chainSet) tryStartChain(c *chain) (<-chan struct{}, error) {
+	done := make(chan error, 1)
+	go func() { done <- c.Start() }()
+
+	abandoned := make(chan struct{})
+	abandon := func() {
+		<-done
+		cll.closeAbandonedChain(c)
+		close(abandoned)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			cll.closeAbandonedChain(c)
+			close(abandoned)
+			return abandoned, err
+		}
+		return nil, nil
+	case <-time.After(cll.opts.StartTimeout):
+		go abandon()
+		return abandoned, errors.Errorf("timed out after %s", cll.opts.StartTimeout)
+	case <-cll.chStop:
+		go abandon()
+		return abandoned, errors.New("chain set was closed")
+	}
+}
+
+func (cll *chainSet) closeAbandonedChain(c *chain) {
+	if err := c.Close(); err != nil {
+		cll.logger.Debugw("EVM: Error closing chain that failed to start", "evmChainID", c.ID(), "err", err)
+	}
+}
+
+// retryChain recreates and starts the errored chain with ID cid, with
+// backoff, until it starts, the chain is removed or the chain set is closed
+func (cll *chainSet) retryChain(cid string) {
+	defer cll.wg.Done()
+	b := backoff.Backoff{
+		Min:    cll.opts.StartRetryBackoffMin,
+		Max:    cll.opts.StartRetryBackoffMax,
+		Jitter: true,
+	}
+	for {
+		select {
+		case <-cll.chStop:
+			return
+		case <-time.After(b.Duration()):
+		}
+
+		cll.chainsMu.RLock()
+		status, exists := cll.statuses[cid]
+		var abandoned <-chan struct{}
+		if exists {
+			abandoned = status.abandoned
+		}
+		cll.chainsMu.RUnlock()
+		if abandoned != nil {
+			select {
+			case <-abandoned:
+			default:
+				cll.logger.Warnw("EVM: Waiting for the chain that timed out starting to finish before retrying", "evmChainID", cid)
+				select {
+				case <-cll.chStop:
+					return
+				case <-abandoned:
+				}
+			}
+		}
+
+		cll.chainsMu.Lock()
+		status, exists = cll.statuses[cid]
+		if !exists || status.State != ChainErrored {
+			cll.chainsMu.Unlock()
+			return
+		}
+		c, err := newChain(status.dbchain, cll.opts)
+		if err != nil {
+			status.setErrored(err, nil)
+			cll.chainsMu.Unlock()
+			cll.logger.Errorw("EVM: Failed to recreate errored chain, will retry", "evmChainID", cid, "err", err)
+			continue
+		}
+		for _, fn := range cll.hooks {
+			fn(c)
+		}
+		cll.chains[cid] = c
+		attempt := status.Attempts + 1
+		cll.chainsMu.Unlock()
+
+		cll.logger.Infow("EVM: Retrying to start errored chain", "evmChainID", cid, "attempt", attempt)
+		abandoned, err = cll.tryStartChain(c)
+
+		cll.chainsMu.Lock()
+		if status, exists = cll.statuses[cid]; !exists || cll.chains[cid] != c {
+			cll.chainsMu.Unlock()
+			if err == nil {
+				cll.closeAbandonedChain(c)
+			}
+			return
+		}
+		if err != nil {
+			status.setErrored(err, abandoned)
+			attempts := status.Attempts
+			cll.chainsMu.Unlock()
+			cll.logger.Errorw("EVM: Errored chain failed to start again, will retry", "evmChainID", cid, "attempts", attempts, "err", err)
+			continue
+		}
+		status.setRunning()
+		attempts := status.Attempts
+		cll.chainsMu.Unlock()
+		// Jobs that were waiting for this chain only register with its log
+		// broadcaster once it is running, so it has no dependents to wait for
+		c.logBroadcaster.DependentReady()
+		cll.logger.Infow("EVM: Errored chain started", "evmChainID", cid, "attempts", attempts)
+		return
+	}
+}
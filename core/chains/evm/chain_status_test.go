@@ -0,0 +1,193 @@
+package evm_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestChainSet_Start_IsolatesChainsThatFailToStart(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.Dev = null.BoolFrom(false)
+	cfg.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+
+	healthyID, hangingID := utils.NewBigI(1), utils.NewBigI(3)
+
+	healthyClient := cltest.NewEthClientMock(t)
+	healthyClient.On("Dial", mock.Anything).Return(nil).Once()
+	healthyClient.On("Close").Return().Maybe()
+
+	// The hanging chain's RPC hangs on the initial dial until it recovers,
+	// every retry gets a new client, like it would from newChain
+	recovered := make(chan struct{})
+	newHangingClient := func() evmclient.Client {
+		c := cltest.NewEthClientMock(t)
+		c.On("Dial", mock.Anything).Return(nil).Run(func(mock.Arguments) { <-recovered })
+		c.On("Close").Return().Maybe()
+		return c
+	}
+
+	cs, err := evm.NewChainSet(evm.ChainSetOpts{
+		Config: cfg,
+		Logger: logger.NullLogger,
+		GenEthClient: func(c types.Chain) evmclient.Client {
+			if c.ID.Cmp(healthyID) == 0 {
+				return healthyClient
+			}
+			return newHangingClient()
+		},
+		GenHeadTracker: func(types.Chain) httypes.HeadTracker { return headtracker.NullTracker },
+		GenLogBroadcaster: func(types.Chain) log.Broadcaster {
+			return &log.NullBroadcaster{}
+		},
+		GenTxManager: func(types.Chain) bulletprooftxmanager.TxManager {
+			return &bulletprooftxmanager.NullTxManager{}
+		},
+		StartTimeout:         100 * time.Millisecond,
+		StartRetryBackoffMin: 10 * time.Millisecond,
+		StartRetryBackoffMax: 10 * time.Millisecond,
+	}, []types.Chain{
+		{ID: *healthyID, Enabled: true},
+		{ID: *hangingID, Enabled: true},
+	})
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	created := make(map[string]int)
+	cs.OnEachChain(func(c evm.Chain) {
+		mu.Lock()
+		defer mu.Unlock()
+		created[c.ID().String()]++
+	})
+
+	require.NoError(t, cs.Start())
+	t.Cleanup(func() { assert.NoError(t, cs.Close()) })
+
+	status, err := cs.Status(healthyID.ToInt())
+	require.NoError(t, err)
+	assert.Equal(t, evm.ChainRunning, status.State)
+	running, err := cs.Running(healthyID.ToInt())
+	require.NoError(t, err)
+	select {
+	case <-running:
+	default:
+		t.Fatal("expected the healthy chain to be running")
+	}
+
+	status, err = cs.Status(hangingID.ToInt())
+	require.NoError(t, err)
+	assert.Equal(t, evm.ChainErrored, status.State)
+	assert.Contains(t, status.LastError, "timed out")
+	hangingRunning, err := cs.Running(hangingID.ToInt())
+	require.NoError(t, err)
+	select {
+	case <-hangingRunning:
+		t.Fatal("expected the hanging chain not to be running")
+	default:
+	}
+
+	// The errored chain does not keep the node from being ready, but is
+	// reported by the health check
+	assert.NoError(t, cs.Ready())
+	err = cs.Healthy()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chain 3 failed to start")
+
+	// Errored chains are not handed out, and no replacement is started while
+	// the chain that timed out is still starting
+	for _, c := range cs.Chains() {
+		assert.NotEqual(t, hangingID.String(), c.ID().String())
+	}
+	time.Sleep(20 * time.Millisecond)
+	mu.Lock()
+	assert.Equal(t, 1, created[hangingID.String()])
+	mu.Unlock()
+
+	close(recovered)
+
+	select {
+	case <-hangingRunning:
+	case <-time.After(cltest.WaitTimeout(t)):
+		t.Fatal("expected the hanging chain to eventually be running")
+	}
+	status, err = cs.Status(hangingID.ToInt())
+	require.NoError(t, err)
+	assert.Equal(t, evm.ChainRunning, status.State)
+	assert.Empty(t, status.LastError)
+	assert.Greater(t, status.Attempts, 1)
+	assert.NoError(t, cs.Healthy())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, created[healthyID.String()])
+	assert.Greater(t, created[hangingID.String()], 1, "expected the retried chain to be wired again")
+	healthyClient.AssertExpectations(t)
+}
+
+func TestChainSet_Remove_ErroredChain(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.Dev = null.BoolFrom(false)
+	cfg.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+
+	id := utils.NewBigI(3)
+	orm := new(evmmocks.ORM)
+	orm.On("DeleteChain", *id).Return(nil).Once()
+
+	cs, err := evm.NewChainSet(evm.ChainSetOpts{
+		Config: cfg,
+		Logger: logger.NullLogger,
+		ORM:    orm,
+		GenEthClient: func(types.Chain) evmclient.Client {
+			c := cltest.NewEthClientMock(t)
+			c.On("Dial", mock.Anything).Return(errors.New("connection refused"))
+			c.On("Close").Return().Maybe()
+			return c
+		},
+		GenHeadTracker: func(types.Chain) httypes.HeadTracker { return headtracker.NullTracker },
+		GenLogBroadcaster: func(types.Chain) log.Broadcaster {
+			return &log.NullBroadcaster{}
+		},
+		GenTxManager: func(types.Chain) bulletprooftxmanager.TxManager {
+			return &bulletprooftxmanager.NullTxManager{}
+		},
+		StartRetryBackoffMin: time.Hour,
+		StartRetryBackoffMax: time.Hour,
+	}, []types.Chain{{ID: *id, Enabled: true}})
+	require.NoError(t, err)
+	require.NoError(t, cs.Start())
+	t.Cleanup(func() { assert.NoError(t, cs.Close()) })
+
+	status, err := cs.Status(id.ToInt())
+	require.NoError(t, err)
+	require.Equal(t, evm.ChainErrored, status.State)
+	assert.Empty(t, cs.Chains())
+
+	// The errored chain was already closed when it failed to start
+	require.NoError(t, cs.Remove(id.ToInt()))
+	_, err = cs.Status(id.ToInt())
+	assert.Error(t, err)
+
+	orm.AssertExpectations(t)
+}
@@ -103,6 +103,11 @@ func (e *erroringNode) EthSubscribe(ctx context.Context, channel interface{}, ar
 	return nil, errors.New(e.errMsg)
 }
 
+// Name is used when recording sends, which fail with no live nodes
+func (e *erroringNode) Name() string {
+	return "none"
+}
+
 func (e *erroringNode) String() string {
 	return "<erroring node>"
 }
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -49,6 +50,11 @@ const (
 	TooExpensive
 	FeeTooLow
 	FeeTooHigh
+	L2FeeTooLow
+	L2Full
+	TransactionAlreadyMined
+	GasLimitTooLow
+	TransactionAlreadyKnown
 	Fatal
 )
 
@@ -64,6 +70,7 @@ var parity = ClientErrors{
 	TransactionAlreadyInMempool:       regexp.MustCompile("Transaction with the same hash was already imported."),
 	TerminallyUnderpriced:             regexp.MustCompile("^Transaction gas price is too low. It does not satisfy your node's minimal gas price"),
 	InsufficientEth:                   regexp.MustCompile("^(Insufficient funds. The account you tried to send transaction from does not have enough funds.|Insufficient balance for transaction.)"),
+	TransactionAlreadyKnown:           regexp.MustCompile(`("message":\s*"|desc = )Transaction with the same hash was already imported\.`),
 	Fatal:                             parFatal,
 }
 
@@ -77,6 +84,7 @@ var geth = ClientErrors{
 	TerminallyUnderpriced:             regexp.MustCompile(`(: |^)transaction underpriced$`),
 	InsufficientEth:                   regexp.MustCompile(`(: |^)(insufficient funds for transfer|insufficient funds for gas \* price \+ value|insufficient balance for transfer)$`),
 	TooExpensive:                      regexp.MustCompile(`(: |^)tx fee \([0-9\.]+ ether\) exceeds the configured cap \([0-9\.]+ ether\)$`),
+	TransactionAlreadyKnown:           regexp.MustCompile(`("message":\s*"|desc = )(?i)(known transaction|already known)`),
 	Fatal:                             gethFatal,
 }
 
@@ -93,6 +101,15 @@ var arbitrum = ClientErrors{
 	Fatal:                 arbitrumFatal,
 }
 
+// Arbitrum Nitro
+// See: https://github.com/OffchainLabs/nitro/blob/master/arbos/l1pricing/l1pricing.go
+var arbitrumNitro = ClientErrors{
+	NonceTooLow:     regexp.MustCompile(`(: |^)nonce too low: address 0x[0-9a-fA-F]{40}, tx: \d+ state: \d+$`),
+	InsufficientEth: regexp.MustCompile(`(: |^)insufficient funds for gas \* price \+ value: address 0x[0-9a-fA-F]{40} have \d+ want \d+$`),
+	L2FeeTooLow:     regexp.MustCompile(`(: |^)max fee per gas less than block base fee: address 0x[0-9a-fA-F]{40}, maxFeePerGas: \d+ baseFee: \d+$`),
+	L2Full:          regexp.MustCompile(`(: |^)(queue full|sequencer pending tx pool full, please try again)$`),
+}
+
 var optimism = ClientErrors{
 	FeeTooLow:  regexp.MustCompile(`(: |^)fee too low: \d+, use at least tx.gasLimit = \d+ and tx.gasPrice = \d+$`),
 	FeeTooHigh: regexp.MustCompile(`(: |^)fee too high: \d+, use less than \d+ \* [0-9\.]+$`),
@@ -104,11 +121,35 @@ var substrate = ClientErrors{
 	TransactionAlreadyInMempool: regexp.MustCompile(`(: |^)Pool\(AlreadyImported\)$`),
 }
 
+// Avalanche (coreth)
+// See: https://github.com/ava-labs/coreth/blob/master/core/tx_pool.go
 var avalanche = ClientErrors{
-	NonceTooLow: regexp.MustCompile(`(: |^)nonce too low: address 0x[0-9a-fA-F]{40} current nonce \([\d]+\) > tx nonce \([\d]+\)$`),
+	NonceTooLow:           regexp.MustCompile(`(: |^)nonce too low: address 0x[0-9a-fA-F]{40} current nonce \([\d]+\) > tx nonce \([\d]+\)$`),
+	TerminallyUnderpriced: regexp.MustCompile(`(: |^)transaction underpriced: address 0x[0-9a-fA-F]{40} have gas fee cap \(\d+\) < pool minimum fee cap \(\d+\)$`),
+	InsufficientEth:       regexp.MustCompile(`(: |^)insufficient funds for gas \* price \+ value: address 0x[0-9a-fA-F]{40} have \(\d+\) want \(\d+\)$`),
+}
+
+// BSC (parlia)
+// See: https://github.com/bnb-chain/bsc/blob/master/core/tx_pool.go
+var bsc = ClientErrors{
+	TerminallyUnderpriced: regexp.MustCompile(`(: |^)transaction underpriced: gas tip cap \d+, minimum needed \d+$`),
+	InsufficientEth:       regexp.MustCompile(`(: |^)insufficient funds for gas \* price \+ value: address 0x[0-9a-fA-F]{40} have \d+ want \d+$`),
+}
+
+// Harmony
+// See: https://github.com/harmony-one/harmony/blob/main/core/tx_pool.go
+var harmonyFatal = regexp.MustCompile("(: |^)(invalid shard|staking message does not match directive message|`from` address of transaction in blacklist|`to` address of transaction in blacklist)$")
+var harmony = ClientErrors{
+	TransactionAlreadyMined: regexp.MustCompile(`(: |^)transaction already finalized$`),
+	Fatal:                   harmonyFatal,
+}
+
+// zkSync
+var zkSync = ClientErrors{
+	GasLimitTooLow: regexp.MustCompile(`(: |^)(?i)(gas limit (is )?too low|not enough gas for transaction validation)`),
 }
 
-var clients = []ClientErrors{parity, geth, arbitrum, optimism, substrate, avalanche}
+var clients = []ClientErrors{parity, geth, arbitrum, arbitrumNitro, optimism, substrate, avalanche, bsc, harmony, zkSync}
 
 func (s *SendError) is(errorType int) bool {
 	if s == nil || s.err == nil {
@@ -128,6 +169,10 @@ func (s *SendError) is(errorType int) bool {
 
 var hexDataRegex = regexp.MustCompile(`0x\w+$`)
 
+// suggestedGasLimitRegex extracts the gas limit a node says is required from
+// a gas limit too low error, e.g. "gas limit too low: 21000, required: 78654"
+var suggestedGasLimitRegex = regexp.MustCompile(`(?i)required:? (\d+)`)
+
 // IsReplacementUnderpriced indicates that a transaction already exists in the mempool with this nonce but a different gas price or payload
 func (s *SendError) IsReplacementUnderpriced() bool {
 	return s.is(ReplacementTransactionUnderpriced)
@@ -142,6 +187,15 @@ func (s *SendError) IsTransactionAlreadyInMempool() bool {
 	return s.is(TransactionAlreadyInMempool)
 }
 
+// IsTransactionAlreadyKnown returns true if the node already has this exact
+// transaction, whether it says so directly (see
+// IsTransactionAlreadyInMempool), or in a JSON-RPC error that was wrapped on
+// the way, e.g. by a proxy in front of the node. Like nonce too low, it means
+// the transaction was sent before, so it is not an error.
+func (s *SendError) IsTransactionAlreadyKnown() bool {
+	return s.IsTransactionAlreadyInMempool() || s.is(TransactionAlreadyKnown)
+}
+
 // IsTerminallyUnderpriced indicates that this transaction is so far underpriced the node won't even accept it in the first place
 func (s *SendError) IsTerminallyUnderpriced() bool {
 	return s.is(TerminallyUnderpriced)
@@ -173,6 +227,86 @@ func (s *SendError) IsFeeTooHigh() bool {
 	return s.is(FeeTooHigh)
 }
 
+// IsL2FeeTooLow is an l2-specific error returned when the fee offered is below
+// the L2's current base fee. The fee must be re-estimated.
+func (s *SendError) IsL2FeeTooLow() bool {
+	return s.is(L2FeeTooLow)
+}
+
+// IsL2Full is an l2-specific error returned when the sequencer is not
+// accepting any more transactions for now
+func (s *SendError) IsL2Full() bool {
+	return s.is(L2Full)
+}
+
+// IsTransactionAlreadyMined is returned by some clients (e.g. Harmony) when a
+// transaction with this nonce has already been included in a block
+func (s *SendError) IsTransactionAlreadyMined() bool {
+	return s.is(TransactionAlreadyMined)
+}
+
+// IsGasLimitTooLow is an l2-specific error (e.g. zkSync) returned when the gas
+// limit of the transaction is too low for it to be accepted. Unlike geth's
+// "intrinsic gas too low" it is not fatal, since the limit the chain requires
+// can change with its L1 pricing.
+func (s *SendError) IsGasLimitTooLow() bool {
+	return s.is(GasLimitTooLow)
+}
+
+// Class returns a short, machine readable description of the kind of error,
+// e.g. for metrics. A nil error is "accepted".
+func (s *SendError) Class() string {
+	switch {
+	case s == nil || s.err == nil:
+		return "accepted"
+	case s.IsTransactionAlreadyKnown():
+		return "already_known"
+	case s.IsNonceTooLowError():
+		return "nonce_too_low"
+	case s.IsTransactionAlreadyMined():
+		return "already_mined"
+	case s.IsReplacementUnderpriced():
+		return "replacement_underpriced"
+	case s.IsTerminallyUnderpriced():
+		return "terminally_underpriced"
+	case s.IsTemporarilyUnderpriced():
+		return "temporarily_underpriced"
+	case s.IsInsufficientEth():
+		return "insufficient_eth"
+	case s.IsTooExpensive():
+		return "too_expensive"
+	case s.IsFeeTooLow(), s.IsL2FeeTooLow():
+		return "fee_too_low"
+	case s.IsFeeTooHigh():
+		return "fee_too_high"
+	case s.IsL2Full():
+		return "l2_full"
+	case s.IsGasLimitTooLow():
+		return "gas_limit_too_low"
+	case s.Fatal():
+		return "fatal"
+	default:
+		return "unknown"
+	}
+}
+
+// SuggestedGasLimit returns the gas limit required by the node, if it
+// included one in a gas limit too low error
+func (s *SendError) SuggestedGasLimit() (uint64, bool) {
+	if !s.IsGasLimitTooLow() {
+		return 0, false
+	}
+	matches := suggestedGasLimitRegex.FindStringSubmatch(s.CauseStr())
+	if len(matches) != 2 {
+		return 0, false
+	}
+	limit, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return limit, true
+}
+
 func NewFatalSendError(e error) *SendError {
 	if e == nil {
 		return nil
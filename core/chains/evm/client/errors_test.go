@@ -98,6 +98,35 @@ func Test_Eth_Errors(t *testing.T) {
 		}
 	})
 
+	t.Run("IsTransactionAlreadyKnown", func(t *testing.T) {
+		assert.False(t, randomError.IsTransactionAlreadyKnown())
+
+		tests := []struct {
+			message string
+			expect  bool
+		}{
+			// Geth
+			{"already known", true},
+			{"known transaction: 0x7f657507aee0511e36d2d1972a6b22e917cc89f92b6c12c4dbd57eaabb236960", true},
+			// Parity
+			{"Transaction with the same hash was already imported.", true},
+			// JSON-RPC errors wrapped by a proxy in front of the node
+			{`{"code":-32000,"message":"already known"}`, true},
+			{`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message": "known transaction: 7f65"}}`, true},
+			{`{"jsonrpc":"2.0","id":1,"error":{"code":-32010,"message":"Transaction with the same hash was already imported."}}`, true},
+			{"rpc error: code = Unknown desc = already known", true},
+			// Not the same transaction
+			{`{"code":-32000,"message":"nonce too low"}`, false},
+			{`{"code":-32000,"message":"unknown transaction type"}`, false},
+		}
+		for _, test := range tests {
+			err = evmclient.NewSendErrorS(test.message)
+			assert.Equal(t, test.expect, err.IsTransactionAlreadyKnown(), test.message)
+			err = newSendErrorWrapped(test.message)
+			assert.Equal(t, test.expect, err.IsTransactionAlreadyKnown(), test.message)
+		}
+	})
+
 	t.Run("IsTerminallyUnderpriced", func(t *testing.T) {
 		assert.False(t, randomError.IsTerminallyUnderpriced())
 
@@ -223,6 +252,88 @@ func Test_Eth_Errors(t *testing.T) {
 	})
 }
 
+func Test_Eth_Errors_ChainSpecific(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		client  string
+		message string
+		is      func(*evmclient.SendError) bool
+		name    string
+	}{
+		// Arbitrum Nitro
+		{"arbitrum nitro", "nonce too low: address 0x0499BEA33347cb62D79A9C0b1EDA01d8d329894c, tx: 5 state: 7", (*evmclient.SendError).IsNonceTooLowError, "IsNonceTooLowError"},
+		{"arbitrum nitro", "insufficient funds for gas * price + value: address 0x0499BEA33347cb62D79A9C0b1EDA01d8d329894c have 1000 want 2000", (*evmclient.SendError).IsInsufficientEth, "IsInsufficientEth"},
+		{"arbitrum nitro", "max fee per gas less than block base fee: address 0x0499BEA33347cb62D79A9C0b1EDA01d8d329894c, maxFeePerGas: 100000000 baseFee: 110000000", (*evmclient.SendError).IsL2FeeTooLow, "IsL2FeeTooLow"},
+		{"arbitrum nitro", "queue full", (*evmclient.SendError).IsL2Full, "IsL2Full"},
+		{"arbitrum nitro", "sequencer pending tx pool full, please try again", (*evmclient.SendError).IsL2Full, "IsL2Full"},
+		// Avalanche
+		{"avalanche", "nonce too low: address 0x0499BEA33347cb62D79A9C0b1EDA01d8d329894c current nonce (5833) > tx nonce (5511)", (*evmclient.SendError).IsNonceTooLowError, "IsNonceTooLowError"},
+		{"avalanche", "insufficient funds for gas * price + value: address 0x0499BEA33347cb62D79A9C0b1EDA01d8d329894c have (1000) want (2000)", (*evmclient.SendError).IsInsufficientEth, "IsInsufficientEth"},
+		{"avalanche", "transaction underpriced: address 0x0499BEA33347cb62D79A9C0b1EDA01d8d329894c have gas fee cap (24000000000) < pool minimum fee cap (25000000000)", (*evmclient.SendError).IsTerminallyUnderpriced, "IsTerminallyUnderpriced"},
+		// BSC
+		{"bsc", "transaction underpriced", (*evmclient.SendError).IsTerminallyUnderpriced, "IsTerminallyUnderpriced"},
+		{"bsc", "transaction underpriced: gas tip cap 1000000000, minimum needed 5000000000", (*evmclient.SendError).IsTerminallyUnderpriced, "IsTerminallyUnderpriced"},
+		{"bsc", "insufficient funds for gas * price + value: address 0x0499BEA33347cb62D79A9C0b1EDA01d8d329894c have 1000 want 2000", (*evmclient.SendError).IsInsufficientEth, "IsInsufficientEth"},
+		// Harmony
+		{"harmony", "transaction already finalized", (*evmclient.SendError).IsTransactionAlreadyMined, "IsTransactionAlreadyMined"},
+		{"harmony", "insufficient funds for gas * price + value", (*evmclient.SendError).IsInsufficientEth, "IsInsufficientEth"},
+		// zkSync
+		{"zksync", "gas limit too low: 21000, required: 78654", (*evmclient.SendError).IsGasLimitTooLow, "IsGasLimitTooLow"},
+		{"zksync", "Not enough gas for transaction validation", (*evmclient.SendError).IsGasLimitTooLow, "IsGasLimitTooLow"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %s", test.client, test.name), func(t *testing.T) {
+			err := evmclient.NewSendErrorS(test.message)
+			assert.True(t, test.is(err), test.message)
+			assert.False(t, err.Fatal(), test.message)
+			err = newSendErrorWrapped(test.message)
+			assert.True(t, test.is(err), test.message)
+		})
+	}
+
+	randomError := evmclient.NewSendErrorS("some old bollocks")
+	assert.False(t, randomError.IsL2FeeTooLow())
+	assert.False(t, randomError.IsL2Full())
+	assert.False(t, randomError.IsTransactionAlreadyMined())
+	assert.False(t, randomError.IsGasLimitTooLow())
+	// Nil
+	var err *evmclient.SendError
+	assert.False(t, err.IsL2FeeTooLow())
+	assert.False(t, err.IsL2Full())
+	assert.False(t, err.IsTransactionAlreadyMined())
+	assert.False(t, err.IsGasLimitTooLow())
+}
+
+func Test_Eth_Errors_SuggestedGasLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		message  string
+		limit    uint64
+		expectOk bool
+	}{
+		{"gas limit too low: 21000, required: 78654", 78654, true},
+		{"gas limit is too low, required 1500000", 1500000, true},
+		{"Not enough gas for transaction validation", 0, false},
+		// Not a gas limit too low error, even though it mentions a number
+		{"transaction underpriced: gas tip cap 1000000000, minimum needed 5000000000", 0, false},
+		{"gas limit too low: 21000, required: 99999999999999999999999", 0, false},
+	}
+
+	for _, test := range tests {
+		err := evmclient.NewSendErrorS(test.message)
+		limit, ok := err.SuggestedGasLimit()
+		assert.Equal(t, test.expectOk, ok, test.message)
+		assert.Equal(t, test.limit, limit, test.message)
+
+		limit, ok = newSendErrorWrapped(test.message).SuggestedGasLimit()
+		assert.Equal(t, test.expectOk, ok, test.message)
+		assert.Equal(t, test.limit, limit, test.message)
+	}
+}
+
 func Test_Eth_Errors_Fatal(t *testing.T) {
 	t.Parallel()
 
@@ -262,6 +373,13 @@ func Test_Eth_Errors_Fatal(t *testing.T) {
 		{"forbidden sender address", true},
 		{"tx dropped due to L2 congestion", false},
 		{"execution reverted: error code", true},
+
+		// Harmony
+		{"invalid shard", true},
+		{"staking message does not match directive message", true},
+		{"`from` address of transaction in blacklist", true},
+		{"`to` address of transaction in blacklist", true},
+		{"transaction already finalized", false},
 	}
 
 	for _, test := range tests {
@@ -272,6 +390,29 @@ func Test_Eth_Errors_Fatal(t *testing.T) {
 	}
 }
 
+func Test_Eth_Errors_Class(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		err   error
+		class string
+	}{
+		{nil, "accepted"},
+		{errors.New("already known"), "already_known"},
+		{errors.New(`{"code":-32000,"message":"already known"}`), "already_known"},
+		{errors.New("nonce too low"), "nonce_too_low"},
+		{errors.New("replacement transaction underpriced"), "replacement_underpriced"},
+		{errors.New("transaction underpriced"), "terminally_underpriced"},
+		{errors.New("insufficient funds for transfer"), "insufficient_eth"},
+		{errors.New("invalid sender"), "fatal"},
+		{errors.New("some old bollocks"), "unknown"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.class, evmclient.NewSendError(test.err).Class(), "%v", test.err)
+	}
+}
+
 func Test_ExtractRevertReasonFromRPCError(t *testing.T) {
 	message := "important revert reason"
 	messageHex := utils.RemoveHexPrefix(hexutil.Encode([]byte(message)))
@@ -183,7 +183,7 @@ func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error
 		wg.Add(1)
 		go func(n SendOnlyNode) {
 			defer wg.Done()
-			err := NewSendError(n.SendTransaction(ctx, tx))
+			err := NewSendError(p.send(ctx, n, tx, false))
 			if err == nil || err.IsNonceTooLowError() || err.IsTransactionAlreadyInMempool() {
 				// Nonce too low or transaction known errors are expected since
 				// the primary SendTransaction may well have succeeded already
@@ -193,7 +193,23 @@ func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error
 		}(n)
 	}
 
-	return main.SendTransaction(ctx, tx)
+	return p.send(ctx, main, tx, true)
+}
+
+// send sends tx to a single node, recording how the node handled it
+func (p *Pool) send(ctx context.Context, n SendOnlyNode, tx *types.Transaction, main bool) error {
+	start := time.Now()
+	err := n.SendTransaction(ctx, tx)
+	class := NewSendError(err).Class()
+	promPoolSends.WithLabelValues(p.chainID.String(), n.Name(), class).Inc()
+	recordSend(ctx, SendResult{
+		Node:      n.Name(),
+		Main:      main,
+		Class:     class,
+		LatencyMs: time.Since(start).Milliseconds(),
+		SentAt:    start,
+	})
+	return err
 }
 
 func (p *Pool) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -236,3 +237,51 @@ func TestPool_RunLoop(t *testing.T) {
 	})
 
 }
+
+func TestPool_SendTransaction_RecordsSends(t *testing.T) {
+	n1 := new(evmmocks.Node)
+	n1.Test(t)
+	n2 := new(evmmocks.Node)
+	n2.Test(t)
+	s1 := new(evmmocks.SendOnlyNode)
+	s1.Test(t)
+	p := evmclient.NewPool(logger.TestLogger(t), []evmclient.Node{n1, n2}, []evmclient.SendOnlyNode{s1}, &cltest.FixtureChainID)
+
+	n1.On("Name").Return("n1")
+	n2.On("Name").Return("n2")
+	s1.On("Name").Return("s1")
+	n1.On("String").Maybe().Return("n1")
+	n2.On("String").Maybe().Return("n2")
+	s1.On("String").Maybe().Return("s1")
+
+	// n2 is not alive, so n1 is used as the main node
+	n1.On("State").Return(evmclient.NodeStateAlive)
+	n2.On("State").Return(evmclient.NodeStateDead)
+
+	tx := types.NewTransaction(uint64(42), cltest.NewAddress(), big.NewInt(142), 242, big.NewInt(342), []byte{1, 2, 3})
+	n1.On("SendTransaction", mock.Anything, tx).Return(nil).Once()
+	n2.On("SendTransaction", mock.Anything, tx).Return(errors.New("nonce too low")).Once()
+	s1.On("SendTransaction", mock.Anything, tx).Return(errors.New("some old bollocks")).Once()
+
+	var recorder evmclient.SendRecorder
+	require.NoError(t, p.SendTransaction(evmclient.WithSendRecorder(context.Background(), &recorder), tx))
+
+	results := map[string]evmclient.SendResult{}
+	for _, r := range recorder.Results() {
+		results[r.Node] = r
+	}
+	require.Len(t, results, 3)
+	assert.True(t, results["n1"].Main)
+	assert.Equal(t, "accepted", results["n1"].Class)
+	assert.False(t, results["n2"].Main)
+	assert.Equal(t, "nonce_too_low", results["n2"].Class)
+	assert.False(t, results["s1"].Main)
+	assert.Equal(t, "unknown", results["s1"].Class)
+	for _, r := range results {
+		assert.False(t, r.SentAt.IsZero())
+	}
+
+	n1.AssertExpectations(t)
+	n2.AssertExpectations(t)
+	s1.AssertExpectations(t)
+}
@@ -24,6 +24,7 @@ type SendOnlyNode interface {
 	SendTransaction(ctx context.Context, tx *types.Transaction) error
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
 
+	Name() string
 	String() string
 }
 
@@ -90,6 +91,10 @@ func (s sendOnlyNode) wrap(err error) error {
 	return wrap(err, fmt.Sprintf("sendonly http (%s)", s.uri.String()))
 }
 
+func (s sendOnlyNode) Name() string {
+	return s.name
+}
+
 func (s sendOnlyNode) String() string {
 	return fmt.Sprintf("(secondary)%s:%s", s.name, s.uri.String())
 }
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var promPoolSends = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "evm_pool_rpc_node_sends_total",
+	Help: "The number of transactions sent to each eth node, by how the node responded",
+}, []string{"evmChainID", "nodeName", "class"})
+
+// SendResult records how a single eth node handled a SendTransaction call
+type SendResult struct {
+	// Node is the name of the node, never its URL since that may hold
+	// credentials
+	Node string `json:"node"`
+	// Main is set on the node whose response was returned to the caller.
+	// The other nodes of the pool are sent the transaction in parallel and
+	// their responses are only recorded.
+	Main bool `json:"main"`
+	// Class is the SendError class of the response, see SendError.Class
+	Class     string    `json:"class"`
+	LatencyMs int64     `json:"latencyMs"`
+	SentAt    time.Time `json:"sentAt"`
+}
+
+// SendRecorder collects the SendResults of the SendTransaction calls made
+// with a context returned by WithSendRecorder. It is safe for concurrent use.
+type SendRecorder struct {
+	mu      sync.Mutex
+	results []SendResult
+}
+
+// Results returns the results recorded so far
+func (r *SendRecorder) Results() []SendResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]SendResult(nil), r.results...)
+}
+
+func (r *SendRecorder) record(res SendResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, res)
+}
+
+type sendRecorderKey struct{}
+
+// WithSendRecorder returns a context that records how each node handled a
+// SendTransaction call in r
+func WithSendRecorder(ctx context.Context, r *SendRecorder) context.Context {
+	return context.WithValue(ctx, sendRecorderKey{}, r)
+}
+
+func recordSend(ctx context.Context, res SendResult) {
+	if r, ok := ctx.Value(sendRecorderKey{}).(*SendRecorder); ok {
+		r.record(res)
+	}
+}
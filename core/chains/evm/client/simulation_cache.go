@@ -0,0 +1,162 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+)
+
+// DefaultSimulationCacheSize is how many simulation results a chain's
+// SimulationCache holds at most
+const DefaultSimulationCacheSize = 1000
+
+var (
+	promSimulationCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_simulation_cache_hits_total",
+		Help: "The number of eth_call simulations that were answered from the simulation cache",
+	}, []string{"evmChainID"})
+	promSimulationCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_simulation_cache_misses_total",
+		Help: "The number of eth_call simulations that were not in the simulation cache, and so were sent to the eth node",
+	}, []string{"evmChainID"})
+)
+
+type simulationKey struct {
+	to common.Address
+	// callHash is the hash of everything else about the call that can change
+	// its result, i.e. the payload, and the sender, gas and value
+	callHash  common.Hash
+	blockHash common.Hash
+}
+
+type simulationResult struct {
+	key simulationKey
+	b   []byte
+	err error
+}
+
+// SimulationCache holds the results of eth_calls made against the latest
+// block, so that identical calls within one block, e.g. retries, or several
+// transactions with the same payload, are only sent to the eth node once.
+// It is shared by transaction simulation and the keeper's checkUpkeep call.
+//
+// Only results the eth node returned are cached, i.e. return data or an RPC
+// error like a revert, never errors reaching it, so a hit returns exactly
+// what a fresh call would have. Every new head empties the cache, and calls
+// are not cached before the first head. The least recently used result is
+// evicted once the cache is full.
+type SimulationCache struct {
+	chainID string
+	size    int
+
+	mu        sync.Mutex
+	blockHash common.Hash
+	results   map[simulationKey]*list.Element
+	lru       *list.List
+}
+
+// NewSimulationCache creates a cache holding at most size results. It must
+// be subscribed to the chain's heads to cache anything.
+func NewSimulationCache(chainID *big.Int, size int) *SimulationCache {
+	return &SimulationCache{
+		chainID: chainID.String(),
+		size:    size,
+		results: make(map[simulationKey]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// OnNewLongestChain empties the cache, since results are only valid for the
+// block they were made against. A reorg to a block of the same height
+// empties it too.
+func (c *SimulationCache) OnNewLongestChain(_ context.Context, head *evmtypes.Head) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if head == nil || head.Hash == c.blockHash {
+		return
+	}
+	c.blockHash = head.Hash
+	c.results = make(map[simulationKey]*list.Element)
+	c.lru.Init()
+}
+
+// Call returns the cached result of msg against the latest block, or makes
+// the call with call, and caches its result. call must make msg against the
+// latest block. A nil cache always makes the call.
+func (c *SimulationCache) Call(msg ethereum.CallMsg, call func() ([]byte, error)) ([]byte, error) {
+	if c == nil || msg.To == nil {
+		return call()
+	}
+	c.mu.Lock()
+	key := simulationKey{*msg.To, simulationCallHash(msg), c.blockHash}
+	if key.blockHash == (common.Hash{}) {
+		c.mu.Unlock()
+		return call()
+	}
+	if el, exists := c.results[key]; exists {
+		c.lru.MoveToFront(el)
+		result := el.Value.(*simulationResult)
+		c.mu.Unlock()
+		promSimulationCacheHits.WithLabelValues(c.chainID).Inc()
+		return common.CopyBytes(result.b), result.err
+	}
+	c.mu.Unlock()
+	promSimulationCacheMisses.WithLabelValues(c.chainID).Inc()
+
+	b, err := call()
+	if err != nil && ExtractRPCError(err) == nil {
+		return b, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.blockHash != key.blockHash {
+		// A new head arrived during the call, so it may have been made
+		// against either block
+		return b, err
+	}
+	if _, exists := c.results[key]; !exists {
+		c.results[key] = c.lru.PushFront(&simulationResult{key, common.CopyBytes(b), err})
+		if c.lru.Len() > c.size {
+			oldest := c.lru.Back()
+			c.lru.Remove(oldest)
+			delete(c.results, oldest.Value.(*simulationResult).key)
+		}
+	}
+	return b, err
+}
+
+// simulationCallHash hashes every field of msg but To
+func simulationCallHash(msg ethereum.CallMsg) common.Hash {
+	var gas [8]byte
+	binary.BigEndian.PutUint64(gas[:], msg.Gas)
+	return crypto.Keccak256Hash(
+		msg.From.Bytes(),
+		gas[:],
+		bigBytes(msg.GasPrice),
+		bigBytes(msg.GasFeeCap),
+		bigBytes(msg.GasTipCap),
+		bigBytes(msg.Value),
+		crypto.Keccak256(msg.Data),
+	)
+}
+
+// bigBytes encodes i in 33 bytes, the first telling nil apart from 0
+func bigBytes(i *big.Int) []byte {
+	b := make([]byte, 33)
+	if i != nil {
+		b[0] = 1
+		i.FillBytes(b[1:])
+	}
+	return b
+}
@@ -0,0 +1,123 @@
+package client_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+)
+
+func TestSimulationCache(t *testing.T) {
+	t.Parallel()
+
+	to := cltest.NewAddress()
+	msg := ethereum.CallMsg{From: cltest.NewAddress(), To: &to, Gas: 100000, Data: []byte{1, 2, 3}}
+	calls := 0
+	call := func(b []byte, err error) func() ([]byte, error) {
+		return func() ([]byte, error) {
+			calls++
+			return b, err
+		}
+	}
+
+	t.Run("does not cache before the first head", func(t *testing.T) {
+		cache := evmclient.NewSimulationCache(big.NewInt(0), 10)
+		calls = 0
+		for i := 0; i < 2; i++ {
+			_, err := cache.Call(msg, call([]byte{4}, nil))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("identical calls within a block are made once, until the next head", func(t *testing.T) {
+		cache := evmclient.NewSimulationCache(big.NewInt(0), 10)
+		cache.OnNewLongestChain(context.Background(), cltest.Head(1))
+		calls = 0
+
+		b, err := cache.Call(msg, call([]byte{4}, nil))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{4}, b)
+		b, err = cache.Call(msg, call([]byte{5}, nil))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{4}, b)
+		assert.Equal(t, 1, calls)
+
+		// The same payload from another sender is a different call
+		other := msg
+		other.From = cltest.NewAddress()
+		_, err = cache.Call(other, call([]byte{6}, nil))
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+
+		cache.OnNewLongestChain(context.Background(), cltest.Head(2))
+		b, err = cache.Call(msg, call([]byte{7}, nil))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{7}, b)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("caches reverts, but not errors reaching the node", func(t *testing.T) {
+		cache := evmclient.NewSimulationCache(big.NewInt(0), 10)
+		cache.OnNewLongestChain(context.Background(), cltest.Head(1))
+		calls = 0
+
+		revert := &evmclient.JsonError{Code: 3, Message: "execution reverted", Data: "0x08c379a0"}
+		for i := 0; i < 2; i++ {
+			_, err := cache.Call(msg, call(nil, revert))
+			assert.Equal(t, revert, err)
+		}
+		assert.Equal(t, 1, calls)
+
+		other := msg
+		other.Data = []byte{8}
+		timeout := errors.New("context deadline exceeded")
+		for i := 0; i < 2; i++ {
+			_, err := cache.Call(other, call(nil, timeout))
+			assert.Equal(t, timeout, err)
+		}
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("evicts the least recently used result", func(t *testing.T) {
+		cache := evmclient.NewSimulationCache(big.NewInt(0), 2)
+		cache.OnNewLongestChain(context.Background(), cltest.Head(1))
+		calls = 0
+
+		msgs := make([]ethereum.CallMsg, 3)
+		for i := range msgs {
+			msgs[i] = msg
+			msgs[i].Data = []byte{byte(i)}
+			_, err := cache.Call(msgs[i], call(nil, nil))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 3, calls)
+
+		// The first was evicted, the last two were not
+		_, err := cache.Call(msgs[2], call(nil, nil))
+		require.NoError(t, err)
+		_, err = cache.Call(msgs[1], call(nil, nil))
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+		_, err = cache.Call(msgs[0], call(nil, nil))
+		require.NoError(t, err)
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("a nil cache always makes the call", func(t *testing.T) {
+		var cache *evmclient.SimulationCache
+		calls = 0
+		for i := 0; i < 2; i++ {
+			_, err := cache.Call(msg, call(nil, nil))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, calls)
+	})
+}
@@ -20,6 +20,7 @@ type (
 	// chainSpecificConfigDefaultSet lists the config defaults specific to a particular chain ID
 	chainSpecificConfigDefaultSet struct {
 		balanceMonitorEnabled                      bool
+		balanceMonitorTokens                       string
 		balanceMonitorBlockDelay                   uint16
 		blockEmissionIdleWarningThreshold          time.Duration
 		blockHistoryEstimatorBatchSize             uint32
@@ -27,18 +28,29 @@ type (
 		blockHistoryEstimatorBlockHistorySize      uint16
 		blockHistoryEstimatorTransactionPercentile uint16
 		chainType                                  chains.ChainType
+		broadcastMaxInitialBumps                   uint32
+		broadcastPollJitterDisabled                bool
 		eip1559DynamicFees                         bool
+		ethTxDeadlineBoostCurve                    string
 		ethTxReaperInterval                        time.Duration
 		ethTxReaperThreshold                       time.Duration
 		ethTxResendAfterThreshold                  time.Duration
 		finalityDepth                              uint32
 		flagsContractAddress                       string
 		gasBumpPercent                             uint16
+		gasBumpStrategy                            string
 		gasBumpThreshold                           uint64
 		gasBumpTxDepth                             uint16
 		gasBumpWei                                 big.Int
 		gasEstimatorMode                           string
+		gasEstimatorOwnConfirmationsBlockWindow    uint16
+		gasEstimatorOwnConfirmationsMinBlocks      uint16
+		gasEstimatorRecordInputs                   bool
 		gasLimitDefault                            uint64
+		gasLimitLearningEnabled                    bool
+		gasLimitLearningMarginPercent              uint16
+		gasLimitLearningMin                        uint64
+		gasLimitMax                                uint64
 		gasLimitMultiplier                         float32
 		gasLimitTransfer                           uint64
 		gasPriceDefault                            big.Int
@@ -50,14 +62,26 @@ type (
 		linkContractAddress                        string
 		logBackfillBatchSize                       uint32
 		maxGasPriceWei                             big.Int
+		maxGasPriceExceededPolicy                  string
+		inProgressTxAlertThreshold                 time.Duration
+		keyIdleTimeout                             time.Duration
+		chainHaltThreshold                         time.Duration
+		maxInProgressAge                           time.Duration
+		maxNonceHoles                              uint32
 		maxInFlightTransactions                    uint32
+		maxInFlightValueWei                        big.Int
 		maxQueuedTransactions                      uint64
 		minGasPriceWei                             big.Int
 		minIncomingConfirmations                   uint32
 		minRequiredOutgoingConfirmations           uint64
 		minimumContractPayment                     *assets.Link
+		multicallAddress                           string
 		nonceAutoSync                              bool
+		nonceAutoSyncInterval                      time.Duration
+		rejectSelfTransactions                     bool
 		rpcDefaultBatchSize                        uint32
+		txQueueOrdering                            string
+		txQueueTiebreak                            string
 		// set true if fully configured
 		complete bool
 
@@ -91,48 +115,72 @@ func setChainSpecificConfigDefaultSets() {
 
 	fallbackDefaultSet = chainSpecificConfigDefaultSet{
 		balanceMonitorEnabled:                      true,
+		balanceMonitorTokens:                       "",
 		balanceMonitorBlockDelay:                   1,
 		blockEmissionIdleWarningThreshold:          1 * time.Minute,
 		blockHistoryEstimatorBatchSize:             4, // FIXME: Workaround `websocket: read limit exceeded` until https://app.clubhouse.io/chainlinklabs/story/6717/geth-websockets-can-sometimes-go-bad-under-heavy-load-proposal-for-eth-node-balancer
 		blockHistoryEstimatorBlockDelay:            1,
 		blockHistoryEstimatorBlockHistorySize:      16,
 		blockHistoryEstimatorTransactionPercentile: 60,
-		chainType:                             "",
-		eip1559DynamicFees:                    false,
-		ethTxReaperInterval:                   1 * time.Hour,
-		ethTxReaperThreshold:                  168 * time.Hour,
-		ethTxResendAfterThreshold:             1 * time.Minute,
-		finalityDepth:                         50,
-		gasBumpPercent:                        20,
-		gasBumpThreshold:                      3,
-		gasBumpTxDepth:                        10,
-		gasBumpWei:                            *assets.GWei(5),
-		gasEstimatorMode:                      "BlockHistory",
-		gasLimitDefault:                       DefaultGasLimit,
-		gasLimitMultiplier:                    1.0,
-		gasLimitTransfer:                      21000,
-		gasPriceDefault:                       *DefaultGasPrice,
-		gasTipCapDefault:                      *DefaultGasTip,
-		gasTipCapMinimum:                      *big.NewInt(0),
-		headTrackerHistoryDepth:               100,
-		headTrackerMaxBufferSize:              3,
-		headTrackerSamplingInterval:           1 * time.Second,
-		linkContractAddress:                   "",
-		logBackfillBatchSize:                  100,
-		maxGasPriceWei:                        *assets.GWei(5000),
-		maxInFlightTransactions:               16,
-		maxQueuedTransactions:                 250,
-		minGasPriceWei:                        *assets.GWei(1),
-		minIncomingConfirmations:              3,
-		minRequiredOutgoingConfirmations:      12,
-		minimumContractPayment:                DefaultMinimumContractPayment,
-		nonceAutoSync:                         true,
-		ocrContractConfirmations:              4,
-		ocrContractTransmitterTransmitTimeout: 10 * time.Second,
-		ocrDatabaseTimeout:                    10 * time.Second,
-		ocrObservationGracePeriod:             1 * time.Second,
-		rpcDefaultBatchSize:                   100,
-		complete:                              true,
+		chainType:                               "",
+		broadcastMaxInitialBumps:                0,
+		broadcastPollJitterDisabled:             false,
+		eip1559DynamicFees:                      false,
+		ethTxDeadlineBoostCurve:                 "",
+		ethTxReaperInterval:                     1 * time.Hour,
+		ethTxReaperThreshold:                    168 * time.Hour,
+		ethTxResendAfterThreshold:               1 * time.Minute,
+		finalityDepth:                           50,
+		gasBumpPercent:                          20,
+		gasBumpStrategy:                         "max",
+		gasBumpThreshold:                        3,
+		gasBumpTxDepth:                          10,
+		gasBumpWei:                              *assets.GWei(5),
+		gasEstimatorMode:                        "BlockHistory",
+		gasEstimatorOwnConfirmationsBlockWindow: 0,
+		gasEstimatorOwnConfirmationsMinBlocks:   8,
+		gasEstimatorRecordInputs:                false,
+		gasLimitDefault:                         DefaultGasLimit,
+		gasLimitLearningEnabled:                 false,
+		gasLimitLearningMarginPercent:           25,
+		gasLimitLearningMin:                     0,
+		gasLimitMax:                             0,
+		gasLimitMultiplier:                      1.0,
+		gasLimitTransfer:                        21000,
+		gasPriceDefault:                         *DefaultGasPrice,
+		gasTipCapDefault:                        *DefaultGasTip,
+		gasTipCapMinimum:                        *big.NewInt(0),
+		headTrackerHistoryDepth:                 100,
+		headTrackerMaxBufferSize:                3,
+		headTrackerSamplingInterval:             1 * time.Second,
+		linkContractAddress:                     "",
+		logBackfillBatchSize:                    100,
+		maxGasPriceWei:                          *assets.GWei(5000),
+		maxGasPriceExceededPolicy:               "clamp",
+		inProgressTxAlertThreshold:              5 * time.Minute,
+		keyIdleTimeout:                          0,
+		chainHaltThreshold:                      0,
+		maxInProgressAge:                        0,
+		maxNonceHoles:                           0,
+		maxInFlightTransactions:                 16,
+		maxInFlightValueWei:                     *big.NewInt(0),
+		maxQueuedTransactions:                   250,
+		minGasPriceWei:                          *assets.GWei(1),
+		minIncomingConfirmations:                3,
+		minRequiredOutgoingConfirmations:        12,
+		minimumContractPayment:                  DefaultMinimumContractPayment,
+		multicallAddress:                        "",
+		nonceAutoSync:                           true,
+		nonceAutoSyncInterval:                   0,
+		ocrContractConfirmations:                4,
+		ocrContractTransmitterTransmitTimeout:   10 * time.Second,
+		ocrDatabaseTimeout:                      10 * time.Second,
+		ocrObservationGracePeriod:               1 * time.Second,
+		rejectSelfTransactions:                  false,
+		rpcDefaultBatchSize:                     100,
+		txQueueOrdering:                         "value_asc_fifo",
+		txQueueTiebreak:                         "created_at",
+		complete:                                true,
 	}
 
 	mainnet := fallbackDefaultSet
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +29,7 @@ import (
 
 type ChainScopedOnlyConfig interface {
 	BalanceMonitorEnabled() bool
+	BalanceMonitorTokens() []BalanceMonitorToken
 	BlockEmissionIdleWarningThreshold() time.Duration
 	BlockHistoryEstimatorBatchSize() (size uint32)
 	BlockHistoryEstimatorBlockDelay() uint16
@@ -33,16 +37,22 @@ type ChainScopedOnlyConfig interface {
 	BlockHistoryEstimatorTransactionPercentile() uint16
 	ChainID() *big.Int
 	EvmEIP1559DynamicFees() bool
+	EthTxDeadlineBoostCurve() []DeadlineBoostStage
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
 	EvmFinalityDepth() uint32
 	EvmGasBumpPercent() uint16
+	EvmGasBumpStrategy() string
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
 	EvmGasBumpWei() *big.Int
 	EvmGasFeeCap() *big.Int
 	EvmGasLimitDefault() uint64
+	EvmGasLimitLearningEnabled() bool
+	EvmGasLimitLearningMarginPercent() uint16
+	EvmGasLimitLearningMin() uint64
+	EvmGasLimitMax() uint64
 	EvmGasLimitMultiplier() float32
 	EvmGasLimitTransfer() uint64
 	EvmGasPriceDefault() *big.Int
@@ -53,15 +63,35 @@ type ChainScopedOnlyConfig interface {
 	EvmHeadTrackerSamplingInterval() time.Duration
 	EvmLogBackfillBatchSize() uint32
 	EvmMaxGasPriceWei() *big.Int
+	EvmMaxGasPriceExceededPolicy() string
+	EvmInProgressTxAlertThreshold() time.Duration
+	EvmKeyIdleTimeout() time.Duration
+	EvmMaxInProgressAge() time.Duration
+	EvmMaxNonceHoles() uint32
+	EvmBroadcastMaxInitialBumps() uint32
+	EvmBroadcastPollJitterDisabled() bool
+	EvmChainHaltThreshold() time.Duration
 	EvmMaxInFlightTransactions() uint32
+	EvmMaxInFlightValueWei() *big.Int
 	EvmMaxQueuedTransactions() uint64
 	EvmMinGasPriceWei() *big.Int
+	EvmMulticallAddress() string
 	EvmNonceAutoSync() bool
+	EvmNonceAutoSyncInterval() time.Duration
+	EvmRejectSelfTransactions() bool
 	EvmRPCDefaultBatchSize() uint32
+	EvmStrictSequencing() bool
+	EvmTxQueueOrdering() string
+	EvmTxQueueTiebreak() string
 	FlagsContractAddress() string
 	GasEstimatorMode() string
+	GasEstimatorOwnConfirmationsBlockWindow() uint16
+	GasEstimatorOwnConfirmationsMinBlocks() uint16
+	GasEstimatorRecordInputs() bool
 	ChainType() chains.ChainType
 	KeySpecificMaxGasPriceWei(addr gethcommon.Address) *big.Int
+	KeySpecificStrictSequencing(addr gethcommon.Address) bool
+	KeySpecificTxQueueOrdering(addr gethcommon.Address) string
 	LinkContractAddress() string
 	MinIncomingConfirmations() uint32
 	MinRequiredOutgoingConfirmations() uint64
@@ -174,6 +204,42 @@ func (c *chainScopedConfig) validate() (err error) {
 	if c.EvmFinalityDepth() < 1 {
 		err = multierr.Combine(err, errors.New("ETH_FINALITY_DEPTH must be greater than or equal to 1"))
 	}
+	err = multierr.Combine(err, validateTxQueueOrdering(c.EvmTxQueueOrdering()))
+	c.persistMu.RLock()
+	for addr, keySpecific := range c.persistedCfg.KeySpecific {
+		if keySpecific.EvmTxQueueOrdering.Valid {
+			err = multierr.Combine(err, errors.Wrapf(validateTxQueueOrdering(keySpecific.EvmTxQueueOrdering.String), "key %s", addr))
+		}
+	}
+	c.persistMu.RUnlock()
+	switch tiebreak := c.EvmTxQueueTiebreak(); tiebreak {
+	case "created_at", "id", "subject":
+	default:
+		err = multierr.Combine(err, errors.Errorf("ETH_TX_QUEUE_TIEBREAK %q unrecognised, must be one of: created_at, id, subject", tiebreak))
+	}
+	if addr := c.EvmMulticallAddress(); addr != "" && !gethcommon.IsHexAddress(addr) {
+		err = multierr.Combine(err, errors.Errorf("ETH_MULTICALL_ADDRESS %q is not a valid address", addr))
+	}
+	switch strategy := c.EvmGasBumpStrategy(); strategy {
+	case "max", "geometric":
+	case "linear":
+		if c.EvmGasBumpWei().Sign() <= 0 {
+			err = multierr.Combine(err, errors.New("ETH_GAS_BUMP_WEI must be greater than 0 if ETH_GAS_BUMP_STRATEGY is linear"))
+		}
+	default:
+		err = multierr.Combine(err, errors.Errorf("ETH_GAS_BUMP_STRATEGY %q unrecognised, must be one of: max, linear, geometric", strategy))
+	}
+	switch policy := c.EvmMaxGasPriceExceededPolicy(); policy {
+	case "clamp", "defer", "fail":
+	default:
+		err = multierr.Combine(err, errors.Errorf("ETH_MAX_GAS_PRICE_EXCEEDED_POLICY %q unrecognised, must be one of: clamp, defer, fail", policy))
+	}
+	if _, tokensErr := ParseBalanceMonitorTokens(c.balanceMonitorTokens()); tokensErr != nil {
+		err = multierr.Combine(err, errors.Wrap(tokensErr, "BALANCE_MONITOR_TOKENS is invalid"))
+	}
+	if _, curveErr := ParseDeadlineBoostCurve(c.ethTxDeadlineBoostCurve()); curveErr != nil {
+		err = multierr.Combine(err, errors.Wrap(curveErr, "ETH_TX_DEADLINE_BOOST_CURVE is invalid"))
+	}
 	if c.MinIncomingConfirmations() < 1 {
 		err = multierr.Combine(err, errors.New("MIN_INCOMING_CONFIRMATIONS must be greater than or equal to 1"))
 	}
@@ -225,6 +291,98 @@ func (c *chainScopedConfig) ChainID() *big.Int {
 	return c.id
 }
 
+// BalanceMonitorToken is an ERC-20 token whose balance the BalanceMonitor
+// tracks for every sending key
+type BalanceMonitorToken struct {
+	Address gethcommon.Address
+	// MinBalance is denominated in the token's smallest unit. A key holding
+	// less than this makes the BalanceMonitor unhealthy. Nil means no minimum.
+	MinBalance *big.Int
+}
+
+// ParseBalanceMonitorTokens parses a comma separated list of token addresses,
+// each optionally followed by a colon and a minimum balance in the token's
+// smallest unit e.g. "0x514910771AF9Ca656af840dff83E8264EcF986CA:1000000000000000000"
+func ParseBalanceMonitorTokens(s string) (tokens []BalanceMonitorToken, err error) {
+	seen := make(map[gethcommon.Address]struct{})
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 2)
+		addr := strings.TrimSpace(parts[0])
+		if !gethcommon.IsHexAddress(addr) {
+			return nil, errors.Errorf("%q is not a valid token address", addr)
+		}
+		token := BalanceMonitorToken{Address: gethcommon.HexToAddress(addr)}
+		if _, exists := seen[token.Address]; exists {
+			return nil, errors.Errorf("token %s is listed more than once", token.Address.Hex())
+		}
+		seen[token.Address] = struct{}{}
+		if len(parts) == 2 {
+			min, ok := new(big.Int).SetString(strings.TrimSpace(parts[1]), 10)
+			if !ok || min.Sign() < 0 {
+				return nil, errors.Errorf("%q is not a valid minimum balance for token %s", parts[1], token.Address.Hex())
+			}
+			token.MinBalance = min
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+// DeadlineBoostStage accelerates gas bumping for a transaction whose deadline
+// is at most Remaining away: bumps happen Factor times as often and each bump
+// is applied Factor times over
+type DeadlineBoostStage struct {
+	Remaining time.Duration
+	Factor    uint32
+}
+
+// ParseDeadlineBoostCurve parses a comma separated list of stages, each a
+// duration and a factor separated by a colon e.g. "2m:2,30s:4". The stages are
+// returned ordered by Remaining, longest first.
+func ParseDeadlineBoostCurve(s string) (curve []DeadlineBoostStage, err error) {
+	seen := make(map[time.Duration]struct{})
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("%q must be of the form <remaining>:<factor>", entry)
+		}
+		remaining, perr := time.ParseDuration(strings.TrimSpace(parts[0]))
+		if perr != nil || remaining <= 0 {
+			return nil, errors.Errorf("%q is not a valid remaining duration", parts[0])
+		}
+		if _, exists := seen[remaining]; exists {
+			return nil, errors.Errorf("remaining duration %s is listed more than once", remaining)
+		}
+		seen[remaining] = struct{}{}
+		factor, perr := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 32)
+		if perr != nil || factor < 1 {
+			return nil, errors.Errorf("%q is not a valid factor, must be a positive integer", parts[1])
+		}
+		curve = append(curve, DeadlineBoostStage{Remaining: remaining, Factor: uint32(factor)})
+	}
+	sort.Slice(curve, func(i, j int) bool {
+		return curve[i].Remaining > curve[j].Remaining
+	})
+	return curve, nil
+}
+
+func validateTxQueueOrdering(ordering string) error {
+	switch ordering {
+	case "value_asc_fifo", "fifo", "priority":
+		return nil
+	default:
+		return errors.Errorf("ETH_TX_QUEUE_ORDERING %q unrecognised, must be one of: value_asc_fifo, fifo, priority", ordering)
+	}
+}
+
 func (c *chainScopedConfig) logEnvOverrideOnce(name string, envVal interface{}) {
 	k := fmt.Sprintf("env-%s", name)
 	c.onceMapMu.RLock()
@@ -313,6 +471,90 @@ func (c *chainScopedConfig) EvmGasBumpWei() *big.Int {
 	return &n
 }
 
+// EvmInProgressTxAlertThreshold is how long a transaction may remain
+// in_progress before it is reported as stuck
+func (c *chainScopedConfig) EvmInProgressTxAlertThreshold() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmInProgressTxAlertThreshold()
+	if ok {
+		c.logEnvOverrideOnce("EvmInProgressTxAlertThreshold", val)
+		return val
+	}
+	return c.defaultSet.inProgressTxAlertThreshold
+}
+
+// EvmChainHaltThreshold is how long the chain may go without producing a new
+// head before it is considered halted, at which point the EthBroadcaster stops
+// broadcasting until heads resume. Zero disables halt detection.
+func (c *chainScopedConfig) EvmChainHaltThreshold() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmChainHaltThreshold()
+	if ok {
+		c.logEnvOverrideOnce("EvmChainHaltThreshold", val)
+		return val
+	}
+	return c.defaultSet.chainHaltThreshold
+}
+
+// EvmKeyIdleTimeout is how long a key must go without any pending
+// transactions before its EthBroadcaster monitor stops polling the database
+// and waits for a trigger instead. Zero disables parking.
+func (c *chainScopedConfig) EvmKeyIdleTimeout() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmKeyIdleTimeout()
+	if ok {
+		c.logEnvOverrideOnce("EvmKeyIdleTimeout", val)
+		return val
+	}
+	return c.defaultSet.keyIdleTimeout
+}
+
+// EvmMaxInProgressAge is how long a transaction may remain in_progress before
+// the EthBroadcaster forcibly resolves it according to
+// ETH_TX_IN_PROGRESS_RESOLUTION_POLICY. Zero disables forced resolution.
+func (c *chainScopedConfig) EvmMaxInProgressAge() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmMaxInProgressAge()
+	if ok {
+		c.logEnvOverrideOnce("EvmMaxInProgressAge", val)
+		return val
+	}
+	return c.defaultSet.maxInProgressAge
+}
+
+// EvmMaxNonceHoles is how many nonces below its highest broadcast nonce a key
+// may have that will never be mined before the EthBroadcaster stops
+// broadcasting new transactions for it. Zero disables the limit.
+func (c *chainScopedConfig) EvmMaxNonceHoles() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmMaxNonceHoles()
+	if ok {
+		c.logEnvOverrideOnce("EvmMaxNonceHoles", val)
+		return val
+	}
+	return c.defaultSet.maxNonceHoles
+}
+
+// EvmBroadcastMaxInitialBumps is the number of times the EthBroadcaster will
+// bump gas on a terminally underpriced initial send before marking the
+// transaction as fatally errored
+// 0 value disables the limit
+func (c *chainScopedConfig) EvmBroadcastMaxInitialBumps() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmBroadcastMaxInitialBumps()
+	if ok {
+		c.logEnvOverrideOnce("EvmBroadcastMaxInitialBumps", val)
+		return val
+	}
+	return c.defaultSet.broadcastMaxInitialBumps
+}
+
+// EvmBroadcastPollJitterDisabled, if set, makes the EthBroadcaster poll the
+// database at exactly TRIGGER_FALLBACK_DB_POLL_INTERVAL instead of
+// applying random jitter to it
+func (c *chainScopedConfig) EvmBroadcastPollJitterDisabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmBroadcastPollJitterDisabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmBroadcastPollJitterDisabled", val)
+		return val
+	}
+	return c.defaultSet.broadcastPollJitterDisabled
+}
+
 // EvmMaxInFlightTransactions controls how many transactions are allowed to be
 // "in-flight" i.e. broadcast but unconfirmed at any one time
 // 0 value disables the limit
@@ -325,6 +567,19 @@ func (c *chainScopedConfig) EvmMaxInFlightTransactions() uint32 {
 	return c.defaultSet.maxInFlightTransactions
 }
 
+// EvmMaxInFlightValueWei controls the total value of the transactions that a
+// key may have "in-flight" i.e. broadcast but unconfirmed at any one time
+// 0 value disables the limit
+func (c *chainScopedConfig) EvmMaxInFlightValueWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalEvmMaxInFlightValueWei()
+	if ok {
+		c.logEnvOverrideOnce("EvmMaxInFlightValueWei", val)
+		return val
+	}
+	n := c.defaultSet.maxInFlightValueWei
+	return &n
+}
+
 // EvmMaxGasPriceWei is the maximum amount in Wei that a transaction will be
 // bumped to before abandoning it and marking it as errored.
 func (c *chainScopedConfig) EvmMaxGasPriceWei() *big.Int {
@@ -344,6 +599,22 @@ func (c *chainScopedConfig) EvmMaxGasPriceWei() *big.Int {
 	return &n
 }
 
+// EvmMaxGasPriceExceededPolicy controls what happens when the gas price (or
+// fee cap) estimated for the initial send of a transaction exceeds the max gas
+// price of its key. May be one of:
+// - clamp: send it at the max gas price instead (default)
+// - defer: leave it unstarted and carry on with the rest of the queue, trying again on the next run
+// - fail: mark it as fatally errored
+// Transactions that have already been broadcast are always clamped.
+func (c *chainScopedConfig) EvmMaxGasPriceExceededPolicy() string {
+	val, ok := c.GeneralConfig.GlobalEvmMaxGasPriceExceededPolicy()
+	if ok {
+		c.logEnvOverrideOnce("EvmMaxGasPriceExceededPolicy", val)
+		return val
+	}
+	return c.defaultSet.maxGasPriceExceededPolicy
+}
+
 // EvmMaxQueuedTransactions is the maximum number of unbroadcast
 // transactions per key that are allowed to be enqueued before jobs will start
 // failing and rejecting send of any further transactions.
@@ -386,6 +657,56 @@ func (c *chainScopedConfig) EvmGasLimitDefault() uint64 {
 	return c.defaultSet.gasLimitDefault
 }
 
+// EvmGasLimitLearningEnabled, if set, makes the BulletproofTxManager keep
+// gas used statistics of the contract methods that transactions opt in for
+// with NewTx.UseLearnedGasLimit, and use them plus
+// EvmGasLimitLearningMarginPercent as the gas limit of those transactions in
+// place of the one they were created with.
+func (c *chainScopedConfig) EvmGasLimitLearningEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmGasLimitLearningEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasLimitLearningEnabled", val)
+		return val
+	}
+	return c.defaultSet.gasLimitLearningEnabled
+}
+
+// EvmGasLimitLearningMarginPercent is the margin added to a learned gas used
+// to get the gas limit, see EvmGasLimitLearningEnabled
+func (c *chainScopedConfig) EvmGasLimitLearningMarginPercent() uint16 {
+	val, ok := c.GeneralConfig.GlobalEvmGasLimitLearningMarginPercent()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasLimitLearningMarginPercent", val)
+		return val
+	}
+	return c.defaultSet.gasLimitLearningMarginPercent
+}
+
+// EvmGasLimitLearningMin is the lowest gas limit that is used for a
+// transaction that opts in to the gas limit learned from receipts, see
+// NewTx.UseLearnedGasLimit. Zero means no minimum, other than the intrinsic
+// gas of the transaction.
+func (c *chainScopedConfig) EvmGasLimitLearningMin() uint64 {
+	val, ok := c.GeneralConfig.GlobalEvmGasLimitLearningMin()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasLimitLearningMin", val)
+		return val
+	}
+	return c.defaultSet.gasLimitLearningMin
+}
+
+// EvmGasLimitMax is the highest gas limit the EthBroadcaster will raise a
+// transaction to when the node rejects it for having too low a gas limit.
+// Zero means no maximum.
+func (c *chainScopedConfig) EvmGasLimitMax() uint64 {
+	val, ok := c.GeneralConfig.GlobalEvmGasLimitMax()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasLimitMax", val)
+		return val
+	}
+	return c.defaultSet.gasLimitMax
+}
+
 // EvmGasLimitTransfer is the gas limit for an ordinary eth->eth transfer
 func (c *chainScopedConfig) EvmGasLimitTransfer() uint64 {
 	val, ok := c.GeneralConfig.GlobalEvmGasLimitTransfer()
@@ -668,6 +989,43 @@ func (c *chainScopedConfig) GasEstimatorMode() string {
 	return c.defaultSet.gasEstimatorMode
 }
 
+// GasEstimatorOwnConfirmationsBlockWindow is the number of most recent blocks
+// in which our own confirmed transactions are tracked to derive a gas price
+// floor. The floor is applied on top of whichever estimator is configured
+// with GAS_ESTIMATOR_MODE.
+// 0 value disables the floor
+func (c *chainScopedConfig) GasEstimatorOwnConfirmationsBlockWindow() uint16 {
+	val, ok := c.GeneralConfig.GlobalGasEstimatorOwnConfirmationsBlockWindow()
+	if ok {
+		c.logEnvOverrideOnce("GasEstimatorOwnConfirmationsBlockWindow", val)
+		return val
+	}
+	return c.defaultSet.gasEstimatorOwnConfirmationsBlockWindow
+}
+
+// GasEstimatorOwnConfirmationsMinBlocks is the number of blocks the primary
+// estimator must have in its history before it is trusted on its own. Below
+// this, the own-confirmations floor is applied.
+func (c *chainScopedConfig) GasEstimatorOwnConfirmationsMinBlocks() uint16 {
+	val, ok := c.GeneralConfig.GlobalGasEstimatorOwnConfirmationsMinBlocks()
+	if ok {
+		c.logEnvOverrideOnce("GasEstimatorOwnConfirmationsMinBlocks", val)
+		return val
+	}
+	return c.defaultSet.gasEstimatorOwnConfirmationsMinBlocks
+}
+
+// GasEstimatorRecordInputs, if set, records on every transaction attempt
+// what the gas estimator was given and what it returned
+func (c *chainScopedConfig) GasEstimatorRecordInputs() bool {
+	val, ok := c.GeneralConfig.GlobalGasEstimatorRecordInputs()
+	if ok {
+		c.logEnvOverrideOnce("GasEstimatorRecordInputs", val)
+		return val
+	}
+	return c.defaultSet.gasEstimatorRecordInputs
+}
+
 func (c *chainScopedConfig) KeySpecificMaxGasPriceWei(addr gethcommon.Address) *big.Int {
 	val, ok := c.GeneralConfig.GlobalEvmMaxGasPriceWei()
 	if ok {
@@ -807,6 +1165,27 @@ func (c *chainScopedConfig) EvmGasBumpPercent() uint16 {
 	return c.defaultSet.gasBumpPercent
 }
 
+// EvmGasBumpStrategy controls how the gas price (or tip cap and fee cap) of a
+// transaction is bumped. May be one of:
+// - max: the larger of ETH_GAS_BUMP_PERCENT and ETH_GAS_BUMP_WEI on top of the previous price (default)
+// - linear: add ETH_GAS_BUMP_WEI to the previous price
+// - geometric: add ETH_GAS_BUMP_PERCENT to the previous price
+func (c *chainScopedConfig) EvmGasBumpStrategy() string {
+	val, ok := c.GeneralConfig.GlobalEvmGasBumpStrategy()
+	if ok {
+		c.logEnvOverrideOnce("EvmGasBumpStrategy", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmGasBumpStrategy
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmGasBumpStrategy", p.String)
+		return p.String
+	}
+	return c.defaultSet.gasBumpStrategy
+}
+
 // EvmNonceAutoSync enables/disables running the NonceSyncer on application start
 func (c *chainScopedConfig) EvmNonceAutoSync() bool {
 	val, ok := c.GeneralConfig.GlobalEvmNonceAutoSync()
@@ -824,6 +1203,18 @@ func (c *chainScopedConfig) EvmNonceAutoSync() bool {
 	return c.defaultSet.nonceAutoSync
 }
 
+// EvmNonceAutoSyncInterval is how often the EthBroadcaster re-syncs the
+// nonces of keys that have no pending transactions while it is running, if
+// EvmNonceAutoSync is enabled. Zero only syncs on start.
+func (c *chainScopedConfig) EvmNonceAutoSyncInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmNonceAutoSyncInterval()
+	if ok {
+		c.logEnvOverrideOnce("EvmNonceAutoSyncInterval", val)
+		return val
+	}
+	return c.defaultSet.nonceAutoSyncInterval
+}
+
 // EvmGasLimitMultiplier is a factor by which a transaction's GasLimit is
 // multiplied before transmission. So if the value is 1.1, and the GasLimit for
 // a transaction is 10, 10% will be added before transmission.
@@ -918,6 +1309,37 @@ func (c *chainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	return c.defaultSet.logBackfillBatchSize
 }
 
+// EvmMulticallAddress is the address of a Multicall2 contract on the chain.
+// If set, flux monitor jobs batch their contract reads into tryAggregate
+// calls to it.
+func (c *chainScopedConfig) EvmMulticallAddress() string {
+	val, ok := c.GeneralConfig.GlobalEvmMulticallAddress()
+	if ok {
+		c.logEnvOverrideOnce("EvmMulticallAddress", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmMulticallAddress
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmMulticallAddress", p.String)
+		return p.String
+	}
+	return c.defaultSet.multicallAddress
+}
+
+// EvmRejectSelfTransactions, if set, makes CreateEthTransaction reject
+// transactions sent from an address to itself, unless they are plain
+// transfers of a non-zero value with no payload
+func (c *chainScopedConfig) EvmRejectSelfTransactions() bool {
+	val, ok := c.GeneralConfig.GlobalEvmRejectSelfTransactions()
+	if ok {
+		c.logEnvOverrideOnce("EvmRejectSelfTransactions", val)
+		return val
+	}
+	return c.defaultSet.rejectSelfTransactions
+}
+
 // EvmRPCDefaultBatchSize controls the number of receipts fetched in each
 // request in the EthConfirmer
 func (c *chainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
@@ -936,6 +1358,94 @@ func (c *chainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
 	return c.defaultSet.rpcDefaultBatchSize
 }
 
+// EvmStrictSequencing, if set, holds back the unstarted transactions of every
+// key on the chain while the key has any transaction in flight, so that a
+// transaction is never broadcast before the one with the previous nonce is
+// confirmed. This limits each key to a single transaction in flight, and is
+// meant for target contracts that fail if transactions land out of order.
+func (c *chainScopedConfig) EvmStrictSequencing() bool {
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmStrictSequencing
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmStrictSequencing", p.Bool)
+		return p.Bool
+	}
+	return false
+}
+
+// KeySpecificStrictSequencing returns whether the given key is strictly
+// sequenced, falling back to EvmStrictSequencing
+func (c *chainScopedConfig) KeySpecificStrictSequencing(addr gethcommon.Address) bool {
+	c.persistMu.RLock()
+	keySpecific := c.persistedCfg.KeySpecific[addr.Hex()].EvmStrictSequencing
+	c.persistMu.RUnlock()
+	if keySpecific.Valid {
+		c.logKeySpecificOverrideOnce("EvmStrictSequencing", addr, keySpecific.Bool)
+		return keySpecific.Bool
+	}
+	return c.EvmStrictSequencing()
+}
+
+// EvmTxQueueOrdering controls the order in which unstarted transactions are
+// picked for broadcast. May be one of:
+// - value_asc_fifo: lowest value first, then by EvmTxQueueTiebreak (default)
+// - fifo: by EvmTxQueueTiebreak only, ignoring value
+// - priority: highest priority first, then as value_asc_fifo. Transactions
+// without a priority go last.
+//
+// Note that with fifo, a transaction that cannot be sent (e.g. because the
+// key has insufficient funds for its value) blocks every transaction queued
+// after it, since nonces are assigned strictly in queue order.
+func (c *chainScopedConfig) EvmTxQueueOrdering() string {
+	val, ok := c.GeneralConfig.GlobalEvmTxQueueOrdering()
+	if ok {
+		c.logEnvOverrideOnce("EvmTxQueueOrdering", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmTxQueueOrdering
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmTxQueueOrdering", p.String)
+		return p.String
+	}
+	return c.defaultSet.txQueueOrdering
+}
+
+// KeySpecificTxQueueOrdering returns the unstarted queue ordering for the
+// given key, falling back to EvmTxQueueOrdering
+func (c *chainScopedConfig) KeySpecificTxQueueOrdering(addr gethcommon.Address) string {
+	val, ok := c.GeneralConfig.GlobalEvmTxQueueOrdering()
+	if ok {
+		c.logEnvOverrideOnce("EvmTxQueueOrdering", val)
+		return val
+	}
+	c.persistMu.RLock()
+	keySpecific := c.persistedCfg.KeySpecific[addr.Hex()].EvmTxQueueOrdering
+	c.persistMu.RUnlock()
+	if keySpecific.Valid {
+		c.logKeySpecificOverrideOnce("EvmTxQueueOrdering", addr, keySpecific.String)
+		return keySpecific.String
+	}
+	return c.EvmTxQueueOrdering()
+}
+
+// EvmTxQueueTiebreak controls how unstarted transactions with equal value are
+// ordered relative to one another when picking the next one to broadcast.
+// May be one of:
+// - created_at: oldest first, falling back to id (default)
+// - id: strictly by insertion id, ignoring timestamps
+// - subject: grouped by subject, falling back to id
+func (c *chainScopedConfig) EvmTxQueueTiebreak() string {
+	val, ok := c.GeneralConfig.GlobalEvmTxQueueTiebreak()
+	if ok {
+		c.logEnvOverrideOnce("EvmTxQueueTiebreak", val)
+		return val
+	}
+	return c.defaultSet.txQueueTiebreak
+}
+
 // FlagsContractAddress represents the Flags contract address
 func (c *chainScopedConfig) FlagsContractAddress() string {
 	val, ok := c.GeneralConfig.GlobalFlagsContractAddress()
@@ -963,6 +1473,46 @@ func (c *chainScopedConfig) BalanceMonitorEnabled() bool {
 	return c.defaultSet.balanceMonitorEnabled
 }
 
+// BalanceMonitorTokens is the list of ERC-20 tokens whose balance the
+// BalanceMonitor tracks for every sending key, in addition to ETH
+func (c *chainScopedConfig) BalanceMonitorTokens() []BalanceMonitorToken {
+	tokens, err := ParseBalanceMonitorTokens(c.balanceMonitorTokens())
+	if err != nil {
+		c.logger.Errorw("Invalid value for BALANCE_MONITOR_TOKENS, no tokens will be monitored", "error", err)
+		return nil
+	}
+	return tokens
+}
+
+func (c *chainScopedConfig) balanceMonitorTokens() string {
+	val, ok := c.GeneralConfig.GlobalBalanceMonitorTokens()
+	if ok {
+		c.logEnvOverrideOnce("BalanceMonitorTokens", val)
+		return val
+	}
+	return c.defaultSet.balanceMonitorTokens
+}
+
+// EthTxDeadlineBoostCurve controls how gas bumping accelerates as a
+// transaction approaches its deadline. It is empty if boosting is disabled.
+func (c *chainScopedConfig) EthTxDeadlineBoostCurve() []DeadlineBoostStage {
+	curve, err := ParseDeadlineBoostCurve(c.ethTxDeadlineBoostCurve())
+	if err != nil {
+		c.logger.Errorw("Invalid value for ETH_TX_DEADLINE_BOOST_CURVE, deadline boosting is disabled", "error", err)
+		return nil
+	}
+	return curve
+}
+
+func (c *chainScopedConfig) ethTxDeadlineBoostCurve() string {
+	val, ok := c.GeneralConfig.GlobalEthTxDeadlineBoostCurve()
+	if ok {
+		c.logEnvOverrideOnce("EthTxDeadlineBoostCurve", val)
+		return val
+	}
+	return c.defaultSet.ethTxDeadlineBoostCurve
+}
+
 // EvmEIP1559DynamicFees will send transactions with the 0x2 dynamic fee EIP-2718
 // type and gas fields when enabled
 func (c *chainScopedConfig) EvmEIP1559DynamicFees() bool {
@@ -98,6 +98,39 @@ func TestChainScopedConfig(t *testing.T) {
 			assert.Equal(t, val.String(), cfg.KeySpecificMaxGasPriceWei(addr).String())
 		})
 	})
+
+	t.Run("KeySpecificTxQueueOrdering", func(t *testing.T) {
+		addr := cltest.NewAddress()
+		randomOtherAddr := cltest.NewAddress()
+		evmconfig.UpdatePersistedCfg(cfg, func(cfg *evmtypes.ChainCfg) {
+			cfg.KeySpecific[randomOtherAddr.Hex()] = evmtypes.ChainCfg{EvmTxQueueOrdering: null.StringFrom("priority")}
+		})
+
+		t.Run("uses chain-specific default value when nothing is set", func(t *testing.T) {
+			assert.Equal(t, "value_asc_fifo", cfg.KeySpecificTxQueueOrdering(addr))
+		})
+		t.Run("uses chain-specific override value when that is set", func(t *testing.T) {
+			evmconfig.UpdatePersistedCfg(cfg, func(cfg *evmtypes.ChainCfg) {
+				cfg.EvmTxQueueOrdering = null.StringFrom("fifo")
+			})
+
+			assert.Equal(t, "fifo", cfg.EvmTxQueueOrdering())
+			assert.Equal(t, "fifo", cfg.KeySpecificTxQueueOrdering(addr))
+		})
+		t.Run("uses key-specific override value when that is set", func(t *testing.T) {
+			evmconfig.UpdatePersistedCfg(cfg, func(cfg *evmtypes.ChainCfg) {
+				cfg.KeySpecific[addr.Hex()] = evmtypes.ChainCfg{EvmTxQueueOrdering: null.StringFrom("value_asc_fifo")}
+			})
+
+			assert.Equal(t, "value_asc_fifo", cfg.KeySpecificTxQueueOrdering(addr))
+			assert.Equal(t, "fifo", cfg.EvmTxQueueOrdering())
+		})
+		t.Run("uses global value when that is set", func(t *testing.T) {
+			gcfg.Overrides.GlobalEvmTxQueueOrdering = null.StringFrom("priority")
+
+			assert.Equal(t, "priority", cfg.KeySpecificTxQueueOrdering(addr))
+		})
+	})
 }
 
 func TestChainScopedConfig_BSCDefaults(t *testing.T) {
@@ -224,4 +257,110 @@ func Test_chainScopedConfig_Validate(t *testing.T) {
 			assert.Error(t, cfg.Validate())
 		})
 	})
+
+	t.Run("tx-queue-ordering", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		gcfg.Overrides.GlobalEvmTxQueueOrdering = null.StringFrom("lifo")
+		lggr := logger.TestLogger(t)
+		cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{}, nil, lggr, gcfg)
+		assert.Error(t, cfg.Validate())
+	})
+
+	t.Run("key-specific tx-queue-ordering", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		lggr := logger.TestLogger(t)
+		cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{
+			KeySpecific: map[string]evmtypes.ChainCfg{
+				cltest.NewAddress().Hex(): {EvmTxQueueOrdering: null.StringFrom("lifo")},
+			},
+		}, nil, lggr, gcfg)
+		assert.Error(t, cfg.Validate())
+	})
+
+	t.Run("tx-queue-tiebreak", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		gcfg.Overrides.GlobalEvmTxQueueTiebreak = null.StringFrom("random")
+		lggr := logger.TestLogger(t)
+		cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{}, nil, lggr, gcfg)
+		assert.Error(t, cfg.Validate())
+	})
+
+	t.Run("gas-bump-strategy", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		gcfg.Overrides.GlobalEvmGasBumpStrategy = null.StringFrom("exponential")
+		lggr := logger.TestLogger(t)
+		cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{}, nil, lggr, gcfg)
+		assert.Error(t, cfg.Validate())
+	})
+
+	t.Run("linear gas-bump-strategy without bump wei", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		lggr := logger.TestLogger(t)
+		cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{
+			EvmGasBumpStrategy: null.StringFrom("linear"),
+			EvmGasBumpWei:      utils.NewBigI(0),
+		}, nil, lggr, gcfg)
+		assert.Error(t, cfg.Validate())
+	})
+
+	t.Run("balance-monitor-tokens", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		gcfg.Overrides.GlobalBalanceMonitorTokens = null.StringFrom("0x514910771AF9Ca656af840dff83E8264EcF986CA:-1")
+		lggr := logger.TestLogger(t)
+		cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{}, nil, lggr, gcfg)
+		assert.Error(t, cfg.Validate())
+		assert.Nil(t, cfg.BalanceMonitorTokens())
+	})
+}
+
+func TestParseBalanceMonitorTokens(t *testing.T) {
+	link := "0x514910771AF9Ca656af840dff83E8264EcF986CA"
+	dai := "0x6B175474E89094C44Da98b954EedeAC495271d0F"
+
+	tokens, err := evmconfig.ParseBalanceMonitorTokens(fmt.Sprintf(" %s:1000000000000000000, %s ", link, dai))
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, link, tokens[0].Address.Hex())
+	assert.Equal(t, "1000000000000000000", tokens[0].MinBalance.String())
+	assert.Equal(t, dai, tokens[1].Address.Hex())
+	assert.Nil(t, tokens[1].MinBalance)
+
+	tokens, err = evmconfig.ParseBalanceMonitorTokens("")
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	for _, invalid := range []string{
+		"0xdeadbeef",
+		link + ":ten",
+		link + ":-1",
+		link + "," + strings.ToLower(link),
+	} {
+		_, err = evmconfig.ParseBalanceMonitorTokens(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestParseDeadlineBoostCurve(t *testing.T) {
+	curve, err := evmconfig.ParseDeadlineBoostCurve(" 30s:4, 2m:2 ")
+	require.NoError(t, err)
+	assert.Equal(t, []evmconfig.DeadlineBoostStage{
+		{Remaining: 2 * time.Minute, Factor: 2},
+		{Remaining: 30 * time.Second, Factor: 4},
+	}, curve)
+
+	curve, err = evmconfig.ParseDeadlineBoostCurve("")
+	require.NoError(t, err)
+	assert.Empty(t, curve)
+
+	for _, invalid := range []string{
+		"2m",
+		"2m:0",
+		"2m:two",
+		"-2m:2",
+		"ten:2",
+		"2m:2,120s:3",
+	} {
+		_, err = evmconfig.ParseDeadlineBoostCurve(invalid)
+		assert.Error(t, err, invalid)
+	}
 }
@@ -13,6 +13,8 @@ import (
 
 	commontypes "github.com/smartcontractkit/libocr/commontypes"
 
+	config "github.com/smartcontractkit/chainlink/core/chains/evm/config"
+
 	coreconfig "github.com/smartcontractkit/chainlink/core/config"
 
 	dialects "github.com/smartcontractkit/chainlink/core/store/dialects"
@@ -331,6 +333,22 @@ func (_m *ChainScopedConfig) BalanceMonitorEnabled() bool {
 	return r0
 }
 
+// BalanceMonitorTokens provides a mock function with given fields:
+func (_m *ChainScopedConfig) BalanceMonitorTokens() []config.BalanceMonitorToken {
+	ret := _m.Called()
+
+	var r0 []config.BalanceMonitorToken
+	if rf, ok := ret.Get(0).(func() []config.BalanceMonitorToken); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.BalanceMonitorToken)
+		}
+	}
+
+	return r0
+}
+
 // BlockBackfillDepth provides a mock function with given fields:
 func (_m *ChainScopedConfig) BlockBackfillDepth() uint64 {
 	ret := _m.Called()
@@ -731,8 +749,8 @@ func (_m *ChainScopedConfig) EVMDisabled() bool {
 	return r0
 }
 
-// EthTxReaperInterval provides a mock function with given fields:
-func (_m *ChainScopedConfig) EthTxReaperInterval() time.Duration {
+// EthTxBumpDigestInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxBumpDigestInterval() time.Duration {
 	ret := _m.Called()
 
 	var r0 time.Duration
@@ -745,22 +763,24 @@ func (_m *ChainScopedConfig) EthTxReaperInterval() time.Duration {
 	return r0
 }
 
-// EthTxReaperThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) EthTxReaperThreshold() time.Duration {
+// EthTxDeadlineBoostCurve provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxDeadlineBoostCurve() []config.DeadlineBoostStage {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 []config.DeadlineBoostStage
+	if rf, ok := ret.Get(0).(func() []config.DeadlineBoostStage); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.DeadlineBoostStage)
+		}
 	}
 
 	return r0
 }
 
-// EthTxResendAfterThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) EthTxResendAfterThreshold() time.Duration {
+// EthTxDrainTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxDrainTimeout() time.Duration {
 	ret := _m.Called()
 
 	var r0 time.Duration
@@ -773,22 +793,22 @@ func (_m *ChainScopedConfig) EthTxResendAfterThreshold() time.Duration {
 	return r0
 }
 
-// EthereumDisabled provides a mock function with given fields:
-func (_m *ChainScopedConfig) EthereumDisabled() bool {
+// EthTxDuplicateInstancePolicy provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxDuplicateInstancePolicy() string {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// EthereumHTTPURL provides a mock function with given fields:
-func (_m *ChainScopedConfig) EthereumHTTPURL() *url.URL {
+// EthTxFailureWebhookURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxFailureWebhookURL() *url.URL {
 	ret := _m.Called()
 
 	var r0 *url.URL
@@ -803,244 +823,238 @@ func (_m *ChainScopedConfig) EthereumHTTPURL() *url.URL {
 	return r0
 }
 
-// EthereumSecondaryURLs provides a mock function with given fields:
-func (_m *ChainScopedConfig) EthereumSecondaryURLs() []url.URL {
+// EthTxFundsRecoveryBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxFundsRecoveryBatchSize() uint32 {
 	ret := _m.Called()
 
-	var r0 []url.URL
-	if rf, ok := ret.Get(0).(func() []url.URL); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]url.URL)
-		}
+		r0 = ret.Get(0).(uint32)
 	}
 
 	return r0
 }
 
-// EthereumURL provides a mock function with given fields:
-func (_m *ChainScopedConfig) EthereumURL() string {
+// EthTxFundsRecoveryCheckInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxFundsRecoveryCheckInterval() time.Duration {
 	ret := _m.Called()
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmEIP1559DynamicFees provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmEIP1559DynamicFees() bool {
+// EthTxInProgressResolutionPolicy provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxInProgressResolutionPolicy() string {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// EvmFinalityDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
+// EthTxInsufficientEthBackoffMax provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxInsufficientEthBackoffMax() time.Duration {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmGasBumpPercent provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
+// EthTxInsufficientEthMode provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxInsufficientEthMode() string {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// EvmGasBumpThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasBumpThreshold() uint64 {
+// EthTxMaxFeeMode provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxMaxFeeMode() string {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// EvmGasBumpTxDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasBumpTxDepth() uint16 {
+// EthTxOutcomeKafkaTopic provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxOutcomeKafkaTopic() string {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// EvmGasBumpWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasBumpWei() *big.Int {
+// EthTxOutcomeKafkaURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxOutcomeKafkaURL() *url.URL {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
+			r0 = ret.Get(0).(*url.URL)
 		}
 	}
 
 	return r0
 }
 
-// EvmGasFeeCap provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasFeeCap() *big.Int {
+// EthTxReaperInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmGasLimitDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitDefault() uint64 {
+// EthTxReaperThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxReaperThreshold() time.Duration {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmGasLimitMultiplier provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitMultiplier() float32 {
+// EthTxResendAfterThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxResendAfterThreshold() time.Duration {
 	ret := _m.Called()
 
-	var r0 float32
-	if rf, ok := ret.Get(0).(func() float32); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(float32)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmGasLimitTransfer provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasLimitTransfer() uint64 {
+// EthTxResumeBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxResumeBatchSize() uint32 {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	return r0
 }
 
-// EvmGasPriceDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasPriceDefault() *big.Int {
+// EthereumDisabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthereumDisabled() bool {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
 	return r0
 }
 
-// EvmGasTipCapDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasTipCapDefault() *big.Int {
+// EthereumHTTPURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthereumHTTPURL() *url.URL {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
+			r0 = ret.Get(0).(*url.URL)
 		}
 	}
 
 	return r0
 }
 
-// EvmGasTipCapMinimum provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmGasTipCapMinimum() *big.Int {
+// EthereumSecondaryURLs provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthereumSecondaryURLs() []url.URL {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 []url.URL
+	if rf, ok := ret.Get(0).(func() []url.URL); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
+			r0 = ret.Get(0).([]url.URL)
 		}
 	}
 
 	return r0
 }
 
-// EvmHeadTrackerHistoryDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmHeadTrackerHistoryDepth() uint32 {
+// EthereumURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthereumURL() string {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// EvmHeadTrackerMaxBufferSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmHeadTrackerMaxBufferSize() uint32 {
+// EvmBroadcastMaxInitialBumps provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmBroadcastMaxInitialBumps() uint32 {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1053,52 +1067,50 @@ func (_m *ChainScopedConfig) EvmHeadTrackerMaxBufferSize() uint32 {
 	return r0
 }
 
-// EvmHeadTrackerSamplingInterval provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmHeadTrackerSamplingInterval() time.Duration {
+// EvmBroadcastPollJitterDisabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmBroadcastPollJitterDisabled() bool {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		r0 = ret.Get(0).(bool)
 	}
 
 	return r0
 }
 
-// EvmLogBackfillBatchSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmLogBackfillBatchSize() uint32 {
+// EvmChainHaltThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmChainHaltThreshold() time.Duration {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// EvmMaxGasPriceWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmMaxGasPriceWei() *big.Int {
+// EvmEIP1559DynamicFees provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmEIP1559DynamicFees() bool {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
 	return r0
 }
 
-// EvmMaxInFlightTransactions provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmMaxInFlightTransactions() uint32 {
+// EvmFinalityDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1111,65 +1123,555 @@ func (_m *ChainScopedConfig) EvmMaxInFlightTransactions() uint32 {
 	return r0
 }
 
-// EvmMaxQueuedTransactions provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmMaxQueuedTransactions() uint64 {
+// EvmGasBumpPercent provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	return r0
 }
 
-// EvmMinGasPriceWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmMinGasPriceWei() *big.Int {
+// EvmGasBumpStrategy provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasBumpStrategy() string {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// EvmNonceAutoSync provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmNonceAutoSync() bool {
+// EvmGasBumpThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasBumpThreshold() uint64 {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(uint64)
 	}
 
 	return r0
 }
 
-// EvmRPCDefaultBatchSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
+// EvmGasBumpTxDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasBumpTxDepth() uint16 {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	return r0
 }
 
-// ExplorerAccessKey provides a mock function with given fields:
+// EvmGasBumpWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasBumpWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasFeeCap provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasFeeCap() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasLimitDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitDefault() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// EvmGasLimitLearningEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitLearningEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmGasLimitLearningMarginPercent provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitLearningMarginPercent() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EvmGasLimitLearningMin provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitLearningMin() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// EvmGasLimitMax provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitMax() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// EvmGasLimitMultiplier provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitMultiplier() float32 {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	return r0
+}
+
+// EvmGasLimitTransfer provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasLimitTransfer() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// EvmGasPriceDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasPriceDefault() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasTipCapDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasTipCapDefault() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmGasTipCapMinimum provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmGasTipCapMinimum() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerHistoryDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerHistoryDepth() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerMaxBufferSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerMaxBufferSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerSamplingInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerSamplingInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmInProgressTxAlertThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmInProgressTxAlertThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmKeyIdleTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmKeyIdleTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmLogBackfillBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogBackfillBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmMaxGasPriceExceededPolicy provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxGasPriceExceededPolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmMaxGasPriceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxGasPriceWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmMaxInFlightTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxInFlightTransactions() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmMaxInFlightValueWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxInFlightValueWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmMaxInProgressAge provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxInProgressAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmMaxNonceHoles provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxNonceHoles() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmMaxQueuedTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxQueuedTransactions() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// EvmMinGasPriceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMinGasPriceWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmMulticallAddress provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMulticallAddress() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmNonceAutoSync provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmNonceAutoSync() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmNonceAutoSyncInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmNonceAutoSyncInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmRPCDefaultBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmRejectSelfTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmRejectSelfTransactions() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmStrictSequencing provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmStrictSequencing() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmTxQueueOrdering provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTxQueueOrdering() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmTxQueueTiebreak provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTxQueueTiebreak() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ExplorerAccessKey provides a mock function with given fields:
 func (_m *ChainScopedConfig) ExplorerAccessKey() string {
 	ret := _m.Called()
 
@@ -1180,11 +1682,419 @@ func (_m *ChainScopedConfig) ExplorerAccessKey() string {
 		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	return r0
+}
+
+// ExplorerSecret provides a mock function with given fields:
+func (_m *ChainScopedConfig) ExplorerSecret() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ExplorerURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) ExplorerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// FMDefaultTransactionQueueDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) FMDefaultTransactionQueueDepth() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// FMSimulateTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) FMSimulateTransactions() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FeatureExternalInitiators provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeatureExternalInitiators() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FeatureFeedsManager provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeatureFeedsManager() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FeatureOffchainReporting provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeatureOffchainReporting() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FeatureOffchainReporting2 provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeatureOffchainReporting2() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FeatureUICSAKeys provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeatureUICSAKeys() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FeatureUIFeedsManager provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeatureUIFeedsManager() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FlagsContractAddress provides a mock function with given fields:
+func (_m *ChainScopedConfig) FlagsContractAddress() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GasEstimatorMode provides a mock function with given fields:
+func (_m *ChainScopedConfig) GasEstimatorMode() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GasEstimatorOwnConfirmationsBlockWindow provides a mock function with given fields:
+func (_m *ChainScopedConfig) GasEstimatorOwnConfirmationsBlockWindow() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// GasEstimatorOwnConfirmationsMinBlocks provides a mock function with given fields:
+func (_m *ChainScopedConfig) GasEstimatorOwnConfirmationsMinBlocks() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// GasEstimatorRecordInputs provides a mock function with given fields:
+func (_m *ChainScopedConfig) GasEstimatorRecordInputs() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GetAdvisoryLockIDConfiguredOrDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) GetAdvisoryLockIDConfiguredOrDefault() int64 {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// GetDatabaseDialectConfiguredOrDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) GetDatabaseDialectConfiguredOrDefault() dialects.DialectName {
+	ret := _m.Called()
+
+	var r0 dialects.DialectName
+	if rf, ok := ret.Get(0).(func() dialects.DialectName); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(dialects.DialectName)
+	}
+
+	return r0
+}
+
+// GlobalBalanceMonitorEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalBalanceMonitorEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalBalanceMonitorTokens provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalBalanceMonitorTokens() (string, bool) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalBlockEmissionIdleWarningThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalBlockEmissionIdleWarningThreshold() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalBlockHistoryEstimatorBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorBatchSize() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalBlockHistoryEstimatorBlockDelay provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorBlockDelay() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalBlockHistoryEstimatorBlockHistorySize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorBlockHistorySize() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalBlockHistoryEstimatorTransactionPercentile provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorTransactionPercentile() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalChainType provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalChainType() (string, bool) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// ExplorerSecret provides a mock function with given fields:
-func (_m *ChainScopedConfig) ExplorerSecret() string {
+// GlobalEthTxDeadlineBoostCurve provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEthTxDeadlineBoostCurve() (string, bool) {
 	ret := _m.Called()
 
 	var r0 string
@@ -1194,27 +2104,81 @@ func (_m *ChainScopedConfig) ExplorerSecret() string {
 		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// ExplorerURL provides a mock function with given fields:
-func (_m *ChainScopedConfig) ExplorerURL() *url.URL {
+// GlobalEthTxReaperInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 *url.URL
-	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*url.URL)
-		}
+		r0 = ret.Get(0).(time.Duration)
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// FMDefaultTransactionQueueDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) FMDefaultTransactionQueueDepth() uint32 {
+// GlobalEthTxReaperThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEthTxReaperThreshold() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEthTxResendAfterThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmBroadcastMaxInitialBumps provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmBroadcastMaxInitialBumps() (uint32, bool) {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1224,11 +2188,18 @@ func (_m *ChainScopedConfig) FMDefaultTransactionQueueDepth() uint32 {
 		r0 = ret.Get(0).(uint32)
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// FMSimulateTransactions provides a mock function with given fields:
-func (_m *ChainScopedConfig) FMSimulateTransactions() bool {
+// GlobalEvmBroadcastPollJitterDisabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmBroadcastPollJitterDisabled() (bool, bool) {
 	ret := _m.Called()
 
 	var r0 bool
@@ -1238,39 +2209,60 @@ func (_m *ChainScopedConfig) FMSimulateTransactions() bool {
 		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// FeatureExternalInitiators provides a mock function with given fields:
-func (_m *ChainScopedConfig) FeatureExternalInitiators() bool {
+// GlobalEvmChainHaltThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmChainHaltThreshold() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// FeatureFeedsManager provides a mock function with given fields:
-func (_m *ChainScopedConfig) FeatureFeedsManager() bool {
+// GlobalEvmDefaultBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmDefaultBatchSize() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(uint32)
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// FeatureOffchainReporting provides a mock function with given fields:
-func (_m *ChainScopedConfig) FeatureOffchainReporting() bool {
+// GlobalEvmEIP1559DynamicFees provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
 	ret := _m.Called()
 
 	var r0 bool
@@ -1280,109 +2272,167 @@ func (_m *ChainScopedConfig) FeatureOffchainReporting() bool {
 		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmFinalityDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmFinalityDepth() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// FeatureOffchainReporting2 provides a mock function with given fields:
-func (_m *ChainScopedConfig) FeatureOffchainReporting2() bool {
+// GlobalEvmGasBumpPercent provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(uint16)
 	}
 
-	return r0
-}
-
-// FeatureUICSAKeys provides a mock function with given fields:
-func (_m *ChainScopedConfig) FeatureUICSAKeys() bool {
-	ret := _m.Called()
-
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
-		r0 = rf()
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r1 = ret.Get(1).(bool)
 	}
 
-	return r0
+	return r0, r1
 }
 
-// FeatureUIFeedsManager provides a mock function with given fields:
-func (_m *ChainScopedConfig) FeatureUIFeedsManager() bool {
+// GlobalEvmGasBumpStrategy provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasBumpStrategy() (string, bool) {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// FlagsContractAddress provides a mock function with given fields:
-func (_m *ChainScopedConfig) FlagsContractAddress() string {
+// GlobalEvmGasBumpThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
 	ret := _m.Called()
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint64)
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// GasEstimatorMode provides a mock function with given fields:
-func (_m *ChainScopedConfig) GasEstimatorMode() string {
+// GlobalEvmGasBumpTxDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint16)
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// GetAdvisoryLockIDConfiguredOrDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) GetAdvisoryLockIDConfiguredOrDefault() int64 {
+// GlobalEvmGasBumpWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasBumpWei() (*big.Int, bool) {
 	ret := _m.Called()
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func() int64); ok {
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// GetDatabaseDialectConfiguredOrDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) GetDatabaseDialectConfiguredOrDefault() dialects.DialectName {
+// GlobalEvmGasLimitDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
 	ret := _m.Called()
 
-	var r0 dialects.DialectName
-	if rf, ok := ret.Get(0).(func() dialects.DialectName); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(dialects.DialectName)
+		r0 = ret.Get(0).(uint64)
 	}
 
-	return r0
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// GlobalBalanceMonitorEnabled provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalBalanceMonitorEnabled() (bool, bool) {
+// GlobalEvmGasLimitLearningEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasLimitLearningEnabled() (bool, bool) {
 	ret := _m.Called()
 
 	var r0 bool
@@ -1402,15 +2452,15 @@ func (_m *ChainScopedConfig) GlobalBalanceMonitorEnabled() (bool, bool) {
 	return r0, r1
 }
 
-// GlobalBlockEmissionIdleWarningThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalBlockEmissionIdleWarningThreshold() (time.Duration, bool) {
+// GlobalEvmGasLimitLearningMarginPercent provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasLimitLearningMarginPercent() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1423,15 +2473,15 @@ func (_m *ChainScopedConfig) GlobalBlockEmissionIdleWarningThreshold() (time.Dur
 	return r0, r1
 }
 
-// GlobalBlockHistoryEstimatorBatchSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorBatchSize() (uint32, bool) {
+// GlobalEvmGasLimitLearningMin provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasLimitLearningMin() (uint64, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 bool
@@ -1444,15 +2494,15 @@ func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorBatchSize() (uint32, boo
 	return r0, r1
 }
 
-// GlobalBlockHistoryEstimatorBlockDelay provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorBlockDelay() (uint16, bool) {
+// GlobalEvmGasLimitMax provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasLimitMax() (uint64, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 bool
@@ -1465,15 +2515,15 @@ func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorBlockDelay() (uint16, bo
 	return r0, r1
 }
 
-// GlobalBlockHistoryEstimatorBlockHistorySize provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorBlockHistorySize() (uint16, bool) {
+// GlobalEvmGasLimitMultiplier provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(float32)
 	}
 
 	var r1 bool
@@ -1486,15 +2536,15 @@ func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorBlockHistorySize() (uint
 	return r0, r1
 }
 
-// GlobalBlockHistoryEstimatorTransactionPercentile provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorTransactionPercentile() (uint16, bool) {
+// GlobalEvmGasLimitTransfer provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasLimitTransfer() (uint64, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 bool
@@ -1507,15 +2557,17 @@ func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorTransactionPercentile()
 	return r0, r1
 }
 
-// GlobalChainType provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalChainType() (string, bool) {
+// GlobalEvmGasPriceDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
 	ret := _m.Called()
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
 	}
 
 	var r1 bool
@@ -1528,15 +2580,17 @@ func (_m *ChainScopedConfig) GlobalChainType() (string, bool) {
 	return r0, r1
 }
 
-// GlobalEthTxReaperInterval provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
+// GlobalEvmGasTipCapDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasTipCapDefault() (*big.Int, bool) {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
 	}
 
 	var r1 bool
@@ -1549,15 +2603,17 @@ func (_m *ChainScopedConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
 	return r0, r1
 }
 
-// GlobalEthTxReaperThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEthTxReaperThreshold() (time.Duration, bool) {
+// GlobalEvmGasTipCapMinimum provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasTipCapMinimum() (*big.Int, bool) {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
 	}
 
 	var r1 bool
@@ -1570,15 +2626,15 @@ func (_m *ChainScopedConfig) GlobalEthTxReaperThreshold() (time.Duration, bool)
 	return r0, r1
 }
 
-// GlobalEthTxResendAfterThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool) {
+// GlobalEvmHeadTrackerHistoryDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1591,8 +2647,8 @@ func (_m *ChainScopedConfig) GlobalEthTxResendAfterThreshold() (time.Duration, b
 	return r0, r1
 }
 
-// GlobalEvmDefaultBatchSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmDefaultBatchSize() (uint32, bool) {
+// GlobalEvmHeadTrackerMaxBufferSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1612,15 +2668,15 @@ func (_m *ChainScopedConfig) GlobalEvmDefaultBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmEIP1559DynamicFees provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
+// GlobalEvmHeadTrackerSamplingInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1633,15 +2689,15 @@ func (_m *ChainScopedConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
 	return r0, r1
 }
 
-// GlobalEvmFinalityDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmFinalityDepth() (uint32, bool) {
+// GlobalEvmInProgressTxAlertThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmInProgressTxAlertThreshold() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1654,15 +2710,15 @@ func (_m *ChainScopedConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpPercent provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
+// GlobalEvmKeyIdleTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmKeyIdleTimeout() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1675,15 +2731,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
+// GlobalEvmLogBackfillBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1696,15 +2752,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpTxDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
+// GlobalEvmMaxGasPriceExceededPolicy provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMaxGasPriceExceededPolicy() (string, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1717,8 +2773,8 @@ func (_m *ChainScopedConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasBumpWei() (*big.Int, bool) {
+// GlobalEvmMaxGasPriceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	ret := _m.Called()
 
 	var r0 *big.Int
@@ -1740,15 +2796,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasBumpWei() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
+// GlobalEvmMaxInFlightTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1761,15 +2817,17 @@ func (_m *ChainScopedConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitMultiplier provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
+// GlobalEvmMaxInFlightValueWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMaxInFlightValueWei() (*big.Int, bool) {
 	ret := _m.Called()
 
-	var r0 float32
-	if rf, ok := ret.Get(0).(func() float32); ok {
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(float32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
 	}
 
 	var r1 bool
@@ -1782,15 +2840,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitTransfer provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasLimitTransfer() (uint64, bool) {
+// GlobalEvmMaxInProgressAge provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMaxInProgressAge() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1803,17 +2861,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasLimitTransfer() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasPriceDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
+// GlobalEvmMaxNonceHoles provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMaxNonceHoles() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1826,17 +2882,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasTipCapDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasTipCapDefault() (*big.Int, bool) {
+// GlobalEvmMaxQueuedTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 bool
@@ -1849,8 +2903,8 @@ func (_m *ChainScopedConfig) GlobalEvmGasTipCapDefault() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasTipCapMinimum provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasTipCapMinimum() (*big.Int, bool) {
+// GlobalEvmMinGasPriceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	ret := _m.Called()
 
 	var r0 *big.Int
@@ -1872,15 +2926,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasTipCapMinimum() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerHistoryDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
+// GlobalEvmMulticallAddress provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMulticallAddress() (string, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1893,15 +2947,15 @@ func (_m *ChainScopedConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerMaxBufferSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
+// GlobalEvmNonceAutoSync provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1914,8 +2968,8 @@ func (_m *ChainScopedConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool)
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerSamplingInterval provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
+// GlobalEvmNonceAutoSyncInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmNonceAutoSyncInterval() (time.Duration, bool) {
 	ret := _m.Called()
 
 	var r0 time.Duration
@@ -1935,8 +2989,8 @@ func (_m *ChainScopedConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Durati
 	return r0, r1
 }
 
-// GlobalEvmLogBackfillBatchSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
+// GlobalEvmRPCDefaultBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1956,17 +3010,15 @@ func (_m *ChainScopedConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxGasPriceWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
+// GlobalEvmRejectSelfTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmRejectSelfTransactions() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1979,15 +3031,15 @@ func (_m *ChainScopedConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxInFlightTransactions provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
+// GlobalEvmTxQueueOrdering provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmTxQueueOrdering() (string, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -2000,15 +3052,15 @@ func (_m *ChainScopedConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxQueuedTransactions provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
+// GlobalEvmTxQueueTiebreak provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmTxQueueTiebreak() (string, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -2021,17 +3073,15 @@ func (_m *ChainScopedConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMinGasPriceWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
+// GlobalFlagsContractAddress provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalFlagsContractAddress() (string, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -2044,15 +3094,15 @@ func (_m *ChainScopedConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmNonceAutoSync provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmNonceAutoSync() (bool, bool) {
+// GlobalGasEstimatorMode provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalGasEstimatorMode() (string, bool) {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -2065,15 +3115,15 @@ func (_m *ChainScopedConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	return r0, r1
 }
 
-// GlobalEvmRPCDefaultBatchSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
+// GlobalGasEstimatorOwnConfirmationsBlockWindow provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalGasEstimatorOwnConfirmationsBlockWindow() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -2086,15 +3136,15 @@ func (_m *ChainScopedConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalFlagsContractAddress provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalFlagsContractAddress() (string, bool) {
+// GlobalGasEstimatorOwnConfirmationsMinBlocks provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalGasEstimatorOwnConfirmationsMinBlocks() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -2107,15 +3157,15 @@ func (_m *ChainScopedConfig) GlobalFlagsContractAddress() (string, bool) {
 	return r0, r1
 }
 
-// GlobalGasEstimatorMode provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalGasEstimatorMode() (string, bool) {
+// GlobalGasEstimatorRecordInputs provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalGasEstimatorRecordInputs() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -2466,6 +3516,34 @@ func (_m *ChainScopedConfig) KeeperMaximumGracePeriod() int64 {
 	return r0
 }
 
+// KeeperMinimumBalanceBufferPercent provides a mock function with given fields:
+func (_m *ChainScopedConfig) KeeperMinimumBalanceBufferPercent() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// KeeperPendingPerformTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) KeeperPendingPerformTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // KeeperRegistryCheckGasOverhead provides a mock function with given fields:
 func (_m *ChainScopedConfig) KeeperRegistryCheckGasOverhead() uint64 {
 	ret := _m.Called()
@@ -2522,6 +3600,34 @@ func (_m *ChainScopedConfig) KeeperRegistrySyncUpkeepQueueSize() uint32 {
 	return r0
 }
 
+// KeeperUpkeepOrder provides a mock function with given fields:
+func (_m *ChainScopedConfig) KeeperUpkeepOrder() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// KeeperUpkeepRunsRetention provides a mock function with given fields:
+func (_m *ChainScopedConfig) KeeperUpkeepRunsRetention() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // KeyFile provides a mock function with given fields:
 func (_m *ChainScopedConfig) KeyFile() string {
 	ret := _m.Called()
@@ -2552,6 +3658,62 @@ func (_m *ChainScopedConfig) KeySpecificMaxGasPriceWei(addr common.Address) *big
 	return r0
 }
 
+// KeySpecificStrictSequencing provides a mock function with given fields: addr
+func (_m *ChainScopedConfig) KeySpecificStrictSequencing(addr common.Address) bool {
+	ret := _m.Called(addr)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Address) bool); ok {
+		r0 = rf(addr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// KeySpecificTxQueueOrdering provides a mock function with given fields: addr
+func (_m *ChainScopedConfig) KeySpecificTxQueueOrdering(addr common.Address) string {
+	ret := _m.Called(addr)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(common.Address) string); ok {
+		r0 = rf(addr)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LeaderElectionCheckInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) LeaderElectionCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// LeaderElectionMode provides a mock function with given fields:
+func (_m *ChainScopedConfig) LeaderElectionMode() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // LeaseLockDuration provides a mock function with given fields:
 func (_m *ChainScopedConfig) LeaseLockDuration() time.Duration {
 	ret := _m.Called()
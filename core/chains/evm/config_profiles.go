@@ -0,0 +1,306 @@
+package evm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ErrConfigValidationFailed is returned when a config change was refused
+// because validating it against the live chain found errors
+var ErrConfigValidationFailed = errors.New("config validation failed")
+
+// ConfigProfile is a named bundle of recommended chain config overrides, for
+// a specific chain or for an archetype of chain, that can be applied to a
+// chain instead of tuning each setting by hand.
+//
+// Version must be incremented whenever Config changes, so that chains the
+// profile was applied to can tell that they are behind.
+type ConfigProfile struct {
+	Name        string         `json:"name"`
+	Version     int32          `json:"version"`
+	Description string         `json:"description"`
+	Config      types.ChainCfg `json:"config"`
+}
+
+func gwei(n int64) *utils.Big {
+	return utils.NewBig(assets.GWei(n))
+}
+
+func duration(d time.Duration) *models.Duration {
+	md := models.MustMakeDuration(d)
+	return &md
+}
+
+var configProfiles = []ConfigProfile{
+	{
+		Name:        "ethereum-mainnet",
+		Version:     1,
+		Description: "Ethereum mainnet, with EIP-1559 fees and block history gas estimation",
+		Config: types.ChainCfg{
+			BlockHistoryEstimatorBlockDelay:       null.IntFrom(1),
+			BlockHistoryEstimatorBlockHistorySize: null.IntFrom(24),
+			EvmEIP1559DynamicFees:                 null.BoolFrom(true),
+			EvmFinalityDepth:                      null.IntFrom(50),
+			EvmGasBumpPercent:                     null.IntFrom(20),
+			EvmGasBumpTxDepth:                     null.IntFrom(10),
+			EvmGasBumpWei:                         gwei(5),
+			EvmGasPriceDefault:                    gwei(20),
+			EvmHeadTrackerHistoryDepth:            null.IntFrom(100),
+			EvmMaxGasPriceWei:                     gwei(5000),
+			GasEstimatorMode:                      null.StringFrom("BlockHistory"),
+			MinIncomingConfirmations:              null.IntFrom(3),
+			MinRequiredOutgoingConfirmations:      null.IntFrom(12),
+		},
+	},
+	{
+		Name:        "polygon-mainnet",
+		Version:     1,
+		Description: "Polygon mainnet, with 2s blocks and frequent deep re-orgs",
+		Config: types.ChainCfg{
+			BlockHistoryEstimatorBlockDelay:       null.IntFrom(10),
+			BlockHistoryEstimatorBlockHistorySize: null.IntFrom(24),
+			EthTxResendAfterThreshold:             duration(5 * time.Minute),
+			EvmFinalityDepth:                      null.IntFrom(200),
+			EvmGasBumpPercent:                     null.IntFrom(20),
+			EvmGasBumpWei:                         gwei(20),
+			EvmGasPriceDefault:                    gwei(30),
+			EvmHeadTrackerHistoryDepth:            null.IntFrom(250),
+			EvmHeadTrackerSamplingInterval:        duration(time.Second),
+			EvmMaxGasPriceWei:                     gwei(5000),
+			GasEstimatorMode:                      null.StringFrom("BlockHistory"),
+			MinIncomingConfirmations:              null.IntFrom(5),
+			MinRequiredOutgoingConfirmations:      null.IntFrom(12),
+		},
+	},
+	{
+		Name:        "bsc-mainnet",
+		Version:     1,
+		Description: "BNB Smart Chain mainnet, with 3s blocks and Clique finality",
+		Config: types.ChainCfg{
+			BlockHistoryEstimatorBlockDelay:       null.IntFrom(2),
+			BlockHistoryEstimatorBlockHistorySize: null.IntFrom(24),
+			EthTxResendAfterThreshold:             duration(time.Minute),
+			EvmFinalityDepth:                      null.IntFrom(50),
+			EvmGasBumpWei:                         gwei(5),
+			EvmGasPriceDefault:                    gwei(5),
+			EvmHeadTrackerHistoryDepth:            null.IntFrom(100),
+			EvmHeadTrackerSamplingInterval:        duration(time.Second),
+			GasEstimatorMode:                      null.StringFrom("BlockHistory"),
+			MinIncomingConfirmations:              null.IntFrom(3),
+			MinRequiredOutgoingConfirmations:      null.IntFrom(12),
+		},
+	},
+	{
+		Name:        "avalanche-mainnet",
+		Version:     1,
+		Description: "Avalanche C-Chain mainnet, with 2s blocks and single block finality",
+		Config: types.ChainCfg{
+			BlockHistoryEstimatorBlockDelay:       null.IntFrom(2),
+			BlockHistoryEstimatorBlockHistorySize: null.IntFrom(24),
+			EvmFinalityDepth:                      null.IntFrom(1),
+			EvmGasPriceDefault:                    gwei(25),
+			EvmMaxGasPriceWei:                     gwei(1000),
+			GasEstimatorMode:                      null.StringFrom("BlockHistory"),
+			MinIncomingConfirmations:              null.IntFrom(1),
+			MinRequiredOutgoingConfirmations:      null.IntFrom(1),
+		},
+	},
+	{
+		Name:        "arbitrum-mainnet",
+		Version:     1,
+		Description: "Arbitrum One, a sequencer based L2 with fixed gas prices and no gas bumping",
+		Config: types.ChainCfg{
+			EvmGasLimitDefault:               null.IntFrom(7000000),
+			EvmGasPriceDefault:               gwei(1000),
+			EvmMaxGasPriceWei:                gwei(1000),
+			GasEstimatorMode:                 null.StringFrom("FixedPrice"),
+			MinIncomingConfirmations:         null.IntFrom(1),
+			MinRequiredOutgoingConfirmations: null.IntFrom(1),
+		},
+	},
+	{
+		Name:        "optimism-mainnet",
+		Version:     1,
+		Description: "Optimism mainnet, a sequencer based L2 priced by the Optimism2 estimator",
+		Config: types.ChainCfg{
+			EthTxResendAfterThreshold:        duration(15 * time.Second),
+			EvmFinalityDepth:                 null.IntFrom(1),
+			EvmHeadTrackerHistoryDepth:       null.IntFrom(10),
+			EvmHeadTrackerSamplingInterval:   duration(time.Second),
+			GasEstimatorMode:                 null.StringFrom("Optimism2"),
+			MinIncomingConfirmations:         null.IntFrom(1),
+			MinRequiredOutgoingConfirmations: null.IntFrom(0),
+		},
+	},
+	{
+		Name:        "fast-l2",
+		Version:     1,
+		Description: "Any L2 or sidechain with sub-second to 2s blocks and shallow re-orgs: short history, fast resends and few confirmations",
+		Config: types.ChainCfg{
+			BlockHistoryEstimatorBlockDelay:       null.IntFrom(2),
+			BlockHistoryEstimatorBlockHistorySize: null.IntFrom(16),
+			EthTxResendAfterThreshold:             duration(15 * time.Second),
+			EvmFinalityDepth:                      null.IntFrom(10),
+			EvmHeadTrackerHistoryDepth:            null.IntFrom(20),
+			EvmHeadTrackerSamplingInterval:        duration(time.Second),
+			EvmRPCDefaultBatchSize:                null.IntFrom(100),
+			MinIncomingConfirmations:              null.IntFrom(1),
+			MinRequiredOutgoingConfirmations:      null.IntFrom(1),
+		},
+	},
+	{
+		Name:        "congested-l1",
+		Version:     1,
+		Description: "Any L1 with volatile, often spiking gas prices: reacts to recent blocks quickly and bumps aggressively",
+		Config: types.ChainCfg{
+			BlockHistoryEstimatorBlockDelay:       null.IntFrom(1),
+			BlockHistoryEstimatorBlockHistorySize: null.IntFrom(8),
+			EthTxResendAfterThreshold:             duration(30 * time.Second),
+			EvmEIP1559DynamicFees:                 null.BoolFrom(true),
+			EvmGasBumpPercent:                     null.IntFrom(30),
+			EvmGasBumpStrategy:                    null.StringFrom("geometric"),
+			EvmGasBumpTxDepth:                     null.IntFrom(20),
+			EvmMaxGasPriceWei:                     gwei(10000),
+			GasEstimatorMode:                      null.StringFrom("BlockHistory"),
+		},
+	},
+}
+
+// ConfigProfiles returns every config profile shipped with the node, sorted
+// by name
+func ConfigProfiles() []ConfigProfile {
+	profiles := make([]ConfigProfile, len(configProfiles))
+	copy(profiles, configProfiles)
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name < profiles[j].Name })
+	return profiles
+}
+
+// GetConfigProfile returns the config profile with the given name
+func GetConfigProfile(name string) (ConfigProfile, error) {
+	for _, p := range configProfiles {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return ConfigProfile{}, errors.Errorf("no config profile named %q", name)
+}
+
+// ApplyConfigProfile expands the named config profile into the chain's config
+// overrides, keeping any overrides the profile doesn't set, and records which
+// version of the profile was applied. The resulting config is validated
+// against the live chain first, and is not applied if any errors are found
+// unless force is set.
+func (cll *chainSet) ApplyConfigProfile(ctx context.Context, id *big.Int, name string, force bool) (types.Chain, ConfigValidationReport, error) {
+	profile, err := GetConfigProfile(name)
+	if err != nil {
+		return types.Chain{}, ConfigValidationReport{}, err
+	}
+	report, err := cll.ValidateConfig(ctx, id, MergeConfig(profile.Config))
+	if err != nil {
+		return types.Chain{}, report, err
+	}
+	if report.HasErrors() && !force {
+		return types.Chain{}, report, errors.Wrapf(ErrConfigValidationFailed, "refusing to apply config profile %s: %s", profile.Name, report.Err())
+	}
+
+	bid := utils.NewBig(id)
+	dbchain, err := cll.orm.Chain(*bid)
+	if err != nil {
+		return types.Chain{}, report, err
+	}
+	config := dbchain.Cfg
+	if err = MergeConfig(profile.Config)(&config); err != nil {
+		return types.Chain{}, report, err
+	}
+	chain, err := cll.Configure(id, dbchain.Enabled, config)
+	if err != nil {
+		return chain, report, err
+	}
+	if _, err = cll.orm.SetChainConfigProfile(*bid, profile.Name, profile.Version, profile.Config); err != nil {
+		return chain, report, errors.Wrap(err, "failed to record applied config profile")
+	}
+	cll.logger.Infow("Applied config profile", "evmChainID", id, "profile", profile.Name, "version", profile.Version)
+	return chain, report, nil
+}
+
+// ConfigProfileDrift reports how a chain's config has diverged from the
+// config profile last applied to it
+type ConfigProfileDrift struct {
+	Profile string `json:"profile"`
+	Version int32  `json:"version"`
+	// LatestVersion is the version of the profile shipped with the node,
+	// which is newer than Version if the profile has changed since it was
+	// applied, or zero if the profile is no longer shipped
+	LatestVersion int32                `json:"latestVersion"`
+	AppliedAt     time.Time            `json:"appliedAt"`
+	Drifted       []ConfigSettingDrift `json:"drifted"`
+}
+
+// ConfigSettingDrift is a setting that was changed away from the value a
+// config profile set it to
+type ConfigSettingDrift struct {
+	Setting string      `json:"setting"`
+	Profile interface{} `json:"profile"`
+	Current interface{} `json:"current"`
+}
+
+// ConfigProfileDrift compares the chain's current config with the overrides
+// of the config profile last applied to it. It returns sql.ErrNoRows if no
+// profile was ever applied.
+func (cll *chainSet) ConfigProfileDrift(id *big.Int) (ConfigProfileDrift, error) {
+	bid := utils.NewBig(id)
+	applied, err := cll.orm.ChainConfigProfile(*bid)
+	if err != nil {
+		return ConfigProfileDrift{}, err
+	}
+	dbchain, err := cll.orm.Chain(*bid)
+	if err != nil {
+		return ConfigProfileDrift{}, err
+	}
+	drift := ConfigProfileDrift{
+		Profile:   applied.Name,
+		Version:   applied.Version,
+		AppliedAt: applied.AppliedAt,
+		Drifted:   DiffConfig(applied.Cfg, dbchain.Cfg),
+	}
+	if latest, err := GetConfigProfile(applied.Name); err == nil {
+		drift.LatestVersion = latest.Version
+	}
+	return drift, nil
+}
+
+// DiffConfig returns every setting that is set in expected but has a
+// different value in current. KeySpecific settings are not compared.
+func DiffConfig(expected, current types.ChainCfg) (drifted []ConfigSettingDrift) {
+	ev := reflect.ValueOf(expected)
+	cv := reflect.ValueOf(current)
+	for i := 0; i < ev.NumField(); i++ {
+		field := ev.Type().Field(i)
+		if field.Name == "KeySpecific" || ev.Field(i).IsZero() {
+			continue
+		}
+		want, got := ev.Field(i).Interface(), cv.Field(i).Interface()
+		// Compare the serialized values, as the config is persisted, so that
+		// e.g. big.Ints that went through a round trip compare equal
+		wantJSON, err1 := json.Marshal(want)
+		gotJSON, err2 := json.Marshal(got)
+		if err1 == nil && err2 == nil && bytes.Equal(wantJSON, gotJSON) {
+			continue
+		}
+		drifted = append(drifted, ConfigSettingDrift{Setting: field.Name, Profile: want, Current: got})
+	}
+	return drifted
+}
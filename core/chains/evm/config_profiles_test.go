@@ -0,0 +1,140 @@
+package evm_test
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestChainSet_ApplyConfigProfile(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	kst := cltest.NewKeyStore(t, db, cfg)
+	ethClient := newValidationEthClient(t, rpcResponses{
+		chainID:   &cltest.FixtureChainID,
+		head:      cltest.Head(1000),
+		gasPrice:  big.NewInt(1),
+		finalized: cltest.Head(980),
+	})
+	chainSet := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, KeyStore: kst.Eth(), GeneralConfig: cfg, Client: ethClient})
+	orm := evm.NewORM(db)
+	ctx := context.Background()
+
+	_, err := chainSet.ConfigProfileDrift(&cltest.FixtureChainID)
+	require.True(t, errors.Is(err, sql.ErrNoRows), "expected no profile to be applied yet, got %v", err)
+
+	t.Run("expands the profile into the chain's overrides", func(t *testing.T) {
+		_, err := chainSet.Configure(&cltest.FixtureChainID, true, types.ChainCfg{EvmGasLimitMultiplier: null.FloatFrom(1.5)})
+		require.NoError(t, err)
+		profile, err := evm.GetConfigProfile("polygon-mainnet")
+		require.NoError(t, err)
+
+		_, report, err := chainSet.ApplyConfigProfile(ctx, &cltest.FixtureChainID, "polygon-mainnet", false)
+		require.NoError(t, err)
+		assert.False(t, report.HasErrors())
+
+		dbchain, err := orm.Chain(*utils.NewBig(&cltest.FixtureChainID))
+		require.NoError(t, err)
+		assert.Empty(t, evm.DiffConfig(profile.Config, dbchain.Cfg))
+		assert.Equal(t, null.IntFrom(200), dbchain.Cfg.EvmFinalityDepth)
+		assert.Equal(t, null.StringFrom("BlockHistory"), dbchain.Cfg.GasEstimatorMode)
+		// Overrides the profile doesn't set are kept
+		assert.Equal(t, null.FloatFrom(1.5), dbchain.Cfg.EvmGasLimitMultiplier)
+
+		chain, err := chainSet.Get(&cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(200), chain.Config().EvmFinalityDepth())
+
+		applied, err := orm.ChainConfigProfile(*utils.NewBig(&cltest.FixtureChainID))
+		require.NoError(t, err)
+		assert.Equal(t, "polygon-mainnet", applied.Name)
+		assert.Equal(t, profile.Version, applied.Version)
+
+		drift, err := chainSet.ConfigProfileDrift(&cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, "polygon-mainnet", drift.Profile)
+		assert.Equal(t, profile.Version, drift.LatestVersion)
+		assert.Empty(t, drift.Drifted)
+	})
+
+	t.Run("reports drift after a manual change", func(t *testing.T) {
+		dbchain, err := orm.Chain(*utils.NewBig(&cltest.FixtureChainID))
+		require.NoError(t, err)
+		config := dbchain.Cfg
+		config.EvmFinalityDepth = null.IntFrom(100)
+		config.EvmGasPriceDefault = nil
+		_, err = chainSet.Configure(&cltest.FixtureChainID, dbchain.Enabled, config)
+		require.NoError(t, err)
+
+		drift, err := chainSet.ConfigProfileDrift(&cltest.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, drift.Drifted, 2)
+		assert.Equal(t, "EvmFinalityDepth", drift.Drifted[0].Setting)
+		assert.Equal(t, null.IntFrom(200), drift.Drifted[0].Profile)
+		assert.Equal(t, null.IntFrom(100), drift.Drifted[0].Current)
+		assert.Equal(t, "EvmGasPriceDefault", drift.Drifted[1].Setting)
+		assert.Nil(t, drift.Drifted[1].Current)
+	})
+
+	t.Run("refuses a profile that fails validation unless forced", func(t *testing.T) {
+		// The head has no baseFeePerGas, but the profile enables EIP-1559
+		_, report, err := chainSet.ApplyConfigProfile(ctx, &cltest.FixtureChainID, "ethereum-mainnet", false)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, evm.ErrConfigValidationFailed))
+		assert.True(t, report.HasErrors())
+		applied, err := orm.ChainConfigProfile(*utils.NewBig(&cltest.FixtureChainID))
+		require.NoError(t, err)
+		assert.Equal(t, "polygon-mainnet", applied.Name)
+
+		chain, _, err := chainSet.ApplyConfigProfile(ctx, &cltest.FixtureChainID, "ethereum-mainnet", true)
+		require.NoError(t, err)
+		assert.Equal(t, null.BoolFrom(true), chain.Cfg.EvmEIP1559DynamicFees)
+		applied, err = orm.ChainConfigProfile(*utils.NewBig(&cltest.FixtureChainID))
+		require.NoError(t, err)
+		assert.Equal(t, "ethereum-mainnet", applied.Name)
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		_, _, err := chainSet.ApplyConfigProfile(ctx, &cltest.FixtureChainID, "no-such-chain", false)
+		require.EqualError(t, err, `no config profile named "no-such-chain"`)
+	})
+}
+
+func TestDiffConfig(t *testing.T) {
+	t.Parallel()
+
+	expected := types.ChainCfg{
+		EvmFinalityDepth:   null.IntFrom(10),
+		EvmGasPriceDefault: utils.NewBigI(5),
+		GasEstimatorMode:   null.StringFrom("FixedPrice"),
+	}
+	current := types.ChainCfg{
+		EvmFinalityDepth:   null.IntFrom(10),
+		EvmGasPriceDefault: utils.NewBigI(5),
+		GasEstimatorMode:   null.StringFrom("BlockHistory"),
+		// Not set by the profile, so not drift
+		EvmGasBumpPercent: null.IntFrom(50),
+	}
+
+	drifted := evm.DiffConfig(expected, current)
+
+	require.Len(t, drifted, 1)
+	assert.Equal(t, "GasEstimatorMode", drifted[0].Setting)
+	assert.Equal(t, null.StringFrom("FixedPrice"), drifted[0].Profile)
+	assert.Equal(t, null.StringFrom("BlockHistory"), drifted[0].Current)
+}
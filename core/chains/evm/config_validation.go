@@ -0,0 +1,141 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+)
+
+// ConfigValidationReport lists the problems found when checking a proposed
+// chain config against the live chain. Errors are expected to break
+// transaction broadcasting; warnings are likely misconfigurations but may be
+// intentional.
+type ConfigValidationReport struct {
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+func (r *ConfigValidationReport) addError(format string, args ...interface{}) {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, args...))
+}
+
+func (r *ConfigValidationReport) addWarning(format string, args ...interface{}) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+}
+
+// HasErrors returns true if any check failed with an error
+func (r ConfigValidationReport) HasErrors() bool {
+	return len(r.Errors) > 0
+}
+
+// Err combines all errors in the report into one, or returns nil if there are none
+func (r ConfigValidationReport) Err() error {
+	if !r.HasErrors() {
+		return nil
+	}
+	return errors.Errorf("config validation failed: %s", strings.Join(r.Errors, "; "))
+}
+
+// ValidateConfig applies the updaters to a copy of the chain's current
+// persisted config and checks the result against the live chain. Nothing is
+// persisted.
+func (cll *chainSet) ValidateConfig(ctx context.Context, id *big.Int, updaters ...ChainConfigUpdater) (ConfigValidationReport, error) {
+	c, err := cll.Get(id)
+	if err != nil {
+		return ConfigValidationReport{}, err
+	}
+	proposed := c.Config().PersistedConfig()
+	for _, updater := range updaters {
+		if err = updater(&proposed); err != nil {
+			return ConfigValidationReport{}, err
+		}
+	}
+	cfg := evmconfig.NewChainScopedConfig(c.ID(), proposed, nil, cll.logger.Named("ConfigValidator"), cll.opts.Config)
+	return ValidateConfigAgainstChain(ctx, cfg, c.Client()), nil
+}
+
+// MergeConfig overrides every field of the config that is set in delta
+func MergeConfig(delta types.ChainCfg) ChainConfigUpdater {
+	return func(config *types.ChainCfg) error {
+		cv := reflect.ValueOf(config).Elem()
+		dv := reflect.ValueOf(delta)
+		for i := 0; i < dv.NumField(); i++ {
+			if f := dv.Field(i); !f.IsZero() {
+				cv.Field(i).Set(f)
+			}
+		}
+		return nil
+	}
+}
+
+// ReplaceConfig replaces the config wholesale, as ChainSet.Configure does
+func ReplaceConfig(replacement types.ChainCfg) ChainConfigUpdater {
+	return func(config *types.ChainCfg) error {
+		*config = replacement
+		return nil
+	}
+}
+
+// ValidateConfigAgainstChain runs the static config validation and then
+// checks the config for consistency with what the connected RPC node reports:
+// - eth_chainId must match the configured chain ID
+// - baseFeePerGas must be present on the latest block if EIP-1559 is enabled
+// - eth_gasPrice must be within the configured gas price bounds
+// - the configured finality depth should cover the chain's finalized block, if the node supports the finalized tag
+func ValidateConfigAgainstChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, client evmclient.Client) (report ConfigValidationReport) {
+	if err := cfg.Validate(); err != nil {
+		for _, e := range multierr.Errors(err) {
+			report.addError("%v", e)
+		}
+	}
+
+	var chainID hexutil.Big
+	if err := client.CallContext(ctx, &chainID, "eth_chainId"); err != nil {
+		report.addError("failed to fetch eth_chainId: %v", err)
+	} else if chainID.ToInt().Cmp(cfg.ChainID()) != 0 {
+		report.addError("eth_chainId mismatch: node reports chain ID %s but chain is configured with ID %s", chainID.ToInt().String(), cfg.ChainID().String())
+	}
+
+	head, err := client.HeadByNumber(ctx, nil)
+	if err != nil {
+		report.addError("failed to fetch latest head: %v", err)
+	} else if cfg.EvmEIP1559DynamicFees() && head.BaseFeePerGas == nil {
+		report.addError("EVM_EIP1559_DYNAMIC_FEES is enabled but latest block %d has no baseFeePerGas; this chain does not appear to support EIP-1559", head.Number)
+	} else if !cfg.EvmEIP1559DynamicFees() && head.BaseFeePerGas != nil {
+		report.addWarning("latest block %d has baseFeePerGas of %s wei but EVM_EIP1559_DYNAMIC_FEES is disabled; consider enabling it", head.Number, head.BaseFeePerGas.String())
+	}
+
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		report.addWarning("failed to fetch eth_gasPrice, gas price defaults were not checked: %v", err)
+	} else {
+		if gasPrice.Cmp(cfg.EvmMaxGasPriceWei()) > 0 {
+			report.addError("node suggests gas price of %s wei which exceeds ETH_MAX_GAS_PRICE_WEI of %s wei; transactions will not be priced high enough to confirm", gasPrice.String(), cfg.EvmMaxGasPriceWei().String())
+		}
+		if gasPrice.Cmp(cfg.EvmGasPriceDefault()) > 0 {
+			report.addWarning("ETH_GAS_PRICE_DEFAULT of %s wei is below the node's suggested gas price of %s wei; transactions may need several bumps before they confirm", cfg.EvmGasPriceDefault().String(), gasPrice.String())
+		}
+	}
+
+	if head != nil {
+		var finalized *types.Head
+		// Not all nodes support the finalized tag, in which case there is nothing to check
+		if err := client.CallContext(ctx, &finalized, "eth_getBlockByNumber", "finalized", false); err == nil && finalized != nil {
+			if depth := head.Number - finalized.Number; int64(cfg.EvmFinalityDepth()) < depth {
+				report.addWarning("ETH_FINALITY_DEPTH of %d is shallower than the chain's current finalized depth of %d blocks; re-orgs deeper than ETH_FINALITY_DEPTH may not be handled correctly", cfg.EvmFinalityDepth(), depth)
+			}
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,202 @@
+package evm_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type rpcResponses struct {
+	chainID       *big.Int
+	head          *types.Head
+	gasPrice      *big.Int
+	finalized     *types.Head
+	finalizedErr  error
+	gasPriceErr   error
+	chainIDFailed bool
+}
+
+func newValidationEthClient(t *testing.T, r rpcResponses) *evmmocks.Client {
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	if r.chainIDFailed {
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_chainId").Return(errors.New("connection refused"))
+	} else {
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_chainId").Run(func(args mock.Arguments) {
+			id := args.Get(1).(*hexutil.Big)
+			*id = hexutil.Big(*r.chainID)
+		}).Return(nil)
+	}
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(r.head, nil)
+	ethClient.On("SuggestGasPrice", mock.Anything).Return(r.gasPrice, r.gasPriceErr)
+	ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_getBlockByNumber", "finalized", false).Run(func(args mock.Arguments) {
+		head := args.Get(1).(**types.Head)
+		*head = r.finalized
+	}).Return(r.finalizedErr).Maybe()
+	return ethClient
+}
+
+func TestValidateConfigAgainstChain(t *testing.T) {
+	t.Parallel()
+
+	gcfg := cltest.NewTestGeneralConfig(t)
+	gcfg.Overrides.GlobalEvmFinalityDepth = null.IntFrom(50)
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+
+	healthy := func() rpcResponses {
+		return rpcResponses{
+			chainID:   cfg.ChainID(),
+			head:      cltest.Head(100),
+			gasPrice:  big.NewInt(1),
+			finalized: cltest.Head(80),
+		}
+	}
+
+	t.Run("healthy chain produces an empty report", func(t *testing.T) {
+		ethClient := newValidationEthClient(t, healthy())
+
+		report := evm.ValidateConfigAgainstChain(context.Background(), cfg, ethClient)
+
+		assert.Empty(t, report.Errors)
+		assert.Empty(t, report.Warnings)
+		assert.NoError(t, report.Err())
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("eth_chainId mismatch is an error", func(t *testing.T) {
+		r := healthy()
+		r.chainID = big.NewInt(42)
+		ethClient := newValidationEthClient(t, r)
+
+		report := evm.ValidateConfigAgainstChain(context.Background(), cfg, ethClient)
+
+		require.Len(t, report.Errors, 1)
+		assert.Contains(t, report.Errors[0], "eth_chainId mismatch: node reports chain ID 42")
+		assert.True(t, report.HasErrors())
+	})
+
+	t.Run("failing to fetch eth_chainId is an error", func(t *testing.T) {
+		r := healthy()
+		r.chainIDFailed = true
+		ethClient := newValidationEthClient(t, r)
+
+		report := evm.ValidateConfigAgainstChain(context.Background(), cfg, ethClient)
+
+		require.Len(t, report.Errors, 1)
+		assert.Contains(t, report.Errors[0], "failed to fetch eth_chainId: connection refused")
+	})
+
+	t.Run("EIP-1559 enabled without baseFeePerGas is an error", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		gcfg.Overrides.GlobalEvmFinalityDepth = null.IntFrom(50)
+		gcfg.Overrides.GlobalEvmEIP1559DynamicFees = null.BoolFrom(true)
+		cfg := evmtest.NewChainScopedConfig(t, gcfg)
+		ethClient := newValidationEthClient(t, healthy())
+
+		report := evm.ValidateConfigAgainstChain(context.Background(), cfg, ethClient)
+
+		require.Len(t, report.Errors, 1)
+		assert.Contains(t, report.Errors[0], "EVM_EIP1559_DYNAMIC_FEES is enabled but latest block 100 has no baseFeePerGas")
+	})
+
+	t.Run("baseFeePerGas present with EIP-1559 disabled is a warning", func(t *testing.T) {
+		r := healthy()
+		r.head.BaseFeePerGas = utils.NewBigI(7)
+		ethClient := newValidationEthClient(t, r)
+
+		report := evm.ValidateConfigAgainstChain(context.Background(), cfg, ethClient)
+
+		assert.Empty(t, report.Errors)
+		require.Len(t, report.Warnings, 1)
+		assert.Contains(t, report.Warnings[0], "latest block 100 has baseFeePerGas of 7 wei but EVM_EIP1559_DYNAMIC_FEES is disabled")
+	})
+
+	t.Run("suggested gas price above the default is a warning", func(t *testing.T) {
+		r := healthy()
+		r.gasPrice = new(big.Int).Add(cfg.EvmGasPriceDefault(), big.NewInt(1))
+		ethClient := newValidationEthClient(t, r)
+
+		report := evm.ValidateConfigAgainstChain(context.Background(), cfg, ethClient)
+
+		assert.Empty(t, report.Errors)
+		require.Len(t, report.Warnings, 1)
+		assert.Contains(t, report.Warnings[0], "is below the node's suggested gas price")
+	})
+
+	t.Run("suggested gas price above the maximum is an error", func(t *testing.T) {
+		r := healthy()
+		r.gasPrice = new(big.Int).Add(cfg.EvmMaxGasPriceWei(), big.NewInt(1))
+		ethClient := newValidationEthClient(t, r)
+
+		report := evm.ValidateConfigAgainstChain(context.Background(), cfg, ethClient)
+
+		require.Len(t, report.Errors, 1)
+		assert.Contains(t, report.Errors[0], "exceeds ETH_MAX_GAS_PRICE_WEI")
+		require.Len(t, report.Warnings, 1)
+	})
+
+	t.Run("failing to fetch the gas price is a warning", func(t *testing.T) {
+		r := healthy()
+		r.gasPrice = nil
+		r.gasPriceErr = errors.New("method not found")
+		ethClient := newValidationEthClient(t, r)
+
+		report := evm.ValidateConfigAgainstChain(context.Background(), cfg, ethClient)
+
+		assert.Empty(t, report.Errors)
+		require.Len(t, report.Warnings, 1)
+		assert.Contains(t, report.Warnings[0], "failed to fetch eth_gasPrice")
+	})
+
+	t.Run("finalized block deeper than finality depth is a warning", func(t *testing.T) {
+		r := healthy()
+		r.finalized = cltest.Head(10)
+		ethClient := newValidationEthClient(t, r)
+
+		report := evm.ValidateConfigAgainstChain(context.Background(), cfg, ethClient)
+
+		assert.Empty(t, report.Errors)
+		require.Len(t, report.Warnings, 1)
+		assert.Contains(t, report.Warnings[0], "ETH_FINALITY_DEPTH of 50 is shallower than the chain's current finalized depth of 90 blocks")
+	})
+
+	t.Run("finalized tag not supported skips the finality check", func(t *testing.T) {
+		r := healthy()
+		r.finalized = nil
+		r.finalizedErr = errors.New("invalid block number")
+		ethClient := newValidationEthClient(t, r)
+
+		report := evm.ValidateConfigAgainstChain(context.Background(), cfg, ethClient)
+
+		assert.Empty(t, report.Errors)
+		assert.Empty(t, report.Warnings)
+	})
+}
+
+func TestMergeConfig(t *testing.T) {
+	t.Parallel()
+
+	config := types.ChainCfg{
+		EvmFinalityDepth:      null.IntFrom(10),
+		EvmGasLimitMultiplier: null.FloatFrom(1.5),
+	}
+
+	err := evm.MergeConfig(types.ChainCfg{EvmFinalityDepth: null.IntFrom(20)})(&config)
+
+	require.NoError(t, err)
+	assert.Equal(t, null.IntFrom(20), config.EvmFinalityDepth)
+	assert.Equal(t, null.FloatFrom(1.5), config.EvmGasLimitMultiplier)
+}
@@ -76,8 +76,12 @@ type (
 		ctxCancel           context.CancelFunc
 
 		gasPrice *big.Int
-		tipCap   *big.Int
-		mu       sync.RWMutex
+		// unclampedGasPrice is gasPrice before it was lowered to
+		// ETH_MAX_GAS_PRICE_WEI, see OptUnclamped
+		unclampedGasPrice *big.Int
+		tipCap            *big.Int
+		baseFee           *big.Int
+		mu                sync.RWMutex
 
 		logger logger.Logger
 	}
@@ -100,6 +104,8 @@ func NewBlockHistoryEstimator(lggr logger.Logger, ethClient evmclient.Client, co
 		cancel,
 		nil,
 		nil,
+		nil,
+		nil,
 		sync.RWMutex{},
 		lggr.Named("BlockHistoryEstimator"),
 	}
@@ -143,10 +149,14 @@ func (b *BlockHistoryEstimator) Close() error {
 	})
 }
 
-func (b *BlockHistoryEstimator) GetLegacyGas(_ []byte, gasLimit uint64, _ ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+func (b *BlockHistoryEstimator) GetLegacyGas(_ []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
 	ok := b.IfStarted(func() {
 		chainSpecificGasLimit = applyMultiplier(gasLimit, b.config.EvmGasLimitMultiplier())
-		gasPrice = b.getGasPrice()
+		if hasOpt(opts, OptUnclamped) {
+			gasPrice = b.getUnclampedGasPrice()
+		} else {
+			gasPrice = b.getGasPrice()
+		}
 	})
 	if !ok {
 		return nil, 0, errors.New("BlockHistoryEstimator is not started; cannot estimate gas")
@@ -162,11 +172,24 @@ func (b *BlockHistoryEstimator) getGasPrice() *big.Int {
 	defer b.mu.RUnlock()
 	return b.gasPrice
 }
+func (b *BlockHistoryEstimator) getUnclampedGasPrice() *big.Int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.unclampedGasPrice == nil {
+		return b.gasPrice
+	}
+	return b.unclampedGasPrice
+}
 func (b *BlockHistoryEstimator) getTipCap() *big.Int {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 	return b.tipCap
 }
+func (b *BlockHistoryEstimator) getBaseFee() *big.Int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.baseFee
+}
 
 func (b *BlockHistoryEstimator) BumpLegacyGas(originalGasPrice *big.Int, gasLimit uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
 	return BumpLegacyGasPriceOnly(b.config, b.logger, b.getGasPrice(), originalGasPrice, gasLimit)
@@ -193,7 +216,7 @@ func (b *BlockHistoryEstimator) GetDynamicFee(gasLimit uint64) (fee DynamicFee,
 }
 
 func (b *BlockHistoryEstimator) BumpDynamicFee(originalFee DynamicFee, originalGasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
-	return BumpDynamicFeeOnly(b.config, b.logger, b.getTipCap(), originalFee, originalGasLimit)
+	return BumpDynamicFeeOnly(b.config, b.logger, b.getTipCap(), b.getBaseFee(), originalFee, originalGasLimit)
 }
 
 func (b *BlockHistoryEstimator) runLoop() {
@@ -230,6 +253,10 @@ func (b *BlockHistoryEstimator) FetchBlocksAndRecalculate(ctx context.Context, h
 func (b *BlockHistoryEstimator) Recalculate(head *evmtypes.Head) {
 	enableEIP1559 := b.config.EvmEIP1559DynamicFees()
 
+	if enableEIP1559 && head.BaseFeePerGas != nil {
+		b.setBaseFee(head.BaseFeePerGas.ToInt())
+	}
+
 	percentile := int(b.config.BlockHistoryEstimatorTransactionPercentile())
 
 	if len(b.rollingBlockHistory) == 0 {
@@ -374,7 +401,9 @@ func (b *BlockHistoryEstimator) FetchBlocks(ctx context.Context, head *evmtypes.
 		start = 0
 	}
 
+	b.mu.Lock()
 	b.rollingBlockHistory = newBlockHistory[start:]
+	b.mu.Unlock()
 
 	return nil
 }
@@ -479,6 +508,12 @@ func (b *BlockHistoryEstimator) setPercentileTipCap(tipCap *big.Int) {
 	}
 }
 
+func (b *BlockHistoryEstimator) setBaseFee(baseFee *big.Int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.baseFee = baseFee
+}
+
 func (b *BlockHistoryEstimator) setPercentileGasPrice(gasPrice *big.Int) {
 	max := b.config.EvmMaxGasPriceWei()
 	min := b.config.EvmMinGasPriceWei()
@@ -488,11 +523,14 @@ func (b *BlockHistoryEstimator) setPercentileGasPrice(gasPrice *big.Int) {
 	if gasPrice.Cmp(max) > 0 {
 		b.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei exceeds ETH_MAX_GAS_PRICE_WEI=%[2]s, setting gas price to the maximum allowed value of %[2]s Wei instead", gasPrice.String(), max.String()), "gasPriceWei", gasPrice, "maxGasPriceWei", max)
 		b.gasPrice = max
+		b.unclampedGasPrice = gasPrice
 	} else if gasPrice.Cmp(min) < 0 {
 		b.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei falls below ETH_MIN_GAS_PRICE_WEI=%[2]s, setting gas price to the minimum allowed value of %[2]s Wei instead", gasPrice.String(), min.String()), "gasPriceWei", gasPrice, "minGasPriceWei", min)
 		b.gasPrice = min
+		b.unclampedGasPrice = nil
 	} else {
 		b.gasPrice = gasPrice
+		b.unclampedGasPrice = nil
 	}
 }
 
@@ -500,6 +538,14 @@ func (b *BlockHistoryEstimator) RollingBlockHistory() []Block {
 	return b.rollingBlockHistory
 }
 
+// BlockCount returns the number of blocks currently held in the rolling
+// block history
+func (b *BlockHistoryEstimator) BlockCount() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.rollingBlockHistory)
+}
+
 // isUsable returns true if the tx is usable both generally and specifically for
 // this Config.
 func (tx *Transaction) isUsable(cfg Config, lggr logger.Logger) bool {
@@ -625,6 +625,8 @@ func TestBlockHistoryEstimator_Recalculate_NoEIP1559(t *testing.T) {
 
 		price := gas.GetGasPrice(bhe)
 		require.Equal(t, maxGasPrice, price)
+		// The original calculation is kept for OptUnclamped
+		assert.Equal(t, big.NewInt(9001), gas.GetUnclampedGasPrice(bhe))
 
 		ethClient.AssertExpectations(t)
 		config.AssertExpectations(t)
@@ -1415,6 +1417,7 @@ func TestBlockHistoryEstimator_Bumps(t *testing.T) {
 		bhe := newBlockHistoryEstimator(t, nil, config)
 
 		config.On("EvmGasBumpPercent").Return(uint16(10))
+		config.On("EvmGasBumpStrategy").Return("max")
 		config.On("EvmGasBumpWei").Return(big.NewInt(150))
 		config.On("EvmMaxGasPriceWei").Return(big.NewInt(1000000))
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
@@ -1472,6 +1475,7 @@ func TestBlockHistoryEstimator_Bumps(t *testing.T) {
 		bhe := newBlockHistoryEstimator(t, nil, config)
 
 		config.On("EvmGasBumpPercent").Return(uint16(10))
+		config.On("EvmGasBumpStrategy").Return("max")
 		config.On("EvmGasBumpWei").Return(big.NewInt(150))
 		config.On("EvmMaxGasPriceWei").Return(big.NewInt(1000000))
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
@@ -1515,6 +1519,18 @@ func TestBlockHistoryEstimator_Bumps(t *testing.T) {
 			assert.Equal(t, 110000, int(gasLimit))
 			assert.Equal(t, gas.DynamicFee{FeeCap: big.NewInt(1000000), TipCap: big.NewInt(202)}, fee)
 		})
+		t.Run("recalculates fee cap from current base fee", func(t *testing.T) {
+			gas.SetTipCap(bhe, nil)
+			gas.SetBaseFee(bhe, big.NewInt(1000))
+
+			originalFee := gas.DynamicFee{FeeCap: big.NewInt(100), TipCap: big.NewInt(25)}
+			fee, gasLimit, err := bhe.BumpDynamicFee(originalFee, 100000)
+			require.NoError(t, err)
+
+			assert.Equal(t, 110000, int(gasLimit))
+			// 2 * base fee + bumped tip cap
+			assert.Equal(t, gas.DynamicFee{FeeCap: big.NewInt(2202), TipCap: big.NewInt(202)}, fee)
+		})
 
 		config.AssertExpectations(t)
 	})
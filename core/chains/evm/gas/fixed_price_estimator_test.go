@@ -37,6 +37,7 @@ func Test_FixedPriceEstimator(t *testing.T) {
 
 		config.On("EvmGasPriceDefault").Return(big.NewInt(42))
 		config.On("EvmGasBumpPercent").Return(uint16(10))
+		config.On("EvmGasBumpStrategy").Return("max")
 		config.On("EvmGasBumpWei").Return(big.NewInt(150))
 		config.On("EvmMaxGasPriceWei").Return(big.NewInt(1000000))
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
@@ -78,6 +79,7 @@ func Test_FixedPriceEstimator(t *testing.T) {
 		f := gas.NewFixedPriceEstimator(config, lggr)
 
 		config.On("EvmGasBumpPercent").Return(uint16(10))
+		config.On("EvmGasBumpStrategy").Return("max")
 		config.On("EvmGasBumpWei").Return(big.NewInt(150))
 		config.On("EvmMaxGasPriceWei").Return(big.NewInt(1000000))
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
@@ -87,7 +89,7 @@ func Test_FixedPriceEstimator(t *testing.T) {
 		fee, gasLimit, err := f.BumpDynamicFee(originalFee, 100000)
 		require.NoError(t, err)
 
-		expectedFee, expectedGasLimit, err := gas.BumpDynamicFeeOnly(config, lggr, nil, originalFee, 100000)
+		expectedFee, expectedGasLimit, err := gas.BumpDynamicFeeOnly(config, lggr, nil, nil, originalFee, 100000)
 		require.NoError(t, err)
 
 		assert.Equal(t, expectedGasLimit, gasLimit)
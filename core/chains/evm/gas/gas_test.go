@@ -94,6 +94,7 @@ func Test_BumpLegacyGasPriceOnly(t *testing.T) {
 			cfg := new(gasmocks.Config)
 			cfg.Test(t)
 			cfg.On("EvmGasBumpPercent").Return(test.bumpPercent)
+			cfg.On("EvmGasBumpStrategy").Return("max")
 			cfg.On("EvmGasBumpWei").Return(test.bumpWei)
 			cfg.On("EvmMaxGasPriceWei").Return(test.maxGasPriceWei)
 			cfg.On("EvmGasLimitMultiplier").Return(test.limitMultiplierPercent)
@@ -112,6 +113,7 @@ func Test_BumpLegacyGasPriceOnly_HitsMaxError(t *testing.T) {
 	t.Parallel()
 	cfg := new(gasmocks.Config)
 	cfg.On("EvmGasBumpPercent").Return(uint16(50))
+	cfg.On("EvmGasBumpStrategy").Return("max")
 	cfg.On("EvmGasPriceDefault").Return(assets.GWei(20))
 	cfg.On("EvmGasBumpWei").Return(assets.Wei(5000000000))
 	cfg.On("EvmMaxGasPriceWei").Return(assets.GWei(40))
@@ -127,6 +129,7 @@ func Test_BumpLegacyGasPriceOnly_NoBumpError(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	cfg := new(gasmocks.Config)
 	cfg.On("EvmGasBumpPercent").Return(uint16(0))
+	cfg.On("EvmGasBumpStrategy").Return("max")
 	cfg.On("EvmGasBumpWei").Return(big.NewInt(0))
 	cfg.On("EvmMaxGasPriceWei").Return(assets.GWei(40))
 	cfg.On("EvmGasPriceDefault").Return(assets.GWei(20))
@@ -143,6 +146,40 @@ func Test_BumpLegacyGasPriceOnly_NoBumpError(t *testing.T) {
 	require.Contains(t, err.Error(), "bumped gas price of 40000000000 is equal to original gas price of 40000000000. ACTION REQUIRED: This is a configuration error, you must increase either ETH_GAS_BUMP_PERCENT or ETH_GAS_BUMP_WEI")
 }
 
+func Test_BumpLegacyGasPriceOnly_Strategies(t *testing.T) {
+	t.Parallel()
+
+	originalGasPrice := assets.GWei(30)
+
+	for _, test := range []struct {
+		strategy         string
+		bumpWei          *big.Int
+		expectedGasPrice *big.Int
+	}{
+		{"max", assets.GWei(2), assets.GWei(36)},
+		{"max", assets.GWei(10), assets.GWei(40)},
+		{"linear", assets.GWei(2), assets.GWei(32)},
+		{"linear", assets.GWei(10), assets.GWei(40)},
+		{"geometric", assets.GWei(2), assets.GWei(36)},
+		{"geometric", assets.GWei(10), assets.GWei(36)},
+	} {
+		test := test
+		t.Run(fmt.Sprintf("%s with bump wei %s", test.strategy, test.bumpWei), func(t *testing.T) {
+			cfg := new(gasmocks.Config)
+			cfg.Test(t)
+			cfg.On("EvmGasBumpPercent").Return(uint16(20))
+			cfg.On("EvmGasBumpStrategy").Return(test.strategy)
+			cfg.On("EvmGasBumpWei").Return(test.bumpWei)
+			cfg.On("EvmMaxGasPriceWei").Return(assets.GWei(1000))
+			cfg.On("EvmGasLimitMultiplier").Return(float32(1))
+
+			gasPrice, _, err := gas.BumpLegacyGasPriceOnly(cfg, logger.TestLogger(t), nil, originalGasPrice, 42)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedGasPrice.String(), gasPrice.String())
+		})
+	}
+}
+
 func Test_BumpDynamicFeeOnly(t *testing.T) {
 	t.Parallel()
 
@@ -268,11 +305,12 @@ func Test_BumpDynamicFeeOnly(t *testing.T) {
 			cfg := new(gasmocks.Config)
 			cfg.Test(t)
 			cfg.On("EvmGasBumpPercent").Return(test.bumpPercent)
+			cfg.On("EvmGasBumpStrategy").Return("max")
 			cfg.On("EvmGasTipCapDefault").Return(test.tipCapDefault)
 			cfg.On("EvmGasBumpWei").Return(test.bumpWei)
 			cfg.On("EvmMaxGasPriceWei").Return(test.maxGasPriceWei)
 			cfg.On("EvmGasLimitMultiplier").Return(test.limitMultiplierPercent)
-			actual, limit, err := gas.BumpDynamicFeeOnly(cfg, logger.TestLogger(t), test.currentTipCap, test.originalFee, test.originalLimit)
+			actual, limit, err := gas.BumpDynamicFeeOnly(cfg, logger.TestLogger(t), test.currentTipCap, nil, test.originalFee, test.originalLimit)
 			require.NoError(t, err)
 			if actual.TipCap.Cmp(test.expectedFee.TipCap) != 0 {
 				t.Fatalf("TipCap not equal, expected %s but got %s", test.expectedFee.TipCap.String(), actual.TipCap.String())
@@ -291,16 +329,119 @@ func Test_BumpDynamicFeeOnly_HitsMaxError(t *testing.T) {
 	cfg := new(gasmocks.Config)
 	cfg.Test(t)
 	cfg.On("EvmGasBumpPercent").Return(uint16(50))
+	cfg.On("EvmGasBumpStrategy").Return("max")
 	cfg.On("EvmGasTipCapDefault").Return(assets.GWei(0))
 	cfg.On("EvmGasBumpWei").Return(assets.Wei(5000000000))
 	cfg.On("EvmMaxGasPriceWei").Return(assets.GWei(40))
 
 	originalFee := gas.DynamicFee{TipCap: assets.GWei(30), FeeCap: assets.GWei(100)}
-	_, _, err := gas.BumpDynamicFeeOnly(cfg, logger.TestLogger(t), nil, originalFee, 42)
+	_, _, err := gas.BumpDynamicFeeOnly(cfg, logger.TestLogger(t), nil, nil, originalFee, 42)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "bumped tip cap of 45000000000 would exceed configured max gas price of 40000000000 (original fee: tip cap 30000000000, fee cap 100000000000)")
 }
 
+func Test_BumpDynamicFeeOnly_Strategies(t *testing.T) {
+	t.Parallel()
+
+	originalFee := gas.DynamicFee{TipCap: assets.GWei(10), FeeCap: assets.GWei(100)}
+	currentBaseFee := assets.GWei(10)
+
+	for _, test := range []struct {
+		strategy       string
+		expectedTipCap *big.Int
+		expectedFeeCap *big.Int
+	}{
+		// Tip cap and fee cap both take the larger of 20% and 5 gwei
+		{"max", assets.GWei(15), assets.GWei(120)},
+		// Tip cap and fee cap both add 5 gwei
+		{"linear", assets.GWei(15), assets.GWei(105)},
+		// Tip cap and fee cap both add 20%
+		{"geometric", assets.GWei(12), assets.GWei(120)},
+	} {
+		test := test
+		t.Run(test.strategy, func(t *testing.T) {
+			cfg := new(gasmocks.Config)
+			cfg.Test(t)
+			cfg.On("EvmGasBumpPercent").Return(uint16(20))
+			cfg.On("EvmGasBumpStrategy").Return(test.strategy)
+			cfg.On("EvmGasTipCapDefault").Return(assets.GWei(1))
+			cfg.On("EvmGasBumpWei").Return(assets.GWei(5))
+			cfg.On("EvmMaxGasPriceWei").Return(assets.GWei(1000))
+			cfg.On("EvmGasLimitMultiplier").Return(float32(1))
+
+			bumped, _, err := gas.BumpDynamicFeeOnly(cfg, logger.TestLogger(t), nil, currentBaseFee, originalFee, 42)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedTipCap.String(), bumped.TipCap.String())
+			assert.Equal(t, test.expectedFeeCap.String(), bumped.FeeCap.String())
+		})
+	}
+}
+
+func Test_BumpDynamicFeeOnly_RecalculatesFeeCapFromBaseFee(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(t *testing.T, maxGasPriceWei *big.Int) *gasmocks.Config {
+		cfg := new(gasmocks.Config)
+		cfg.Test(t)
+		cfg.On("EvmGasBumpPercent").Return(uint16(10))
+		cfg.On("EvmGasBumpStrategy").Return("max")
+		cfg.On("EvmGasTipCapDefault").Return(assets.GWei(1))
+		cfg.On("EvmGasBumpWei").Return(assets.GWei(1))
+		cfg.On("EvmMaxGasPriceWei").Return(maxGasPriceWei)
+		cfg.On("EvmGasLimitMultiplier").Return(float32(1))
+		return cfg
+	}
+
+	// Each test bumps the original fee once per base fee, feeding each bumped
+	// fee into the next bump as the confirmer would
+	for _, test := range []struct {
+		name            string
+		baseFees        []*big.Int
+		expectedFeeCaps []*big.Int
+	}{
+		{
+			name:            "rising base fee uses projected base fee",
+			baseFees:        []*big.Int{assets.GWei(50), assets.GWei(80), assets.GWei(120)},
+			expectedFeeCaps: []*big.Int{assets.GWei(110), assets.GWei(164), assets.GWei(245)},
+		},
+		{
+			name:            "falling base fee uses minimum replacement fee cap",
+			baseFees:        []*big.Int{assets.GWei(40), assets.GWei(20), assets.GWei(10)},
+			expectedFeeCaps: []*big.Int{assets.GWei(110), assets.GWei(121), toBigInt("1.331e11")},
+		},
+		{
+			name:            "flat base fee switches to minimum replacement fee cap once it overtakes",
+			baseFees:        []*big.Int{assets.GWei(60), assets.GWei(60), assets.GWei(60)},
+			expectedFeeCaps: []*big.Int{assets.GWei(123), toBigInt("1.353e11"), toBigInt("1.4883e11")},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newConfig(t, assets.GWei(1000))
+			fee := gas.DynamicFee{TipCap: assets.GWei(2), FeeCap: assets.GWei(100)}
+			expectedTipCaps := []*big.Int{assets.GWei(3), assets.GWei(4), assets.GWei(5)}
+
+			for i, baseFee := range test.baseFees {
+				bumped, _, err := gas.BumpDynamicFeeOnly(cfg, logger.TestLogger(t), nil, baseFee, fee, 100000)
+				require.NoError(t, err)
+				assert.Equal(t, expectedTipCaps[i].String(), bumped.TipCap.String(), "tip cap at bump %d", i)
+				assert.Equal(t, test.expectedFeeCaps[i].String(), bumped.FeeCap.String(), "fee cap at bump %d", i)
+				fee = bumped
+			}
+		})
+	}
+
+	t.Run("fee cap never exceeds max gas price", func(t *testing.T) {
+		cfg := newConfig(t, assets.GWei(500))
+		fee := gas.DynamicFee{TipCap: assets.GWei(2), FeeCap: assets.GWei(100)}
+
+		bumped, _, err := gas.BumpDynamicFeeOnly(cfg, logger.TestLogger(t), nil, assets.GWei(1000), fee, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(500).String(), bumped.FeeCap.String())
+		assert.Equal(t, assets.GWei(3).String(), bumped.TipCap.String())
+	})
+}
+
 // toBigInt is used to convert scientific notation string to a *big.Int
 func toBigInt(input string) *big.Int {
 	flt, _, err := big.ParseFloat(input, 10, 0, big.ToNearestEven)
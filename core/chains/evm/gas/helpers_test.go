@@ -22,12 +22,24 @@ func SetTipCap(b *BlockHistoryEstimator, gp *big.Int) {
 	b.tipCap = gp
 }
 
+func SetBaseFee(b *BlockHistoryEstimator, baseFee *big.Int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.baseFee = baseFee
+}
+
 func GetGasPrice(b *BlockHistoryEstimator) *big.Int {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 	return b.gasPrice
 }
 
+func GetUnclampedGasPrice(b *BlockHistoryEstimator) *big.Int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.unclampedGasPrice
+}
+
 func GetTipCap(b *BlockHistoryEstimator) *big.Int {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
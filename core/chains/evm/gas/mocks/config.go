@@ -127,6 +127,20 @@ func (_m *Config) EvmGasBumpPercent() uint16 {
 	return r0
 }
 
+// EvmGasBumpStrategy provides a mock function with given fields:
+func (_m *Config) EvmGasBumpStrategy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmGasBumpWei provides a mock function with given fields:
 func (_m *Config) EvmGasBumpWei() *big.Int {
 	ret := _m.Called()
@@ -266,3 +280,31 @@ func (_m *Config) GasEstimatorMode() string {
 
 	return r0
 }
+
+// GasEstimatorOwnConfirmationsBlockWindow provides a mock function with given fields:
+func (_m *Config) GasEstimatorOwnConfirmationsBlockWindow() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// GasEstimatorOwnConfirmationsMinBlocks provides a mock function with given fields:
+func (_m *Config) GasEstimatorOwnConfirmationsMinBlocks() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
@@ -29,6 +29,14 @@ func IsBumpErr(err error) bool {
 }
 
 func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, config Config) Estimator {
+	e := newPrimaryEstimator(lggr, ethClient, config)
+	if config.GasEstimatorOwnConfirmationsBlockWindow() > 0 {
+		return NewOwnConfirmationsEstimator(lggr, e, config)
+	}
+	return e
+}
+
+func newPrimaryEstimator(lggr logger.Logger, ethClient evmclient.Client, config Config) Estimator {
 	s := config.GasEstimatorMode()
 	switch s {
 	case "BlockHistory":
@@ -69,8 +77,21 @@ type Opt int
 const (
 	// OptForceRefetch forces the estimator to bust a cache if necessary
 	OptForceRefetch Opt = iota
+	// OptUnclamped returns the estimate even if it exceeds
+	// ETH_MAX_GAS_PRICE_WEI, for callers that enforce the max gas price
+	// themselves. Estimators that never clamp ignore it.
+	OptUnclamped
 )
 
+func hasOpt(opts []Opt, opt Opt) bool {
+	for _, o := range opts {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
 func applyMultiplier(gasLimit uint64, multiplier float32) uint64 {
 	return uint64(decimal.NewFromBigInt(big.NewInt(0).SetUint64(gasLimit), 0).Mul(decimal.NewFromFloat32(multiplier)).IntPart())
 }
@@ -86,6 +107,7 @@ type Config interface {
 	EvmEIP1559DynamicFees() bool
 	EvmFinalityDepth() uint32
 	EvmGasBumpPercent() uint16
+	EvmGasBumpStrategy() string
 	EvmGasBumpWei() *big.Int
 	EvmGasFeeCap() *big.Int
 	EvmGasLimitMultiplier() float32
@@ -95,6 +117,8 @@ type Config interface {
 	EvmMaxGasPriceWei() *big.Int
 	EvmMinGasPriceWei() *big.Int
 	GasEstimatorMode() string
+	GasEstimatorOwnConfirmationsBlockWindow() uint16
+	GasEstimatorOwnConfirmationsMinBlocks() uint16
 }
 
 // Int64ToHex converts an int64 into go-ethereum's hex representation
@@ -249,21 +273,13 @@ func BumpLegacyGasPriceOnly(config Config, lggr logger.Logger, currentGasPrice,
 	return
 }
 
-// bumpGasPrice computes the next gas price to attempt as the largest of:
-// - A configured percentage bump (ETH_GAS_BUMP_PERCENT) on top of the baseline price.
-// - A configured fixed amount of Wei (ETH_GAS_PRICE_WEI) on top of the baseline price.
-// The baseline price is the maximum of the previous gas price attempt and the node's current gas price.
+// bumpGasPrice computes the next gas price to attempt by applying the
+// configured bump strategy (see bumpByStrategy) to the previous gas price. If
+// the node's current gas price is higher, that is used instead.
 func bumpGasPrice(config Config, lggr logger.Logger, currentGasPrice, originalGasPrice *big.Int) (*big.Int, error) {
 	maxGasPrice := config.EvmMaxGasPriceWei()
 
-	var priceByPercentage = new(big.Int)
-	priceByPercentage.Mul(originalGasPrice, big.NewInt(int64(100+config.EvmGasBumpPercent())))
-	priceByPercentage.Div(priceByPercentage, big.NewInt(100))
-
-	var priceByIncrement = new(big.Int)
-	priceByIncrement.Add(originalGasPrice, config.EvmGasBumpWei())
-
-	bumpedGasPrice := max(priceByPercentage, priceByIncrement)
+	bumpedGasPrice := bumpByStrategy(config, originalGasPrice)
 	if currentGasPrice != nil {
 		if currentGasPrice.Cmp(maxGasPrice) > 0 {
 			lggr.Errorf("invariant violation: ignoring current gas price of %s that would exceed max gas price of %s", currentGasPrice.String(), maxGasPrice.String())
@@ -286,6 +302,32 @@ func bumpGasPrice(config Config, lggr logger.Logger, currentGasPrice, originalGa
 	return bumpedGasPrice, nil
 }
 
+// bumpByStrategy bumps the given price according to ETH_GAS_BUMP_STRATEGY:
+// - max: the larger of a percentage bump (ETH_GAS_BUMP_PERCENT) and a fixed bump (ETH_GAS_BUMP_WEI)
+// - linear: a fixed bump of ETH_GAS_BUMP_WEI
+// - geometric: a percentage bump of ETH_GAS_BUMP_PERCENT
+func bumpByStrategy(config Config, price *big.Int) *big.Int {
+	byPercentage := new(big.Int).Mul(price, big.NewInt(int64(100+config.EvmGasBumpPercent())))
+	byPercentage.Div(byPercentage, big.NewInt(100))
+	byIncrement := new(big.Int).Add(price, config.EvmGasBumpWei())
+
+	switch config.EvmGasBumpStrategy() {
+	case "linear":
+		return byIncrement
+	case "geometric":
+		return byPercentage
+	default:
+		return max(byPercentage, byIncrement)
+	}
+}
+
+// MinReplacementPrice returns the smallest gas price, fee cap or tip cap that
+// is accepted to replace a transaction sent at price, by the same strategy
+// used for gas bumping
+func MinReplacementPrice(config Config, price *big.Int) *big.Int {
+	return bumpByStrategy(config, price)
+}
+
 func max(a, b *big.Int) *big.Int {
 	if a.Cmp(b) >= 0 {
 		return a
@@ -293,9 +335,10 @@ func max(a, b *big.Int) *big.Int {
 	return b
 }
 
-// BumpDynamicFeeOnly bumps the tip cap and max gas price if necessary
-func BumpDynamicFeeOnly(config Config, lggr logger.Logger, currentTipCap *big.Int, originalFee DynamicFee, originalGasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
-	bumped, err = bumpDynamicFee(config, lggr, currentTipCap, originalFee)
+// BumpDynamicFeeOnly bumps the tip cap and max gas price if necessary.
+// currentBaseFee is the base fee of the latest head, or nil if unknown.
+func BumpDynamicFeeOnly(config Config, lggr logger.Logger, currentTipCap, currentBaseFee *big.Int, originalFee DynamicFee, originalGasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	bumped, err = bumpDynamicFee(config, lggr, currentTipCap, currentBaseFee, originalFee)
 	if err != nil {
 		return bumped, 0, err
 	}
@@ -303,23 +346,22 @@ func BumpDynamicFeeOnly(config Config, lggr logger.Logger, currentTipCap *big.In
 	return
 }
 
-// bumpDynamicFee computes the next tip cap to attempt as the largest of:
-// - A configured percentage bump (ETH_GAS_BUMP_PERCENT) on top of the baseline tip cap.
-// - A configured fixed amount of Wei (ETH_GAS_PRICE_WEI) on top of the baseline tip cap.
-// The baseline tip cap is the maximum of the previous tip cap attempt and the node's current tip cap.
-// It increases the max fee cap if it changed
-func bumpDynamicFee(config Config, lggr logger.Logger, currentTipCap *big.Int, originalFee DynamicFee) (bumpedFee DynamicFee, err error) {
+// bumpDynamicFee computes the next tip cap to attempt by applying the
+// configured bump strategy (see bumpByStrategy) to the baseline tip cap. The
+// baseline tip cap is the maximum of the previous tip cap attempt and the
+// default tip cap. If the node's current tip cap is higher, that is used
+// instead.
+//
+// The fee cap is recalculated on every bump as the larger of:
+// - The minimum fee cap the node will accept as a replacement, i.e. the original fee cap bumped in the same way as the tip cap.
+// - The projected base fee (see projectBaseFee) plus the bumped tip cap.
+// If the current base fee is unknown, the max gas price is used in place of the projection.
+// The fee cap never exceeds the larger of the max gas price and the original fee cap.
+func bumpDynamicFee(config Config, lggr logger.Logger, currentTipCap, currentBaseFee *big.Int, originalFee DynamicFee) (bumpedFee DynamicFee, err error) {
 	maxGasPrice := config.EvmMaxGasPriceWei()
 	baselineTipCap := max(originalFee.TipCap, config.EvmGasTipCapDefault())
 
-	var tipCapByPercentage = new(big.Int)
-	tipCapByPercentage.Mul(baselineTipCap, big.NewInt(int64(100+config.EvmGasBumpPercent())))
-	tipCapByPercentage.Div(tipCapByPercentage, big.NewInt(100))
-
-	var tipCapByIncrement = new(big.Int)
-	tipCapByIncrement.Add(baselineTipCap, config.EvmGasBumpWei())
-
-	bumpedTipCap := max(tipCapByPercentage, tipCapByIncrement)
+	bumpedTipCap := bumpByStrategy(config, baselineTipCap)
 	if currentTipCap != nil {
 		if currentTipCap.Cmp(maxGasPrice) > 0 {
 			lggr.Errorf("invariant violation: ignoring current tip cap of %s that would exceed max gas price of %s", currentTipCap.String(), maxGasPrice.String())
@@ -340,7 +382,46 @@ func bumpDynamicFee(config Config, lggr logger.Logger, currentTipCap *big.Int, o
 			"ETH_GAS_BUMP_PERCENT or ETH_GAS_BUMP_WEI", bumpedTipCap.String(), originalFee.TipCap.String())
 	}
 
-	bumpedFeeCap := max(originalFee.FeeCap, maxGasPrice)
+	ruleFeeCap := bumpFeeCapForReplacement(config, originalFee.FeeCap)
+	var marketFeeCap *big.Int
+	if currentBaseFee != nil {
+		marketFeeCap = new(big.Int).Add(projectBaseFee(currentBaseFee), bumpedTipCap)
+	} else {
+		marketFeeCap = maxGasPrice
+	}
+
+	bumpedFeeCap := max(max(ruleFeeCap, marketFeeCap), bumpedTipCap)
+	if ceiling := max(originalFee.FeeCap, maxGasPrice); bumpedFeeCap.Cmp(ceiling) > 0 {
+		if ruleFeeCap.Cmp(ceiling) > 0 {
+			lggr.Warnw("Bumped fee cap is capped by max gas price and is below the minimum replacement fee cap; the node may reject the replacement transaction",
+				"ruleFeeCap", ruleFeeCap, "maxGasPriceWei", maxGasPrice)
+		}
+		bumpedFeeCap = ceiling
+	}
+
+	lggr.Debugw("Bumped dynamic fee",
+		"originalFeeCap", originalFee.FeeCap,
+		"originalTipCap", originalFee.TipCap,
+		"currentBaseFee", currentBaseFee,
+		"ruleFeeCap", ruleFeeCap,
+		"marketFeeCap", marketFeeCap,
+		"bumpedFeeCap", bumpedFeeCap,
+		"bumpedTipCap", bumpedTipCap,
+	)
 
 	return DynamicFee{FeeCap: bumpedFeeCap, TipCap: bumpedTipCap}, nil
 }
+
+// bumpFeeCapForReplacement returns the smallest fee cap the node will accept
+// for a replacement transaction, using the same bump strategy as the tip cap
+func bumpFeeCapForReplacement(config Config, originalFeeCap *big.Int) *big.Int {
+	return bumpByStrategy(config, originalFeeCap)
+}
+
+// projectBaseFee returns an upper bound for the base fee a few blocks from
+// now. The base fee can rise by at most 12.5% per block, so doubling the
+// current base fee keeps the transaction includable through six consecutive
+// full blocks.
+func projectBaseFee(currentBaseFee *big.Int) *big.Int {
+	return new(big.Int).Mul(currentBaseFee, big.NewInt(2))
+}
@@ -0,0 +1,152 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// ConfirmationRecorder is implemented by estimators that want to be told
+// about the gas prices paid by our own transactions once they are confirmed
+type ConfirmationRecorder interface {
+	RecordConfirmation(blockNum int64, gasPrice *big.Int)
+}
+
+// blockCounter is implemented by estimators that keep a history of blocks,
+// allowing us to tell whether they have enough data to be trusted
+type blockCounter interface {
+	BlockCount() int
+}
+
+type ownConfirmation struct {
+	blockNum int64
+	gasPrice *big.Int
+}
+
+var (
+	_ Estimator            = &ownConfirmationsEstimator{}
+	_ ConfirmationRecorder = &ownConfirmationsEstimator{}
+)
+
+// ownConfirmationsEstimator wraps another estimator and, while that estimator
+// has insufficient data, uses the minimum gas price paid by our own
+// transactions confirmed in the last GAS_ESTIMATOR_OWN_CONFIRMATIONS_BLOCK_WINDOW
+// blocks as a floor for its estimates
+type ownConfirmationsEstimator struct {
+	Estimator
+	config Config
+	lggr   logger.Logger
+
+	mu             sync.RWMutex
+	confirmations  []ownConfirmation
+	latestBlockNum int64
+}
+
+// NewOwnConfirmationsEstimator returns an Estimator that applies a gas price
+// floor derived from our own recent confirmations on top of primary
+func NewOwnConfirmationsEstimator(lggr logger.Logger, primary Estimator, config Config) Estimator {
+	return &ownConfirmationsEstimator{
+		Estimator: primary,
+		config:    config,
+		lggr:      lggr.Named("OwnConfirmationsEstimator"),
+	}
+}
+
+func (o *ownConfirmationsEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	o.Estimator.OnNewLongestChain(ctx, head)
+	if head == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if head.Number > o.latestBlockNum {
+		o.latestBlockNum = head.Number
+	}
+	o.prune()
+}
+
+// RecordConfirmation records the gas price paid by one of our own
+// transactions that was confirmed in blockNum
+func (o *ownConfirmationsEstimator) RecordConfirmation(blockNum int64, gasPrice *big.Int) {
+	if gasPrice == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.confirmations = append(o.confirmations, ownConfirmation{blockNum, new(big.Int).Set(gasPrice)})
+	if blockNum > o.latestBlockNum {
+		o.latestBlockNum = blockNum
+	}
+	o.prune()
+}
+
+// prune drops confirmations that have fallen outside of the block window
+// NOTE: Must be called with the lock held
+func (o *ownConfirmationsEstimator) prune() {
+	earliest := o.latestBlockNum - int64(o.config.GasEstimatorOwnConfirmationsBlockWindow()) + 1
+	kept := o.confirmations[:0]
+	for _, c := range o.confirmations {
+		if c.blockNum >= earliest {
+			kept = append(kept, c)
+		}
+	}
+	o.confirmations = kept
+}
+
+// floor returns the minimum gas price among our own confirmations in the
+// block window, or nil if there are none
+func (o *ownConfirmationsEstimator) floor() *big.Int {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	var min *big.Int
+	for _, c := range o.confirmations {
+		if min == nil || c.gasPrice.Cmp(min) < 0 {
+			min = c.gasPrice
+		}
+	}
+	if min == nil {
+		return nil
+	}
+	if max := o.config.EvmMaxGasPriceWei(); min.Cmp(max) > 0 {
+		return max
+	}
+	return new(big.Int).Set(min)
+}
+
+// primaryHasEnoughData returns false if the primary estimator keeps a block
+// history that is shorter than GAS_ESTIMATOR_OWN_CONFIRMATIONS_MIN_BLOCKS,
+// or does not keep a block history at all
+func (o *ownConfirmationsEstimator) primaryHasEnoughData() bool {
+	bc, ok := o.Estimator.(blockCounter)
+	if !ok {
+		return false
+	}
+	return bc.BlockCount() >= int(o.config.GasEstimatorOwnConfirmationsMinBlocks())
+}
+
+func (o *ownConfirmationsEstimator) GetLegacyGas(calldata []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	gasPrice, chainSpecificGasLimit, err = o.Estimator.GetLegacyGas(calldata, gasLimit, opts...)
+	if err != nil || o.primaryHasEnoughData() {
+		return
+	}
+	if floor := o.floor(); floor != nil && gasPrice.Cmp(floor) < 0 {
+		o.lggr.Infow("Primary estimator has insufficient data, using minimum gas price from own recent confirmations as a floor", "estimatedGasPriceWei", gasPrice, "floorGasPriceWei", floor)
+		gasPrice = floor
+	}
+	return
+}
+
+func (o *ownConfirmationsEstimator) GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	fee, chainSpecificGasLimit, err = o.Estimator.GetDynamicFee(gasLimit)
+	if err != nil || o.primaryHasEnoughData() {
+		return
+	}
+	if floor := o.floor(); floor != nil && fee.FeeCap != nil && fee.FeeCap.Cmp(floor) < 0 {
+		o.lggr.Infow("Primary estimator has insufficient data, using minimum gas price from own recent confirmations as a fee cap floor", "estimatedFeeCapWei", fee.FeeCap, "floorFeeCapWei", floor)
+		fee.FeeCap = floor
+	}
+	return
+}
@@ -0,0 +1,144 @@
+package gas_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	gumocks "github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func newOwnConfirmationsConfig(t *testing.T) *gumocks.Config {
+	config := newConfigWithEIP1559DynamicFeesDisabled(t)
+	config.On("GasEstimatorOwnConfirmationsBlockWindow").Maybe().Return(uint16(100))
+	config.On("GasEstimatorOwnConfirmationsMinBlocks").Maybe().Return(uint16(3))
+	config.On("EvmMaxGasPriceWei").Maybe().Return(big.NewInt(500))
+	config.On("EvmGasLimitMultiplier").Maybe().Return(float32(1))
+	return config
+}
+
+func Test_OwnConfirmationsEstimator_BlockHistory(t *testing.T) {
+	t.Parallel()
+
+	newStartedEstimators := func(t *testing.T) (*gas.BlockHistoryEstimator, gas.Estimator) {
+		config := newOwnConfirmationsConfig(t)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("something exploded"))
+
+		bhe := newBlockHistoryEstimator(t, ethClient, config)
+		require.NoError(t, bhe.Start())
+		t.Cleanup(func() { assert.NoError(t, bhe.Close()) })
+		gas.SetGasPrice(bhe, big.NewInt(100))
+
+		return bhe, gas.NewOwnConfirmationsEstimator(logger.TestLogger(t), bhe, config)
+	}
+
+	t.Run("with empty block history and no own confirmations, returns the primary estimate", func(t *testing.T) {
+		_, e := newStartedEstimators(t)
+
+		gasPrice, gasLimit, err := e.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(100), gasPrice)
+		assert.Equal(t, 100000, int(gasLimit))
+	})
+
+	t.Run("with empty block history, uses the minimum of own confirmations as a floor", func(t *testing.T) {
+		_, e := newStartedEstimators(t)
+		recorder := e.(gas.ConfirmationRecorder)
+
+		recorder.RecordConfirmation(42, big.NewInt(300))
+		recorder.RecordConfirmation(43, big.NewInt(200))
+		recorder.RecordConfirmation(44, big.NewInt(250))
+
+		gasPrice, _, err := e.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(200), gasPrice)
+	})
+
+	t.Run("does not lower the primary estimate", func(t *testing.T) {
+		_, e := newStartedEstimators(t)
+		e.(gas.ConfirmationRecorder).RecordConfirmation(42, big.NewInt(50))
+
+		gasPrice, _, err := e.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(100), gasPrice)
+	})
+
+	t.Run("caps the floor at ETH_MAX_GAS_PRICE_WEI", func(t *testing.T) {
+		_, e := newStartedEstimators(t)
+		e.(gas.ConfirmationRecorder).RecordConfirmation(42, big.NewInt(1000))
+
+		gasPrice, _, err := e.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(500), gasPrice)
+	})
+
+	t.Run("ignores own confirmations once the primary has enough blocks", func(t *testing.T) {
+		bhe, e := newStartedEstimators(t)
+		e.(gas.ConfirmationRecorder).RecordConfirmation(42, big.NewInt(300))
+
+		gas.SetRollingBlockHistory(bhe, []gas.Block{{Number: 40}, {Number: 41}, {Number: 42}})
+
+		gasPrice, _, err := e.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(100), gasPrice)
+	})
+}
+
+func Test_OwnConfirmationsEstimator_FixedPrice(t *testing.T) {
+	t.Parallel()
+
+	newEstimator := func(t *testing.T) gas.Estimator {
+		config := newOwnConfirmationsConfig(t)
+		config.On("EvmGasPriceDefault").Maybe().Return(big.NewInt(100))
+		config.On("EvmGasTipCapDefault").Maybe().Return(big.NewInt(1))
+		config.On("EvmGasFeeCap").Maybe().Return(big.NewInt(150))
+		return gas.NewOwnConfirmationsEstimator(logger.TestLogger(t), gas.NewFixedPriceEstimator(config, logger.TestLogger(t)), config)
+	}
+
+	t.Run("applies the floor to legacy gas", func(t *testing.T) {
+		e := newEstimator(t)
+		e.(gas.ConfirmationRecorder).RecordConfirmation(42, big.NewInt(200))
+
+		gasPrice, _, err := e.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(200), gasPrice)
+	})
+
+	t.Run("applies the floor to the dynamic fee cap", func(t *testing.T) {
+		e := newEstimator(t)
+		e.(gas.ConfirmationRecorder).RecordConfirmation(42, big.NewInt(200))
+
+		fee, _, err := e.GetDynamicFee(100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(200), fee.FeeCap)
+		assert.Equal(t, big.NewInt(1), fee.TipCap)
+	})
+
+	t.Run("prunes own confirmations that fall outside the block window", func(t *testing.T) {
+		e := newEstimator(t)
+		e.(gas.ConfirmationRecorder).RecordConfirmation(42, big.NewInt(200))
+
+		e.OnNewLongestChain(context.Background(), &evmtypes.Head{Hash: utils.NewHash(), Number: 141})
+
+		gasPrice, _, err := e.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(200), gasPrice)
+
+		e.OnNewLongestChain(context.Background(), &evmtypes.Head{Hash: utils.NewHash(), Number: 142})
+
+		gasPrice, _, err = e.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(100), gasPrice)
+	})
+}
@@ -5,6 +5,8 @@ package mocks
 import (
 	assets "github.com/smartcontractkit/chainlink/core/assets"
 
+	balancemonitor "github.com/smartcontractkit/chainlink/core/chains/evm/balancemonitor"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	context "context"
@@ -49,6 +51,22 @@ func (_m *BalanceMonitor) GetEthBalance(_a0 common.Address) *assets.Eth {
 	return r0
 }
 
+// GetTokenBalances provides a mock function with given fields: _a0
+func (_m *BalanceMonitor) GetTokenBalances(_a0 common.Address) []balancemonitor.TokenBalance {
+	ret := _m.Called(_a0)
+
+	var r0 []balancemonitor.TokenBalance
+	if rf, ok := ret.Get(0).(func(common.Address) []balancemonitor.TokenBalance); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]balancemonitor.TokenBalance)
+		}
+	}
+
+	return r0
+}
+
 // Healthy provides a mock function with given fields:
 func (_m *BalanceMonitor) Healthy() error {
 	ret := _m.Called()
@@ -13,6 +13,8 @@ import (
 
 	config "github.com/smartcontractkit/chainlink/core/chains/evm/config"
 
+	leader "github.com/smartcontractkit/chainlink/core/services/leader"
+
 	log "github.com/smartcontractkit/chainlink/core/chains/evm/log"
 
 	logger "github.com/smartcontractkit/chainlink/core/logger"
@@ -151,6 +153,22 @@ func (_m *Chain) ID() *big.Int {
 	return r0
 }
 
+// LeaderElector provides a mock function with given fields:
+func (_m *Chain) LeaderElector() *leader.Elector {
+	ret := _m.Called()
+
+	var r0 *leader.Elector
+	if rf, ok := ret.Get(0).(func() *leader.Elector); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*leader.Elector)
+		}
+	}
+
+	return r0
+}
+
 // LogBroadcaster provides a mock function with given fields:
 func (_m *Chain) LogBroadcaster() log.Broadcaster {
 	ret := _m.Called()
@@ -197,6 +215,22 @@ func (_m *Chain) Ready() error {
 	return r0
 }
 
+// SimulationCache provides a mock function with given fields:
+func (_m *Chain) SimulationCache() *client.SimulationCache {
+	ret := _m.Called()
+
+	var r0 *client.SimulationCache
+	if rf, ok := ret.Get(0).(func() *client.SimulationCache); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.SimulationCache)
+		}
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields:
 func (_m *Chain) Start() error {
 	ret := _m.Called()
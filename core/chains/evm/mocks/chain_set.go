@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	big "math/big"
 
 	evm "github.com/smartcontractkit/chainlink/core/chains/evm"
@@ -37,6 +39,34 @@ func (_m *ChainSet) Add(id *big.Int, config types.ChainCfg) (types.Chain, error)
 	return r0, r1
 }
 
+// ApplyConfigProfile provides a mock function with given fields: ctx, id, name, force
+func (_m *ChainSet) ApplyConfigProfile(ctx context.Context, id *big.Int, name string, force bool) (types.Chain, evm.ConfigValidationReport, error) {
+	ret := _m.Called(ctx, id, name, force)
+
+	var r0 types.Chain
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, string, bool) types.Chain); ok {
+		r0 = rf(ctx, id, name, force)
+	} else {
+		r0 = ret.Get(0).(types.Chain)
+	}
+
+	var r1 evm.ConfigValidationReport
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, string, bool) evm.ConfigValidationReport); ok {
+		r1 = rf(ctx, id, name, force)
+	} else {
+		r1 = ret.Get(1).(evm.ConfigValidationReport)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *big.Int, string, bool) error); ok {
+		r2 = rf(ctx, id, name, force)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // ChainCount provides a mock function with given fields:
 func (_m *ChainSet) ChainCount() int {
 	ret := _m.Called()
@@ -81,6 +111,27 @@ func (_m *ChainSet) Close() error {
 	return r0
 }
 
+// ConfigProfileDrift provides a mock function with given fields: id
+func (_m *ChainSet) ConfigProfileDrift(id *big.Int) (evm.ConfigProfileDrift, error) {
+	ret := _m.Called(id)
+
+	var r0 evm.ConfigProfileDrift
+	if rf, ok := ret.Get(0).(func(*big.Int) evm.ConfigProfileDrift); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(evm.ConfigProfileDrift)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Configure provides a mock function with given fields: id, enabled, config
 func (_m *ChainSet) Configure(id *big.Int, enabled bool, config types.ChainCfg) (types.Chain, error) {
 	ret := _m.Called(id, enabled, config)
@@ -178,6 +229,11 @@ func (_m *ChainSet) ORM() types.ORM {
 	return r0
 }
 
+// OnEachChain provides a mock function with given fields: fn
+func (_m *ChainSet) OnEachChain(fn func(evm.Chain)) {
+	_m.Called(fn)
+}
+
 // Ready provides a mock function with given fields:
 func (_m *ChainSet) Ready() error {
 	ret := _m.Called()
@@ -206,6 +262,29 @@ func (_m *ChainSet) Remove(id *big.Int) error {
 	return r0
 }
 
+// Running provides a mock function with given fields: id
+func (_m *ChainSet) Running(id *big.Int) (<-chan struct{}, error) {
+	ret := _m.Called(id)
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func(*big.Int) <-chan struct{}); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields:
 func (_m *ChainSet) Start() error {
 	ret := _m.Called()
@@ -220,6 +299,27 @@ func (_m *ChainSet) Start() error {
 	return r0
 }
 
+// Status provides a mock function with given fields: id
+func (_m *ChainSet) Status(id *big.Int) (evm.ChainStatus, error) {
+	ret := _m.Called(id)
+
+	var r0 evm.ChainStatus
+	if rf, ok := ret.Get(0).(func(*big.Int) evm.ChainStatus); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(evm.ChainStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateConfig provides a mock function with given fields: id, updaters
 func (_m *ChainSet) UpdateConfig(id *big.Int, updaters ...evm.ChainConfigUpdater) error {
 	_va := make([]interface{}, len(updaters))
@@ -240,3 +340,32 @@ func (_m *ChainSet) UpdateConfig(id *big.Int, updaters ...evm.ChainConfigUpdater
 
 	return r0
 }
+
+// ValidateConfig provides a mock function with given fields: ctx, id, updaters
+func (_m *ChainSet) ValidateConfig(ctx context.Context, id *big.Int, updaters ...evm.ChainConfigUpdater) (evm.ConfigValidationReport, error) {
+	_va := make([]interface{}, len(updaters))
+	for _i := range updaters {
+		_va[_i] = updaters[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 evm.ConfigValidationReport
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, ...evm.ChainConfigUpdater) evm.ConfigValidationReport); ok {
+		r0 = rf(ctx, id, updaters...)
+	} else {
+		r0 = ret.Get(0).(evm.ConfigValidationReport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, ...evm.ChainConfigUpdater) error); ok {
+		r1 = rf(ctx, id, updaters...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -37,6 +37,27 @@ func (_m *ORM) Chain(id utils.Big) (types.Chain, error) {
 	return r0, r1
 }
 
+// ChainConfigProfile provides a mock function with given fields: chainID
+func (_m *ORM) ChainConfigProfile(chainID utils.Big) (types.ChainConfigProfile, error) {
+	ret := _m.Called(chainID)
+
+	var r0 types.ChainConfigProfile
+	if rf, ok := ret.Get(0).(func(utils.Big) types.ChainConfigProfile); ok {
+		r0 = rf(chainID)
+	} else {
+		r0 = ret.Get(0).(types.ChainConfigProfile)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(utils.Big) error); ok {
+		r1 = rf(chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Chains provides a mock function with given fields: offset, limit
 func (_m *ORM) Chains(offset int, limit int) ([]types.Chain, int, error) {
 	ret := _m.Called(offset, limit)
@@ -301,6 +322,27 @@ func (_m *ORM) NodesForChain(chainID utils.Big, offset int, limit int) ([]types.
 	return r0, r1, r2
 }
 
+// SetChainConfigProfile provides a mock function with given fields: chainID, name, version, cfg
+func (_m *ORM) SetChainConfigProfile(chainID utils.Big, name string, version int32, cfg types.ChainCfg) (types.ChainConfigProfile, error) {
+	ret := _m.Called(chainID, name, version, cfg)
+
+	var r0 types.ChainConfigProfile
+	if rf, ok := ret.Get(0).(func(utils.Big, string, int32, types.ChainCfg) types.ChainConfigProfile); ok {
+		r0 = rf(chainID, name, version, cfg)
+	} else {
+		r0 = ret.Get(0).(types.ChainConfigProfile)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(utils.Big, string, int32, types.ChainCfg) error); ok {
+		r1 = rf(chainID, name, version, cfg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreString provides a mock function with given fields: chainID, key, val
 func (_m *ORM) StoreString(chainID *big.Int, key string, val string) error {
 	ret := _m.Called(chainID, key, val)
@@ -70,6 +70,20 @@ func (_m *SendOnlyNode) Dial(_a0 context.Context) error {
 	return r0
 }
 
+// Name provides a mock function with given fields:
+func (_m *SendOnlyNode) Name() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // SendTransaction provides a mock function with given fields: ctx, tx
 func (_m *SendOnlyNode) SendTransaction(ctx context.Context, tx *types.Transaction) error {
 	ret := _m.Called(ctx, tx)
@@ -205,3 +205,19 @@ func (o *orm) Clear(chainID *big.Int, name string) error {
 	}
 	return nil
 }
+
+func (o *orm) ChainConfigProfile(chainID utils.Big) (profile types.ChainConfigProfile, err error) {
+	sql := `SELECT * FROM evm_chain_config_profiles WHERE evm_chain_id = $1`
+	err = o.db.Get(&profile, sql, chainID)
+	return profile, err
+}
+
+// SetChainConfigProfile records that version of the named profile was applied
+// to the chain, expanding into cfg, replacing any profile applied before
+func (o *orm) SetChainConfigProfile(chainID utils.Big, name string, version int32, cfg types.ChainCfg) (profile types.ChainConfigProfile, err error) {
+	sql := `INSERT INTO evm_chain_config_profiles (evm_chain_id, name, version, cfg, applied_at) VALUES ($1, $2, $3, $4, now())
+ON CONFLICT (evm_chain_id) DO UPDATE SET name = EXCLUDED.name, version = EXCLUDED.version, cfg = EXCLUDED.cfg, applied_at = EXCLUDED.applied_at
+RETURNING *`
+	err = o.db.Get(&profile, sql, chainID, name, version, cfg)
+	return profile, err
+}
@@ -42,6 +42,8 @@ type ORM interface {
 	Node(id int32) (Node, error)
 	Nodes(offset, limit int) ([]Node, int, error)
 	NodesForChain(chainID utils.Big, offset, limit int) ([]Node, int, error)
+	ChainConfigProfile(chainID utils.Big) (ChainConfigProfile, error)
+	SetChainConfigProfile(chainID utils.Big, name string, version int32, cfg ChainCfg) (ChainConfigProfile, error)
 	ChainConfigORM
 }
 
@@ -53,6 +55,7 @@ type ChainCfg struct {
 	EvmEIP1559DynamicFees                 null.Bool
 	EvmFinalityDepth                      null.Int
 	EvmGasBumpPercent                     null.Int
+	EvmGasBumpStrategy                    null.String
 	EvmGasBumpTxDepth                     null.Int
 	EvmGasBumpWei                         *utils.Big
 	EvmGasLimitDefault                    null.Int
@@ -65,8 +68,11 @@ type ChainCfg struct {
 	EvmHeadTrackerSamplingInterval        *models.Duration
 	EvmLogBackfillBatchSize               null.Int
 	EvmMaxGasPriceWei                     *utils.Big
+	EvmMulticallAddress                   null.String
 	EvmNonceAutoSync                      null.Bool
 	EvmRPCDefaultBatchSize                null.Int
+	EvmStrictSequencing                   null.Bool
+	EvmTxQueueOrdering                    null.String
 	FlagsContractAddress                  null.String
 	GasEstimatorMode                      null.String
 	ChainType                             null.String
@@ -102,6 +108,16 @@ func (Chain) TableName() string {
 	return "evm_chains"
 }
 
+// ChainConfigProfile records the config profile that was last applied to a
+// chain, and the overrides it expanded into at the time
+type ChainConfigProfile struct {
+	EVMChainID utils.Big
+	Name       string
+	Version    int32
+	Cfg        ChainCfg
+	AppliedAt  time.Time
+}
+
 type Node struct {
 	ID         int32
 	Name       string
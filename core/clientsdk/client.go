@@ -0,0 +1,269 @@
+// Package clientsdk provides typed Go clients for the node's HTTP API, for
+// services integrating with a node. The CLI uses it for the commands it
+// covers.
+package clientsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+const (
+	// DefaultMaxRetries is how many times an idempotent request is retried
+	// after a 5xx response or a network error
+	DefaultMaxRetries = 2
+	// DefaultRetryBackoff is how long to wait before the first retry. Each
+	// subsequent retry waits twice as long.
+	DefaultRetryBackoff = 500 * time.Millisecond
+)
+
+// Doer sends an HTTP request, e.g. *http.Client
+type Doer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Client sends authenticated requests to a node. It is safe for concurrent
+// use. Use the Txs and Keepers methods to get the typed API clients.
+type Client struct {
+	baseURL      string
+	doer         Doer
+	maxRetries   int
+	retryBackoff time.Duration
+
+	mu             sync.RWMutex
+	sessionRequest *sessionRequest
+	cookie         *http.Cookie
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithDoer sends requests with d instead of http.DefaultClient
+func WithDoer(d Doer) Option {
+	return func(c *Client) {
+		c.doer = d
+	}
+}
+
+// WithRetries sets how many times idempotent requests are retried after a 5xx
+// response or a network error, and how long to wait before the first retry
+func WithRetries(maxRetries int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.retryBackoff = backoff
+	}
+}
+
+// New returns a Client for the node at baseURL, e.g. http://localhost:6688
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:      baseURL,
+		doer:         http.DefaultClient,
+		maxRetries:   DefaultMaxRetries,
+		retryBackoff: DefaultRetryBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Txs returns the client for the transaction API
+func (c *Client) Txs() *TxClient {
+	return &TxClient{c}
+}
+
+// Keepers returns the client for the keeper API
+func (c *Client) Keepers() *KeeperClient {
+	return &KeeperClient{c}
+}
+
+// Login creates a session with the given credentials. The credentials are
+// kept so that an expired session is renewed automatically.
+func (c *Client) Login(ctx context.Context, email, password string) error {
+	sr := sessionRequest{Email: email, Password: password}
+	if err := c.login(ctx, sr); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessionRequest = &sr
+	return nil
+}
+
+func (c *Client) login(ctx context.Context, sr sessionRequest) error {
+	b, err := json.Marshal(sr)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/sessions", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "login failed")
+	}
+	if _, err = readResponse(resp); err != nil {
+		return errors.Wrap(err, "login failed")
+	}
+	cookie := findSessionCookie(resp.Cookies())
+	if cookie == nil {
+		return errors.New("login failed: did not receive cookie with session id")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cookie = cookie
+	return nil
+}
+
+// do sends a request with body encoded as JSON and unmarshals the JSONAPI
+// response into dst, which may be nil
+func (c *Client) do(ctx context.Context, method, path string, body, dst interface{}) error {
+	var b []byte
+	if body != nil {
+		var err error
+		if b, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+	respBody, err := c.doWithRetries(ctx, method, path, b)
+	if err != nil {
+		return err
+	}
+	if dst == nil {
+		return nil
+	}
+	return errors.Wrapf(jsonapi.Unmarshal(respBody, dst), "unable to unmarshal data of type %T", dst)
+}
+
+// doWithRetries retries idempotent requests after a 5xx response or a
+// network error. Other requests are sent once, since e.g. creating a
+// transaction twice would send it twice.
+func (c *Client) doWithRetries(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	retries := 0
+	if isIdempotent(method) {
+		retries = c.maxRetries
+	}
+	backoff := c.retryBackoff
+	for attempt := 0; ; attempt++ {
+		b, err := c.doAuthenticated(ctx, method, path, body)
+		if err == nil || attempt >= retries || !isRetryable(err) {
+			return b, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// doAuthenticated sends a request with the session cookie, logging in again
+// and resending it once if the session has expired
+func (c *Client) doAuthenticated(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	b, err := c.send(ctx, method, path, body)
+	if !errors.Is(err, ErrUnauthorized) {
+		return b, err
+	}
+	c.mu.RLock()
+	sr := c.sessionRequest
+	c.mu.RUnlock()
+	if sr == nil {
+		return b, err
+	}
+	if lerr := c.login(ctx, *sr); lerr != nil {
+		return b, err
+	}
+	return c.send(ctx, method, path, body)
+}
+
+func (c *Client) send(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, r)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	c.mu.RLock()
+	if c.cookie != nil {
+		req.AddCookie(c.cookie)
+	}
+	c.mu.RUnlock()
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return nil, &networkError{err}
+	}
+	return readResponse(resp)
+}
+
+// readResponse reads and closes the response body
+func readResponse(resp *http.Response) (b []byte, err error) {
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	b, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &networkError{err}
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return b, newError(resp.StatusCode, b)
+	}
+	return b, nil
+}
+
+// sessionCookieName is the name of the cookie holding the session ID
+const sessionCookieName = "clsession"
+
+func findSessionCookie(cookies []*http.Cookie) *http.Cookie {
+	for _, c := range cookies {
+		if c.Name == sessionCookieName {
+			return c
+		}
+	}
+	return nil
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func isRetryable(err error) bool {
+	var ne *networkError
+	if errors.As(err, &ne) {
+		return true
+	}
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode >= http.StatusInternalServerError
+}
+
+// networkError is returned when no response could be read from the node
+type networkError struct {
+	err error
+}
+
+func (e *networkError) Error() string { return e.err.Error() }
+
+func (e *networkError) Unwrap() error { return e.err }
@@ -0,0 +1,280 @@
+package clientsdk_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/clientsdk"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func newLoggedInClient(t *testing.T, app *cltest.TestApplication) *clientsdk.Client {
+	t.Helper()
+
+	c := clientsdk.New(app.Server.URL, clientsdk.WithRetries(0, 0))
+	require.NoError(t, c.Login(context.Background(), cltest.APIEmail, cltest.Password))
+	return c
+}
+
+func TestClient_Login(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+
+	c := clientsdk.New(app.Server.URL)
+	err := c.Login(context.Background(), cltest.APIEmail, "wrong")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, clientsdk.ErrUnauthorized))
+
+	// Without a session, requests are rejected
+	_, err = c.Txs().Status(context.Background(), "0x0")
+	assert.True(t, errors.Is(err, clientsdk.ErrUnauthorized))
+
+	require.NoError(t, c.Login(context.Background(), cltest.APIEmail, cltest.Password))
+	_, err = c.Txs().Status(context.Background(), "0x0")
+	assert.True(t, errors.Is(err, clientsdk.ErrNotFound))
+}
+
+func TestTxClient_CreateAndStatus(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+	c := newLoggedInClient(t, app)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+
+	t.Run("Create", func(t *testing.T) {
+		tx, err := c.Txs().Create(context.Background(), clientsdk.SendEtherRequest{
+			DestinationAddress: common.HexToAddress("0xFA01FA015C8A5332987319823728982379128371"),
+			FromAddress:        from,
+			Amount:             *clientsdk.NewBig(big.NewInt(100)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, from, *tx.From)
+		cltest.AssertCount(t, app.GetSqlxDB(), "eth_txes", 1)
+	})
+
+	t.Run("Create with unknown key", func(t *testing.T) {
+		_, err := c.Txs().Create(context.Background(), clientsdk.SendEtherRequest{
+			DestinationAddress: common.HexToAddress("0xFA01FA015C8A5332987319823728982379128371"),
+			FromAddress:        common.HexToAddress("0x0000000000000000000000000000000000000000"),
+			Amount:             *clientsdk.NewBig(big.NewInt(100)),
+		})
+		require.Error(t, err)
+		var sdkErr *clientsdk.Error
+		require.True(t, errors.As(err, &sdkErr))
+		assert.GreaterOrEqual(t, sdkErr.StatusCode, http.StatusBadRequest)
+		assert.NotEmpty(t, sdkErr.Details)
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, app.BPTXMORM(), 1, from)
+		attempt := etx.EthTxAttempts[0]
+
+		tx, err := c.Txs().Status(context.Background(), attempt.Hash.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, attempt.Hash, tx.Hash)
+		assert.Equal(t, from, *tx.From)
+
+		_, err = c.Txs().Status(context.Background(), utils.NewHash().Hex())
+		assert.True(t, errors.Is(err, clientsdk.ErrNotFound))
+	})
+}
+
+func TestTxClient_BulkStatusCancelAndSubjects(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+	c := newLoggedInClient(t, app)
+	borm := app.BPTXMORM()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+
+	t.Run("BulkStatus", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, from)
+		hash := etx.EthTxAttempts[0].Hash
+
+		txs, err := c.Txs().BulkStatus(context.Background(), hash.Hex(), utils.NewHash().Hex())
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		assert.Equal(t, hash, txs[0].Hash)
+		assert.Equal(t, etx.ID, txs[0].EthTxID)
+
+		_, err = c.Txs().BulkStatus(context.Background())
+		assert.True(t, errors.Is(err, clientsdk.ErrUnprocessableEntity))
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, from)
+
+		tx, err := c.Txs().Cancel(context.Background(), etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "fatal_error", tx.State)
+
+		_, err = c.Txs().Cancel(context.Background(), etx.ID)
+		assert.True(t, errors.Is(err, clientsdk.ErrConflict))
+
+		_, err = c.Txs().Cancel(context.Background(), etx.ID+100)
+		assert.True(t, errors.Is(err, clientsdk.ErrNotFound))
+	})
+
+	t.Run("Subjects", func(t *testing.T) {
+		subject := uuid.NewV4()
+		empty := uuid.NewV4()
+		cltest.MustInsertUnstartedEthTx(t, borm, from, subject)
+
+		subjects, err := c.Txs().Subjects(context.Background(), subject, empty)
+		require.NoError(t, err)
+		require.Len(t, subjects, 2)
+		assert.Equal(t, subject, subjects[0].Subject)
+		assert.Equal(t, int64(1), subjects[0].Unstarted)
+		assert.Equal(t, empty, subjects[1].Subject)
+		assert.Equal(t, int64(0), subjects[1].Unstarted)
+	})
+}
+
+func TestKeeperClient_Pause(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+	c := newLoggedInClient(t, app)
+
+	db := app.GetSqlxDB()
+	cfg := evmtest.NewChainScopedConfig(t, app.Config)
+	korm := keeper.NewORM(db, logger.TestLogger(t), nil, cfg, nil)
+	registry, keeperJob := cltest.MustInsertKeeperRegistry(t, db, korm, app.KeyStore.Eth())
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, cfg, registry)
+	jobID := strconv.Itoa(int(keeperJob.ID))
+
+	resource, err := c.Keepers().Pause(context.Background(), jobID, upkeep.UpkeepID)
+	require.NoError(t, err)
+	assert.Equal(t, keeperJob.ID, resource.JobID)
+	assert.True(t, resource.Paused)
+
+	resource, err = c.Keepers().Unpause(context.Background(), jobID, upkeep.UpkeepID)
+	require.NoError(t, err)
+	assert.False(t, resource.Paused)
+
+	_, err = c.Keepers().Pause(context.Background(), jobID, upkeep.UpkeepID+1)
+	assert.True(t, errors.Is(err, clientsdk.ErrNotFound))
+
+	resource, err = c.Keepers().SetMaxPerformGasPrice(context.Background(), jobID, upkeep.UpkeepID, big.NewInt(100e9))
+	require.NoError(t, err)
+	require.NotNil(t, resource.MaxPerformGasPrice)
+	assert.Equal(t, "100000000000", resource.MaxPerformGasPrice.String())
+	assert.False(t, resource.Paused)
+
+	resource, err = c.Keepers().SetMaxPerformGasPrice(context.Background(), jobID, upkeep.UpkeepID, big.NewInt(0))
+	require.NoError(t, err)
+	assert.Nil(t, resource.MaxPerformGasPrice)
+}
+
+func TestKeeperClient_UpkeepStatsAndExplain(t *testing.T) {
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app := cltest.NewApplicationWithConfigAndKey(t, cltest.NewTestGeneralConfig(t), ethClient)
+	require.NoError(t, app.Start())
+	c := newLoggedInClient(t, app)
+
+	db := app.GetSqlxDB()
+	cfg := evmtest.NewChainScopedConfig(t, app.Config)
+	korm := keeper.NewORM(db, logger.TestLogger(t), nil, cfg, nil)
+	registry, keeperJob := cltest.MustInsertKeeperRegistry(t, db, korm, app.KeyStore.Eth())
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, cfg, registry)
+	jobID := strconv.Itoa(int(keeperJob.ID))
+
+	stats, err := c.Keepers().UpkeepStats(context.Background(), jobID, upkeep.UpkeepID)
+	require.NoError(t, err)
+	assert.Equal(t, keeperJob.ID, stats.JobID)
+	assert.Equal(t, int64(0), stats.PendingRuns)
+	assert.Nil(t, stats.LastRunAt)
+
+	eligibility, err := c.Keepers().Explain(context.Background(), jobID, upkeep.UpkeepID)
+	require.NoError(t, err)
+	assert.True(t, eligibility.Eligible)
+
+	require.NoError(t, korm.SetUpkeepPaused(registry.ID, upkeep.UpkeepID, true))
+	eligibility, err = c.Keepers().Explain(context.Background(), jobID, upkeep.UpkeepID)
+	require.NoError(t, err)
+	assert.False(t, eligibility.Eligible)
+	assert.Equal(t, "the upkeep is paused", eligibility.Reason)
+
+	_, err = c.Keepers().UpkeepStats(context.Background(), jobID, upkeep.UpkeepID+1)
+	assert.True(t, errors.Is(err, clientsdk.ErrNotFound))
+}
+
+func TestClient_Retries(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"detail":"Transaction not found"}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("retries idempotent requests on 5xx", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		c := clientsdk.New(server.URL, clientsdk.WithRetries(2, time.Millisecond))
+
+		_, err := c.Txs().Status(context.Background(), "0x0")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, clientsdk.ErrNotFound))
+		assert.Equal(t, "404 Not Found: Transaction not found", err.Error())
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		c := clientsdk.New(server.URL, clientsdk.WithRetries(1, time.Millisecond))
+
+		_, err := c.Txs().Status(context.Background(), "0x0")
+		require.Error(t, err)
+		var sdkErr *clientsdk.Error
+		require.True(t, errors.As(err, &sdkErr))
+		assert.Equal(t, http.StatusServiceUnavailable, sdkErr.StatusCode)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("does not retry creating a transaction", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		c := clientsdk.New(server.URL, clientsdk.WithRetries(2, time.Millisecond))
+
+		_, err := c.Txs().Create(context.Background(), clientsdk.SendEtherRequest{})
+		require.Error(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("stops retrying when the context is done", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		c := clientsdk.New(server.URL, clientsdk.WithRetries(2, time.Hour))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := c.Txs().Status(ctx, "0x0")
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+}
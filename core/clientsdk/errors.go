@@ -0,0 +1,52 @@
+package clientsdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Errors matching the status codes returned by the node, for use with
+// errors.Is
+var (
+	ErrBadRequest          = &Error{StatusCode: http.StatusBadRequest}
+	ErrUnauthorized        = &Error{StatusCode: http.StatusUnauthorized}
+	ErrForbidden           = &Error{StatusCode: http.StatusForbidden}
+	ErrNotFound            = &Error{StatusCode: http.StatusNotFound}
+	ErrConflict            = &Error{StatusCode: http.StatusConflict}
+	ErrUnprocessableEntity = &Error{StatusCode: http.StatusUnprocessableEntity}
+	ErrInternalServerError = &Error{StatusCode: http.StatusInternalServerError}
+)
+
+// Error is returned when the node responds with an error status code. Details
+// are the error messages from the JSONAPI response body, if any.
+type Error struct {
+	StatusCode int
+	Details    []string
+}
+
+func newError(statusCode int, body []byte) *Error {
+	e := &Error{StatusCode: statusCode}
+	jae := jsonAPIErrors{}
+	if json.Unmarshal(body, &jae) == nil {
+		for _, je := range jae.Errors {
+			e.Details = append(e.Details, je.Detail)
+		}
+	}
+	return e
+}
+
+func (e *Error) Error() string {
+	if len(e.Details) == 0 {
+		return fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), strings.Join(e.Details, ","))
+}
+
+// Is matches any Error with the same status code, so that e.g.
+// errors.Is(err, ErrNotFound) holds for every 404 response
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.StatusCode == e.StatusCode
+}
@@ -0,0 +1,69 @@
+package clientsdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+)
+
+// KeeperClient is the client for the keeper API
+type KeeperClient struct {
+	c *Client
+}
+
+// Pause stops the upkeep of a keeper job from being performed, see
+// PATCH /v2/jobs/:ID/upkeeps/:upkeepID. jobID can be the job ID or the
+// external job ID.
+func (kc *KeeperClient) Pause(ctx context.Context, jobID string, upkeepID int64) (*Upkeep, error) {
+	return kc.setPaused(ctx, jobID, upkeepID, true)
+}
+
+// Unpause resumes performing a paused upkeep of a keeper job
+func (kc *KeeperClient) Unpause(ctx context.Context, jobID string, upkeepID int64) (*Upkeep, error) {
+	return kc.setPaused(ctx, jobID, upkeepID, false)
+}
+
+// SetMaxPerformGasPrice sets the highest gas price in wei the upkeep of a
+// keeper job is performed at. A price of 0 removes the ceiling.
+func (kc *KeeperClient) SetMaxPerformGasPrice(ctx context.Context, jobID string, upkeepID int64, price *big.Int) (*Upkeep, error) {
+	return kc.update(ctx, jobID, upkeepID, updateUpkeepRequest{MaxPerformGasPrice: NewBig(price)})
+}
+
+// UpkeepStats returns the upkeep of a keeper job along with the number of its
+// runs by status, see GET /v2/jobs/:ID/upkeeps/:upkeepID/stats
+func (kc *KeeperClient) UpkeepStats(ctx context.Context, jobID string, upkeepID int64) (*UpkeepStats, error) {
+	var resource UpkeepStats
+	if err := kc.c.do(ctx, http.MethodGet, upkeepPath(jobID, upkeepID)+"/stats", nil, &resource); err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Explain explains whether the upkeep of a keeper job is eligible to be
+// performed by the node at the latest head, and if not, why. See
+// GET /v2/jobs/:ID/upkeeps/:upkeepID/explain
+func (kc *KeeperClient) Explain(ctx context.Context, jobID string, upkeepID int64) (*UpkeepEligibility, error) {
+	var resource UpkeepEligibility
+	if err := kc.c.do(ctx, http.MethodGet, upkeepPath(jobID, upkeepID)+"/explain", nil, &resource); err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+func (kc *KeeperClient) setPaused(ctx context.Context, jobID string, upkeepID int64, paused bool) (*Upkeep, error) {
+	return kc.update(ctx, jobID, upkeepID, updateUpkeepRequest{Paused: &paused})
+}
+
+func (kc *KeeperClient) update(ctx context.Context, jobID string, upkeepID int64, request updateUpkeepRequest) (*Upkeep, error) {
+	var resource Upkeep
+	if err := kc.c.do(ctx, http.MethodPatch, upkeepPath(jobID, upkeepID), request, &resource); err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+func upkeepPath(jobID string, upkeepID int64) string {
+	return fmt.Sprintf("/v2/jobs/%s/upkeeps/%d", url.PathEscape(jobID), upkeepID)
+}
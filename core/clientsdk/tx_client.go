@@ -0,0 +1,105 @@
+package clientsdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// TxClient is the client for the transaction API
+type TxClient struct {
+	c *Client
+}
+
+// Create sends ETH from one of the node's keys, see POST /v2/transfers
+func (tc *TxClient) Create(ctx context.Context, request SendEtherRequest) (*EthTx, error) {
+	var resource EthTx
+	if err := tc.c.do(ctx, http.MethodPost, "/v2/transfers", request, &resource); err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Status returns the transaction with an attempt with the given hash, see
+// GET /v2/transactions/:TxHash
+func (tc *TxClient) Status(ctx context.Context, txHash string) (*EthTx, error) {
+	var resource EthTx
+	if err := tc.c.do(ctx, http.MethodGet, "/v2/transactions/"+url.PathEscape(txHash), nil, &resource); err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// BulkStatus returns the transactions with an attempt with any of the given
+// hashes, see GET /v2/transactions/bulk_status. Hashes that are not found are
+// left out.
+func (tc *TxClient) BulkStatus(ctx context.Context, txHashes ...string) ([]EthTx, error) {
+	params := make([]string, len(txHashes))
+	for i, hash := range txHashes {
+		params[i] = "hash=" + url.QueryEscape(hash)
+	}
+	var resources []EthTx
+	if err := tc.c.do(ctx, http.MethodGet, "/v2/transactions/bulk_status?"+strings.Join(params, "&"), nil, &resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// Cancel errors an unstarted transaction so that it is never sent, see
+// POST /v2/eth_txes/:ID/cancel. ethTxID is the EthTxID of the transaction.
+// Once the transaction has been picked up for broadcast it can no longer be
+// cancelled, and an error matching ErrConflict is returned.
+func (tc *TxClient) Cancel(ctx context.Context, ethTxID int64) (*EthTx, error) {
+	var resource EthTx
+	path := fmt.Sprintf("/v2/eth_txes/%d/cancel", ethTxID)
+	if err := tc.c.do(ctx, http.MethodPost, path, struct{}{}, &resource); err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Subjects summarizes the transactions queued under each of the given
+// subjects, in the same order, see GET /v2/tx_subjects
+func (tc *TxClient) Subjects(ctx context.Context, subjects ...uuid.UUID) ([]TxSubject, error) {
+	params := make([]string, len(subjects))
+	for i, subject := range subjects {
+		params[i] = "subject=" + subject.String()
+	}
+	var resources []TxSubject
+	if err := tc.c.do(ctx, http.MethodGet, "/v2/tx_subjects?"+strings.Join(params, "&"), nil, &resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// Replay makes the node process blocks again from the given block number, see
+// POST /v2/replay_from_block/:number. evmChainID is optional if the node has
+// a single chain.
+func (tc *TxClient) Replay(ctx context.Context, blockNumber int64, evmChainID *big.Int) (*ReplayResponse, error) {
+	path := fmt.Sprintf("/v2/replay_from_block/%d", blockNumber)
+	if evmChainID != nil {
+		path += "?evmChainID=" + evmChainID.String()
+	}
+	var response ReplayResponse
+	if err := tc.c.do(ctx, http.MethodPost, path, struct{}{}, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// BumpAll replaces every unconfirmed transaction of the key with a new
+// attempt at the given pricing, see POST /v2/keys/eth/:keyID/bump_all. With
+// DryRun set, nothing is sent, and the result reports what would be bumped.
+func (tc *TxClient) BumpAll(ctx context.Context, address string, request BumpAllRequest) ([]EthTxBump, error) {
+	var resources []EthTxBump
+	path := fmt.Sprintf("/v2/keys/eth/%s/bump_all", url.PathEscape(address))
+	if err := tc.c.do(ctx, http.MethodPost, path, request, &resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
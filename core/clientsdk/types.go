@@ -0,0 +1,286 @@
+package clientsdk
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// The request and response types of the node's HTTP API. They mirror the
+// node's own types, so that the package can be used without depending on the
+// node itself.
+
+// Big is an integer encoded as a base 10 string, e.g. an amount in wei
+type Big big.Int
+
+// NewBig returns i as a Big, or nil if i is nil
+func NewBig(i *big.Int) *Big {
+	if i == nil {
+		return nil
+	}
+	return (*Big)(i)
+}
+
+// ToInt returns b as a *big.Int
+func (b *Big) ToInt() *big.Int {
+	return (*big.Int)(b)
+}
+
+// String returns the base 10 encoding of b
+func (b *Big) String() string {
+	return b.ToInt().String()
+}
+
+// MarshalJSON implements json.Marshaler
+func (b Big) MarshalJSON() ([]byte, error) {
+	return json.Marshal((*big.Int)(&b).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts base 10 and 0x
+// prefixed hex, both quoted and unquoted.
+func (b *Big) UnmarshalJSON(input []byte) error {
+	if string(input) == "null" {
+		return nil
+	}
+	s := strings.Trim(string(input), `"`)
+	var ok bool
+	if strings.HasPrefix(s, "0x") {
+		_, ok = b.ToInt().SetString(s[2:], 16)
+	} else {
+		_, ok = b.ToInt().SetString(s, 10)
+	}
+	if !ok {
+		return errors.Errorf("clientsdk: cannot unmarshal %q into a *Big", input)
+	}
+	return nil
+}
+
+// JAID is the JSONAPI ID of a resource
+type JAID struct {
+	ID string `json:"-"`
+}
+
+// GetID implements the api2go MarshalIdentifier interface
+func (jaid JAID) GetID() string {
+	return jaid.ID
+}
+
+// SetID implements the api2go UnmarshalIdentifier interface
+func (jaid *JAID) SetID(value string) error {
+	jaid.ID = value
+	return nil
+}
+
+// sessionRequest is the request body of POST /sessions
+type sessionRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// jsonAPIErrors is the body of an error response
+type jsonAPIErrors struct {
+	Errors []struct {
+		Detail string `json:"detail"`
+	} `json:"errors"`
+}
+
+// SendEtherRequest is the request body of POST /v2/transfers. Amount is in
+// wei. EVMChainID is optional if the node has a single chain.
+type SendEtherRequest struct {
+	DestinationAddress common.Address `json:"address"`
+	FromAddress        common.Address `json:"from"`
+	Amount             Big            `json:"amount"`
+	EVMChainID         *Big           `json:"evmChainID"`
+}
+
+// EthTxOrigin is what created a transaction
+type EthTxOrigin struct {
+	JobID      int32  `json:"jobID,omitempty"`
+	JobName    string `json:"jobName,omitempty"`
+	User       string `json:"user,omitempty"`
+	Credential string `json:"credential,omitempty"`
+}
+
+// SendResult is how an eth node responded to an attempt
+type SendResult struct {
+	Node      string    `json:"node"`
+	Main      bool      `json:"main"`
+	Class     string    `json:"class"`
+	LatencyMs int64     `json:"latencyMs"`
+	SentAt    time.Time `json:"sentAt"`
+}
+
+// EthTx is a transaction, along with one of its attempts if it has been sent.
+// The ID is the hash of the attempt, if any, while EthTxID identifies the
+// transaction across its attempts.
+type EthTx struct {
+	JAID
+	State               string          `json:"state"`
+	Data                hexutil.Bytes   `json:"data"`
+	From                *common.Address `json:"from"`
+	GasLimit            string          `json:"gasLimit"`
+	GasPrice            string          `json:"gasPrice"`
+	Hash                common.Hash     `json:"hash"`
+	Hex                 string          `json:"rawHex"`
+	Nonce               string          `json:"nonce"`
+	SentAt              string          `json:"sentAt"`
+	To                  *common.Address `json:"to"`
+	Value               string          `json:"value"`
+	EVMChainID          Big             `json:"evmChainID"`
+	EffectiveGasPrice   string          `json:"effectiveGasPrice,omitempty"`
+	GasUsed             string          `json:"gasUsed,omitempty"`
+	Deadline            *time.Time      `json:"deadline,omitempty"`
+	DeadlineBoostFactor string          `json:"deadlineBoostFactor,omitempty"`
+	SendLog             []SendResult    `json:"sendLog,omitempty"`
+	Origin              *EthTxOrigin    `json:"origin,omitempty"`
+	EthTxID             int64           `json:"ethTxID,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EthTx) GetName() string {
+	return "transactions"
+}
+
+// TxSubject summarizes the transactions queued under a subject. The ID is the
+// subject.
+type TxSubject struct {
+	JAID
+	Subject     uuid.UUID `json:"subject"`
+	Unstarted   int64     `json:"unstarted"`
+	Unconfirmed int64     `json:"unconfirmed"`
+	// OldestPendingAt is the creation time of the oldest unstarted,
+	// in_progress or unconfirmed transaction, if any
+	OldestPendingAt *time.Time `json:"oldestPendingAt"`
+	// OldestPendingAge is the age of that transaction, e.g. "1m30s"
+	OldestPendingAge    *string      `json:"oldestPendingAge"`
+	LastConfirmedTxHash *common.Hash `json:"lastConfirmedTxHash"`
+	LastConfirmedAt     *time.Time   `json:"lastConfirmedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (TxSubject) GetName() string {
+	return "txSubjects"
+}
+
+// ReplayResponse is the response of POST /v2/replay_from_block/:number
+type ReplayResponse struct {
+	Message    string `json:"message"`
+	EVMChainID *Big   `json:"evmChainID"`
+}
+
+// GetID implements the api2go MarshalIdentifier interface
+func (ReplayResponse) GetID() string {
+	return "replayID"
+}
+
+// GetName implements the api2go EntityNamer interface
+func (ReplayResponse) GetName() string {
+	return "replay"
+}
+
+// SetID implements the api2go UnmarshalIdentifier interface
+func (*ReplayResponse) SetID(string) error {
+	return nil
+}
+
+// BumpAllRequest is the request body of POST /v2/keys/eth/:keyID/bump_all.
+// GasPriceOrFeeCapWei is the gas price of legacy transactions and the fee cap
+// of dynamic fee transactions, TipCapWei is only required if there are
+// dynamic fee transactions to bump.
+type BumpAllRequest struct {
+	GasPriceOrFeeCapWei *Big `json:"gasPriceOrFeeCapWei"`
+	TipCapWei           *Big `json:"tipCapWei"`
+	DryRun              bool `json:"dryRun"`
+}
+
+// EthTxBump is what an administrative gas bump did, or would do in a dry run,
+// with a single unconfirmed transaction. The ID is the ID of the transaction.
+type EthTxBump struct {
+	JAID
+	Nonce    int64  `json:"nonce"`
+	TxType   int    `json:"txType"`
+	GasLimit uint64 `json:"gasLimit"`
+	// PreviousPrice and NewPrice are the gas price of legacy transactions,
+	// or the fee cap of dynamic fee transactions
+	PreviousPrice     *Big         `json:"previousPrice"`
+	PreviousTipCap    *Big         `json:"previousTipCap,omitempty"`
+	NewPrice          *Big         `json:"newPrice"`
+	NewTipCap         *Big         `json:"newTipCap,omitempty"`
+	AdditionalCostWei *Big         `json:"additionalCostWei"`
+	Hash              *common.Hash `json:"hash,omitempty"`
+	Status            string       `json:"status"`
+	Error             string       `json:"error,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EthTxBump) GetName() string {
+	return "ethTxBumps"
+}
+
+// updateUpkeepRequest is the request body of
+// PATCH /v2/jobs/:ID/upkeeps/:upkeepID
+type updateUpkeepRequest struct {
+	Paused             *bool `json:"paused,omitempty"`
+	MaxPerformGasPrice *Big  `json:"maxPerformGasPrice,omitempty"`
+}
+
+// Upkeep is an upkeep of a keeper job. The ID is the upkeep ID.
+type Upkeep struct {
+	JAID
+	JobID  int32 `json:"jobID"`
+	Paused bool  `json:"paused"`
+	// MaxPerformGasPrice is in wei, nil if there is no ceiling
+	MaxPerformGasPrice *Big `json:"maxPerformGasPrice"`
+	// GracePeriodBlocks overrides the node's grace period, if set
+	GracePeriodBlocks *int64 `json:"gracePeriodBlocks"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (Upkeep) GetName() string {
+	return "upkeeps"
+}
+
+// UpkeepStats is an upkeep of a keeper job, along with the number of its runs
+// by status
+type UpkeepStats struct {
+	Upkeep
+	LastRunBlockHeight int64      `json:"lastRunBlockHeight"`
+	PendingRuns        int64      `json:"pendingRuns"`
+	SuccessfulRuns     int64      `json:"successfulRuns"`
+	RevertedRuns       int64      `json:"revertedRuns"`
+	FailedRuns         int64      `json:"failedRuns"`
+	LastRunAt          *time.Time `json:"lastRunAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (UpkeepStats) GetName() string {
+	return "upkeepStats"
+}
+
+// UpkeepEligibility explains whether an upkeep of a keeper job is eligible to
+// be performed by the node at a block. Reason says why it is not, if it is
+// not. The ID is the upkeep ID.
+type UpkeepEligibility struct {
+	JAID
+	BlockNumber       int64       `json:"blockNumber"`
+	TurnBlockHash     common.Hash `json:"turnBlockHash"`
+	Paused            bool        `json:"paused"`
+	GracePeriodBlocks int64       `json:"gracePeriodBlocks"`
+	PerformedRecently bool        `json:"performedRecently"`
+	Position          int64       `json:"position"`
+	TurnKeeperIndex   int64       `json:"turnKeeperIndex"`
+	KeeperIndex       int32       `json:"keeperIndex"`
+	Eligible          bool        `json:"eligible"`
+	Reason            string      `json:"reason,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (UpkeepEligibility) GetName() string {
+	return "upkeepEligibilities"
+}
@@ -236,6 +236,18 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:      "pause-upkeep",
+					Usage:     "Stop performing an upkeep of a keeper job, without deleting it",
+					ArgsUsage: "JOB_ID UPKEEP_ID",
+					Action:    client.PauseUpkeep,
+				},
+				{
+					Name:      "unpause-upkeep",
+					Usage:     "Resume performing a paused upkeep of a keeper job",
+					ArgsUsage: "JOB_ID UPKEEP_ID",
+					Action:    client.UnpauseUpkeep,
+				},
 			},
 		},
 		{
@@ -743,6 +755,55 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:   "syncnonce",
+					Usage:  "Fast-forward the next nonce for a key to the on-chain nonce, if the chain is ahead, e.g. because the key was used by an external wallet. The nonce is never moved backwards.",
+					Action: client.SyncNonce,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "address",
+							Usage: "address of the key for which to sync the nonce",
+						},
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "Chain ID for the key. If left blank, ETH_CHAIN_ID will be used.",
+						},
+					},
+				},
+				{
+					Name:   "reservenonce",
+					Usage:  "Reserve the next nonce for a key, so that a transaction can be sent from it by an external wallet without breaking the node's nonce tracking. Prints the reserved nonce.",
+					Action: client.ReserveNonce,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "address",
+							Usage: "address of the key for which to reserve a nonce",
+						},
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "Chain ID for the key. If left blank, ETH_CHAIN_ID will be used.",
+						},
+					},
+				},
+				{
+					Name:   "releasenonce",
+					Usage:  "Release a nonce reserved with reservenonce that was not used. Only possible while it is still the highest nonce for the key and has not been consumed on chain.",
+					Action: client.ReleaseNonce,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "address",
+							Usage: "address of the key for which the nonce was reserved",
+						},
+						cli.Int64Flag{
+							Name:  "nonce",
+							Usage: "the reserved nonce to release",
+						},
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "Chain ID for the key. If left blank, ETH_CHAIN_ID will be used.",
+						},
+					},
+				},
 				{
 					Name:    "start",
 					Aliases: []string{"node", "n"},
@@ -800,6 +861,10 @@ func NewApp(client *Client) *cli.App {
 							Name:  "gasLimit",
 							Usage: "OPTIONAL: gas limit to use for each transaction ",
 						},
+						cli.BoolFlag{
+							Name:  "force",
+							Usage: "OPTIONAL: rebroadcast even if gasPriceWei exceeds the max gas price for the key",
+						},
 					},
 				},
 				{
@@ -926,6 +991,25 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "get information on a specific Ethereum Transaction",
 					Action: client.ShowTransaction,
 				},
+				{
+					Name:   "bump-all",
+					Usage:  "Replace every unconfirmed transaction from node ETH account <address> with a new attempt at the specified gas price (legacy) or fee cap and tip cap (EIP-1559)",
+					Action: client.BumpAllTransactions,
+					Flags: []cli.Flag{
+						cli.Uint64Flag{
+							Name:  "gasPriceWei, g",
+							Usage: "gas price of legacy transactions, and fee cap of EIP-1559 transactions (in Wei)",
+						},
+						cli.Uint64Flag{
+							Name:  "tipCapWei, t",
+							Usage: "tip cap of EIP-1559 transactions (in Wei), required if there are any to bump",
+						},
+						cli.BoolFlag{
+							Name:  "dry-run",
+							Usage: "only report which transactions would be bumped and the estimated additional cost",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -968,6 +1052,37 @@ func NewApp(client *Client) *cli.App {
 								},
 							},
 						},
+						{
+							Name:   "profiles",
+							Usage:  "List the config profiles that can be applied to an EVM chain",
+							Action: client.IndexChainProfiles,
+						},
+						{
+							Name:   "apply-profile",
+							Usage:  "Apply a config profile to an EVM chain, after validating it against the chain",
+							Action: client.ApplyChainProfile,
+							Flags: []cli.Flag{
+								cli.Int64Flag{
+									Name:  "id",
+									Usage: "chain ID",
+								},
+								cli.BoolFlag{
+									Name:  "force",
+									Usage: "apply the profile even if validation finds errors",
+								},
+							},
+						},
+						{
+							Name:   "profile-drift",
+							Usage:  "Show the settings of an EVM chain that were changed away from the config profile applied to it",
+							Action: client.ChainProfileDrift,
+							Flags: []cli.Flag{
+								cli.Int64Flag{
+									Name:  "id",
+									Usage: "chain ID",
+								},
+							},
+						},
 					},
 				},
 			},
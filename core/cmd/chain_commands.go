@@ -10,6 +10,7 @@ import (
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -201,3 +202,128 @@ func (cli *Client) ConfigureChain(c *cli.Context) (err error) {
 	}()
 	return cli.renderAPIResponse(resp, &ChainPresenter{})
 }
+
+type ChainConfigProfilePresenter struct {
+	presenters.ChainConfigProfileResource
+}
+
+func (p *ChainConfigProfilePresenter) ToRow() []string {
+	config, err := json.MarshalIndent(p.Config, "", "    ")
+	if err != nil {
+		panic(err)
+	}
+
+	return []string{
+		p.GetID(),
+		fmt.Sprintf("%d", p.Version),
+		p.Description,
+		string(config),
+	}
+}
+
+type ChainConfigProfilePresenters []ChainConfigProfilePresenter
+
+// RenderTable implements TableRenderer
+func (ps ChainConfigProfilePresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"Name", "Version", "Description", "Config"}
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(headers, rows, rt.Writer)
+
+	return nil
+}
+
+type ChainConfigProfileDriftPresenter struct {
+	presenters.ChainConfigProfileDriftResource
+}
+
+// RenderTable implements TableRenderer
+// Renders a row for every drifted setting
+func (p ChainConfigProfileDriftPresenter) RenderTable(rt RendererTable) error {
+	renderList([]string{"Profile", "Version", "Latest Version", "Applied"}, [][]string{{
+		p.Profile,
+		fmt.Sprintf("%d", p.Version),
+		fmt.Sprintf("%d", p.LatestVersion),
+		p.AppliedAt.String(),
+	}}, rt.Writer)
+
+	headers := []string{"Setting", "Profile", "Current"}
+	rows := [][]string{}
+	for _, d := range p.Drifted {
+		rows = append(rows, []string{d.Setting, fmt.Sprintf("%v", d.Profile), fmt.Sprintf("%v", d.Current)})
+	}
+	renderList(headers, rows, rt.Writer)
+
+	return nil
+}
+
+// IndexChainProfiles lists the config profiles that can be applied to a
+// chain
+func (cli *Client) IndexChainProfiles(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/chain_profiles/evm")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ChainConfigProfilePresenters{})
+}
+
+// ApplyChainProfile expands a config profile into a chain's config
+func (cli *Client) ApplyChainProfile(c *cli.Context) (err error) {
+	chainID := c.Int64("id")
+	if chainID == 0 {
+		return cli.errorOut(errors.New("missing chain ID (usage: chainlink chains evm apply-profile [-id integer] [--force] profile)"))
+	}
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the profile to apply (usage: chainlink chains evm apply-profile [-id integer] [--force] profile)"))
+	}
+
+	body, err := json.Marshal(web.ApplyChainProfileRequest{
+		Name:  c.Args().First(),
+		Force: c.Bool("force"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/chains/evm/%v/profile", chainID), bytes.NewBuffer(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ChainPresenter{})
+}
+
+// ChainProfileDrift shows the settings of a chain that were changed away
+// from the config profile last applied to it
+func (cli *Client) ChainProfileDrift(c *cli.Context) (err error) {
+	chainID := c.Int64("id")
+	if chainID == 0 {
+		return cli.errorOut(errors.New("missing chain ID (usage: chainlink chains evm profile-drift [-id integer])"))
+	}
+
+	resp, err := cli.HTTP.Get(fmt.Sprintf("/v2/chains/evm/%v/profile/drift", chainID))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ChainConfigProfileDriftPresenter{})
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/leader"
 	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -152,6 +153,7 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 	}
 
 	eventBroadcaster := pg.NewEventBroadcaster(cfg.DatabaseURL(), cfg.DatabaseListenerMinReconnectInterval(), cfg.DatabaseListenerMaxReconnectDuration(), appLggr, cfg.AppID())
+	leaderElectors := leader.NewElectors(db, cfg, appLggr)
 	ccOpts := evm.ChainSetOpts{
 		Config:           cfg,
 		Logger:           appLggr,
@@ -159,6 +161,7 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 		ORM:              evm.NewORM(db),
 		KeyStore:         keyStore.Eth(),
 		EventBroadcaster: eventBroadcaster,
+		LeaderElectors:   leaderElectors,
 	}
 	chainSet, err := evm.LoadChainSet(ccOpts)
 	if err != nil {
@@ -174,6 +177,7 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 		EventBroadcaster:         eventBroadcaster,
 		Logger:                   appLggr,
 		ExternalInitiatorManager: externalInitiatorManager,
+		LeaderElectors:           leaderElectors,
 		Version:                  static.Version,
 	})
 }
@@ -377,6 +381,30 @@ func (h *authenticatedHTTPClient) doRequest(verb, path string, body io.Reader, h
 	return response, nil
 }
 
+// sdkDoer sends clientsdk requests through an HTTPClient, so that they are
+// authenticated like every other CLI request
+type sdkDoer struct {
+	HTTPClient
+}
+
+func (d sdkDoer) Do(req *http.Request) (*http.Response, error) {
+	path := req.URL.RequestURI()
+	switch req.Method {
+	case http.MethodGet:
+		return d.Get(path)
+	case http.MethodPost:
+		return d.Post(path, req.Body)
+	case http.MethodPut:
+		return d.Put(path, req.Body)
+	case http.MethodPatch:
+		return d.Patch(path, req.Body)
+	case http.MethodDelete:
+		return d.Delete(path)
+	default:
+		return nil, errors.Errorf("unsupported method %s", req.Method)
+	}
+}
+
 // CookieAuthenticator is the interface to generating a cookie to authenticate
 // future HTTP requests.
 type CookieAuthenticator interface {
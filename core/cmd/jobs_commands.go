@@ -2,15 +2,18 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/web"
 
+	"github.com/smartcontractkit/chainlink/core/clientsdk"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/urfave/cli"
@@ -250,3 +253,52 @@ func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// UpkeepPresenter wraps the JSONAPI Upkeep Resource and adds rendering functionality
+type UpkeepPresenter struct {
+	JAID
+	clientsdk.Upkeep
+}
+
+// RenderTable implements TableRenderer
+func (p *UpkeepPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Job ID", "Upkeep ID", "Paused"})
+	table.Append([]string{
+		strconv.FormatInt(int64(p.JobID), 10),
+		p.ID,
+		strconv.FormatBool(p.Paused),
+	})
+	render("Upkeep", table)
+	return nil
+}
+
+// PauseUpkeep pauses an upkeep of a keeper job
+func (cli *Client) PauseUpkeep(c *cli.Context) error {
+	return cli.setUpkeepPaused(c, true)
+}
+
+// UnpauseUpkeep unpauses an upkeep of a keeper job
+func (cli *Client) UnpauseUpkeep(c *cli.Context) error {
+	return cli.setUpkeepPaused(c, false)
+}
+
+func (cli *Client) setUpkeepPaused(c *cli.Context, paused bool) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the job id and the upkeep id"))
+	}
+	upkeepID, err := strconv.ParseInt(c.Args().Get(1), 10, 64)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid upkeep id"))
+	}
+	keepers := cli.sdk().Keepers()
+	var upkeep *clientsdk.Upkeep
+	if paused {
+		upkeep, err = keepers.Pause(context.Background(), c.Args().Get(0), upkeepID)
+	} else {
+		upkeep, err = keepers.Unpause(context.Background(), c.Args().Get(0), upkeepID)
+	}
+	if err != nil {
+		return cli.sdkErrorOut(err)
+	}
+	return cli.errorOut(cli.Render(&UpkeepPresenter{JAID: JAID{ID: upkeep.ID}, Upkeep: *upkeep}))
+}
@@ -3,13 +3,17 @@ package cmd_test
 import (
 	"bytes"
 	"flag"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
@@ -386,6 +390,48 @@ func TestClient_DeleteJob(t *testing.T) {
 	requireJobsCount(t, app.JobORM(), 0)
 }
 
+func TestClient_PauseUpkeep(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withConfigSet(func(c *configtest.TestGeneralConfig) {
+		c.Overrides.EVMDisabled = null.BoolFrom(false)
+		c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+		c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+		c.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	}))
+	client, r := app.NewClientAndRenderer()
+
+	db := app.GetSqlxDB()
+	cfg := evmtest.NewChainScopedConfig(t, app.Config)
+	korm := keeper.NewORM(db, logger.TestLogger(t), nil, cfg, nil)
+	registry, keeperJob := cltest.MustInsertKeeperRegistry(t, db, korm, app.KeyStore.Eth())
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, cfg, registry)
+
+	// Must supply job id and upkeep id
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{strconv.Itoa(int(keeperJob.ID))})
+	c := cli.NewContext(nil, set, nil)
+	require.Equal(t, "must pass the job id and the upkeep id", client.PauseUpkeep(c).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{strconv.Itoa(int(keeperJob.ID)), strconv.FormatInt(upkeep.UpkeepID, 10)})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.PauseUpkeep(c))
+	require.Len(t, r.Renders, 1)
+	assert.True(t, r.Renders[0].(*cmd.UpkeepPresenter).Paused)
+
+	var paused bool
+	require.NoError(t, db.Get(&paused, `SELECT paused FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+	assert.True(t, paused)
+
+	require.NoError(t, client.UnpauseUpkeep(c))
+	require.Len(t, r.Renders, 2)
+	assert.False(t, r.Renders[1].(*cmd.UpkeepPresenter).Paused)
+
+	require.NoError(t, db.Get(&paused, `SELECT paused FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+	assert.False(t, paused)
+}
+
 func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	jobs, _, err := orm.FindJobs(0, 1000)
 	require.NoError(t, err)
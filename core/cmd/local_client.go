@@ -332,6 +332,7 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) (err error) {
 	overrideGasLimit := c.Uint64("gasLimit")
 	addressHex := c.String("address")
 	chainIDStr := c.String("evmChainID")
+	force := c.Bool("force")
 
 	addressBytes, err := hexutil.Decode(addressHex)
 	if err != nil {
@@ -373,6 +374,9 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) (err error) {
 	if err != nil {
 		return cli.errorOut(err)
 	}
+	if max := chain.Config().KeySpecificMaxGasPriceWei(address); new(big.Int).SetUint64(gasPriceWei).Cmp(max) > 0 && !force {
+		return cli.errorOut(errors.Errorf("gas price %d wei exceeds the max gas price of %s wei for key %s, use --force to rebroadcast anyway", gasPriceWei, max.String(), address.Hex()))
+	}
 	keyStore := app.GetKeyStore()
 
 	ethClient := chain.Client()
@@ -393,7 +397,7 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) (err error) {
 	if err != nil {
 		return cli.errorOut(err)
 	}
-	ec := bulletprooftxmanager.NewEthConfirmer(app.GetSqlxDB(), ethClient, chain.Config(), keyStore.Eth(), keyStates, nil, nil, chain.Logger())
+	ec := bulletprooftxmanager.NewEthConfirmer(app.GetSqlxDB(), ethClient, chain.Config(), keyStore.Eth(), keyStates, nil, nil, nil, chain.Logger())
 	err = ec.ForceRebroadcast(beginningNonce, endingNonce, gasPriceWei, address, overrideGasLimit)
 	return cli.errorOut(err)
 }
@@ -791,3 +795,111 @@ func (cli *Client) SetNextNonce(c *clipkg.Context) error {
 	}
 	return nil
 }
+
+// ReserveNonce reserves the next nonce for a key, so that it can be used to
+// send a transaction from outside of the node without corrupting the node's
+// nonce tracking
+func (cli *Client) ReserveNonce(c *clipkg.Context) error {
+	address, chainID, err := cli.nonceReservationArgs(c)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	lggr := cli.Logger.Named("ReserveNonce")
+	db, err := newConnection(cli.Config, lggr)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer lggr.ErrorIfClosing(db, "db")
+
+	nonce, err := bulletprooftxmanager.ReserveNonce(pg.NewQ(db, lggr, cli.Config), address, chainID)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	fmt.Printf("Reserved nonce %d for key %s on chain %s. Use `chainlink node releasenonce` to release it if it is not used.\n", nonce, address.Hex(), chainID.String())
+	return nil
+}
+
+// ReleaseNonce releases a nonce reserved with ReserveNonce that was not used
+func (cli *Client) ReleaseNonce(c *clipkg.Context) error {
+	address, chainID, err := cli.nonceReservationArgs(c)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	if !c.IsSet("nonce") {
+		return cli.errorOut(errors.New("must pass the reserved --nonce to release"))
+	}
+	nonce := c.Int64("nonce")
+
+	lggr := cli.Logger.Named("ReleaseNonce")
+	db, err := newConnection(cli.Config, lggr)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer lggr.ErrorIfClosing(db, "db")
+
+	if err := bulletprooftxmanager.ReleaseNonce(pg.NewQ(db, lggr, cli.Config), address, chainID, nonce); err != nil {
+		return cli.errorOut(err)
+	}
+	fmt.Printf("Released nonce %d for key %s on chain %s\n", nonce, address.Hex(), chainID.String())
+	return nil
+}
+
+// SyncNonce fast-forwards the next nonce of a key to the on-chain nonce, if
+// the chain is ahead, e.g. because the key was used by an external wallet
+func (cli *Client) SyncNonce(c *clipkg.Context) (err error) {
+	address, chainID, err := cli.nonceReservationArgs(c)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	lggr := cli.Logger.Named("SyncNonce")
+	db, err := openDB(cli.Config, lggr)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "opening db"))
+	}
+	defer lggr.ErrorIfClosing(db, "db")
+
+	app, err := cli.AppFactory.NewApplication(cli.Config, db, shutdown.NewSignal())
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "creating application"))
+	}
+	defer func() {
+		if serr := app.Stop(); serr != nil {
+			err = multierr.Append(err, serr)
+		}
+	}()
+	chain, err := app.GetChainSet().Get(chainID)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	ethClient := chain.Client()
+	if err = ethClient.Dial(context.TODO()); err != nil {
+		return cli.errorOut(err)
+	}
+
+	txm := bulletprooftxmanager.NewBulletproofTxManager(app.GetSqlxDB(), ethClient, chain.Config(), app.GetKeyStore().Eth(), nil, chain.Logger())
+	if err = txm.SyncNonce(context.TODO(), address); err != nil {
+		return cli.errorOut(err)
+	}
+	fmt.Printf("Synced nonce for key %s on chain %s\n", address.Hex(), chainID.String())
+	return nil
+}
+
+func (cli *Client) nonceReservationArgs(c *clipkg.Context) (address gethCommon.Address, chainID *big.Int, err error) {
+	addressBytes, err := hexutil.Decode(c.String("address"))
+	if err != nil {
+		return address, nil, errors.Wrap(err, "could not decode address")
+	}
+	address = gethCommon.BytesToAddress(addressBytes)
+
+	chainID = cli.Config.DefaultChainID()
+	if c.IsSet("evmChainID") {
+		var ok bool
+		chainID, ok = big.NewInt(0).SetString(c.String("evmChainID"), 10)
+		if !ok {
+			return address, nil, errors.Errorf("invalid evmChainID: %s", c.String("evmChainID"))
+		}
+	}
+	return address, chainID, nil
+}
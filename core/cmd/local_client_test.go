@@ -125,6 +125,7 @@ KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH: 1
 KEEPER_GAS_PRICE_BUFFER_PERCENT: 20
 KEEPER_GAS_TIP_CAP_BUFFER_PERCENT: 20
 KEEPER_MAXIMUM_GRACE_PERIOD: 0
+KEEPER_MINIMUM_BALANCE_BUFFER_PERCENT: 0
 KEEPER_REGISTRY_CHECK_GAS_OVERHEAD: 0
 KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD: 0
 KEEPER_REGISTRY_SYNC_INTERVAL: 
@@ -513,6 +514,62 @@ func TestClient_RebroadcastTransactions_OutsideRange_BPTXM(t *testing.T) {
 	}
 }
 
+func TestClient_RebroadcastTransactions_AboveMaxGasPrice_BPTXM(t *testing.T) {
+	config, sqlxDB := heavyweight.FullTestDB(t, "rebroadcasttransactions_abovemax", true, true)
+	keyStore := cltest.NewKeyStore(t, sqlxDB, config)
+	_, fromAddress := cltest.MustInsertRandomKey(t, keyStore.Eth(), 0)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, config)
+	gasPrice := new(big.Int).Add(evmcfg.EvmMaxGasPriceWei(), big.NewInt(1))
+
+	newContext := func(force bool) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.Bool("debug", true, "")
+		set.Uint("beginningNonce", 0, "")
+		set.Uint("endingNonce", 0, "")
+		set.Uint64("gasPriceWei", gasPrice.Uint64(), "")
+		set.String("address", fromAddress.Hex(), "")
+		set.String("password", "../internal/fixtures/correct_password.txt", "")
+		set.Bool("force", force, "")
+		return cli.NewContext(nil, set, nil)
+	}
+
+	app := new(mocks.Application)
+	app.Test(t)
+	app.On("GetSqlxDB").Return(sqlxDB).Maybe()
+	app.On("GetKeyStore").Return(keyStore).Maybe()
+	app.On("Stop").Return(nil)
+	app.On("ID").Maybe().Return(uuid.NewV4())
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("Dial", mock.Anything).Return(nil).Maybe()
+	app.On("GetChainSet").Return(cltest.NewChainSetMockWithOneChain(t, ethClient, evmcfg))
+
+	client := cmd.Client{
+		Config:                 config,
+		Logger:                 logger.TestLogger(t),
+		AppFactory:             cltest.InstanceAppFactory{App: app},
+		FallbackAPIInitializer: cltest.NewMockAPIInitializer(t),
+		Runner:                 cltest.EmptyRunner{},
+	}
+
+	t.Run("refuses without --force", func(t *testing.T) {
+		err := client.RebroadcastTransactions(newContext(false))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exceeds the max gas price")
+	})
+
+	t.Run("rebroadcasts with --force", func(t *testing.T) {
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && tx.GasPrice().Cmp(gasPrice) == 0
+		})).Once().Return(nil)
+
+		require.NoError(t, client.RebroadcastTransactions(newContext(true)))
+	})
+
+	app.AssertExpectations(t)
+	ethClient.AssertExpectations(t)
+}
+
 func TestClient_SetNextNonce(t *testing.T) {
 	// Need to use separate database
 	config, sqlxDB := heavyweight.FullTestDB(t, "setnextnonce", true, true)
@@ -539,3 +596,41 @@ func TestClient_SetNextNonce(t *testing.T) {
 	require.NotNil(t, state.NextNonce)
 	require.Equal(t, int64(42), state.NextNonce)
 }
+
+func TestClient_ReserveNonce_ReleaseNonce(t *testing.T) {
+	// Need to use separate database
+	config, sqlxDB := heavyweight.FullTestDB(t, "reservenonce", true, true)
+	ethKeyStore := cltest.NewKeyStore(t, sqlxDB, config).Eth()
+
+	client := cmd.Client{
+		Config: config,
+		Logger: logger.TestLogger(t),
+		Runner: cltest.EmptyRunner{},
+	}
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 7)
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("address", fromAddress.Hex(), "")
+	set.String("evmChainID", cltest.FixtureChainID.String(), "")
+	set.Int64("nonce", 7, "")
+	require.NoError(t, set.Set("evmChainID", cltest.FixtureChainID.String()))
+	c := cli.NewContext(nil, set, nil)
+
+	require.NoError(t, client.ReserveNonce(c))
+
+	var state ethkey.State
+	require.NoError(t, sqlxDB.Get(&state, `SELECT * FROM eth_key_states`))
+	require.Equal(t, int64(8), state.NextNonce)
+	cltest.AssertCount(t, sqlxDB, "eth_txes", 1)
+
+	require.NoError(t, set.Set("nonce", "7"))
+	require.NoError(t, client.ReleaseNonce(c))
+
+	require.NoError(t, sqlxDB.Get(&state, `SELECT * FROM eth_key_states`))
+	require.Equal(t, int64(7), state.NextNonce)
+	cltest.AssertCount(t, sqlxDB, "eth_txes", 0)
+
+	// Nothing left to release
+	require.Error(t, client.ReleaseNonce(c))
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -21,6 +22,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/clientsdk"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -127,29 +129,11 @@ func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 		return cli.errorOut(errors.New("Must pass a positive value in '--block-number' parameter"))
 	}
 
-	buf := bytes.NewBufferString("{}")
-
-	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/replay_from_block/%v", blockNumber), buf)
+	response, err := cli.sdk().Txs().Replay(context.Background(), blockNumber, nil)
 	if err != nil {
-		return cli.errorOut(err)
+		return cli.sdkErrorOut(err)
 	}
-
-	defer func() {
-		if cerr := resp.Body.Close(); cerr != nil {
-			err = multierr.Append(err, cerr)
-		}
-	}()
-
-	if resp.StatusCode != http.StatusOK {
-		bytes, err2 := cli.parseResponse(resp)
-		if err2 != nil {
-			return errors.Wrap(err2, "parseResponse error")
-		}
-		return cli.errorOut(errors.New(string(bytes)))
-	}
-
-	err = cli.printResponseBody(resp)
-	return err
+	return cli.errorOut(cli.Render(response))
 }
 
 // RemoteLogin creates a cookie session to run remote commands.
@@ -233,6 +217,18 @@ func (cli *Client) parseResponse(resp *http.Response) ([]byte, error) {
 	return b, err
 }
 
+// sdk returns a clientsdk.Client which sends its requests through cli.HTTP
+func (cli *Client) sdk() *clientsdk.Client {
+	return clientsdk.New("", clientsdk.WithDoer(sdkDoer{cli.HTTP}))
+}
+
+func (cli *Client) sdkErrorOut(err error) error {
+	if errors.Is(err, clientsdk.ErrUnauthorized) {
+		err = multierr.Append(err, fmt.Errorf("your credentials may be missing, invalid or you may need to login first using the CLI via 'chainlink admin login'"))
+	}
+	return cli.errorOut(err)
+}
+
 func (cli *Client) printResponseBody(resp *http.Response) error {
 	b, err := parseResponse(resp)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/olekukonko/tablewriter"
 
+	"github.com/smartcontractkit/chainlink/core/clientsdk"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/config/envvar"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -61,6 +62,8 @@ func (rt RendererTable) Render(v interface{}, headers ...string) error {
 		return rt.renderExternalInitiatorAuthentication(*typed)
 	case *web.ConfigPatchResponse:
 		return rt.renderConfigPatchResponse(typed)
+	case *clientsdk.ReplayResponse:
+		return rt.renderReplayResponse(typed)
 	case *config.ConfigPrinter:
 		return rt.renderConfiguration(*typed)
 	case *webpresenters.PipelineRunResource:
@@ -217,6 +220,16 @@ func (rt RendererTable) renderConfigPatchResponse(config *web.ConfigPatchRespons
 	return nil
 }
 
+func (rt RendererTable) renderReplayResponse(replay *clientsdk.ReplayResponse) error {
+	table := rt.newTable([]string{"Message", "EVM Chain ID"})
+	table.Append([]string{
+		replay.Message,
+		replay.EVMChainID.String(),
+	})
+	render("Replay", table)
+	return nil
+}
+
 func (rt RendererTable) renderPipelineRun(run webpresenters.PipelineRunResource) error {
 	table := rt.newTable([]string{"ID", "Created At", "Finished At"})
 
@@ -1,22 +1,23 @@
 package cmd
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
+	"math/big"
+	"strconv"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
-	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/clientsdk"
 	"github.com/smartcontractkit/chainlink/core/utils"
-	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 )
 
 type EthTxPresenter struct {
 	JAID
-	presenters.EthTxResource
+	clientsdk.EthTx
 }
 
 // RenderTable implements TableRenderer
@@ -64,19 +65,11 @@ func (cli *Client) ShowTransaction(c *cli.Context) (err error) {
 	if !c.Args().Present() {
 		return cli.errorOut(errors.New("must pass the hash of the transaction"))
 	}
-	hash := c.Args().First()
-	resp, err := cli.HTTP.Get("/v2/transactions/" + hash)
+	tx, err := cli.sdk().Txs().Status(context.Background(), c.Args().First())
 	if err != nil {
-		return cli.errorOut(err)
+		return cli.sdkErrorOut(err)
 	}
-	defer func() {
-		if cerr := resp.Body.Close(); cerr != nil {
-			err = multierr.Append(err, cerr)
-		}
-	}()
-
-	err = cli.renderAPIResponse(resp, &EthTxPresenter{})
-	return err
+	return cli.errorOut(cli.Render(&EthTxPresenter{JAID: JAID{ID: tx.ID}, EthTx: *tx}))
 }
 
 // IndexTxAttempts returns the list of transactions in descending order,
@@ -113,29 +106,88 @@ func (cli *Client) SendEther(c *cli.Context) (err error) {
 				unparsedDestinationAddress), err))
 	}
 
-	request := models.SendEtherRequest{
+	request := clientsdk.SendEtherRequest{
 		DestinationAddress: destinationAddress,
 		FromAddress:        fromAddress,
-		Amount:             amount,
+		Amount:             *clientsdk.NewBig(amount.ToInt()),
 	}
 
-	requestData, err := json.Marshal(request)
+	tx, err := cli.sdk().Txs().Create(context.Background(), request)
 	if err != nil {
-		return cli.errorOut(err)
+		return cli.sdkErrorOut(err)
+	}
+	return cli.errorOut(cli.Render(&EthTxPresenter{JAID: JAID{ID: tx.ID}, EthTx: *tx}))
+}
+
+type EthTxBumpPresenters []clientsdk.EthTxBump
+
+// RenderTable implements TableRenderer
+func (ps EthTxBumpPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Nonce", "Type", "Gas Limit", "Previous Price", "New Price", "Additional Cost (Wei)", "Status", "Error"})
+	total := new(big.Int)
+	for _, p := range ps {
+		var additionalCost string
+		if p.AdditionalCostWei != nil {
+			additionalCost = p.AdditionalCostWei.String()
+			if status := bulletprooftxmanager.BumpStatus(p.Status); status == bulletprooftxmanager.BumpStatusBumped || status == bulletprooftxmanager.BumpStatusWouldBump {
+				total.Add(total, p.AdditionalCostWei.ToInt())
+			}
+		}
+		table.Append([]string{
+			p.ID,
+			strconv.FormatInt(p.Nonce, 10),
+			strconv.Itoa(p.TxType),
+			strconv.FormatUint(p.GasLimit, 10),
+			formatBumpPrice(p.PreviousPrice, p.PreviousTipCap),
+			formatBumpPrice(p.NewPrice, p.NewTipCap),
+			additionalCost,
+			p.Status,
+			p.Error,
+		})
 	}
 
-	buf := bytes.NewBuffer(requestData)
+	render(fmt.Sprintf("Transaction Bumps (total additional cost: %s wei)", total.String()), table)
+	return nil
+}
 
-	resp, err := cli.HTTP.Post("/v2/transfers", buf)
+// formatBumpPrice formats a gas price, or a fee cap and tip cap
+func formatBumpPrice(price, tipCap *clientsdk.Big) string {
+	if price == nil {
+		return ""
+	}
+	if tipCap == nil {
+		return price.String()
+	}
+	return fmt.Sprintf("%s (tip %s)", price.String(), tipCap.String())
+}
+
+// BumpAllTransactions replaces every unconfirmed transaction of the given key
+// with a new attempt at the given pricing, or with --dry-run only reports
+// what would be bumped
+func (cli *Client) BumpAllTransactions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the address of the key"))
+	}
+	address, err := utils.ParseEthereumAddress(c.Args().First())
 	if err != nil {
-		return cli.errorOut(err)
+		return cli.errorOut(multierr.Combine(
+			fmt.Errorf("while parsing address %v", c.Args().First()), err))
+	}
+	if c.Uint64("gasPriceWei") == 0 {
+		return cli.errorOut(errors.New("must pass --gasPriceWei"))
+	}
+
+	request := clientsdk.BumpAllRequest{
+		GasPriceOrFeeCapWei: clientsdk.NewBig(new(big.Int).SetUint64(c.Uint64("gasPriceWei"))),
+		DryRun:              c.Bool("dry-run"),
+	}
+	if c.IsSet("tipCapWei") {
+		request.TipCapWei = clientsdk.NewBig(new(big.Int).SetUint64(c.Uint64("tipCapWei")))
 	}
-	defer func() {
-		if cerr := resp.Body.Close(); cerr != nil {
-			err = multierr.Append(err, cerr)
-		}
-	}()
 
-	err = cli.renderAPIResponse(resp, &EthTxPresenter{})
-	return err
+	results, err := cli.sdk().Txs().BumpAll(context.Background(), address.Hex(), request)
+	if err != nil {
+		return cli.sdkErrorOut(err)
+	}
+	return cli.errorOut(cli.Render(EthTxBumpPresenters(results)))
 }
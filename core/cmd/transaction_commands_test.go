@@ -2,6 +2,7 @@ package cmd_test
 
 import (
 	"flag"
+	"strconv"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
@@ -140,3 +141,50 @@ func TestClient_SendEther_From_BPTXM(t *testing.T) {
 	assert.Equal(t, &etx.ToAddress, output.To)
 	assert.Equal(t, etx.Value.String(), output.Value)
 }
+
+func TestClient_BumpAllTransactions_DryRun(t *testing.T) {
+	t.Parallel()
+
+	ethMock, assertMocksCalled := newEthMock(t)
+	defer assertMocksCalled()
+	app := startNewApplication(t,
+		withKey(),
+		withMocks(ethMock),
+		withConfigSet(func(c *configtest.TestGeneralConfig) {
+			c.Overrides.EVMDisabled = null.BoolFrom(false)
+			c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+			c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+		}),
+	)
+	client, r := app.NewClientAndRenderer()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	legacyEtx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, app.BPTXMORM(), 0, fromAddress)
+	dynamicEtx := cltest.MustInsertUnconfirmedEthTxWithBroadcastDynamicFeeAttempt(t, app.BPTXMORM(), 1, fromAddress)
+
+	set := flag.NewFlagSet("bump-all", 0)
+	set.Uint64("gasPriceWei", 0, "")
+	set.Uint64("tipCapWei", 0, "")
+	set.Bool("dry-run", false, "")
+	require.NoError(t, set.Parse([]string{"--gasPriceWei", "20000000000", "--tipCapWei", "10000000000", "--dry-run", fromAddress.Hex()}))
+	c := cli.NewContext(cli.NewApp(), set, nil)
+
+	require.NoError(t, client.BumpAllTransactions(c))
+
+	output := r.Renders[0].(cmd.EthTxBumpPresenters)
+	require.Len(t, output, 2)
+	assert.Equal(t, strconv.FormatInt(legacyEtx.ID, 10), output[0].ID)
+	assert.Equal(t, "would_bump", output[0].Status)
+	assert.Equal(t, "20000000000", output[0].NewPrice.String())
+	assert.Nil(t, output[0].NewTipCap)
+	assert.Equal(t, "839999999958", output[0].AdditionalCostWei.String())
+	assert.Equal(t, strconv.FormatInt(dynamicEtx.ID, 10), output[1].ID)
+	assert.Equal(t, "would_bump", output[1].Status)
+	assert.Equal(t, 2, output[1].TxType)
+	assert.Equal(t, "10000000000", output[1].NewTipCap.String())
+
+	// nothing was sent
+	etx, err := app.BPTXMORM().FindEthTxWithAttempts(legacyEtx.ID)
+	require.NoError(t, err)
+	assert.Len(t, etx.EthTxAttempts, 1)
+}
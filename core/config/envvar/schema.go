@@ -50,6 +50,9 @@ type ConfigSchema struct {
 	DatabaseLockingMode       string        `env:"DATABASE_LOCKING_MODE" default:"dual"`
 	LeaseLockDuration         time.Duration `env:"LEASE_LOCK_DURATION" default:"10s"`
 	LeaseLockRefreshInterval  time.Duration `env:"LEASE_LOCK_REFRESH_INTERVAL" default:"1s"`
+	// Leader Election
+	LeaderElectionCheckInterval time.Duration `env:"LEADER_ELECTION_CHECK_INTERVAL" default:"1s"`
+	LeaderElectionMode          string        `env:"LEADER_ELECTION_MODE" default:"none"`
 	// Database Autobackups
 	DatabaseBackupDir       string        `env:"DATABASE_BACKUP_DIR"`
 	DatabaseBackupFrequency time.Duration `env:"DATABASE_BACKUP_FREQUENCY" default:"1h"`
@@ -103,47 +106,91 @@ type ConfigSchema struct {
 	DefaultChainID   *big.Int `env:"ETH_CHAIN_ID"`
 	EVMDisabled      bool     `env:"EVM_DISABLED" default:"false"`
 	EthereumDisabled bool     `env:"ETH_DISABLED" default:"false"`
+	// Insufficient eth handling
+	EthTxFundsRecoveryBatchSize     uint32        `env:"ETH_TX_FUNDS_RECOVERY_BATCH_SIZE" default:"100"`
+	EthTxFundsRecoveryCheckInterval time.Duration `env:"ETH_TX_FUNDS_RECOVERY_CHECK_INTERVAL" default:"1m"`
+	EthTxInsufficientEthBackoffMax  time.Duration `env:"ETH_TX_INSUFFICIENT_ETH_BACKOFF_MAX" default:"5m"`
+	EthTxInsufficientEthMode        string        `env:"ETH_TX_INSUFFICIENT_ETH_MODE" default:"retry"`
+	EthTxMaxFeeMode                 string        `env:"ETH_TX_MAX_FEE_MODE" default:"clamp"`
+	// Stuck in_progress handling
+	EthTxInProgressResolutionPolicy string `env:"ETH_TX_IN_PROGRESS_RESOLUTION_POLICY" default:"resend"`
+	// Duplicate broadcaster detection
+	EthTxDuplicateInstancePolicy string `env:"ETH_TX_DUPLICATE_INSTANCE_POLICY" default:"warn"`
+	// Transaction notifications
+	EthTxBumpDigestInterval time.Duration `env:"ETH_TX_BUMP_DIGEST_INTERVAL" default:"1m"`
+	EthTxFailureWebhookURL  *url.URL      `env:"ETH_TX_FAILURE_WEBHOOK_URL"`
+	// Transaction outcome export
+	EthTxOutcomeKafkaURL   *url.URL `env:"ETH_TX_OUTCOME_KAFKA_URL"`
+	EthTxOutcomeKafkaTopic string   `env:"ETH_TX_OUTCOME_KAFKA_TOPIC" default:"chainlink-tx-outcomes"`
+	// Pipeline resumption
+	EthTxResumeBatchSize uint32 `env:"ETH_TX_RESUME_BATCH_SIZE" default:"100"`
+	// Shutdown
+	EthTxDrainTimeout time.Duration `env:"ETH_TX_DRAIN_TIMEOUT" default:"10s"`
 	// Per-chain overrides
 	BalanceMonitorEnabled             bool          `env:"BALANCE_MONITOR_ENABLED"`
+	BalanceMonitorTokens              string        `env:"BALANCE_MONITOR_TOKENS"`
 	BlockBackfillDepth                uint64        `env:"BLOCK_BACKFILL_DEPTH" default:"10"`
 	BlockBackfillSkip                 bool          `env:"BLOCK_BACKFILL_SKIP" default:"false"`
 	BlockEmissionIdleWarningThreshold time.Duration `env:"BLOCK_EMISSION_IDLE_WARNING_THRESHOLD"` //nodoc
+	EthTxDeadlineBoostCurve           string        `env:"ETH_TX_DEADLINE_BOOST_CURVE"`
 	EthTxReaperInterval               time.Duration `env:"ETH_TX_REAPER_INTERVAL"`
 	EthTxReaperThreshold              time.Duration `env:"ETH_TX_REAPER_THRESHOLD"`
 	EthTxResendAfterThreshold         time.Duration `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
+	EvmBroadcastMaxInitialBumps       uint32        `env:"ETH_BROADCAST_MAX_INITIAL_BUMPS"`
+	EvmBroadcastPollJitterDisabled    bool          `env:"ETH_BROADCAST_POLL_JITTER_DISABLED"`
+	EvmChainHaltThreshold             time.Duration `env:"ETH_CHAIN_HALT_THRESHOLD"`
 	EvmFinalityDepth                  uint32        `env:"ETH_FINALITY_DEPTH"`
+	EvmGasLimitLearningEnabled        bool          `env:"ETH_GAS_LIMIT_LEARNING_ENABLED"`
+	EvmGasLimitLearningMarginPercent  uint16        `env:"ETH_GAS_LIMIT_LEARNING_MARGIN_PERCENT"`
+	EvmGasLimitLearningMin            uint64        `env:"ETH_GAS_LIMIT_LEARNING_MIN"`
 	EvmHeadTrackerHistoryDepth        uint          `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
 	EvmHeadTrackerMaxBufferSize       uint          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
 	EvmHeadTrackerSamplingInterval    time.Duration `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL"`
+	EvmInProgressTxAlertThreshold     time.Duration `env:"ETH_IN_PROGRESS_TX_ALERT_THRESHOLD"`
+	EvmKeyIdleTimeout                 time.Duration `env:"ETH_KEY_IDLE_TIMEOUT"`
 	EvmLogBackfillBatchSize           uint32        `env:"ETH_LOG_BACKFILL_BATCH_SIZE"`
+	EvmMaxInProgressAge               time.Duration `env:"ETH_MAX_IN_PROGRESS_AGE"`
+	EvmMaxNonceHoles                  uint32        `env:"ETH_MAX_NONCE_HOLES"`
+	EvmMulticallAddress               string        `env:"ETH_MULTICALL_ADDRESS"`
+	EvmNonceAutoSyncInterval          time.Duration `env:"ETH_NONCE_AUTO_SYNC_INTERVAL"`
+	EvmRejectSelfTransactions         bool          `env:"ETH_REJECT_SELF_TRANSACTIONS"`
 	EvmRPCDefaultBatchSize            uint32        `env:"ETH_RPC_DEFAULT_BATCH_SIZE"`
 	LinkContractAddress               string        `env:"LINK_CONTRACT_ADDRESS"`
 	MinIncomingConfirmations          uint32        `env:"MIN_INCOMING_CONFIRMATIONS"`
 	MinRequiredOutgoingConfirmations  uint64        `env:"MIN_OUTGOING_CONFIRMATIONS"`
 	MinimumContractPayment            assets.Link   `env:"MINIMUM_CONTRACT_PAYMENT_LINK_JUELS"`
 	// EVM Gas Controls
-	EvmEIP1559DynamicFees      bool     `env:"EVM_EIP1559_DYNAMIC_FEES"`
-	EvmGasBumpPercent          uint16   `env:"ETH_GAS_BUMP_PERCENT"`
-	EvmGasBumpThreshold        uint64   `env:"ETH_GAS_BUMP_THRESHOLD"`
-	EvmGasBumpTxDepth          uint16   `env:"ETH_GAS_BUMP_TX_DEPTH"`
-	EvmGasBumpWei              *big.Int `env:"ETH_GAS_BUMP_WEI"`
-	EvmGasLimitDefault         uint64   `env:"ETH_GAS_LIMIT_DEFAULT"`
-	EvmGasLimitMultiplier      float32  `env:"ETH_GAS_LIMIT_MULTIPLIER"`
-	EvmGasLimitTransfer        uint64   `env:"ETH_GAS_LIMIT_TRANSFER"`
-	EvmGasPriceDefault         *big.Int `env:"ETH_GAS_PRICE_DEFAULT"`
-	EvmGasTipCapDefault        *big.Int `env:"EVM_GAS_TIP_CAP_DEFAULT"`
-	EvmGasTipCapMinimum        *big.Int `env:"EVM_GAS_TIP_CAP_MINIMUM"`
-	EvmMaxGasPriceWei          *big.Int `env:"ETH_MAX_GAS_PRICE_WEI"`
-	EvmMaxInFlightTransactions uint32   `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
-	EvmMaxQueuedTransactions   uint64   `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
-	EvmMinGasPriceWei          *big.Int `env:"ETH_MIN_GAS_PRICE_WEI"`
-	EvmNonceAutoSync           bool     `env:"ETH_NONCE_AUTO_SYNC"`
+	EvmEIP1559DynamicFees        bool     `env:"EVM_EIP1559_DYNAMIC_FEES"`
+	EvmGasBumpPercent            uint16   `env:"ETH_GAS_BUMP_PERCENT"`
+	EvmGasBumpStrategy           string   `env:"ETH_GAS_BUMP_STRATEGY"`
+	EvmGasBumpThreshold          uint64   `env:"ETH_GAS_BUMP_THRESHOLD"`
+	EvmGasBumpTxDepth            uint16   `env:"ETH_GAS_BUMP_TX_DEPTH"`
+	EvmGasBumpWei                *big.Int `env:"ETH_GAS_BUMP_WEI"`
+	EvmGasLimitDefault           uint64   `env:"ETH_GAS_LIMIT_DEFAULT"`
+	EvmGasLimitMax               uint64   `env:"ETH_GAS_LIMIT_MAX"`
+	EvmGasLimitMultiplier        float32  `env:"ETH_GAS_LIMIT_MULTIPLIER"`
+	EvmGasLimitTransfer          uint64   `env:"ETH_GAS_LIMIT_TRANSFER"`
+	EvmGasPriceDefault           *big.Int `env:"ETH_GAS_PRICE_DEFAULT"`
+	EvmGasTipCapDefault          *big.Int `env:"EVM_GAS_TIP_CAP_DEFAULT"`
+	EvmGasTipCapMinimum          *big.Int `env:"EVM_GAS_TIP_CAP_MINIMUM"`
+	EvmMaxGasPriceWei            *big.Int `env:"ETH_MAX_GAS_PRICE_WEI"`
+	EvmMaxGasPriceExceededPolicy string   `env:"ETH_MAX_GAS_PRICE_EXCEEDED_POLICY"`
+	EvmMaxInFlightTransactions   uint32   `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
+	EvmMaxInFlightValueWei       *big.Int `env:"ETH_MAX_IN_FLIGHT_VALUE_WEI"`
+	EvmMaxQueuedTransactions     uint64   `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
+	EvmMinGasPriceWei            *big.Int `env:"ETH_MIN_GAS_PRICE_WEI"`
+	EvmNonceAutoSync             bool     `env:"ETH_NONCE_AUTO_SYNC"`
+	EvmTxQueueOrdering           string   `env:"ETH_TX_QUEUE_ORDERING"`
+	EvmTxQueueTiebreak           string   `env:"ETH_TX_QUEUE_TIEBREAK"`
 	// Gas Estimation
 	GasEstimatorMode                           string `env:"GAS_ESTIMATOR_MODE"`
 	BlockHistoryEstimatorBatchSize             uint32 `env:"BLOCK_HISTORY_ESTIMATOR_BATCH_SIZE"`
 	BlockHistoryEstimatorBlockDelay            uint16 `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_DELAY"`
 	BlockHistoryEstimatorBlockHistorySize      uint16 `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE"`
 	BlockHistoryEstimatorTransactionPercentile uint16 `env:"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE"`
+	GasEstimatorOwnConfirmationsBlockWindow    uint16 `env:"GAS_ESTIMATOR_OWN_CONFIRMATIONS_BLOCK_WINDOW"`
+	GasEstimatorOwnConfirmationsMinBlocks      uint16 `env:"GAS_ESTIMATOR_OWN_CONFIRMATIONS_MIN_BLOCKS"`
+	GasEstimatorRecordInputs                   bool   `env:"GAS_ESTIMATOR_RECORD_INPUTS"`
 
 	// Job Pipeline and tasks
 	DefaultHTTPAllowUnrestrictedNetworkAccess bool            `env:"DEFAULT_HTTP_ALLOW_UNRESTRICTED_NETWORK_ACCESS" default:"false"`
@@ -226,10 +273,14 @@ type ConfigSchema struct {
 	KeeperGasPriceBufferPercent        uint32        `env:"KEEPER_GAS_PRICE_BUFFER_PERCENT" default:"20"`
 	KeeperGasTipCapBufferPercent       uint32        `env:"KEEPER_GAS_TIP_CAP_BUFFER_PERCENT" default:"20"`
 	KeeperMaximumGracePeriod           int64         `env:"KEEPER_MAXIMUM_GRACE_PERIOD" default:"100"`
+	KeeperMinimumBalanceBufferPercent  uint32        `env:"KEEPER_MINIMUM_BALANCE_BUFFER_PERCENT" default:"0"`
+	KeeperPendingPerformTimeout        time.Duration `env:"KEEPER_PENDING_PERFORM_TIMEOUT" default:"30m"`
 	KeeperRegistryCheckGasOverhead     uint64        `env:"KEEPER_REGISTRY_CHECK_GAS_OVERHEAD" default:"200000"`
 	KeeperRegistryPerformGasOverhead   uint64        `env:"KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD" default:"150000"`
 	KeeperRegistrySyncInterval         time.Duration `env:"KEEPER_REGISTRY_SYNC_INTERVAL" default:"30m"`
 	KeeperRegistrySyncUpkeepQueueSize  uint32        `env:"KEEPER_REGISTRY_SYNC_UPKEEP_QUEUE_SIZE" default:"10"`
+	KeeperUpkeepOrder                  string        `env:"KEEPER_UPKEEP_ORDER" default:"id"`
+	KeeperUpkeepRunsRetention          time.Duration `env:"KEEPER_UPKEEP_RUNS_RETENTION" default:"720h"`
 
 	// CLI client
 	AdminCredentialsFile string `env:"ADMIN_CREDENTIALS_FILE" default:"$ROOT/apicredentials"`
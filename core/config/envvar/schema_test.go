@@ -29,6 +29,7 @@ func TestConfigSchema(t *testing.T) {
 		"AutoPprofMemThreshold":                      "AUTO_PPROF_MEM_THRESHOLD",
 		"AutoPprofGoroutineThreshold":                "AUTO_PPROF_GOROUTINE_THRESHOLD",
 		"BalanceMonitorEnabled":                      "BALANCE_MONITOR_ENABLED",
+		"BalanceMonitorTokens":                       "BALANCE_MONITOR_TOKENS",
 		"BlockBackfillDepth":                         "BLOCK_BACKFILL_DEPTH",
 		"BlockBackfillSkip":                          "BLOCK_BACKFILL_SKIP",
 		"BlockEmissionIdleWarningThreshold":          "BLOCK_EMISSION_IDLE_WARNING_THRESHOLD",
@@ -53,9 +54,23 @@ func TestConfigSchema(t *testing.T) {
 		"DefaultHTTPTimeout":                         "DEFAULT_HTTP_TIMEOUT",
 		"Dev":                                        "CHAINLINK_DEV",
 		"EVMDisabled":                                "EVM_DISABLED",
+		"EthTxBumpDigestInterval":                    "ETH_TX_BUMP_DIGEST_INTERVAL",
+		"EthTxDeadlineBoostCurve":                    "ETH_TX_DEADLINE_BOOST_CURVE",
+		"EthTxDrainTimeout":                          "ETH_TX_DRAIN_TIMEOUT",
+		"EthTxDuplicateInstancePolicy":               "ETH_TX_DUPLICATE_INSTANCE_POLICY",
+		"EthTxFailureWebhookURL":                     "ETH_TX_FAILURE_WEBHOOK_URL",
+		"EthTxOutcomeKafkaTopic":                     "ETH_TX_OUTCOME_KAFKA_TOPIC",
+		"EthTxOutcomeKafkaURL":                       "ETH_TX_OUTCOME_KAFKA_URL",
+		"EthTxFundsRecoveryBatchSize":                "ETH_TX_FUNDS_RECOVERY_BATCH_SIZE",
+		"EthTxFundsRecoveryCheckInterval":            "ETH_TX_FUNDS_RECOVERY_CHECK_INTERVAL",
+		"EthTxInProgressResolutionPolicy":            "ETH_TX_IN_PROGRESS_RESOLUTION_POLICY",
+		"EthTxInsufficientEthBackoffMax":             "ETH_TX_INSUFFICIENT_ETH_BACKOFF_MAX",
+		"EthTxInsufficientEthMode":                   "ETH_TX_INSUFFICIENT_ETH_MODE",
+		"EthTxMaxFeeMode":                            "ETH_TX_MAX_FEE_MODE",
 		"EthTxReaperInterval":                        "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                       "ETH_TX_REAPER_THRESHOLD",
 		"EthTxResendAfterThreshold":                  "ETH_TX_RESEND_AFTER_THRESHOLD",
+		"EthTxResumeBatchSize":                       "ETH_TX_RESUME_BATCH_SIZE",
 		"EthereumDisabled":                           "ETH_DISABLED",
 		"EthereumHTTPURL":                            "ETH_HTTP_URL",
 		"EthereumSecondaryURL":                       "ETH_SECONDARY_URL",
@@ -64,12 +79,19 @@ func TestConfigSchema(t *testing.T) {
 		"EvmBalanceMonitorBlockDelay":                "ETH_BALANCE_MONITOR_BLOCK_DELAY",
 		"EvmDefaultBatchSize":                        "ETH_DEFAULT_BATCH_SIZE",
 		"EvmEIP1559DynamicFees":                      "EVM_EIP1559_DYNAMIC_FEES",
+		"EvmBroadcastMaxInitialBumps":                "ETH_BROADCAST_MAX_INITIAL_BUMPS",
+		"EvmBroadcastPollJitterDisabled":             "ETH_BROADCAST_POLL_JITTER_DISABLED",
+		"EvmChainHaltThreshold":                      "ETH_CHAIN_HALT_THRESHOLD",
 		"EvmFinalityDepth":                           "ETH_FINALITY_DEPTH",
 		"EvmGasBumpPercent":                          "ETH_GAS_BUMP_PERCENT",
 		"EvmGasBumpThreshold":                        "ETH_GAS_BUMP_THRESHOLD",
+		"EvmGasBumpStrategy":                         "ETH_GAS_BUMP_STRATEGY",
 		"EvmGasBumpTxDepth":                          "ETH_GAS_BUMP_TX_DEPTH",
 		"EvmGasBumpWei":                              "ETH_GAS_BUMP_WEI",
 		"EvmGasLimitDefault":                         "ETH_GAS_LIMIT_DEFAULT",
+		"EvmGasLimitLearningEnabled":                 "ETH_GAS_LIMIT_LEARNING_ENABLED",
+		"EvmGasLimitLearningMarginPercent":           "ETH_GAS_LIMIT_LEARNING_MARGIN_PERCENT",
+		"EvmGasLimitMax":                             "ETH_GAS_LIMIT_MAX",
 		"EvmGasLimitMultiplier":                      "ETH_GAS_LIMIT_MULTIPLIER",
 		"EvmGasLimitTransfer":                        "ETH_GAS_LIMIT_TRANSFER",
 		"EvmGasPriceDefault":                         "ETH_GAS_PRICE_DEFAULT",
@@ -80,11 +102,22 @@ func TestConfigSchema(t *testing.T) {
 		"EvmHeadTrackerSamplingInterval":             "ETH_HEAD_TRACKER_SAMPLING_INTERVAL",
 		"EvmLogBackfillBatchSize":                    "ETH_LOG_BACKFILL_BATCH_SIZE",
 		"EvmMaxGasPriceWei":                          "ETH_MAX_GAS_PRICE_WEI",
+		"EvmMaxGasPriceExceededPolicy":               "ETH_MAX_GAS_PRICE_EXCEEDED_POLICY",
+		"EvmInProgressTxAlertThreshold":              "ETH_IN_PROGRESS_TX_ALERT_THRESHOLD",
+		"EvmKeyIdleTimeout":                          "ETH_KEY_IDLE_TIMEOUT",
+		"EvmMaxInProgressAge":                        "ETH_MAX_IN_PROGRESS_AGE",
+		"EvmMaxNonceHoles":                           "ETH_MAX_NONCE_HOLES",
 		"EvmMaxInFlightTransactions":                 "ETH_MAX_IN_FLIGHT_TRANSACTIONS",
+		"EvmMaxInFlightValueWei":                     "ETH_MAX_IN_FLIGHT_VALUE_WEI",
 		"EvmMaxQueuedTransactions":                   "ETH_MAX_QUEUED_TRANSACTIONS",
 		"EvmMinGasPriceWei":                          "ETH_MIN_GAS_PRICE_WEI",
+		"EvmMulticallAddress":                        "ETH_MULTICALL_ADDRESS",
 		"EvmNonceAutoSync":                           "ETH_NONCE_AUTO_SYNC",
+		"EvmNonceAutoSyncInterval":                   "ETH_NONCE_AUTO_SYNC_INTERVAL",
 		"EvmRPCDefaultBatchSize":                     "ETH_RPC_DEFAULT_BATCH_SIZE",
+		"EvmRejectSelfTransactions":                  "ETH_REJECT_SELF_TRANSACTIONS",
+		"EvmTxQueueOrdering":                         "ETH_TX_QUEUE_ORDERING",
+		"EvmTxQueueTiebreak":                         "ETH_TX_QUEUE_TIEBREAK",
 		"ExplorerAccessKey":                          "EXPLORER_ACCESS_KEY",
 		"ExplorerSecret":                             "EXPLORER_SECRET",
 		"ExplorerURL":                                "EXPLORER_URL",
@@ -98,6 +131,9 @@ func TestConfigSchema(t *testing.T) {
 		"FeatureUIFeedsManager":                      "FEATURE_UI_FEEDS_MANAGER",
 		"FlagsContractAddress":                       "FLAGS_CONTRACT_ADDRESS",
 		"GasEstimatorMode":                           "GAS_ESTIMATOR_MODE",
+		"GasEstimatorOwnConfirmationsBlockWindow":    "GAS_ESTIMATOR_OWN_CONFIRMATIONS_BLOCK_WINDOW",
+		"GasEstimatorOwnConfirmationsMinBlocks":      "GAS_ESTIMATOR_OWN_CONFIRMATIONS_MIN_BLOCKS",
+		"GasEstimatorRecordInputs":                   "GAS_ESTIMATOR_RECORD_INPUTS",
 		"GasUpdaterBatchSize":                        "GAS_UPDATER_BATCH_SIZE",
 		"GasUpdaterBlockDelay":                       "GAS_UPDATER_BLOCK_DELAY",
 		"GasUpdaterBlockHistorySize":                 "GAS_UPDATER_BLOCK_HISTORY_SIZE",
@@ -114,10 +150,16 @@ func TestConfigSchema(t *testing.T) {
 		"KeeperGasPriceBufferPercent":                "KEEPER_GAS_PRICE_BUFFER_PERCENT",
 		"KeeperGasTipCapBufferPercent":               "KEEPER_GAS_TIP_CAP_BUFFER_PERCENT",
 		"KeeperMaximumGracePeriod":                   "KEEPER_MAXIMUM_GRACE_PERIOD",
+		"KeeperMinimumBalanceBufferPercent":          "KEEPER_MINIMUM_BALANCE_BUFFER_PERCENT",
+		"KeeperPendingPerformTimeout":                "KEEPER_PENDING_PERFORM_TIMEOUT",
 		"KeeperRegistryCheckGasOverhead":             "KEEPER_REGISTRY_CHECK_GAS_OVERHEAD",
 		"KeeperRegistryPerformGasOverhead":           "KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD",
 		"KeeperRegistrySyncInterval":                 "KEEPER_REGISTRY_SYNC_INTERVAL",
 		"KeeperRegistrySyncUpkeepQueueSize":          "KEEPER_REGISTRY_SYNC_UPKEEP_QUEUE_SIZE",
+		"KeeperUpkeepOrder":                          "KEEPER_UPKEEP_ORDER",
+		"KeeperUpkeepRunsRetention":                  "KEEPER_UPKEEP_RUNS_RETENTION",
+		"LeaderElectionCheckInterval":                "LEADER_ELECTION_CHECK_INTERVAL",
+		"LeaderElectionMode":                         "LEADER_ELECTION_MODE",
 		"LeaseLockDuration":                          "LEASE_LOCK_DURATION",
 		"LeaseLockRefreshInterval":                   "LEASE_LOCK_REFRESH_INTERVAL",
 		"LinkContractAddress":                        "LINK_CONTRACT_ADDRESS",
@@ -85,6 +85,19 @@ type GeneralOnlyConfig interface {
 	DefaultLogLevel() zapcore.Level
 	Dev() bool
 	EVMDisabled() bool
+	EthTxBumpDigestInterval() time.Duration
+	EthTxDrainTimeout() time.Duration
+	EthTxDuplicateInstancePolicy() string
+	EthTxFailureWebhookURL() *url.URL
+	EthTxFundsRecoveryBatchSize() uint32
+	EthTxFundsRecoveryCheckInterval() time.Duration
+	EthTxInProgressResolutionPolicy() string
+	EthTxInsufficientEthBackoffMax() time.Duration
+	EthTxInsufficientEthMode() string
+	EthTxOutcomeKafkaTopic() string
+	EthTxOutcomeKafkaURL() *url.URL
+	EthTxMaxFeeMode() string
+	EthTxResumeBatchSize() uint32
 	EthereumDisabled() bool
 	EthereumHTTPURL() *url.URL
 	EthereumSecondaryURLs() []url.URL
@@ -114,11 +127,17 @@ type GeneralOnlyConfig interface {
 	KeeperGasPriceBufferPercent() uint32
 	KeeperGasTipCapBufferPercent() uint32
 	KeeperMaximumGracePeriod() int64
+	KeeperMinimumBalanceBufferPercent() uint32
+	KeeperPendingPerformTimeout() time.Duration
 	KeeperRegistryCheckGasOverhead() uint64
 	KeeperRegistryPerformGasOverhead() uint64
 	KeeperRegistrySyncInterval() time.Duration
 	KeeperRegistrySyncUpkeepQueueSize() uint32
+	KeeperUpkeepOrder() string
+	KeeperUpkeepRunsRetention() time.Duration
 	KeyFile() string
+	LeaderElectionCheckInterval() time.Duration
+	LeaderElectionMode() string
 	LeaseLockDuration() time.Duration
 	LeaseLockRefreshInterval() time.Duration
 	LogFileDir() string
@@ -158,22 +177,32 @@ type GeneralOnlyConfig interface {
 // The second bool indicates if it is set or not
 type GlobalConfig interface {
 	GlobalBalanceMonitorEnabled() (bool, bool)
+	GlobalBalanceMonitorTokens() (string, bool)
 	GlobalBlockEmissionIdleWarningThreshold() (time.Duration, bool)
 	GlobalBlockHistoryEstimatorBatchSize() (uint32, bool)
 	GlobalBlockHistoryEstimatorBlockDelay() (uint16, bool)
 	GlobalBlockHistoryEstimatorBlockHistorySize() (uint16, bool)
 	GlobalBlockHistoryEstimatorTransactionPercentile() (uint16, bool)
+	GlobalEthTxDeadlineBoostCurve() (string, bool)
 	GlobalEthTxReaperInterval() (time.Duration, bool)
 	GlobalEthTxReaperThreshold() (time.Duration, bool)
 	GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 	GlobalEvmDefaultBatchSize() (uint32, bool)
+	GlobalEvmBroadcastMaxInitialBumps() (uint32, bool)
+	GlobalEvmBroadcastPollJitterDisabled() (bool, bool)
+	GlobalEvmChainHaltThreshold() (time.Duration, bool)
 	GlobalEvmEIP1559DynamicFees() (bool, bool)
 	GlobalEvmFinalityDepth() (uint32, bool)
 	GlobalEvmGasBumpPercent() (uint16, bool)
+	GlobalEvmGasBumpStrategy() (string, bool)
 	GlobalEvmGasBumpThreshold() (uint64, bool)
 	GlobalEvmGasBumpTxDepth() (uint16, bool)
 	GlobalEvmGasBumpWei() (*big.Int, bool)
 	GlobalEvmGasLimitDefault() (uint64, bool)
+	GlobalEvmGasLimitLearningEnabled() (bool, bool)
+	GlobalEvmGasLimitLearningMarginPercent() (uint16, bool)
+	GlobalEvmGasLimitLearningMin() (uint64, bool)
+	GlobalEvmGasLimitMax() (uint64, bool)
 	GlobalEvmGasLimitMultiplier() (float32, bool)
 	GlobalEvmGasLimitTransfer() (uint64, bool)
 	GlobalEvmGasPriceDefault() (*big.Int, bool)
@@ -184,13 +213,27 @@ type GlobalConfig interface {
 	GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool)
 	GlobalEvmLogBackfillBatchSize() (uint32, bool)
 	GlobalEvmMaxGasPriceWei() (*big.Int, bool)
+	GlobalEvmMaxGasPriceExceededPolicy() (string, bool)
+	GlobalEvmInProgressTxAlertThreshold() (time.Duration, bool)
+	GlobalEvmKeyIdleTimeout() (time.Duration, bool)
+	GlobalEvmMaxInProgressAge() (time.Duration, bool)
+	GlobalEvmMaxNonceHoles() (uint32, bool)
 	GlobalEvmMaxInFlightTransactions() (uint32, bool)
+	GlobalEvmMaxInFlightValueWei() (*big.Int, bool)
 	GlobalEvmMaxQueuedTransactions() (uint64, bool)
 	GlobalEvmMinGasPriceWei() (*big.Int, bool)
+	GlobalEvmMulticallAddress() (string, bool)
 	GlobalEvmNonceAutoSync() (bool, bool)
+	GlobalEvmNonceAutoSyncInterval() (time.Duration, bool)
 	GlobalEvmRPCDefaultBatchSize() (uint32, bool)
+	GlobalEvmRejectSelfTransactions() (bool, bool)
+	GlobalEvmTxQueueOrdering() (string, bool)
+	GlobalEvmTxQueueTiebreak() (string, bool)
 	GlobalFlagsContractAddress() (string, bool)
 	GlobalGasEstimatorMode() (string, bool)
+	GlobalGasEstimatorOwnConfirmationsBlockWindow() (uint16, bool)
+	GlobalGasEstimatorOwnConfirmationsMinBlocks() (uint16, bool)
+	GlobalGasEstimatorRecordInputs() (bool, bool)
 	GlobalChainType() (string, bool)
 	GlobalLinkContractAddress() (string, bool)
 	GlobalMinIncomingConfirmations() (uint32, bool)
@@ -347,6 +390,53 @@ func (c *generalConfig) Validate() error {
 		return errors.Errorf("unrecognised value for DATABASE_LOCKING_MODE: %s (valid options are 'dual', 'lease', 'advisorylock' or 'none')", c.DatabaseLockingMode())
 	}
 
+	switch c.KeeperUpkeepOrder() {
+	case "id", "execute_gas":
+	default:
+		return errors.Errorf("unrecognised value for KEEPER_UPKEEP_ORDER: %s (valid options are 'id' or 'execute_gas')", c.KeeperUpkeepOrder())
+	}
+
+	switch c.EthTxInsufficientEthMode() {
+	case "retry", "skip", "defer_value":
+	default:
+		return errors.Errorf("unrecognised value for ETH_TX_INSUFFICIENT_ETH_MODE: %s (valid options are 'retry', 'skip' or 'defer_value')", c.EthTxInsufficientEthMode())
+	}
+
+	switch c.EthTxMaxFeeMode() {
+	case "clamp", "fatal":
+	default:
+		return errors.Errorf("unrecognised value for ETH_TX_MAX_FEE_MODE: %s (valid options are 'clamp' or 'fatal')", c.EthTxMaxFeeMode())
+	}
+
+	switch c.EthTxDuplicateInstancePolicy() {
+	case "warn", "refuse":
+	default:
+		return errors.Errorf("unrecognised value for ETH_TX_DUPLICATE_INSTANCE_POLICY: %s (valid options are 'warn' or 'refuse')", c.EthTxDuplicateInstancePolicy())
+	}
+
+	switch c.EthTxInProgressResolutionPolicy() {
+	case "resend", "fatal":
+	default:
+		return errors.Errorf("unrecognised value for ETH_TX_IN_PROGRESS_RESOLUTION_POLICY: %s (valid options are 'resend' or 'fatal')", c.EthTxInProgressResolutionPolicy())
+	}
+
+	if c.EthTxFundsRecoveryCheckInterval() <= 0 {
+		return errors.New("ETH_TX_FUNDS_RECOVERY_CHECK_INTERVAL must be greater than zero")
+	}
+
+	switch c.LeaderElectionMode() {
+	case "none":
+	case "global", "chain":
+		if c.DatabaseLockingMode() != "none" {
+			return errors.Errorf("LEADER_ELECTION_MODE=%s requires DATABASE_LOCKING_MODE=none, otherwise the standby node can't boot while the leader holds the database lock (got DATABASE_LOCKING_MODE=%s)", c.LeaderElectionMode(), c.DatabaseLockingMode())
+		}
+		if c.LeaderElectionCheckInterval() <= 0 {
+			return errors.New("LEADER_ELECTION_CHECK_INTERVAL must be greater than zero")
+		}
+	default:
+		return errors.Errorf("unrecognised value for LEADER_ELECTION_MODE: %s (valid options are 'none', 'global' or 'chain')", c.LeaderElectionMode())
+	}
+
 	if c.LeaseLockRefreshInterval() > c.LeaseLockDuration()/2 {
 		return errors.Errorf("LEASE_LOCK_REFRESH_INTERVAL must be less than or equal to half of LEASE_LOCK_DURATION (got LEASE_LOCK_REFRESH_INTERVAL=%s, LEASE_LOCK_DURATION=%s)", c.LeaseLockRefreshInterval().String(), c.LeaseLockDuration().String())
 	}
@@ -649,6 +739,114 @@ func (c *generalConfig) EthereumDisabled() bool {
 	return c.viper.GetBool(envvar.Name("EthereumDisabled"))
 }
 
+// EthTxDuplicateInstancePolicy controls what happens when the EthBroadcaster
+// starts while another instance has recently sent a heartbeat for the same
+// chain and keys. May be one of:
+// - warn: log an error and start anyway (default)
+// - refuse: do not start
+func (c *generalConfig) EthTxDuplicateInstancePolicy() string {
+	return c.getWithFallback("EthTxDuplicateInstancePolicy", parse.String).(string)
+}
+
+// EthTxInsufficientEthMode controls what happens when a transaction cannot be
+// sent because the key has insufficient eth. May be one of:
+// - retry: keep retrying the transaction, blocking the key's queue (default)
+// - skip: move the transaction to awaiting_funds and carry on with the rest of the queue
+// - defer_value: like skip, but only if the transaction's value alone exceeds the key's balance
+func (c *generalConfig) EthTxInsufficientEthMode() string {
+	return c.getWithFallback("EthTxInsufficientEthMode", parse.String).(string)
+}
+
+// EthTxMaxFeeMode controls what happens when a dynamic fee attempt for a
+// transaction with a MaxFeeWei would cost more than MaxFeeWei in the worst
+// case (i.e. GasFeeCap * GasLimit). May be one of:
+// - clamp: lower the fee cap so that the worst case cost is within MaxFeeWei (default)
+// - fatal: do not send the transaction and mark it as fatally errored
+func (c *generalConfig) EthTxMaxFeeMode() string {
+	return c.getWithFallback("EthTxMaxFeeMode", parse.String).(string)
+}
+
+// EthTxInsufficientEthBackoffMax caps the exponential backoff between
+// resends of a transaction that was rejected due to insufficient eth in
+// retry mode. 0 disables the backoff, resending on every poll.
+func (c *generalConfig) EthTxInsufficientEthBackoffMax() time.Duration {
+	return c.getWithFallback("EthTxInsufficientEthBackoffMax", parse.Duration).(time.Duration)
+}
+
+// EthTxFundsRecoveryCheckInterval is how often the balance of keys with
+// awaiting_funds transactions is checked
+func (c *generalConfig) EthTxFundsRecoveryCheckInterval() time.Duration {
+	return c.getWithFallback("EthTxFundsRecoveryCheckInterval", parse.Duration).(time.Duration)
+}
+
+// EthTxDrainTimeout is how long the node waits on shutdown for transactions
+// that are in the middle of being broadcast to finish sending. Zero disables
+// draining.
+func (c *generalConfig) EthTxDrainTimeout() time.Duration {
+	return c.getWithFallback("EthTxDrainTimeout", parse.Duration).(time.Duration)
+}
+
+// EthTxResumeBatchSize is the maximum number of pipeline run resumptions
+// passed to a ResumeBatchCallback at once
+func (c *generalConfig) EthTxResumeBatchSize() uint32 {
+	return c.getWithFallback("EthTxResumeBatchSize", parse.Uint32).(uint32)
+}
+
+// EthTxFundsRecoveryBatchSize is the maximum number of awaiting_funds
+// transactions per key that are moved back to unstarted on each check
+func (c *generalConfig) EthTxFundsRecoveryBatchSize() uint32 {
+	return c.getWithFallback("EthTxFundsRecoveryBatchSize", parse.Uint32).(uint32)
+}
+
+// EthTxInProgressResolutionPolicy controls how a transaction that has been
+// in_progress for longer than ETH_MAX_IN_PROGRESS_AGE is resolved. May be one of:
+// - resend: re-estimate gas and resend it with a new attempt (default)
+// - fatal: mark it as fatally errored, freeing its nonce
+func (c *generalConfig) EthTxInProgressResolutionPolicy() string {
+	return c.getWithFallback("EthTxInProgressResolutionPolicy", parse.String).(string)
+}
+
+// EthTxBumpDigestInterval is how often gas bumps are summarized into a single
+// digest per key. 0 disables aggregation, logging every bump individually.
+func (c *generalConfig) EthTxBumpDigestInterval() time.Duration {
+	return c.getWithFallback("EthTxBumpDigestInterval", parse.Duration).(time.Duration)
+}
+
+// EthTxFailureWebhookURL returns the URL that transaction failure events
+// (fatal errors and insufficient eth) are POSTed to, or nil if not set
+func (c *generalConfig) EthTxFailureWebhookURL() *url.URL {
+	rval := c.getWithFallback("EthTxFailureWebhookURL", parse.URL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		panic(fmt.Sprintf("invariant: EthTxFailureWebhookURL returned as type %T", rval))
+	}
+}
+
+// EthTxOutcomeKafkaURL returns the URL of the Kafka REST proxy that
+// transaction outcome records are published to, or nil if they are not
+// published
+func (c *generalConfig) EthTxOutcomeKafkaURL() *url.URL {
+	rval := c.getWithFallback("EthTxOutcomeKafkaURL", parse.URL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		panic(fmt.Sprintf("invariant: EthTxOutcomeKafkaURL returned as type %T", rval))
+	}
+}
+
+// EthTxOutcomeKafkaTopic is the Kafka topic that transaction outcome records
+// are published to
+func (c *generalConfig) EthTxOutcomeKafkaTopic() string {
+	return c.getWithFallback("EthTxOutcomeKafkaTopic", parse.String).(string)
+}
+
 // EVMDisabled prevents any evm_chains from being loaded at all if set
 func (c *generalConfig) EVMDisabled() bool {
 	return c.viper.GetBool(envvar.Name("EVMDisabled"))
@@ -732,11 +930,41 @@ func (c *generalConfig) KeeperMaximumGracePeriod() int64 {
 	return c.viper.GetInt64(envvar.Name("KeeperMaximumGracePeriod"))
 }
 
+// KeeperMinimumBalanceBufferPercent, if set, makes the keeper skip upkeeps
+// whose balance on the registry is below executeGas * gas price plus this
+// percentage. Zero disables the check.
+func (c *generalConfig) KeeperMinimumBalanceBufferPercent() uint32 {
+	return c.viper.GetUint32(envvar.Name("KeeperMinimumBalanceBufferPercent"))
+}
+
+// KeeperPendingPerformTimeout is how long the keeper waits for a perform
+// transaction to be confirmed before it considers the upkeep eligible again.
+// Until then the upkeep is not performed again. Zero means wait until the
+// transaction is either confirmed or fatally errored, which a transaction
+// stuck in confirmed_missing_receipt never is.
+func (c *generalConfig) KeeperPendingPerformTimeout() time.Duration {
+	return c.getWithFallback("KeeperPendingPerformTimeout", parse.Duration).(time.Duration)
+}
+
 // KeeperRegistrySyncUpkeepQueueSize represents the maximum number of upkeeps that can be synced in parallel
 func (c *generalConfig) KeeperRegistrySyncUpkeepQueueSize() uint32 {
 	return c.getWithFallback("KeeperRegistrySyncUpkeepQueueSize", parse.Uint32).(uint32)
 }
 
+// KeeperUpkeepOrder is the order in which the keeper attempts the upkeeps that
+// are eligible in its turn. May be one of:
+// - id: in the order they were synced from the registry (default)
+// - execute_gas: cheapest first, by their execute gas
+func (c *generalConfig) KeeperUpkeepOrder() string {
+	return c.viper.GetString(envvar.Name("KeeperUpkeepOrder"))
+}
+
+// KeeperUpkeepRunsRetention is how long the record of the upkeeps the keeper
+// performed is kept for. Zero means it is kept forever.
+func (c *generalConfig) KeeperUpkeepRunsRetention() time.Duration {
+	return c.getWithFallback("KeeperUpkeepRunsRetention", parse.Duration).(time.Duration)
+}
+
 // JSONConsole when set to true causes logging to be made in JSON format
 // If set to false, logs in console format
 func (c *generalConfig) JSONConsole() bool {
@@ -1033,6 +1261,13 @@ func (c *generalConfig) GlobalBalanceMonitorEnabled() (bool, bool) {
 	}
 	return val.(bool), ok
 }
+func (c *generalConfig) GlobalBalanceMonitorTokens() (string, bool) {
+	val, ok := c.lookupEnv(envvar.Name("BalanceMonitorTokens"), parse.String)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
 func (c *generalConfig) GlobalBlockEmissionIdleWarningThreshold() (time.Duration, bool) {
 	val, ok := c.lookupEnv(envvar.Name("BlockEmissionIdleWarningThreshold"), parse.Duration)
 	if val == nil {
@@ -1068,6 +1303,13 @@ func (c *generalConfig) GlobalBlockHistoryEstimatorTransactionPercentile() (uint
 	}
 	return val.(uint16), ok
 }
+func (c *generalConfig) GlobalEthTxDeadlineBoostCurve() (string, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EthTxDeadlineBoostCurve"), parse.String)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
 func (c *generalConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
 	val, ok := c.lookupEnv(envvar.Name("EthTxReaperInterval"), parse.Duration)
 	if val == nil {
@@ -1110,6 +1352,13 @@ func (c *generalConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	}
 	return val.(uint16), ok
 }
+func (c *generalConfig) GlobalEvmGasBumpStrategy() (string, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmGasBumpStrategy"), parse.String)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
 func (c *generalConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
 	val, ok := c.lookupEnv(envvar.Name("EvmGasBumpThreshold"), parse.Uint64)
 	if val == nil {
@@ -1138,6 +1387,34 @@ func (c *generalConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
 	}
 	return val.(uint64), ok
 }
+func (c *generalConfig) GlobalEvmGasLimitLearningEnabled() (bool, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmGasLimitLearningEnabled"), parse.Bool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
+func (c *generalConfig) GlobalEvmGasLimitLearningMarginPercent() (uint16, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmGasLimitLearningMarginPercent"), parse.Uint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
+func (c *generalConfig) GlobalEvmGasLimitLearningMin() (uint64, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmGasLimitLearningMin"), parse.Uint64)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint64), ok
+}
+func (c *generalConfig) GlobalEvmGasLimitMax() (uint64, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmGasLimitMax"), parse.Uint64)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint64), ok
+}
 func (c *generalConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
 	val, ok := c.lookupEnv(envvar.Name("EvmGasLimitMultiplier"), parse.F32)
 	if val == nil {
@@ -1194,6 +1471,48 @@ func (c *generalConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	}
 	return val.(*big.Int), ok
 }
+func (c *generalConfig) GlobalEvmMaxGasPriceExceededPolicy() (string, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmMaxGasPriceExceededPolicy"), parse.String)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
+func (c *generalConfig) GlobalEvmInProgressTxAlertThreshold() (time.Duration, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmInProgressTxAlertThreshold"), parse.Duration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
+func (c *generalConfig) GlobalEvmChainHaltThreshold() (time.Duration, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmChainHaltThreshold"), parse.Duration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
+func (c *generalConfig) GlobalEvmKeyIdleTimeout() (time.Duration, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmKeyIdleTimeout"), parse.Duration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
+func (c *generalConfig) GlobalEvmMaxInProgressAge() (time.Duration, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmMaxInProgressAge"), parse.Duration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
+func (c *generalConfig) GlobalEvmMaxNonceHoles() (uint32, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmMaxNonceHoles"), parse.Uint32)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint32), ok
+}
 func (c *generalConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	val, ok := c.lookupEnv(envvar.Name("EvmMaxInFlightTransactions"), parse.Uint32)
 	if val == nil {
@@ -1201,6 +1520,13 @@ func (c *generalConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (c *generalConfig) GlobalEvmMaxInFlightValueWei() (*big.Int, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmMaxInFlightValueWei"), parse.BigInt)
+	if val == nil {
+		return nil, false
+	}
+	return val.(*big.Int), ok
+}
 func (c *generalConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 	val, ok := c.lookupEnv(envvar.Name("EvmMaxQueuedTransactions"), parse.Uint64)
 	if val == nil {
@@ -1215,6 +1541,13 @@ func (c *generalConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	}
 	return val.(*big.Int), ok
 }
+func (c *generalConfig) GlobalEvmMulticallAddress() (string, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmMulticallAddress"), parse.String)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
 func (c *generalConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	val, ok := c.lookupEnv(envvar.Name("EvmNonceAutoSync"), parse.Bool)
 	if val == nil {
@@ -1222,6 +1555,20 @@ func (c *generalConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	}
 	return val.(bool), ok
 }
+func (c *generalConfig) GlobalEvmNonceAutoSyncInterval() (time.Duration, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmNonceAutoSyncInterval"), parse.Duration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
+func (c *generalConfig) GlobalEvmRejectSelfTransactions() (bool, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmRejectSelfTransactions"), parse.Bool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
 func (c *generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	val, ok := c.lookupEnv(envvar.Name("EvmRPCDefaultBatchSize"), parse.Uint32)
 	if val == nil {
@@ -1229,6 +1576,20 @@ func (c *generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (c *generalConfig) GlobalEvmTxQueueOrdering() (string, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmTxQueueOrdering"), parse.String)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
+func (c *generalConfig) GlobalEvmTxQueueTiebreak() (string, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmTxQueueTiebreak"), parse.String)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
 func (c *generalConfig) GlobalFlagsContractAddress() (string, bool) {
 	val, ok := c.lookupEnv(envvar.Name("FlagsContractAddress"), parse.String)
 	if val == nil {
@@ -1243,6 +1604,27 @@ func (c *generalConfig) GlobalGasEstimatorMode() (string, bool) {
 	}
 	return val.(string), ok
 }
+func (c *generalConfig) GlobalGasEstimatorOwnConfirmationsBlockWindow() (uint16, bool) {
+	val, ok := c.lookupEnv(envvar.Name("GasEstimatorOwnConfirmationsBlockWindow"), parse.Uint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
+func (c *generalConfig) GlobalGasEstimatorOwnConfirmationsMinBlocks() (uint16, bool) {
+	val, ok := c.lookupEnv(envvar.Name("GasEstimatorOwnConfirmationsMinBlocks"), parse.Uint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
+func (c *generalConfig) GlobalGasEstimatorRecordInputs() (bool, bool) {
+	val, ok := c.lookupEnv(envvar.Name("GasEstimatorRecordInputs"), parse.Bool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
 
 // GlobalChainType overrides all chains and forces them to act as a particular
 // chain type. List of chain types is given in `chaintype.go`.
@@ -1281,6 +1663,20 @@ func (c *generalConfig) GlobalMinimumContractPayment() (*assets.Link, bool) {
 	}
 	return val.(*assets.Link), ok
 }
+func (c *generalConfig) GlobalEvmBroadcastMaxInitialBumps() (uint32, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmBroadcastMaxInitialBumps"), parse.Uint32)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint32), ok
+}
+func (c *generalConfig) GlobalEvmBroadcastPollJitterDisabled() (bool, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmBroadcastPollJitterDisabled"), parse.Bool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
 func (c *generalConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
 	val, ok := c.lookupEnv(envvar.Name("EvmEIP1559DynamicFees"), parse.Bool)
 	if val == nil {
@@ -1340,6 +1736,23 @@ func (c *generalConfig) AdvisoryLockCheckInterval() time.Duration {
 	return c.getDuration("AdvisoryLockCheckInterval")
 }
 
+// LeaderElectionMode can be one of 'none', 'global' or 'chain'. It controls
+// whether nodes sharing the database elect a leader to run the services that
+// send transactions:
+// - none: every node runs them (default)
+// - global: one leader for all chains
+// - chain: one leader per chain, so different nodes may lead different chains
+func (c *generalConfig) LeaderElectionMode() string {
+	return c.getWithFallback("LeaderElectionMode", parse.String).(string)
+}
+
+// LeaderElectionCheckInterval controls how often a standby node tries to take
+// leadership, and how often the leader checks that it still holds it. This
+// bounds the time it takes a standby to take over from a leader that stopped.
+func (c *generalConfig) LeaderElectionCheckInterval() time.Duration {
+	return c.getDuration("LeaderElectionCheckInterval")
+}
+
 // LogFileDir if set will override RootDir as the output path for log files
 func (c *generalConfig) LogFileDir() string {
 	s := c.viper.GetString(envvar.Name("LogFileDir"))
@@ -597,6 +597,192 @@ func (_m *GeneralConfig) EVMDisabled() bool {
 	return r0
 }
 
+// EthTxBumpDigestInterval provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxBumpDigestInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthTxDrainTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxDrainTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthTxDuplicateInstancePolicy provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxDuplicateInstancePolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthTxFailureWebhookURL provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxFailureWebhookURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// EthTxFundsRecoveryBatchSize provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxFundsRecoveryBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EthTxFundsRecoveryCheckInterval provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxFundsRecoveryCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthTxInProgressResolutionPolicy provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxInProgressResolutionPolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthTxInsufficientEthBackoffMax provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxInsufficientEthBackoffMax() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthTxInsufficientEthMode provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxInsufficientEthMode() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthTxMaxFeeMode provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxMaxFeeMode() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthTxOutcomeKafkaTopic provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxOutcomeKafkaTopic() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthTxOutcomeKafkaURL provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxOutcomeKafkaURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// EthTxResumeBatchSize provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxResumeBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // EthereumDisabled provides a mock function with given fields:
 func (_m *GeneralConfig) EthereumDisabled() bool {
 	ret := _m.Called()
@@ -862,6 +1048,27 @@ func (_m *GeneralConfig) GlobalBalanceMonitorEnabled() (bool, bool) {
 	return r0, r1
 }
 
+// GlobalBalanceMonitorTokens provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalBalanceMonitorTokens() (string, bool) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalBlockEmissionIdleWarningThreshold provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalBlockEmissionIdleWarningThreshold() (time.Duration, bool) {
 	ret := _m.Called()
@@ -988,6 +1195,27 @@ func (_m *GeneralConfig) GlobalChainType() (string, bool) {
 	return r0, r1
 }
 
+// GlobalEthTxDeadlineBoostCurve provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEthTxDeadlineBoostCurve() (string, bool) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEthTxReaperInterval provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
 	ret := _m.Called()
@@ -1051,6 +1279,69 @@ func (_m *GeneralConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 	return r0, r1
 }
 
+// GlobalEvmBroadcastMaxInitialBumps provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmBroadcastMaxInitialBumps() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmBroadcastPollJitterDisabled provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmBroadcastPollJitterDisabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmChainHaltThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmChainHaltThreshold() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmDefaultBatchSize provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmDefaultBatchSize() (uint32, bool) {
 	ret := _m.Called()
@@ -1101,7 +1392,414 @@ func (_m *GeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasBumpPercent provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasBumpStrategy provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasBumpStrategy() (string, bool) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasBumpThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasBumpTxDepth provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasBumpWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasBumpWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitDefault provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitLearningEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitLearningEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitLearningMarginPercent provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitLearningMarginPercent() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitLearningMin provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitLearningMin() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitMax provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitMax() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitMultiplier provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitTransfer provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasLimitTransfer() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasPriceDefault provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasTipCapDefault provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasTipCapDefault() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasTipCapMinimum provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmGasTipCapMinimum() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmHeadTrackerHistoryDepth provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmHeadTrackerMaxBufferSize provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmHeadTrackerSamplingInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmInProgressTxAlertThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmInProgressTxAlertThreshold() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1114,15 +1812,15 @@ func (_m *GeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpPercent provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
+// GlobalEvmKeyIdleTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmKeyIdleTimeout() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1135,15 +1833,15 @@ func (_m *GeneralConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpThreshold provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
+// GlobalEvmLogBackfillBatchSize provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1156,15 +1854,15 @@ func (_m *GeneralConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpTxDepth provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
+// GlobalEvmMaxGasPriceExceededPolicy provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxGasPriceExceededPolicy() (string, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1177,8 +1875,8 @@ func (_m *GeneralConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpWei provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasBumpWei() (*big.Int, bool) {
+// GlobalEvmMaxGasPriceWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	ret := _m.Called()
 
 	var r0 *big.Int
@@ -1200,15 +1898,15 @@ func (_m *GeneralConfig) GlobalEvmGasBumpWei() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitDefault provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
+// GlobalEvmMaxInFlightTransactions provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1221,15 +1919,17 @@ func (_m *GeneralConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitMultiplier provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
+// GlobalEvmMaxInFlightValueWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxInFlightValueWei() (*big.Int, bool) {
 	ret := _m.Called()
 
-	var r0 float32
-	if rf, ok := ret.Get(0).(func() float32); ok {
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(float32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
 	}
 
 	var r1 bool
@@ -1242,15 +1942,15 @@ func (_m *GeneralConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitTransfer provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasLimitTransfer() (uint64, bool) {
+// GlobalEvmMaxInProgressAge provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxInProgressAge() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1263,17 +1963,15 @@ func (_m *GeneralConfig) GlobalEvmGasLimitTransfer() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasPriceDefault provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
+// GlobalEvmMaxNonceHoles provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxNonceHoles() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1286,17 +1984,15 @@ func (_m *GeneralConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasTipCapDefault provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasTipCapDefault() (*big.Int, bool) {
+// GlobalEvmMaxQueuedTransactions provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 bool
@@ -1309,8 +2005,8 @@ func (_m *GeneralConfig) GlobalEvmGasTipCapDefault() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasTipCapMinimum provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmGasTipCapMinimum() (*big.Int, bool) {
+// GlobalEvmMinGasPriceWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	ret := _m.Called()
 
 	var r0 *big.Int
@@ -1332,15 +2028,15 @@ func (_m *GeneralConfig) GlobalEvmGasTipCapMinimum() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerHistoryDepth provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
+// GlobalEvmMulticallAddress provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMulticallAddress() (string, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1353,15 +2049,15 @@ func (_m *GeneralConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerMaxBufferSize provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
+// GlobalEvmNonceAutoSync provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1374,8 +2070,8 @@ func (_m *GeneralConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerSamplingInterval provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
+// GlobalEvmNonceAutoSyncInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmNonceAutoSyncInterval() (time.Duration, bool) {
 	ret := _m.Called()
 
 	var r0 time.Duration
@@ -1395,8 +2091,8 @@ func (_m *GeneralConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration,
 	return r0, r1
 }
 
-// GlobalEvmLogBackfillBatchSize provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
+// GlobalEvmRPCDefaultBatchSize provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1416,17 +2112,15 @@ func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxGasPriceWei provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
+// GlobalEvmRejectSelfTransactions provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmRejectSelfTransactions() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1439,15 +2133,15 @@ func (_m *GeneralConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxInFlightTransactions provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
+// GlobalEvmTxQueueOrdering provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmTxQueueOrdering() (string, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1460,15 +2154,15 @@ func (_m *GeneralConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxQueuedTransactions provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
+// GlobalEvmTxQueueTiebreak provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmTxQueueTiebreak() (string, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1481,17 +2175,15 @@ func (_m *GeneralConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMinGasPriceWei provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
+// GlobalFlagsContractAddress provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalFlagsContractAddress() (string, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1504,15 +2196,15 @@ func (_m *GeneralConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmNonceAutoSync provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
+// GlobalGasEstimatorMode provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalGasEstimatorMode() (string, bool) {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1525,15 +2217,15 @@ func (_m *GeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	return r0, r1
 }
 
-// GlobalEvmRPCDefaultBatchSize provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
+// GlobalGasEstimatorOwnConfirmationsBlockWindow provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalGasEstimatorOwnConfirmationsBlockWindow() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1546,15 +2238,15 @@ func (_m *GeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalFlagsContractAddress provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalFlagsContractAddress() (string, bool) {
+// GlobalGasEstimatorOwnConfirmationsMinBlocks provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalGasEstimatorOwnConfirmationsMinBlocks() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1567,15 +2259,15 @@ func (_m *GeneralConfig) GlobalFlagsContractAddress() (string, bool) {
 	return r0, r1
 }
 
-// GlobalGasEstimatorMode provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalGasEstimatorMode() (string, bool) {
+// GlobalGasEstimatorRecordInputs provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalGasEstimatorRecordInputs() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1926,6 +2618,34 @@ func (_m *GeneralConfig) KeeperMaximumGracePeriod() int64 {
 	return r0
 }
 
+// KeeperMinimumBalanceBufferPercent provides a mock function with given fields:
+func (_m *GeneralConfig) KeeperMinimumBalanceBufferPercent() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// KeeperPendingPerformTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) KeeperPendingPerformTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // KeeperRegistryCheckGasOverhead provides a mock function with given fields:
 func (_m *GeneralConfig) KeeperRegistryCheckGasOverhead() uint64 {
 	ret := _m.Called()
@@ -1982,6 +2702,34 @@ func (_m *GeneralConfig) KeeperRegistrySyncUpkeepQueueSize() uint32 {
 	return r0
 }
 
+// KeeperUpkeepOrder provides a mock function with given fields:
+func (_m *GeneralConfig) KeeperUpkeepOrder() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// KeeperUpkeepRunsRetention provides a mock function with given fields:
+func (_m *GeneralConfig) KeeperUpkeepRunsRetention() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // KeyFile provides a mock function with given fields:
 func (_m *GeneralConfig) KeyFile() string {
 	ret := _m.Called()
@@ -1996,6 +2744,34 @@ func (_m *GeneralConfig) KeyFile() string {
 	return r0
 }
 
+// LeaderElectionCheckInterval provides a mock function with given fields:
+func (_m *GeneralConfig) LeaderElectionCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// LeaderElectionMode provides a mock function with given fields:
+func (_m *GeneralConfig) LeaderElectionMode() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // LeaseLockDuration provides a mock function with given fields:
 func (_m *GeneralConfig) LeaseLockDuration() time.Duration {
 	ret := _m.Called()
@@ -62,10 +62,16 @@ type EnvPrinter struct {
 	KeeperGasPriceBufferPercent                uint32          `json:"KEEPER_GAS_PRICE_BUFFER_PERCENT"`
 	KeeperGasTipCapBufferPercent               uint32          `json:"KEEPER_GAS_TIP_CAP_BUFFER_PERCENT"`
 	KeeperMaximumGracePeriod                   int64           `json:"KEEPER_MAXIMUM_GRACE_PERIOD"`
+	KeeperMinimumBalanceBufferPercent          uint32          `json:"KEEPER_MINIMUM_BALANCE_BUFFER_PERCENT"`
+	KeeperPendingPerformTimeout                time.Duration   `json:"KEEPER_PENDING_PERFORM_TIMEOUT"`
 	KeeperRegistryCheckGasOverhead             uint64          `json:"KEEPER_REGISTRY_CHECK_GAS_OVERHEAD"`
 	KeeperRegistryPerformGasOverhead           uint64          `json:"KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD"`
 	KeeperRegistrySyncInterval                 time.Duration   `json:"KEEPER_REGISTRY_SYNC_INTERVAL"`
 	KeeperRegistrySyncUpkeepQueueSize          uint32          `json:"KEEPER_REGISTRY_SYNC_UPKEEP_QUEUE_SIZE"`
+	KeeperUpkeepOrder                          string          `json:"KEEPER_UPKEEP_ORDER"`
+	KeeperUpkeepRunsRetention                  time.Duration   `json:"KEEPER_UPKEEP_RUNS_RETENTION"`
+	LeaderElectionCheckInterval                time.Duration   `json:"LEADER_ELECTION_CHECK_INTERVAL"`
+	LeaderElectionMode                         string          `json:"LEADER_ELECTION_MODE"`
 	LeaseLockDuration                          time.Duration   `json:"LEASE_LOCK_DURATION"`
 	LeaseLockRefreshInterval                   time.Duration   `json:"LEASE_LOCK_REFRESH_INTERVAL"`
 	FlagsContractAddress                       string          `json:"FLAGS_CONTRACT_ADDRESS"`
@@ -163,6 +169,12 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			KeeperDefaultTransactionQueueDepth: cfg.KeeperDefaultTransactionQueueDepth(),
 			KeeperGasPriceBufferPercent:        cfg.KeeperGasPriceBufferPercent(),
 			KeeperGasTipCapBufferPercent:       cfg.KeeperGasTipCapBufferPercent(),
+			KeeperMinimumBalanceBufferPercent:  cfg.KeeperMinimumBalanceBufferPercent(),
+			KeeperPendingPerformTimeout:        cfg.KeeperPendingPerformTimeout(),
+			KeeperUpkeepOrder:                  cfg.KeeperUpkeepOrder(),
+			KeeperUpkeepRunsRetention:          cfg.KeeperUpkeepRunsRetention(),
+			LeaderElectionCheckInterval:        cfg.LeaderElectionCheckInterval(),
+			LeaderElectionMode:                 cfg.LeaderElectionMode(),
 			LeaseLockDuration:                  cfg.LeaseLockDuration(),
 			LeaseLockRefreshInterval:           cfg.LeaseLockRefreshInterval(),
 			LogFileDir:                         cfg.LogFileDir(),
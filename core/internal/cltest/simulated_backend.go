@@ -66,7 +66,7 @@ func NewApplicationWithConfigAndKeyOnSimulatedBlockchain(
 	chainId := backend.Blockchain().Config().ChainID
 	cfg.Overrides.DefaultChainID = chainId
 
-	client := &SimulatedBackendClient{b: backend, t: t, chainId: chainId}
+	client := NewSimulatedBackendClient(t, backend)
 	eventBroadcaster := pg.NewEventBroadcaster(cfg.DatabaseURL(), 0, 0, logger.TestLogger(t), uuid.NewV4())
 
 	zero := models.MustMakeDuration(0 * time.Millisecond)
@@ -115,6 +115,12 @@ type SimulatedBackendClient struct {
 
 var _ evmclient.Client = (*SimulatedBackendClient)(nil)
 
+// NewSimulatedBackendClient returns a client for backend, for tests that use
+// a simulated blockchain without a whole application
+func NewSimulatedBackendClient(t testing.TB, backend *backends.SimulatedBackend) *SimulatedBackendClient {
+	return &SimulatedBackendClient{b: backend, t: t, chainId: backend.Blockchain().Config().ChainID}
+}
+
 func (c *SimulatedBackendClient) Dial(context.Context) error {
 	return nil
 }
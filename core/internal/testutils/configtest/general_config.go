@@ -37,68 +37,107 @@ const (
 var _ config.GeneralConfig = &TestGeneralConfig{}
 
 type GeneralConfigOverrides struct {
-	AdvisoryLockCheckInterval                 *time.Duration
-	AdminCredentialsFile                      null.String
-	AdvisoryLockID                            null.Int
-	AllowOrigins                              null.String
-	BlockBackfillDepth                        null.Int
-	BlockBackfillSkip                         null.Bool
-	ClientNodeURL                             null.String
-	DatabaseURL                               null.String
-	DefaultChainID                            *big.Int
-	DefaultHTTPAllowUnrestrictedNetworkAccess null.Bool
-	DefaultHTTPTimeout                        *time.Duration
-	Dev                                       null.Bool
-	Dialect                                   dialects.DialectName
-	EVMDisabled                               null.Bool
-	EthereumDisabled                          null.Bool
-	EthereumURL                               null.String
-	FeatureExternalInitiators                 null.Bool
-	FeatureFeedsManager                       null.Bool
-	GlobalBalanceMonitorEnabled               null.Bool
-	GlobalBlockEmissionIdleWarningThreshold   *time.Duration
-	GlobalChainType                           null.String
-	GlobalEthTxReaperThreshold                *time.Duration
-	GlobalEthTxResendAfterThreshold           *time.Duration
-	GlobalEvmEIP1559DynamicFees               null.Bool
-	GlobalEvmFinalityDepth                    null.Int
-	GlobalEvmGasBumpPercent                   null.Int
-	GlobalEvmGasBumpTxDepth                   null.Int
-	GlobalEvmGasBumpWei                       *big.Int
-	GlobalEvmGasLimitDefault                  null.Int
-	GlobalEvmGasLimitMultiplier               null.Float
-	GlobalEvmGasPriceDefault                  *big.Int
-	GlobalEvmGasTipCapDefault                 *big.Int
-	GlobalEvmGasTipCapMinimum                 *big.Int
-	GlobalEvmHeadTrackerHistoryDepth          null.Int
-	GlobalEvmHeadTrackerMaxBufferSize         null.Int
-	GlobalEvmHeadTrackerSamplingInterval      *time.Duration
-	GlobalEvmLogBackfillBatchSize             null.Int
-	GlobalEvmMaxGasPriceWei                   *big.Int
-	GlobalEvmMinGasPriceWei                   *big.Int
-	GlobalEvmNonceAutoSync                    null.Bool
-	GlobalEvmRPCDefaultBatchSize              null.Int
-	GlobalFlagsContractAddress                null.String
-	GlobalGasEstimatorMode                    null.String
-	GlobalMinIncomingConfirmations            null.Int
-	GlobalMinRequiredOutgoingConfirmations    null.Int
-	GlobalMinimumContractPayment              *assets.Link
-	GlobalOCRObservationGracePeriod           time.Duration
-	KeeperMaximumGracePeriod                  null.Int
-	KeeperRegistrySyncInterval                *time.Duration
-	KeeperRegistrySyncUpkeepQueueSize         null.Int
-	LeaseLockDuration                         *time.Duration
-	LeaseLockRefreshInterval                  *time.Duration
-	LogFileDir                                null.String
-	LogLevel                                  *zapcore.Level
-	DefaultLogLevel                           *zapcore.Level
-	LogSQL                                    null.Bool
-	LogToDisk                                 null.Bool
-	SecretGenerator                           config.SecretGenerator
-	TriggerFallbackDBPollInterval             *time.Duration
-	KeySpecific                               map[string]types.ChainCfg
-	FeatureOffchainReporting                  null.Bool
-	FeatureOffchainReporting2                 null.Bool
+	AdvisoryLockCheckInterval                     *time.Duration
+	AdminCredentialsFile                          null.String
+	AdvisoryLockID                                null.Int
+	AllowOrigins                                  null.String
+	BlockBackfillDepth                            null.Int
+	BlockBackfillSkip                             null.Bool
+	ClientNodeURL                                 null.String
+	DatabaseURL                                   null.String
+	DefaultChainID                                *big.Int
+	DefaultHTTPAllowUnrestrictedNetworkAccess     null.Bool
+	DefaultHTTPTimeout                            *time.Duration
+	Dev                                           null.Bool
+	Dialect                                       dialects.DialectName
+	EVMDisabled                                   null.Bool
+	EthTxBumpDigestInterval                       *time.Duration
+	EthTxDuplicateInstancePolicy                  null.String
+	EthTxFailureWebhookURL                        *url.URL
+	EthTxFundsRecoveryBatchSize                   null.Int
+	EthTxFundsRecoveryCheckInterval               *time.Duration
+	EthTxInProgressResolutionPolicy               null.String
+	EthTxInsufficientEthBackoffMax                *time.Duration
+	EthTxInsufficientEthMode                      null.String
+	EthTxMaxFeeMode                               null.String
+	EthTxResumeBatchSize                          null.Int
+	EthereumDisabled                              null.Bool
+	EthereumURL                                   null.String
+	FeatureExternalInitiators                     null.Bool
+	FeatureFeedsManager                           null.Bool
+	GlobalBalanceMonitorEnabled                   null.Bool
+	GlobalBalanceMonitorTokens                    null.String
+	GlobalBlockEmissionIdleWarningThreshold       *time.Duration
+	GlobalChainType                               null.String
+	GlobalEthTxDeadlineBoostCurve                 null.String
+	GlobalEthTxReaperThreshold                    *time.Duration
+	GlobalEthTxResendAfterThreshold               *time.Duration
+	GlobalEvmBroadcastMaxInitialBumps             null.Int
+	GlobalEvmBroadcastPollJitterDisabled          null.Bool
+	GlobalEvmChainHaltThreshold                   *time.Duration
+	GlobalEvmEIP1559DynamicFees                   null.Bool
+	GlobalEvmFinalityDepth                        null.Int
+	GlobalEvmGasBumpPercent                       null.Int
+	GlobalEvmGasBumpStrategy                      null.String
+	GlobalEvmGasBumpTxDepth                       null.Int
+	GlobalEvmGasBumpWei                           *big.Int
+	GlobalEvmGasLimitDefault                      null.Int
+	GlobalEvmGasLimitLearningEnabled              null.Bool
+	GlobalEvmGasLimitLearningMarginPercent        null.Int
+	GlobalEvmGasLimitLearningMin                  null.Int
+	GlobalEvmGasLimitMax                          null.Int
+	GlobalEvmGasLimitMultiplier                   null.Float
+	GlobalEvmGasPriceDefault                      *big.Int
+	GlobalEvmInProgressTxAlertThreshold           *time.Duration
+	GlobalEvmKeyIdleTimeout                       *time.Duration
+	GlobalEvmMaxInProgressAge                     *time.Duration
+	GlobalEvmMaxNonceHoles                        null.Int
+	GlobalEvmGasTipCapDefault                     *big.Int
+	GlobalEvmGasTipCapMinimum                     *big.Int
+	GlobalEvmHeadTrackerHistoryDepth              null.Int
+	GlobalEvmHeadTrackerMaxBufferSize             null.Int
+	GlobalEvmHeadTrackerSamplingInterval          *time.Duration
+	GlobalEvmLogBackfillBatchSize                 null.Int
+	GlobalEvmMaxGasPriceWei                       *big.Int
+	GlobalEvmMaxInFlightValueWei                  *big.Int
+	GlobalEvmMaxGasPriceExceededPolicy            null.String
+	GlobalEvmMinGasPriceWei                       *big.Int
+	GlobalEvmMulticallAddress                     null.String
+	GlobalEvmNonceAutoSync                        null.Bool
+	GlobalEvmNonceAutoSyncInterval                *time.Duration
+	GlobalEvmRPCDefaultBatchSize                  null.Int
+	GlobalEvmRejectSelfTransactions               null.Bool
+	GlobalEvmTxQueueOrdering                      null.String
+	GlobalEvmTxQueueTiebreak                      null.String
+	GlobalFlagsContractAddress                    null.String
+	GlobalGasEstimatorMode                        null.String
+	GlobalGasEstimatorOwnConfirmationsBlockWindow null.Int
+	GlobalGasEstimatorOwnConfirmationsMinBlocks   null.Int
+	GlobalGasEstimatorRecordInputs                null.Bool
+	GlobalMinIncomingConfirmations                null.Int
+	GlobalMinRequiredOutgoingConfirmations        null.Int
+	GlobalMinimumContractPayment                  *assets.Link
+	GlobalOCRObservationGracePeriod               time.Duration
+	KeeperMaximumGracePeriod                      null.Int
+	KeeperMinimumBalanceBufferPercent             null.Int
+	KeeperPendingPerformTimeout                   *time.Duration
+	KeeperRegistrySyncInterval                    *time.Duration
+	KeeperRegistrySyncUpkeepQueueSize             null.Int
+	KeeperUpkeepOrder                             null.String
+	LeaderElectionCheckInterval                   *time.Duration
+	LeaderElectionMode                            null.String
+	LeaseLockDuration                             *time.Duration
+	LeaseLockRefreshInterval                      *time.Duration
+	LogFileDir                                    null.String
+	LogLevel                                      *zapcore.Level
+	DefaultLogLevel                               *zapcore.Level
+	LogSQL                                        null.Bool
+	LogToDisk                                     null.Bool
+	SecretGenerator                               config.SecretGenerator
+	TriggerFallbackDBPollInterval                 *time.Duration
+	KeySpecific                                   map[string]types.ChainCfg
+	FeatureOffchainReporting                      null.Bool
+	FeatureOffchainReporting2                     null.Bool
 
 	// OCR v2
 	OCR2DatabaseTimeout *time.Duration
@@ -239,6 +278,76 @@ func (c *TestGeneralConfig) ORMMaxOpenConns() int {
 	return 20
 }
 
+func (c *TestGeneralConfig) EthTxFundsRecoveryBatchSize() uint32 {
+	if c.Overrides.EthTxFundsRecoveryBatchSize.Valid {
+		return uint32(c.Overrides.EthTxFundsRecoveryBatchSize.Int64)
+	}
+	return c.GeneralConfig.EthTxFundsRecoveryBatchSize()
+}
+
+func (c *TestGeneralConfig) EthTxResumeBatchSize() uint32 {
+	if c.Overrides.EthTxResumeBatchSize.Valid {
+		return uint32(c.Overrides.EthTxResumeBatchSize.Int64)
+	}
+	return c.GeneralConfig.EthTxResumeBatchSize()
+}
+
+func (c *TestGeneralConfig) EthTxFundsRecoveryCheckInterval() time.Duration {
+	if c.Overrides.EthTxFundsRecoveryCheckInterval != nil {
+		return *c.Overrides.EthTxFundsRecoveryCheckInterval
+	}
+	return c.GeneralConfig.EthTxFundsRecoveryCheckInterval()
+}
+
+func (c *TestGeneralConfig) EthTxInsufficientEthBackoffMax() time.Duration {
+	if c.Overrides.EthTxInsufficientEthBackoffMax != nil {
+		return *c.Overrides.EthTxInsufficientEthBackoffMax
+	}
+	return c.GeneralConfig.EthTxInsufficientEthBackoffMax()
+}
+
+func (c *TestGeneralConfig) EthTxBumpDigestInterval() time.Duration {
+	if c.Overrides.EthTxBumpDigestInterval != nil {
+		return *c.Overrides.EthTxBumpDigestInterval
+	}
+	return c.GeneralConfig.EthTxBumpDigestInterval()
+}
+
+func (c *TestGeneralConfig) EthTxFailureWebhookURL() *url.URL {
+	if c.Overrides.EthTxFailureWebhookURL != nil {
+		return c.Overrides.EthTxFailureWebhookURL
+	}
+	return c.GeneralConfig.EthTxFailureWebhookURL()
+}
+
+func (c *TestGeneralConfig) EthTxInProgressResolutionPolicy() string {
+	if c.Overrides.EthTxInProgressResolutionPolicy.Valid {
+		return c.Overrides.EthTxInProgressResolutionPolicy.String
+	}
+	return c.GeneralConfig.EthTxInProgressResolutionPolicy()
+}
+
+func (c *TestGeneralConfig) EthTxDuplicateInstancePolicy() string {
+	if c.Overrides.EthTxDuplicateInstancePolicy.Valid {
+		return c.Overrides.EthTxDuplicateInstancePolicy.String
+	}
+	return c.GeneralConfig.EthTxDuplicateInstancePolicy()
+}
+
+func (c *TestGeneralConfig) EthTxInsufficientEthMode() string {
+	if c.Overrides.EthTxInsufficientEthMode.Valid {
+		return c.Overrides.EthTxInsufficientEthMode.String
+	}
+	return c.GeneralConfig.EthTxInsufficientEthMode()
+}
+
+func (c *TestGeneralConfig) EthTxMaxFeeMode() string {
+	if c.Overrides.EthTxMaxFeeMode.Valid {
+		return c.Overrides.EthTxMaxFeeMode.String
+	}
+	return c.GeneralConfig.EthTxMaxFeeMode()
+}
+
 func (c *TestGeneralConfig) EthereumDisabled() bool {
 	if c.Overrides.EthereumDisabled.Valid {
 		return c.Overrides.EthereumDisabled.Bool
@@ -362,6 +471,13 @@ func (c *TestGeneralConfig) KeeperRegistrySyncUpkeepQueueSize() uint32 {
 	return c.GeneralConfig.KeeperRegistrySyncUpkeepQueueSize()
 }
 
+func (c *TestGeneralConfig) KeeperUpkeepOrder() string {
+	if c.Overrides.KeeperUpkeepOrder.Valid {
+		return c.Overrides.KeeperUpkeepOrder.String
+	}
+	return c.GeneralConfig.KeeperUpkeepOrder()
+}
+
 func (c *TestGeneralConfig) BlockBackfillDepth() uint64 {
 	if c.Overrides.BlockBackfillDepth.Valid {
 		return uint64(c.Overrides.BlockBackfillDepth.Int64)
@@ -376,6 +492,20 @@ func (c *TestGeneralConfig) KeeperMaximumGracePeriod() int64 {
 	return c.GeneralConfig.KeeperMaximumGracePeriod()
 }
 
+func (c *TestGeneralConfig) KeeperMinimumBalanceBufferPercent() uint32 {
+	if c.Overrides.KeeperMinimumBalanceBufferPercent.Valid {
+		return uint32(c.Overrides.KeeperMinimumBalanceBufferPercent.Int64)
+	}
+	return c.GeneralConfig.KeeperMinimumBalanceBufferPercent()
+}
+
+func (c *TestGeneralConfig) KeeperPendingPerformTimeout() time.Duration {
+	if c.Overrides.KeeperPendingPerformTimeout != nil {
+		return *c.Overrides.KeeperPendingPerformTimeout
+	}
+	return c.GeneralConfig.KeeperPendingPerformTimeout()
+}
+
 func (c *TestGeneralConfig) BlockBackfillSkip() bool {
 	if c.Overrides.BlockBackfillSkip.Valid {
 		return c.Overrides.BlockBackfillSkip.Bool
@@ -425,6 +555,27 @@ func (c *TestGeneralConfig) GlobalGasEstimatorMode() (string, bool) {
 	return c.GeneralConfig.GlobalGasEstimatorMode()
 }
 
+func (c *TestGeneralConfig) GlobalGasEstimatorOwnConfirmationsBlockWindow() (uint16, bool) {
+	if c.Overrides.GlobalGasEstimatorOwnConfirmationsBlockWindow.Valid {
+		return uint16(c.Overrides.GlobalGasEstimatorOwnConfirmationsBlockWindow.Int64), true
+	}
+	return c.GeneralConfig.GlobalGasEstimatorOwnConfirmationsBlockWindow()
+}
+
+func (c *TestGeneralConfig) GlobalGasEstimatorOwnConfirmationsMinBlocks() (uint16, bool) {
+	if c.Overrides.GlobalGasEstimatorOwnConfirmationsMinBlocks.Valid {
+		return uint16(c.Overrides.GlobalGasEstimatorOwnConfirmationsMinBlocks.Int64), true
+	}
+	return c.GeneralConfig.GlobalGasEstimatorOwnConfirmationsMinBlocks()
+}
+
+func (c *TestGeneralConfig) GlobalGasEstimatorRecordInputs() (bool, bool) {
+	if c.Overrides.GlobalGasEstimatorRecordInputs.Valid {
+		return c.Overrides.GlobalGasEstimatorRecordInputs.Bool, true
+	}
+	return c.GeneralConfig.GlobalGasEstimatorRecordInputs()
+}
+
 func (c *TestGeneralConfig) GlobalChainType() (string, bool) {
 	if c.Overrides.GlobalChainType.Valid {
 		return c.Overrides.GlobalChainType.String, true
@@ -438,6 +589,13 @@ func (c *TestGeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	}
 	return c.GeneralConfig.GlobalEvmNonceAutoSync()
 }
+
+func (c *TestGeneralConfig) GlobalEvmNonceAutoSyncInterval() (time.Duration, bool) {
+	if c.Overrides.GlobalEvmNonceAutoSyncInterval != nil {
+		return *c.Overrides.GlobalEvmNonceAutoSyncInterval, true
+	}
+	return c.GeneralConfig.GlobalEvmNonceAutoSyncInterval()
+}
 func (c *TestGeneralConfig) GlobalBalanceMonitorEnabled() (bool, bool) {
 	if c.Overrides.GlobalBalanceMonitorEnabled.Valid {
 		return c.Overrides.GlobalBalanceMonitorEnabled.Bool, true
@@ -445,6 +603,20 @@ func (c *TestGeneralConfig) GlobalBalanceMonitorEnabled() (bool, bool) {
 	return c.GeneralConfig.GlobalBalanceMonitorEnabled()
 }
 
+func (c *TestGeneralConfig) GlobalBalanceMonitorTokens() (string, bool) {
+	if c.Overrides.GlobalBalanceMonitorTokens.Valid {
+		return c.Overrides.GlobalBalanceMonitorTokens.String, true
+	}
+	return c.GeneralConfig.GlobalBalanceMonitorTokens()
+}
+
+func (c *TestGeneralConfig) GlobalEthTxDeadlineBoostCurve() (string, bool) {
+	if c.Overrides.GlobalEthTxDeadlineBoostCurve.Valid {
+		return c.Overrides.GlobalEthTxDeadlineBoostCurve.String, true
+	}
+	return c.GeneralConfig.GlobalEthTxDeadlineBoostCurve()
+}
+
 func (c *TestGeneralConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
 	if c.Overrides.GlobalEvmGasLimitDefault.Valid {
 		return uint64(c.Overrides.GlobalEvmGasLimitDefault.Int64), true
@@ -452,6 +624,34 @@ func (c *TestGeneralConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
 	return c.GeneralConfig.GlobalEvmGasLimitDefault()
 }
 
+func (c *TestGeneralConfig) GlobalEvmGasLimitLearningEnabled() (bool, bool) {
+	if c.Overrides.GlobalEvmGasLimitLearningEnabled.Valid {
+		return c.Overrides.GlobalEvmGasLimitLearningEnabled.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmGasLimitLearningEnabled()
+}
+
+func (c *TestGeneralConfig) GlobalEvmGasLimitLearningMarginPercent() (uint16, bool) {
+	if c.Overrides.GlobalEvmGasLimitLearningMarginPercent.Valid {
+		return uint16(c.Overrides.GlobalEvmGasLimitLearningMarginPercent.Int64), true
+	}
+	return c.GeneralConfig.GlobalEvmGasLimitLearningMarginPercent()
+}
+
+func (c *TestGeneralConfig) GlobalEvmGasLimitLearningMin() (uint64, bool) {
+	if c.Overrides.GlobalEvmGasLimitLearningMin.Valid {
+		return uint64(c.Overrides.GlobalEvmGasLimitLearningMin.Int64), true
+	}
+	return c.GeneralConfig.GlobalEvmGasLimitLearningMin()
+}
+
+func (c *TestGeneralConfig) GlobalEvmGasLimitMax() (uint64, bool) {
+	if c.Overrides.GlobalEvmGasLimitMax.Valid {
+		return uint64(c.Overrides.GlobalEvmGasLimitMax.Int64), true
+	}
+	return c.GeneralConfig.GlobalEvmGasLimitMax()
+}
+
 func (c *TestGeneralConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
 	if c.Overrides.GlobalEvmGasLimitMultiplier.Valid {
 		return float32(c.Overrides.GlobalEvmGasLimitMultiplier.Float64), true
@@ -473,6 +673,13 @@ func (c *TestGeneralConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	return c.GeneralConfig.GlobalEvmGasBumpPercent()
 }
 
+func (c *TestGeneralConfig) GlobalEvmGasBumpStrategy() (string, bool) {
+	if c.Overrides.GlobalEvmGasBumpStrategy.Valid {
+		return c.Overrides.GlobalEvmGasBumpStrategy.String, true
+	}
+	return c.GeneralConfig.GlobalEvmGasBumpStrategy()
+}
+
 func (c *TestGeneralConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
 	if c.Overrides.GlobalEvmGasPriceDefault != nil {
 		return c.Overrides.GlobalEvmGasPriceDefault, true
@@ -480,6 +687,13 @@ func (c *TestGeneralConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
 	return c.GeneralConfig.GlobalEvmGasPriceDefault()
 }
 
+func (c *TestGeneralConfig) GlobalEvmRejectSelfTransactions() (bool, bool) {
+	if c.Overrides.GlobalEvmRejectSelfTransactions.Valid {
+		return c.Overrides.GlobalEvmRejectSelfTransactions.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmRejectSelfTransactions()
+}
+
 func (c *TestGeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	if c.Overrides.GlobalEvmRPCDefaultBatchSize.Valid {
 		return uint32(c.Overrides.GlobalEvmRPCDefaultBatchSize.Int64), true
@@ -487,6 +701,20 @@ func (c *TestGeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return c.GeneralConfig.GlobalEvmRPCDefaultBatchSize()
 }
 
+func (c *TestGeneralConfig) GlobalEvmTxQueueOrdering() (string, bool) {
+	if c.Overrides.GlobalEvmTxQueueOrdering.Valid {
+		return c.Overrides.GlobalEvmTxQueueOrdering.String, true
+	}
+	return c.GeneralConfig.GlobalEvmTxQueueOrdering()
+}
+
+func (c *TestGeneralConfig) GlobalEvmTxQueueTiebreak() (string, bool) {
+	if c.Overrides.GlobalEvmTxQueueTiebreak.Valid {
+		return c.Overrides.GlobalEvmTxQueueTiebreak.String, true
+	}
+	return c.GeneralConfig.GlobalEvmTxQueueTiebreak()
+}
+
 func (c *TestGeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	if c.Overrides.GlobalEvmFinalityDepth.Valid {
 		return uint32(c.Overrides.GlobalEvmFinalityDepth.Int64), true
@@ -508,6 +736,20 @@ func (c *TestGeneralConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	return c.GeneralConfig.GlobalEvmMaxGasPriceWei()
 }
 
+func (c *TestGeneralConfig) GlobalEvmMaxInFlightValueWei() (*big.Int, bool) {
+	if c.Overrides.GlobalEvmMaxInFlightValueWei != nil {
+		return c.Overrides.GlobalEvmMaxInFlightValueWei, true
+	}
+	return c.GeneralConfig.GlobalEvmMaxInFlightValueWei()
+}
+
+func (c *TestGeneralConfig) GlobalEvmMaxGasPriceExceededPolicy() (string, bool) {
+	if c.Overrides.GlobalEvmMaxGasPriceExceededPolicy.Valid {
+		return c.Overrides.GlobalEvmMaxGasPriceExceededPolicy.String, true
+	}
+	return c.GeneralConfig.GlobalEvmMaxGasPriceExceededPolicy()
+}
+
 func (c *TestGeneralConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	if c.Overrides.GlobalEvmMinGasPriceWei != nil {
 		return c.Overrides.GlobalEvmMinGasPriceWei, true
@@ -515,6 +757,13 @@ func (c *TestGeneralConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	return c.GeneralConfig.GlobalEvmMinGasPriceWei()
 }
 
+func (c *TestGeneralConfig) GlobalEvmMulticallAddress() (string, bool) {
+	if c.Overrides.GlobalEvmMulticallAddress.Valid {
+		return c.Overrides.GlobalEvmMulticallAddress.String, true
+	}
+	return c.GeneralConfig.GlobalEvmMulticallAddress()
+}
+
 func (c *TestGeneralConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
 	if c.Overrides.GlobalEvmGasBumpTxDepth.Valid {
 		return uint16(c.Overrides.GlobalEvmGasBumpTxDepth.Int64), true
@@ -522,6 +771,41 @@ func (c *TestGeneralConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
 	return c.GeneralConfig.GlobalEvmGasBumpTxDepth()
 }
 
+func (c *TestGeneralConfig) GlobalEvmInProgressTxAlertThreshold() (time.Duration, bool) {
+	if c.Overrides.GlobalEvmInProgressTxAlertThreshold != nil {
+		return *c.Overrides.GlobalEvmInProgressTxAlertThreshold, true
+	}
+	return c.GeneralConfig.GlobalEvmInProgressTxAlertThreshold()
+}
+
+func (c *TestGeneralConfig) GlobalEvmChainHaltThreshold() (time.Duration, bool) {
+	if c.Overrides.GlobalEvmChainHaltThreshold != nil {
+		return *c.Overrides.GlobalEvmChainHaltThreshold, true
+	}
+	return c.GeneralConfig.GlobalEvmChainHaltThreshold()
+}
+
+func (c *TestGeneralConfig) GlobalEvmKeyIdleTimeout() (time.Duration, bool) {
+	if c.Overrides.GlobalEvmKeyIdleTimeout != nil {
+		return *c.Overrides.GlobalEvmKeyIdleTimeout, true
+	}
+	return c.GeneralConfig.GlobalEvmKeyIdleTimeout()
+}
+
+func (c *TestGeneralConfig) GlobalEvmMaxInProgressAge() (time.Duration, bool) {
+	if c.Overrides.GlobalEvmMaxInProgressAge != nil {
+		return *c.Overrides.GlobalEvmMaxInProgressAge, true
+	}
+	return c.GeneralConfig.GlobalEvmMaxInProgressAge()
+}
+
+func (c *TestGeneralConfig) GlobalEvmMaxNonceHoles() (uint32, bool) {
+	if c.Overrides.GlobalEvmMaxNonceHoles.Valid {
+		return uint32(c.Overrides.GlobalEvmMaxNonceHoles.Int64), true
+	}
+	return c.GeneralConfig.GlobalEvmMaxNonceHoles()
+}
+
 func (c *TestGeneralConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool) {
 	if c.Overrides.GlobalEthTxResendAfterThreshold != nil {
 		return *c.Overrides.GlobalEthTxResendAfterThreshold, true
@@ -585,6 +869,20 @@ func (c *TestGeneralConfig) GlobalEthTxReaperThreshold() (time.Duration, bool) {
 	return c.GeneralConfig.GlobalEthTxReaperThreshold()
 }
 
+func (c *TestGeneralConfig) GlobalEvmBroadcastMaxInitialBumps() (uint32, bool) {
+	if c.Overrides.GlobalEvmBroadcastMaxInitialBumps.Valid {
+		return uint32(c.Overrides.GlobalEvmBroadcastMaxInitialBumps.Int64), true
+	}
+	return c.GeneralConfig.GlobalEvmBroadcastMaxInitialBumps()
+}
+
+func (c *TestGeneralConfig) GlobalEvmBroadcastPollJitterDisabled() (bool, bool) {
+	if c.Overrides.GlobalEvmBroadcastPollJitterDisabled.Valid {
+		return c.Overrides.GlobalEvmBroadcastPollJitterDisabled.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmBroadcastPollJitterDisabled()
+}
+
 func (c *TestGeneralConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
 	if c.Overrides.GlobalEvmEIP1559DynamicFees.Valid {
 		return c.Overrides.GlobalEvmEIP1559DynamicFees.Bool, true
@@ -611,6 +909,20 @@ func (c *TestGeneralConfig) DatabaseLockingMode() string {
 	return "none"
 }
 
+func (c *TestGeneralConfig) LeaderElectionMode() string {
+	if c.Overrides.LeaderElectionMode.Valid {
+		return c.Overrides.LeaderElectionMode.String
+	}
+	return c.GeneralConfig.LeaderElectionMode()
+}
+
+func (c *TestGeneralConfig) LeaderElectionCheckInterval() time.Duration {
+	if c.Overrides.LeaderElectionCheckInterval != nil {
+		return *c.Overrides.LeaderElectionCheckInterval
+	}
+	return c.GeneralConfig.LeaderElectionCheckInterval()
+}
+
 func (c *TestGeneralConfig) LeaseLockRefreshInterval() time.Duration {
 	if c.Overrides.LeaseLockRefreshInterval != nil {
 		return *c.Overrides.LeaseLockRefreshInterval
@@ -177,6 +177,14 @@ func (mo *MockORM) NodesForChain(chainID utils.Big, offset int, limit int) ([]ev
 	panic("not implemented")
 }
 
+func (mo *MockORM) ChainConfigProfile(chainID utils.Big) (evmtypes.ChainConfigProfile, error) {
+	panic("not implemented")
+}
+
+func (mo *MockORM) SetChainConfigProfile(chainID utils.Big, name string, version int32, cfg evmtypes.ChainCfg) (evmtypes.ChainConfigProfile, error) {
+	panic("not implemented")
+}
+
 func ChainEthMainnet(t *testing.T) evmconfig.ChainScopedConfig      { return scopedConfig(t, 1) }
 func ChainOptimismMainnet(t *testing.T) evmconfig.ChainScopedConfig { return scopedConfig(t, 10) }
 func ChainOptimismKovan(t *testing.T) evmconfig.ChainScopedConfig   { return scopedConfig(t, 69) }
@@ -33,6 +33,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/leader"
 	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting2"
@@ -137,6 +138,7 @@ type ApplicationOpts struct {
 	ChainSet                 evm.ChainSet
 	Logger                   logger.Logger
 	ExternalInitiatorManager webhook.ExternalInitiatorManager
+	LeaderElectors           *leader.Electors
 	Version                  string
 }
 
@@ -196,6 +198,12 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Info("DatabaseBackup: periodic database backups are disabled. To enable automatic backups, set DATABASE_BACKUP_MODE=lite or DATABASE_BACKUP_MODE=full")
 	}
 
+	// Leader electors are started before and closed after the chains and jobs
+	// whose services they gate
+	if opts.LeaderElectors != nil {
+		globalLogger.Infow("Leader election is enabled", "mode", cfg.LeaderElectionMode())
+		subservices = append(subservices, opts.LeaderElectors)
+	}
 	subservices = append(subservices, eventBroadcaster, chainSet)
 	promReporter := promreporter.NewPromReporter(db.DB, globalLogger)
 	subservices = append(subservices, promReporter)
@@ -209,10 +217,13 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		bptxmORM       = bulletprooftxmanager.NewORM(db, globalLogger, cfg)
 	)
 
-	for _, chain := range chainSet.Chains() {
+	// Chains that fail to start are replaced by new ones when retried, which
+	// need the same wiring
+	chainSet.OnEachChain(func(chain evm.Chain) {
 		chain.HeadBroadcaster().Subscribe(promReporter)
 		chain.TxManager().RegisterResumeCallback(pipelineRunner.ResumeRun)
-	}
+		chain.TxManager().RegisterResumeBatchCallback(pipelineRunner.ResumeRuns)
+	})
 
 	var (
 		delegates = map[job.Type]job.Delegate{
@@ -471,6 +482,8 @@ func (app *ChainlinkApplication) stop() (err error) {
 			}()
 			app.logger.Info("Gracefully exiting...")
 
+			app.drainTxManagers()
+
 			// Stop services in the reverse order from which they were started
 			for i := len(app.subservices) - 1; i >= 0; i-- {
 				service := app.subservices[i]
@@ -499,13 +512,38 @@ func (app *ChainlinkApplication) stop() (err error) {
 		select {
 		case merr := <-done:
 			err = merr
-		case <-time.After(15 * time.Second):
+		case <-time.After(15*time.Second + app.Config.EthTxDrainTimeout()):
 			err = errors.New("application timed out shutting down")
 		}
 	})
 	return err
 }
 
+// drainTxManagers waits up to ETH_TX_DRAIN_TIMEOUT for every chain to finish
+// sending its in_progress transactions, so that none are interrupted by the
+// services being closed
+func (app *ChainlinkApplication) drainTxManagers() {
+	timeout := app.Config.EthTxDrainTimeout()
+	if timeout <= 0 || app.ChainSet == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	app.logger.Infow("Draining transaction managers...", "timeout", timeout)
+	var wg sync.WaitGroup
+	for _, c := range app.ChainSet.Chains() {
+		wg.Add(1)
+		go func(c evm.Chain) {
+			defer wg.Done()
+			if err := c.TxManager().Drain(ctx); err != nil {
+				app.logger.Warnw("Failed to drain transaction manager, in_progress transactions will be recovered on next start", "evmChainID", c.ID(), "err", err)
+			}
+		}(c)
+	}
+	wg.Wait()
+}
+
 func (app *ChainlinkApplication) GetConfig() config.GeneralConfig {
 	return app.Config
 }
@@ -2,9 +2,11 @@ package fluxmonitorv2
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -17,7 +19,7 @@ var FluxAggregatorABI = evmtypes.MustGetABI(flux_aggregator_wrapper.FluxAggregat
 
 // ContractSubmitter defines an interface to submit an eth tx.
 type ContractSubmitter interface {
-	Submit(roundID *big.Int, submission *big.Int, qopts ...pg.QOpt) error
+	Submit(roundID *big.Int, submission *big.Int, deadline *time.Time, onOutcome bulletprooftxmanager.OutcomeCallback, qopts ...pg.QOpt) error
 }
 
 // FluxAggregatorContractSubmitter submits the polled answer in an eth tx.
@@ -26,6 +28,9 @@ type FluxAggregatorContractSubmitter struct {
 	orm      ORM
 	keyStore KeyStoreInterface
 	gasLimit uint64
+	// maxGasPriceWei is optional, see bulletprooftxmanager.EthTx.MaxGasPriceWei
+	maxGasPriceWei *big.Int
+	jobID          int32
 }
 
 // NewFluxAggregatorContractSubmitter constructs a new NewFluxAggregatorContractSubmitter
@@ -34,18 +39,23 @@ func NewFluxAggregatorContractSubmitter(
 	orm ORM,
 	keyStore KeyStoreInterface,
 	gasLimit uint64,
+	maxGasPriceWei *big.Int,
+	jobID int32,
 ) *FluxAggregatorContractSubmitter {
 	return &FluxAggregatorContractSubmitter{
 		FluxAggregatorInterface: contract,
 		orm:                     orm,
 		keyStore:                keyStore,
 		gasLimit:                gasLimit,
+		maxGasPriceWei:          maxGasPriceWei,
+		jobID:                   jobID,
 	}
 }
 
 // Submit submits the answer by writing a EthTx for the bulletprooftxmanager to
-// pick up
-func (c *FluxAggregatorContractSubmitter) Submit(roundID *big.Int, submission *big.Int, qopts ...pg.QOpt) error {
+// pick up. deadline is when the round times out, if known. onOutcome is
+// optional, and is called once the EthTx reaches a terminal outcome.
+func (c *FluxAggregatorContractSubmitter) Submit(roundID *big.Int, submission *big.Int, deadline *time.Time, onOutcome bulletprooftxmanager.OutcomeCallback, qopts ...pg.QOpt) error {
 	fromAddress, err := c.keyStore.GetRoundRobinAddress()
 	if err != nil {
 		return err
@@ -56,8 +66,11 @@ func (c *FluxAggregatorContractSubmitter) Submit(roundID *big.Int, submission *b
 		return errors.Wrap(err, "abi.Pack failed")
 	}
 
+	round := uint32(roundID.Uint64())
+	meta := &bulletprooftxmanager.EthTxMeta{JobID: c.jobID, RoundID: &round}
+
 	return errors.Wrap(
-		c.orm.CreateEthTransaction(fromAddress, c.Address(), payload, c.gasLimit, qopts...),
+		c.orm.CreateEthTransaction(fromAddress, c.Address(), payload, c.gasLimit, c.maxGasPriceWei, meta, deadline, onOutcome, qopts...),
 		"failed to send Eth transaction",
 	)
 }
@@ -3,9 +3,11 @@ package fluxmonitorv2_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
@@ -19,12 +21,15 @@ func TestFluxAggregatorContractSubmitter_Submit(t *testing.T) {
 		orm            = new(fmmocks.ORM)
 		keyStore       = new(fmmocks.KeyStoreInterface)
 		gasLimit       = uint64(2100)
-		submitter      = fluxmonitorv2.NewFluxAggregatorContractSubmitter(fluxAggregator, orm, keyStore, gasLimit)
+		maxGasPrice    = big.NewInt(5000000000)
+		jobID          = int32(42)
+		submitter      = fluxmonitorv2.NewFluxAggregatorContractSubmitter(fluxAggregator, orm, keyStore, gasLimit, maxGasPrice, jobID)
 
 		toAddress   = cltest.NewAddress()
 		fromAddress = cltest.NewAddress()
 		roundID     = big.NewInt(1)
 		submission  = big.NewInt(2)
+		deadline    = time.Unix(1600000000, 0)
 	)
 
 	payload, err := fluxmonitorv2.FluxAggregatorABI.Pack("submit", roundID, submission)
@@ -32,8 +37,10 @@ func TestFluxAggregatorContractSubmitter_Submit(t *testing.T) {
 
 	keyStore.On("GetRoundRobinAddress", mock.Anything).Return(fromAddress, nil)
 	fluxAggregator.On("Address").Return(toAddress)
-	orm.On("CreateEthTransaction", fromAddress, toAddress, payload, gasLimit).Return(nil)
+	orm.On("CreateEthTransaction", fromAddress, toAddress, payload, gasLimit, maxGasPrice, mock.MatchedBy(func(meta *bulletprooftxmanager.EthTxMeta) bool {
+		return meta.JobID == jobID && meta.RoundID != nil && *meta.RoundID == uint32(1)
+	}), &deadline, mock.Anything).Return(nil)
 
-	err = submitter.Submit(roundID, submission)
+	err = submitter.Submit(roundID, submission, &deadline, nil)
 	assert.NoError(t, err)
 }
@@ -1,6 +1,10 @@
 package fluxmonitorv2
 
 import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
@@ -20,6 +24,11 @@ type Delegate struct {
 	pipelineRunner pipeline.Runner
 	chainSet       evm.ChainSet
 	lggr           logger.Logger
+
+	// multicallLoaders holds a MulticallLoader per chain, shared by all jobs
+	// on the chain
+	multicallLoadersMu sync.Mutex
+	multicallLoaders   map[string]*MulticallLoader
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -42,6 +51,8 @@ func NewDelegate(
 		pipelineRunner,
 		chainSet,
 		lggr.Named("FluxMonitor"),
+		sync.Mutex{},
+		make(map[string]*MulticallLoader),
 	}
 }
 
@@ -53,25 +64,64 @@ func (d *Delegate) JobType() job.Type {
 func (Delegate) AfterJobCreated(spec job.Job)  {}
 func (Delegate) BeforeJobDeleted(spec job.Job) {}
 
-// ServicesForSpec returns the flux monitor service for the job spec
+// ServicesForSpec returns the flux monitor service for the job spec. If the
+// job's chain is not running yet, e.g. because it failed to start and is
+// being retried, the job is dormant until it is.
 func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.Service, err error) {
 	if jb.FluxMonitorSpec == nil {
 		return nil, errors.Errorf("Delegate expects a *job.FluxMonitorSpec to be present, got %v", jb)
 	}
+	chainID := jb.FluxMonitorSpec.EVMChainID.ToInt()
+	if _, err = d.chainSet.Get(chainID); err != nil {
+		chainIDStr := "default"
+		if jb.FluxMonitorSpec.EVMChainID != nil {
+			chainIDStr = jb.FluxMonitorSpec.EVMChainID.String()
+		}
+		return nil, errors.Wrapf(err, "flux monitor job %q references unconfigured or disabled chain %s", jb.Name.ValueOrZero(), chainIDStr)
+	}
+	running, err := d.chainSet.Running(chainID)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case <-running:
+		return d.servicesForSpec(jb)
+	default:
+		d.lggr.Warnw("Chain of flux monitor job is not running yet, the job is dormant until it is", "jobID", jb.ID, "evmChainID", chainID)
+		return []job.Service{job.NewDormantService(d.lggr.With("jobID", jb.ID), running, func() ([]job.Service, error) {
+			return d.servicesForSpec(jb)
+		})}, nil
+	}
+}
+
+func (d *Delegate) servicesForSpec(jb job.Job) (services []job.Service, err error) {
 	chain, err := d.chainSet.Get(jb.FluxMonitorSpec.EVMChainID.ToInt())
 	if err != nil {
 		return nil, err
 	}
+	if err = validateGasOverrides(*jb.FluxMonitorSpec, chain.Config(), d.lggr); err != nil {
+		return nil, err
+	}
+	var multicall *MulticallLoader
+	if addr := chain.Config().EvmMulticallAddress(); addr != "" {
+		multicall = d.multicallLoader(chain, common.HexToAddress(addr))
+	}
 	strategy := bulletprooftxmanager.NewQueueingTxStrategy(jb.ExternalJobID, chain.Config().FMDefaultTransactionQueueDepth(), chain.Config().FMSimulateTransactions())
 
 	fm, err := NewFromJobSpec(
 		jb,
 		d.db,
-		NewORM(d.db, d.lggr, chain.Config(), chain.TxManager(), strategy),
+		NewORM(d.db, d.lggr, chain.Config(), TxOpts{
+			Txm:      chain.TxManager(),
+			Strategy: strategy,
+			Priority: jb.TransactionPriority(),
+			Origin:   bulletprooftxmanager.JobOrigin(jb.ID, jb.Name.ValueOrZero()),
+		}),
 		d.jobORM,
 		d.pipelineORM,
 		NewKeyStore(d.ethKeyStore),
 		chain.Client(),
+		multicall,
 		chain.LogBroadcaster(),
 		d.pipelineRunner,
 		chain.Config(),
@@ -81,5 +131,47 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.Service, err erro
 		return nil, err
 	}
 
-	return []job.Service{fm}, nil
+	return []job.Service{chain.LeaderElector().Gate("FluxMonitor", fm)}, nil
+}
+
+// GasOverridesConfig is the chain config that the gas overrides of a
+// FluxMonitorSpec are validated against
+type GasOverridesConfig interface {
+	EvmGasLimitMax() uint64
+	EvmMaxGasPriceWei() *big.Int
+	EvmMinGasPriceWei() *big.Int
+}
+
+// validateGasOverrides checks the gasLimit and maxGasPriceGWei of spec, if
+// set, against the chain. A max gas price below the chain's min gas price
+// would never be sent, and one above the chain's max has no effect.
+func validateGasOverrides(spec job.FluxMonitorSpec, cfg GasOverridesConfig, lggr logger.Logger) error {
+	if spec.GasLimit != nil && !spec.GasLimitEnv {
+		if max := cfg.EvmGasLimitMax(); max > 0 && *spec.GasLimit > max {
+			return errors.Errorf("gasLimit of %d exceeds ETH_GAS_LIMIT_MAX of %d", *spec.GasLimit, max)
+		}
+	}
+	if spec.MaxGasPriceGWei != nil && !spec.MaxGasPriceGWeiEnv {
+		maxGasPriceWei := spec.MaxGasPriceWei()
+		if min := cfg.EvmMinGasPriceWei(); maxGasPriceWei.Cmp(min) < 0 {
+			return errors.Errorf("maxGasPriceGWei of %v (%s wei) is below ETH_MIN_GAS_PRICE_WEI of %s wei, submissions would never be sent", *spec.MaxGasPriceGWei, maxGasPriceWei.String(), min.String())
+		}
+		if max := cfg.EvmMaxGasPriceWei(); maxGasPriceWei.Cmp(max) > 0 {
+			lggr.Warnw("maxGasPriceGWei is above ETH_MAX_GAS_PRICE_WEI and has no effect", "contractAddress", spec.ContractAddress, "maxGasPriceWei", maxGasPriceWei.String(), "ethMaxGasPriceWei", max.String())
+		}
+	}
+	return nil
+}
+
+// multicallLoader returns the chain's MulticallLoader, creating it if needed
+func (d *Delegate) multicallLoader(chain evm.Chain, address common.Address) *MulticallLoader {
+	d.multicallLoadersMu.Lock()
+	defer d.multicallLoadersMu.Unlock()
+	chainID := chain.ID().String()
+	loader, exists := d.multicallLoaders[chainID]
+	if !exists || loader.address != address {
+		loader = NewMulticallLoader(chain.Client(), address, DefaultMulticallBatchWindow, DefaultMulticallChunkSize, d.lggr)
+		d.multicallLoaders[chainID] = loader
+	}
+	return loader
 }
@@ -0,0 +1,32 @@
+package fluxmonitorv2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestDelegate_ServicesForSpec_UnconfiguredChain(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg})
+
+	delegate := fluxmonitorv2.NewDelegate(nil, nil, nil, nil, db, cc, logger.TestLogger(t))
+
+	jb := job.Job{
+		Name:            null.StringFrom("ETH / USD"),
+		FluxMonitorSpec: &job.FluxMonitorSpec{EVMChainID: utils.NewBigI(42)},
+	}
+	_, err := delegate.ServicesForSpec(jb)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `flux monitor job "ETH / USD" references unconfigured or disabled chain 42`)
+}
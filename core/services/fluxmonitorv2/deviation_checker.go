@@ -35,6 +35,9 @@ func NewZeroDeviationChecker(lggr logger.Logger) *DeviationChecker {
 }
 
 // OutsideDeviation checks whether the next price is outside the threshold.
+// Both the absolute and the relative thresholds must be met, matching OCR. If
+// the current answer is zero the relative deviation is undefined, so only the
+// absolute threshold applies.
 // If both thresholds are zero (default value), always returns true.
 func (c *DeviationChecker) OutsideDeviation(curAnswer, nextAnswer decimal.Decimal) bool {
 	loggerFields := []interface{}{
@@ -61,7 +64,7 @@ func (c *DeviationChecker) OutsideDeviation(curAnswer, nextAnswer decimal.Decima
 			c.lggr.Debugw("Relative deviation is undefined; can't satisfy threshold", loggerFields...)
 			return false
 		}
-		c.lggr.Infow("Threshold met: relative deviation is ∞", loggerFields...)
+		c.lggr.Infow("Absolute deviation threshold met: relative deviation is ∞", loggerFields...)
 		return true
 	}
 
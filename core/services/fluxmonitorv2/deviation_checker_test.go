@@ -81,3 +81,36 @@ func TestDeviationChecker_OutsideDeviation(t *testing.T) {
 		t.Run(tc.name+" max absolute threshold", func(t *testing.T) { c(test3) })
 	}
 }
+
+func TestDeviationChecker_OutsideDeviation_BothThresholds(t *testing.T) {
+	t.Parallel()
+
+	f := decimal.NewFromFloat
+	testCases := []outsideDeviationRow{
+		{"both met", f(100), f(103), 2, 2, true},
+		{"only relative met", f(100), f(103), 2, 5, false},
+		{"only absolute met", f(100), f(103), 5, 2, false},
+		{"neither met", f(100), f(101), 2, 2, false},
+
+		// Feeds that hover near zero, e.g. funding rates
+		{"near zero, relative met but absolute not", f(0.0001), f(0.0002), 50, 0.001, false},
+		{"near zero, both met", f(0.0001), f(0.0021), 50, 0.001, true},
+
+		// The relative deviation from zero is undefined, so only the absolute
+		// threshold applies
+		{"zero current answer, absolute met", f(0), f(0.002), 50, 0.001, true},
+		{"zero current answer, absolute not met", f(0), f(0.0005), 50, 0.001, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			checker := fluxmonitorv2.NewDeviationChecker(tc.threshold, tc.absoluteThreshold, logger.TestLogger(t))
+
+			assert.Equal(t, tc.expectation,
+				checker.OutsideDeviation(tc.curPrice, tc.nextPrice),
+				"check on OutsideDeviation failed for %s", tc,
+			)
+		})
+	}
+}
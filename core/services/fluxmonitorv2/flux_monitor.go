@@ -7,13 +7,16 @@ import (
 	"math/big"
 	mrand "math/rand"
 	"reflect"
+	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flags_wrapper"
@@ -51,6 +54,21 @@ const (
 
 const DefaultHibernationPollPeriod = 168 * time.Hour
 
+const (
+	// MaxSubmissionRetries is how many times we resubmit to a round whose
+	// submission transactions fatally errored
+	MaxSubmissionRetries = 3
+
+	submissionOutcomeQueueSize = 100
+)
+
+// submissionOutcome is the terminal outcome of the transaction of a
+// submission to a round
+type submissionOutcome struct {
+	roundID uint32
+	record  bulletprooftxmanager.OutcomeRecord
+}
+
 // FluxMonitor polls external price adapters via HTTP to check for price swings.
 type FluxMonitor struct {
 	contractAddress   common.Address
@@ -77,6 +95,8 @@ type FluxMonitor struct {
 	backlog       *utils.BoundedPriorityQueue
 	chProcessLogs chan struct{}
 
+	chSubmissionOutcomes chan submissionOutcome
+
 	utils.StartStopOnce
 	chStop     chan struct{}
 	waitOnStop chan struct{}
@@ -129,10 +149,11 @@ func NewFluxMonitor(
 			PriorityAnswerUpdatedLog: 1,
 			PriorityFlagChangedLog:   2,
 		}),
-		StartStopOnce: utils.StartStopOnce{},
-		chProcessLogs: make(chan struct{}, 1),
-		chStop:        make(chan struct{}),
-		waitOnStop:    make(chan struct{}),
+		StartStopOnce:        utils.StartStopOnce{},
+		chProcessLogs:        make(chan struct{}, 1),
+		chSubmissionOutcomes: make(chan submissionOutcome, submissionOutcomeQueueSize),
+		chStop:               make(chan struct{}),
+		waitOnStop:           make(chan struct{}),
 	}
 
 	return fm, nil
@@ -148,6 +169,7 @@ func NewFromJobSpec(
 	pipelineORM pipeline.ORM,
 	keyStore KeyStoreInterface,
 	ethClient evmclient.Client,
+	multicall *MulticallLoader,
 	logBroadcaster log.Broadcaster,
 	pipelineRunner pipeline.Runner,
 	cfg Config,
@@ -163,20 +185,40 @@ func NewFromJobSpec(
 		)
 	}
 
-	// Set up the flux aggregator
+	// Set up the flux aggregator. If the chain has a multicall contract, its
+	// reads are batched with those of the other flux monitor jobs.
+	var backend bind.ContractBackend = ethClient
+	if multicall != nil {
+		backend = multicall.Backend()
+	}
 	fluxAggregator, err := flux_aggregator_wrapper.NewFluxAggregator(
 		fmSpec.ContractAddress.Address(),
-		ethClient,
+		backend,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// A job's gas limit and max gas price override the chain's defaults.
+	// Unset ones are filled in with the defaults when the job is loaded, but
+	// only a max gas price set by the job is passed on, so that it doesn't
+	// lower a higher key specific max gas price.
+	gasLimit := cfg.EvmGasLimitDefault()
+	if fmSpec.GasLimit != nil {
+		gasLimit = *fmSpec.GasLimit
+	}
+	var maxGasPriceWei *big.Int
+	if !fmSpec.MaxGasPriceGWeiEnv {
+		maxGasPriceWei = fmSpec.MaxGasPriceWei()
+	}
+
 	contractSubmitter := NewFluxAggregatorContractSubmitter(
 		fluxAggregator,
 		orm,
 		keyStore,
-		cfg.EvmGasLimitDefault(),
+		gasLimit,
+		maxGasPriceWei,
+		jobSpec.ID,
 	)
 
 	flags, err := NewFlags(cfg.FlagsContractAddress(), ethClient)
@@ -195,14 +237,25 @@ func NewFromJobSpec(
 	jobSpec.PipelineSpec.JobID = jobSpec.ID
 	jobSpec.PipelineSpec.JobName = jobSpec.Name.ValueOrZero()
 
-	min, err := fluxAggregator.MinSubmissionValue(nil)
-	if err != nil {
-		return nil, err
+	// Read concurrently, so that both reads go in the same multicall batch
+	var min, max *big.Int
+	var minErr, maxErr error
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		min, minErr = fluxAggregator.MinSubmissionValue(nil)
+	}()
+	go func() {
+		defer wg.Done()
+		max, maxErr = fluxAggregator.MaxSubmissionValue(nil)
+	}()
+	wg.Wait()
+	if minErr != nil {
+		return nil, minErr
 	}
-
-	max, err := fluxAggregator.MaxSubmissionValue(nil)
-	if err != nil {
-		return nil, err
+	if maxErr != nil {
+		return nil, maxErr
 	}
 
 	fmLogger := lggr.With(
@@ -389,6 +442,11 @@ func (fm *FluxMonitor) consume() {
 		case <-fm.chProcessLogs:
 			recovery.WrapRecover(fm.logger, fm.processLogs)
 
+		case outcome := <-fm.chSubmissionOutcomes:
+			recovery.WrapRecover(fm.logger, func() {
+				fm.handleSubmissionOutcome(outcome)
+			})
+
 		case at := <-fm.pollManager.PollTickerTicks():
 			tickLogger.Debugf("Poll ticker fired on %v", formatTime(at))
 			recovery.WrapRecover(fm.logger, func() {
@@ -757,7 +815,7 @@ func (fm *FluxMonitor) respondToNewRoundLog(log flux_aggregator_wrapper.FluxAggr
 		if err2 := fm.runner.InsertFinishedRun(&run, false, pg.WithQueryer(tx)); err2 != nil {
 			return err2
 		}
-		if err2 := fm.queueTransactionForBPTXM(tx, run.ID, answer, roundState.RoundId, &log); err2 != nil {
+		if err2 := fm.queueTransactionForBPTXM(tx, run.ID, answer, roundState, &log); err2 != nil {
 			return err2
 		}
 		return fm.logBroadcaster.MarkConsumed(lb, pg.WithQueryer(tx))
@@ -980,7 +1038,7 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 		if err2 := fm.runner.InsertFinishedRun(&run, true, pg.WithQueryer(tx)); err2 != nil {
 			return err2
 		}
-		if err2 := fm.queueTransactionForBPTXM(tx, run.ID, answer, roundState.RoundId, nil); err2 != nil {
+		if err2 := fm.queueTransactionForBPTXM(tx, run.ID, answer, roundState, nil); err2 != nil {
 			return err2
 		}
 		if broadcast != nil {
@@ -1056,11 +1114,14 @@ func (fm *FluxMonitor) initialRoundState() flux_aggregator_wrapper.OracleRoundSt
 	return latestRoundState
 }
 
-func (fm *FluxMonitor) queueTransactionForBPTXM(tx pg.Queryer, runID int64, answer decimal.Decimal, roundID uint32, log *flux_aggregator_wrapper.FluxAggregatorNewRound) error {
+func (fm *FluxMonitor) queueTransactionForBPTXM(tx pg.Queryer, runID int64, answer decimal.Decimal, roundState flux_aggregator_wrapper.OracleRoundState, log *flux_aggregator_wrapper.FluxAggregatorNewRound) error {
+	roundID := roundState.RoundId
 	// Submit the Eth Tx
 	err := fm.contractSubmitter.Submit(
 		new(big.Int).SetInt64(int64(roundID)),
 		answer.BigInt(),
+		roundStateDeadline(roundState),
+		fm.onSubmissionOutcome(roundID),
 		pg.WithQueryer(tx),
 	)
 	if err != nil {
@@ -1091,6 +1152,139 @@ func (fm *FluxMonitor) queueTransactionForBPTXM(tx pg.Queryer, runID int64, answ
 	return nil
 }
 
+// onSubmissionOutcome returns the callback that passes the outcome of the
+// transaction of our submission to roundID to the consume loop. It never
+// blocks the transaction manager.
+func (fm *FluxMonitor) onSubmissionOutcome(roundID uint32) bulletprooftxmanager.OutcomeCallback {
+	return func(record bulletprooftxmanager.OutcomeRecord) {
+		select {
+		case fm.chSubmissionOutcomes <- submissionOutcome{roundID, record}:
+		default:
+			fm.logger.Errorw("Submission outcome queue is full, dropping outcome", "roundID", roundID, "ethTxID", record.EthTxID, "outcome", record.Outcome)
+		}
+	}
+}
+
+// handleSubmissionOutcome resubmits to a round if the transaction of our
+// submission to it fatally errored. Such a transaction was never broadcast,
+// so the submission is no longer counted in the round stats. We only
+// resubmit if the round is still open, we are still eligible to submit to it,
+// we haven't submitted to it again since, and we haven't resubmitted to it
+// MaxSubmissionRetries times already.
+//
+// Both the round stats and the retries are derived from the state of the
+// submission transactions, so that they survive restarts. Outcomes that are
+// lost on restart are only not resubmitted straight away, the next poll
+// submits to the round again if needed.
+func (fm *FluxMonitor) handleSubmissionOutcome(outcome submissionOutcome) {
+	roundID := outcome.roundID
+	l := fm.logger.With(
+		"round", roundID,
+		"ethTxID", outcome.record.EthTxID,
+		"outcome", outcome.record.Outcome,
+	)
+
+	if outcome.record.Outcome != bulletprooftxmanager.OutcomeFatalError {
+		l.Debug("Submission transaction reached terminal outcome")
+		return
+	}
+	l = l.With("fatalReason", outcome.record.FatalReason, "err", outcome.record.Error)
+
+	// Every resubmission follows a submission that fatally errored
+	nFatal, err := fm.orm.CountFatalSubmissions(fm.contractAddress, roundID)
+	if err != nil {
+		l.Errorw("Submission transaction fatally errored, not resubmitting: error counting fatally errored submissions", "countErr", err)
+		return
+	}
+	retries := nFatal - 1
+	if retries >= MaxSubmissionRetries {
+		l.Errorf("Submission transaction fatally errored, not resubmitting: already resubmitted %d times", retries)
+		return
+	}
+
+	roundStats, err := fm.orm.FindOrCreateFluxMonitorRoundStats(fm.contractAddress, roundID, 0)
+	if err != nil {
+		l.Errorw("Submission transaction fatally errored, not resubmitting: error fetching round stats", "statsErr", err)
+		return
+	}
+	if roundStats.NumSubmissions > 0 {
+		l.Info("Submission transaction fatally errored, not resubmitting: already submitted to the round again")
+		return
+	}
+
+	roundState, err := fm.roundState(roundID)
+	if err != nil {
+		l.Errorw("Submission transaction fatally errored, not resubmitting: error fetching round state", "roundStateErr", err)
+		return
+	}
+	if err = fm.checkEligibilityAndAggregatorFunding(roundState); err != nil {
+		l.Infof("Submission transaction fatally errored, not resubmitting: %v", err)
+		return
+	}
+
+	l.Warnw("Submission transaction fatally errored, resubmitting", "retry", retries+1)
+	fm.resubmit(l, roundState)
+}
+
+// resubmit polls for a fresh answer and submits it to the round, regardless
+// of deviation
+func (fm *FluxMonitor) resubmit(l logger.Logger, roundState flux_aggregator_wrapper.OracleRoundState) {
+	started := time.Now()
+
+	var metaDataForBridge map[string]interface{}
+	lrd, err := fm.fluxAggregator.LatestRoundData(nil)
+	if err != nil {
+		l.Warnw("Couldn't read latest round data for request meta", "err", err)
+	} else {
+		metaDataForBridge, err = bridges.MarshalBridgeMetaData(lrd.Answer, lrd.UpdatedAt)
+		if err != nil {
+			l.Warnw("Error marshalling roundState for request meta", "err", err)
+		}
+	}
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    fm.jobSpec.ID,
+			"externalJobID": fm.jobSpec.ExternalJobID,
+			"name":          fm.jobSpec.Name.ValueOrZero(),
+		},
+		"jobRun": map[string]interface{}{
+			"meta": metaDataForBridge,
+		},
+	})
+
+	run, results, err := fm.runner.ExecuteRun(context.Background(), fm.spec, vars, fm.logger)
+	if err != nil {
+		l.Errorw(fmt.Sprintf("error executing new run for job ID %v name %v", fm.spec.JobID, fm.spec.JobName), "err", err)
+		return
+	}
+	result, err := results.FinalResult(l).SingularResult()
+	if err != nil || result.Error != nil {
+		l.Errorw("can't fetch answer", "err", err, "result", result)
+		fm.jobORM.TryRecordError(fm.spec.JobID, "Error polling")
+		return
+	}
+	answer, err := utils.ToDecimal(result.Value)
+	if err != nil {
+		l.Errorw(fmt.Sprintf("error executing new run for job ID %v name %v", fm.spec.JobID, fm.spec.JobName), "err", err)
+		return
+	}
+
+	if !fm.isValidSubmission(l, answer, started) {
+		return
+	}
+
+	err = fm.q.Transaction(func(tx pg.Queryer) error {
+		if err2 := fm.runner.InsertFinishedRun(&run, false, pg.WithQueryer(tx)); err2 != nil {
+			return err2
+		}
+		return fm.queueTransactionForBPTXM(tx, run.ID, answer, roundState, nil)
+	})
+	if err != nil {
+		l.Errorw("can't create job run", "err", err)
+	}
+}
+
 func (fm *FluxMonitor) statsAndStatusForRound(roundID uint32, newRoundLogs uint) (FluxMonitorRoundStatsV2, pipeline.RunStatus, error) {
 	roundStats, err := fm.orm.FindOrCreateFluxMonitorRoundStats(fm.contractAddress, roundID, newRoundLogs)
 	if err != nil {
@@ -47,7 +47,7 @@ const oracleCount uint8 = 17
 type answerSet struct{ latestAnswer, polledAnswer int64 }
 
 func newORM(t *testing.T, db *sqlx.DB, cfg pg.LogConfig, txm bulletprooftxmanager.TxManager) fluxmonitorv2.ORM {
-	return fluxmonitorv2.NewORM(db, logger.TestLogger(t), cfg, txm, bulletprooftxmanager.SendEveryStrategy{})
+	return fluxmonitorv2.NewORM(db, logger.TestLogger(t), cfg, fluxmonitorv2.TxOpts{Txm: txm, Strategy: bulletprooftxmanager.SendEveryStrategy{}})
 }
 
 var (
@@ -493,7 +493,7 @@ func TestFluxMonitor_PollIfEligible(t *testing.T) {
 					}).
 					Once()
 				tm.contractSubmitter.
-					On("Submit", big.NewInt(reportableRoundID), big.NewInt(answers.polledAnswer), mock.Anything).
+					On("Submit", big.NewInt(reportableRoundID), big.NewInt(answers.polledAnswer), mock.Anything, mock.Anything, mock.Anything).
 					Return(nil).
 					Once()
 
@@ -629,7 +629,7 @@ func TestPollingDeviationChecker_BuffersLogs(t *testing.T) {
 			args.Get(0).(*pipeline.Run).ID = 1
 		})
 	tm.contractSubmitter.
-		On("Submit", big.NewInt(1), big.NewInt(fetchedValue), mock.Anything).
+		On("Submit", big.NewInt(1), big.NewInt(fetchedValue), mock.Anything, mock.Anything, mock.Anything).
 		Return(nil).
 		Once()
 
@@ -668,7 +668,7 @@ func TestPollingDeviationChecker_BuffersLogs(t *testing.T) {
 			args.Get(0).(*pipeline.Run).ID = 2
 		})
 	tm.contractSubmitter.
-		On("Submit", big.NewInt(3), big.NewInt(fetchedValue), mock.Anything).
+		On("Submit", big.NewInt(3), big.NewInt(fetchedValue), mock.Anything, mock.Anything, mock.Anything).
 		Return(nil).
 		Once()
 	tm.orm.
@@ -706,7 +706,7 @@ func TestPollingDeviationChecker_BuffersLogs(t *testing.T) {
 			args.Get(0).(*pipeline.Run).ID = 3
 		})
 	tm.contractSubmitter.
-		On("Submit", big.NewInt(4), big.NewInt(fetchedValue), mock.Anything).
+		On("Submit", big.NewInt(4), big.NewInt(fetchedValue), mock.Anything, mock.Anything, mock.Anything).
 		Return(nil).
 		Once()
 	tm.orm.
@@ -1577,7 +1577,7 @@ func TestFluxMonitor_DoesNotDoubleSubmit(t *testing.T) {
 				args.Get(0).(*pipeline.Run).ID = 1
 			})
 		tm.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil).Once()
-		tm.contractSubmitter.On("Submit", big.NewInt(roundID), big.NewInt(answer), mock.Anything).Return(nil).Once()
+		tm.contractSubmitter.On("Submit", big.NewInt(roundID), big.NewInt(answer), mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 		tm.orm.
 			On("UpdateFluxMonitorRoundStats",
 				contractAddress,
@@ -1703,7 +1703,7 @@ func TestFluxMonitor_DoesNotDoubleSubmit(t *testing.T) {
 			Run(func(args mock.Arguments) {
 				args.Get(0).(*pipeline.Run).ID = 1
 			})
-		tm.contractSubmitter.On("Submit", big.NewInt(roundID), big.NewInt(answer), mock.Anything).Return(nil).Once()
+		tm.contractSubmitter.On("Submit", big.NewInt(roundID), big.NewInt(answer), mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 		tm.orm.
 			On("UpdateFluxMonitorRoundStats",
 				contractAddress,
@@ -1799,7 +1799,7 @@ func TestFluxMonitor_DoesNotDoubleSubmit(t *testing.T) {
 			Run(func(args mock.Arguments) {
 				args.Get(0).(*pipeline.Run).ID = 1
 			})
-		tm.contractSubmitter.On("Submit", big.NewInt(roundID), big.NewInt(answer), mock.Anything).Return(nil).Once()
+		tm.contractSubmitter.On("Submit", big.NewInt(roundID), big.NewInt(answer), mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 		tm.orm.
 			On("UpdateFluxMonitorRoundStats",
 				contractAddress,
@@ -1872,7 +1872,7 @@ func TestFluxMonitor_DoesNotDoubleSubmit(t *testing.T) {
 			Once()
 
 		// and that should result in a new submission
-		tm.contractSubmitter.On("Submit", big.NewInt(olderRoundID), big.NewInt(answer), mock.Anything).Return(nil).Once()
+		tm.contractSubmitter.On("Submit", big.NewInt(olderRoundID), big.NewInt(answer), mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 
 		tm.orm.
 			On("UpdateFluxMonitorRoundStats",
@@ -1981,7 +1981,7 @@ func TestFluxMonitor_DrumbeatTicker(t *testing.T) {
 			}).
 			Once()
 		tm.contractSubmitter.
-			On("Submit", big.NewInt(int64(roundID)), answerBigInt, mock.Anything).
+			On("Submit", big.NewInt(int64(roundID)), answerBigInt, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil).
 			Once()
 
@@ -2013,3 +2013,154 @@ func TestFluxMonitor_DrumbeatTicker(t *testing.T) {
 	cltest.EventuallyExpectationsMet(t, tm.contractSubmitter, waitTime, interval)
 	tm.AssertExpectations(t)
 }
+
+func TestFluxMonitor_DrumbeatTicker_SkipsRoundAlreadyAnswered(t *testing.T) {
+	t.Parallel()
+
+	db, nodeAddr := setupStoreWithKey(t)
+	fm, tm := setup(t, db, disablePollTicker(true), disableIdleTimer(true), enableDrumbeatTicker("@every 1h", 0))
+
+	const roundID = 3
+	tm.logBroadcaster.On("IsConnected").Return(true)
+	tm.fluxAggregator.On("OracleRoundState", nilOpts, nodeAddr, uint32(0)).
+		Return(flux_aggregator_wrapper.OracleRoundState{
+			RoundId:          roundID,
+			EligibleToSubmit: true,
+			LatestSubmission: big.NewInt(100),
+			AvailableFunds:   big.NewInt(1).Mul(big.NewInt(10000), config.DefaultMinimumContractPayment.ToInt()),
+			PaymentAmount:    config.DefaultMinimumContractPayment.ToInt(),
+			StartedAt:        now(),
+		}, nil).
+		Once()
+
+	// A deviation poll already submitted to this round, and its transaction
+	// has not been mined yet
+	tm.orm.
+		On("FindOrCreateFluxMonitorRoundStats", contractAddress, uint32(roundID), mock.Anything).
+		Return(fluxmonitorv2.FluxMonitorRoundStatsV2{
+			PipelineRunID:  corenull.NewInt64(int64(1), true),
+			Aggregator:     contractAddress,
+			RoundID:        roundID,
+			NumSubmissions: 1,
+		}, nil).
+		Once()
+	tm.pipelineORM.On("FindRun", int64(1)).Return(pipeline.Run{
+		FinishedAt: null.TimeFrom(time.Now()),
+	}, nil)
+
+	fm.ExportedDrumbeatPoll()
+
+	tm.pipelineRunner.AssertNotCalled(t, "ExecuteRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	tm.contractSubmitter.AssertNotCalled(t, "Submit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	tm.AssertExpectations(t)
+}
+
+func TestFluxMonitor_ResubmitsOnFatalError(t *testing.T) {
+	const (
+		roundID = 3
+		answer  = 100
+	)
+	var (
+		paymentAmount  = config.DefaultMinimumContractPayment.ToInt()
+		availableFunds = big.NewInt(1).Mul(paymentAmount, big.NewInt(1000))
+		fatalError     = bulletprooftxmanager.OutcomeRecord{Outcome: bulletprooftxmanager.OutcomeFatalError, EthTxID: 42, FatalReason: bulletprooftxmanager.FatalReasonSimulationReverted}
+	)
+
+	setupFM := func(t *testing.T, eligible bool) (*fluxmonitorv2.FluxMonitor, *testMocks) {
+		db, nodeAddr := setupStoreWithKey(t)
+		fm, tm := setup(t, db, disableIdleTimer(true), disablePollTicker(true))
+
+		tm.keyStore.On("SendingKeys").Return([]ethkey.KeyV2{{Address: ethkey.EIP55AddressFromAddress(nodeAddr)}}, nil).Once()
+		tm.fluxAggregator.On("GetOracles", nilOpts).Return([]common.Address{nodeAddr}, nil)
+		require.NoError(t, fm.SetOracleAddress())
+
+		tm.fluxAggregator.On("OracleRoundState", nilOpts, nodeAddr, uint32(roundID)).
+			Return(flux_aggregator_wrapper.OracleRoundState{
+				RoundId:          roundID,
+				LatestSubmission: big.NewInt(answer),
+				EligibleToSubmit: eligible,
+				AvailableFunds:   availableFunds,
+				PaymentAmount:    paymentAmount,
+				OracleCount:      1,
+			}, nil).Maybe()
+		return fm, tm
+	}
+	expectResubmission := func(tm *testMocks, nFatal int) {
+		tm.orm.On("CountFatalSubmissions", contractAddress, uint32(roundID)).Return(nFatal, nil).Once()
+		tm.orm.
+			On("FindOrCreateFluxMonitorRoundStats", contractAddress, uint32(roundID), uint(0)).
+			Return(fluxmonitorv2.FluxMonitorRoundStatsV2{Aggregator: contractAddress, RoundID: roundID}, nil).Once()
+		tm.fluxAggregator.On("LatestRoundData", nilOpts).Return(flux_aggregator_wrapper.LatestRoundData{
+			Answer:    big.NewInt(10),
+			UpdatedAt: big.NewInt(100),
+		}, nil).Once()
+		tm.pipelineRunner.
+			On("ExecuteRun", context.Background(), pipelineSpec, mock.Anything, mock.Anything).
+			Return(pipeline.Run{}, pipeline.TaskRunResults{
+				{
+					Result: pipeline.Result{Value: decimal.NewFromInt(answer)},
+					Task:   &pipeline.HTTPTask{},
+				},
+			}, nil).Once()
+		tm.pipelineRunner.
+			On("InsertFinishedRun", mock.Anything, false, mock.Anything).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				args.Get(0).(*pipeline.Run).ID = 1
+			}).Once()
+		tm.contractSubmitter.On("Submit", big.NewInt(roundID), big.NewInt(answer), mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		tm.orm.On("UpdateFluxMonitorRoundStats", contractAddress, uint32(roundID), int64(1), uint(0), mock.Anything).Return(nil).Once()
+	}
+
+	t.Run("resubmits while the round is open and we are eligible, up to MaxSubmissionRetries times", func(t *testing.T) {
+		fm, tm := setupFM(t, true)
+
+		for i := 0; i < fluxmonitorv2.MaxSubmissionRetries; i++ {
+			expectResubmission(tm, i+1)
+			fm.ExportedHandleSubmissionOutcome(roundID, fatalError)
+		}
+
+		// Every resubmission fatally errored too, so we give up on the round
+		tm.orm.On("CountFatalSubmissions", contractAddress, uint32(roundID)).Return(fluxmonitorv2.MaxSubmissionRetries+1, nil).Once()
+		fm.ExportedHandleSubmissionOutcome(roundID, fatalError)
+
+		tm.AssertExpectations(t)
+		tm.contractSubmitter.AssertNumberOfCalls(t, "Submit", fluxmonitorv2.MaxSubmissionRetries)
+	})
+
+	t.Run("does not resubmit once we are no longer eligible", func(t *testing.T) {
+		fm, tm := setupFM(t, false)
+
+		tm.orm.On("CountFatalSubmissions", contractAddress, uint32(roundID)).Return(1, nil).Once()
+		tm.orm.
+			On("FindOrCreateFluxMonitorRoundStats", contractAddress, uint32(roundID), uint(0)).
+			Return(fluxmonitorv2.FluxMonitorRoundStatsV2{Aggregator: contractAddress, RoundID: roundID}, nil).Once()
+		fm.ExportedHandleSubmissionOutcome(roundID, fatalError)
+
+		tm.AssertExpectations(t)
+		tm.contractSubmitter.AssertNotCalled(t, "Submit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("does not resubmit if the round was submitted to again", func(t *testing.T) {
+		fm, tm := setupFM(t, true)
+
+		tm.orm.On("CountFatalSubmissions", contractAddress, uint32(roundID)).Return(1, nil).Once()
+		tm.orm.
+			On("FindOrCreateFluxMonitorRoundStats", contractAddress, uint32(roundID), uint(0)).
+			Return(fluxmonitorv2.FluxMonitorRoundStatsV2{Aggregator: contractAddress, RoundID: roundID, NumSubmissions: 1}, nil).Once()
+		fm.ExportedHandleSubmissionOutcome(roundID, fatalError)
+
+		tm.AssertExpectations(t)
+		tm.contractSubmitter.AssertNotCalled(t, "Submit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ignores other outcomes", func(t *testing.T) {
+		fm, tm := setupFM(t, true)
+
+		fm.ExportedHandleSubmissionOutcome(roundID, bulletprooftxmanager.OutcomeRecord{Outcome: bulletprooftxmanager.OutcomeConfirmed, EthTxID: 42})
+		fm.ExportedHandleSubmissionOutcome(roundID, bulletprooftxmanager.OutcomeRecord{Outcome: bulletprooftxmanager.OutcomeDeadLetter, EthTxID: 42})
+
+		tm.AssertExpectations(t)
+		tm.orm.AssertNotCalled(t, "CountFatalSubmissions", mock.Anything, mock.Anything)
+	})
+}
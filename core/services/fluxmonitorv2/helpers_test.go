@@ -1,6 +1,7 @@
 package fluxmonitorv2
 
 import (
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -10,6 +11,10 @@ func (fm *FluxMonitor) ExportedPollIfEligible(threshold, absoluteThreshold float
 	fm.pollIfEligible(PollRequestTypePoll, NewDeviationChecker(threshold, absoluteThreshold, fm.logger), nil)
 }
 
+func (fm *FluxMonitor) ExportedDrumbeatPoll() {
+	fm.pollIfEligible(PollRequestTypeDrumbeat, NewZeroDeviationChecker(fm.logger), nil)
+}
+
 func (fm *FluxMonitor) ExportedProcessLogs() {
 	fm.processLogs()
 }
@@ -31,6 +36,10 @@ func (fm *FluxMonitor) ExportedRespondToFlagsRaisedLog() {
 	fm.rotateSelectLoop()
 }
 
+func (fm *FluxMonitor) ExportedHandleSubmissionOutcome(roundID uint32, record bulletprooftxmanager.OutcomeRecord) {
+	fm.handleSubmissionOutcome(submissionOutcome{roundID, record})
+}
+
 func (fm *FluxMonitor) rotateSelectLoop() {
 	// the PollRequest is sent to 'rotate' the main select loop, so that new timers will be evaluated
 	fm.pollManager.chPoll <- PollRequest{Type: PollRequestTypeUnknown}
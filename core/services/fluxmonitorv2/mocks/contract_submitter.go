@@ -5,9 +5,13 @@ package mocks
 import (
 	big "math/big"
 
+	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+
 	mock "github.com/stretchr/testify/mock"
 
 	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+
+	time "time"
 )
 
 // ContractSubmitter is an autogenerated mock type for the ContractSubmitter type
@@ -15,20 +19,20 @@ type ContractSubmitter struct {
 	mock.Mock
 }
 
-// Submit provides a mock function with given fields: roundID, submission, qopts
-func (_m *ContractSubmitter) Submit(roundID *big.Int, submission *big.Int, qopts ...pg.QOpt) error {
+// Submit provides a mock function with given fields: roundID, submission, deadline, onOutcome, qopts
+func (_m *ContractSubmitter) Submit(roundID *big.Int, submission *big.Int, deadline *time.Time, onOutcome bulletprooftxmanager.OutcomeCallback, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
 	for _i := range qopts {
 		_va[_i] = qopts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, roundID, submission)
+	_ca = append(_ca, roundID, submission, deadline, onOutcome)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*big.Int, *big.Int, ...pg.QOpt) error); ok {
-		r0 = rf(roundID, submission, qopts...)
+	if rf, ok := ret.Get(0).(func(*big.Int, *big.Int, *time.Time, bulletprooftxmanager.OutcomeCallback, ...pg.QOpt) error); ok {
+		r0 = rf(roundID, submission, deadline, onOutcome, qopts...)
 	} else {
 		r0 = ret.Error(0)
 	}
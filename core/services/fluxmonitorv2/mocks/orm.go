@@ -3,6 +3,12 @@
 package mocks
 
 import (
+	big "math/big"
+
+	time "time"
+
+	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+
 	common "github.com/ethereum/go-ethereum/common"
 	fluxmonitorv2 "github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	mock "github.com/stretchr/testify/mock"
@@ -15,6 +21,27 @@ type ORM struct {
 	mock.Mock
 }
 
+// CountFatalSubmissions provides a mock function with given fields: aggregator, roundID
+func (_m *ORM) CountFatalSubmissions(aggregator common.Address, roundID uint32) (int, error) {
+	ret := _m.Called(aggregator, roundID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(common.Address, uint32) int); ok {
+		r0 = rf(aggregator, roundID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, uint32) error); ok {
+		r1 = rf(aggregator, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CountFluxMonitorRoundStats provides a mock function with given fields:
 func (_m *ORM) CountFluxMonitorRoundStats() (int, error) {
 	ret := _m.Called()
@@ -36,20 +63,20 @@ func (_m *ORM) CountFluxMonitorRoundStats() (int, error) {
 	return r0, r1
 }
 
-// CreateEthTransaction provides a mock function with given fields: fromAddress, toAddress, payload, gasLimit, qopts
-func (_m *ORM) CreateEthTransaction(fromAddress common.Address, toAddress common.Address, payload []byte, gasLimit uint64, qopts ...pg.QOpt) error {
+// CreateEthTransaction provides a mock function with given fields: fromAddress, toAddress, payload, gasLimit, maxGasPriceWei, meta, deadline, onOutcome, qopts
+func (_m *ORM) CreateEthTransaction(fromAddress common.Address, toAddress common.Address, payload []byte, gasLimit uint64, maxGasPriceWei *big.Int, meta *bulletprooftxmanager.EthTxMeta, deadline *time.Time, onOutcome bulletprooftxmanager.OutcomeCallback, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
 	for _i := range qopts {
 		_va[_i] = qopts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, fromAddress, toAddress, payload, gasLimit)
+	_ca = append(_ca, fromAddress, toAddress, payload, gasLimit, maxGasPriceWei, meta, deadline, onOutcome)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(common.Address, common.Address, []byte, uint64, ...pg.QOpt) error); ok {
-		r0 = rf(fromAddress, toAddress, payload, gasLimit, qopts...)
+	if rf, ok := ret.Get(0).(func(common.Address, common.Address, []byte, uint64, *big.Int, *bulletprooftxmanager.EthTxMeta, *time.Time, bulletprooftxmanager.OutcomeCallback, ...pg.QOpt) error); ok {
+		r0 = rf(fromAddress, toAddress, payload, gasLimit, maxGasPriceWei, meta, deadline, onOutcome, qopts...)
 	} else {
 		r0 = ret.Error(0)
 	}
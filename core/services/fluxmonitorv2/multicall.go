@@ -0,0 +1,213 @@
+package fluxmonitorv2
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// MulticallABI is the ABI of the tryAggregate method of the Multicall2
+// contract, which is all the MulticallLoader uses
+var MulticallABI = evmtypes.MustGetABI(`[{"inputs":[{"internalType":"bool","name":"requireSuccess","type":"bool"},{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall2.Call[]","name":"calls","type":"tuple[]"}],"name":"tryAggregate","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall2.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"nonpayable","type":"function"}]`)
+
+const (
+	// DefaultMulticallBatchWindow is how long the MulticallLoader waits for
+	// more calls before sending a batch
+	DefaultMulticallBatchWindow = 20 * time.Millisecond
+	// DefaultMulticallChunkSize is the most calls sent in a single batch
+	DefaultMulticallChunkSize = 100
+
+	multicallTimeout = 30 * time.Second
+)
+
+// MulticallCall is a single call in a tryAggregate batch
+type MulticallCall struct {
+	Target   common.Address
+	CallData []byte
+}
+
+// MulticallResult is the result of a single call in a tryAggregate batch
+type MulticallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+type multicallRequest struct {
+	msg      ethereum.CallMsg
+	chResult chan multicallResponse
+}
+
+type multicallResponse struct {
+	data []byte
+	err  error
+}
+
+// MulticallLoader batches contract reads from many callers into tryAggregate
+// calls to a Multicall2 contract, so that e.g. starting hundreds of flux
+// monitor jobs doesn't need several sequential eth_calls per job.
+//
+// Reads that arrive within the batch window of each other are sent together,
+// in chunks of up to chunkSize. A read whose entry in the batch reverts, or
+// every read in a batch that fails as a whole, falls back to an individual
+// eth_call, so that callers see the same result and error as without
+// multicall.
+//
+// A MulticallLoader is shared by all users on a chain; see Backend.
+type MulticallLoader struct {
+	ethClient evmclient.Client
+	address   common.Address
+	window    time.Duration
+	chunkSize int
+	lggr      logger.Logger
+
+	mu      sync.Mutex
+	pending []multicallRequest
+	timer   *time.Timer
+}
+
+// NewMulticallLoader returns a MulticallLoader that batches reads into calls
+// to the Multicall2 contract at address
+func NewMulticallLoader(ethClient evmclient.Client, address common.Address, window time.Duration, chunkSize int, lggr logger.Logger) *MulticallLoader {
+	return &MulticallLoader{
+		ethClient: ethClient,
+		address:   address,
+		window:    window,
+		chunkSize: chunkSize,
+		lggr:      lggr.Named("MulticallLoader"),
+	}
+}
+
+// Backend returns a bind.ContractBackend that sends reads at the latest
+// block through the loader, and everything else straight to the eth client.
+// Contract wrappers created with it batch their reads transparently.
+func (l *MulticallLoader) Backend() bind.ContractBackend {
+	return &multicallBackend{l.ethClient, l}
+}
+
+// Call queues msg for the next batch, and returns its result once the batch
+// has been sent
+func (l *MulticallLoader) Call(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	if msg.To == nil {
+		return nil, errors.New("MulticallLoader: call has no target")
+	}
+	req := multicallRequest{msg, make(chan multicallResponse, 1)}
+	l.enqueue(req)
+
+	select {
+	case res := <-req.chResult:
+		return res.data, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *MulticallLoader) enqueue(req multicallRequest) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pending = append(l.pending, req)
+	if len(l.pending) >= l.chunkSize {
+		l.flushLocked()
+		return
+	}
+	if l.timer == nil {
+		l.timer = time.AfterFunc(l.window, l.flush)
+	}
+}
+
+func (l *MulticallLoader) flush() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.flushLocked()
+}
+
+func (l *MulticallLoader) flushLocked() {
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+	if len(l.pending) == 0 {
+		return
+	}
+	batch := l.pending
+	l.pending = nil
+	go l.send(batch)
+}
+
+// send sends batch as a single tryAggregate call, and delivers each result.
+// Reads that failed are retried individually.
+func (l *MulticallLoader) send(batch []multicallRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), multicallTimeout)
+	defer cancel()
+
+	results, err := l.tryAggregate(ctx, batch)
+	if err != nil {
+		l.lggr.Warnw("Multicall batch failed, falling back to individual calls", "size", len(batch), "err", err)
+	}
+
+	var wg sync.WaitGroup
+	for i, req := range batch {
+		if err == nil && results[i].Success {
+			req.chResult <- multicallResponse{data: results[i].ReturnData}
+			continue
+		}
+		wg.Add(1)
+		go func(req multicallRequest) {
+			defer wg.Done()
+			data, err := l.ethClient.CallContract(ctx, req.msg, nil)
+			req.chResult <- multicallResponse{data, err}
+		}(req)
+	}
+	wg.Wait()
+}
+
+func (l *MulticallLoader) tryAggregate(ctx context.Context, batch []multicallRequest) ([]MulticallResult, error) {
+	calls := make([]MulticallCall, len(batch))
+	for i, req := range batch {
+		calls[i] = MulticallCall{*req.msg.To, req.msg.Data}
+	}
+	data, err := MulticallABI.Pack("tryAggregate", false, calls)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack tryAggregate call")
+	}
+	out, err := l.ethClient.CallContract(ctx, ethereum.CallMsg{To: &l.address, Data: data}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "tryAggregate call failed")
+	}
+	unpacked, err := MulticallABI.Unpack("tryAggregate", out)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unpack tryAggregate result")
+	}
+	results := *abi.ConvertType(unpacked[0], new([]MulticallResult)).(*[]MulticallResult)
+	if len(results) != len(batch) {
+		return nil, errors.Errorf("tryAggregate returned %d results for %d calls", len(results), len(batch))
+	}
+	return results, nil
+}
+
+// multicallBackend is a bind.ContractBackend whose reads at the latest block
+// go through a MulticallLoader
+type multicallBackend struct {
+	evmclient.Client
+	loader *MulticallLoader
+}
+
+// CallContract batches calls at the latest block that have no sender or
+// value, since those are the only calls tryAggregate can make on the
+// caller's behalf
+func (b *multicallBackend) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if blockNumber != nil || msg.To == nil || msg.From != (common.Address{}) || (msg.Value != nil && msg.Value.Sign() != 0) {
+		return b.Client.CallContract(ctx, msg, blockNumber)
+	}
+	return b.loader.Call(ctx, msg)
+}
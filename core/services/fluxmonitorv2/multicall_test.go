@@ -0,0 +1,150 @@
+package fluxmonitorv2_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+)
+
+func TestMulticallLoader(t *testing.T) {
+	t.Parallel()
+
+	multicallAddress := cltest.NewAddress()
+	aggregators := make([]common.Address, 10)
+	values := make(map[common.Address]*big.Int)
+	for i := range aggregators {
+		aggregators[i] = cltest.NewAddress()
+		values[aggregators[i]] = big.NewInt(int64(i + 1))
+	}
+
+	packValue := func(v *big.Int) []byte {
+		b, err := fluxmonitorv2.FluxAggregatorABI.Methods["minSubmissionValue"].Outputs.Pack(v)
+		require.NoError(t, err)
+		return b
+	}
+	// tryAggregate answers a multicall with each aggregator's value, except
+	// for the reverting ones
+	tryAggregate := func(reverting ...common.Address) func(context.Context, ethereum.CallMsg, *big.Int) []byte {
+		return func(_ context.Context, msg ethereum.CallMsg, _ *big.Int) []byte {
+			args, err := fluxmonitorv2.MulticallABI.Methods["tryAggregate"].Inputs.Unpack(msg.Data[4:])
+			require.NoError(t, err)
+			calls := *abi.ConvertType(args[1], new([]fluxmonitorv2.MulticallCall)).(*[]fluxmonitorv2.MulticallCall)
+			results := make([]fluxmonitorv2.MulticallResult, len(calls))
+		outer:
+			for i, call := range calls {
+				for _, r := range reverting {
+					if call.Target == r {
+						continue outer
+					}
+				}
+				results[i] = fluxmonitorv2.MulticallResult{Success: true, ReturnData: packValue(values[call.Target])}
+			}
+			out, err := fluxmonitorv2.MulticallABI.Methods["tryAggregate"].Outputs.Pack(results)
+			require.NoError(t, err)
+			return out
+		}
+	}
+	isMulticall := mock.MatchedBy(func(msg ethereum.CallMsg) bool { return *msg.To == multicallAddress })
+	isTo := func(address common.Address) interface{} {
+		return mock.MatchedBy(func(msg ethereum.CallMsg) bool { return *msg.To == address })
+	}
+
+	// readAll reads the minSubmissionValue of every aggregator concurrently,
+	// as flux monitor jobs starting up would
+	readAll := func(t *testing.T, loader *fluxmonitorv2.MulticallLoader) ([]*big.Int, []error) {
+		vals := make([]*big.Int, len(aggregators))
+		errs := make([]error, len(aggregators))
+		var wg sync.WaitGroup
+		for i, address := range aggregators {
+			fa, err := flux_aggregator_wrapper.NewFluxAggregator(address, loader.Backend())
+			require.NoError(t, err)
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				vals[i], errs[i] = fa.MinSubmissionValue(nil)
+			}(i)
+		}
+		wg.Wait()
+		return vals, errs
+	}
+
+	t.Run("batches concurrent reads into a single multicall", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("CallContract", mock.Anything, isMulticall, (*big.Int)(nil)).Return(tryAggregate(), nil).Once()
+		loader := fluxmonitorv2.NewMulticallLoader(ethClient, multicallAddress, 100*time.Millisecond, 100, logger.TestLogger(t))
+
+		vals, errs := readAll(t, loader)
+		for i, address := range aggregators {
+			require.NoError(t, errs[i])
+			assert.Equal(t, values[address], vals[i])
+		}
+		ethClient.AssertNumberOfCalls(t, "CallContract", 1)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("sends large batches in chunks", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("CallContract", mock.Anything, isMulticall, (*big.Int)(nil)).Return(tryAggregate(), nil).Times(3)
+		loader := fluxmonitorv2.NewMulticallLoader(ethClient, multicallAddress, 100*time.Millisecond, 4, logger.TestLogger(t))
+
+		vals, errs := readAll(t, loader)
+		for i, address := range aggregators {
+			require.NoError(t, errs[i])
+			assert.Equal(t, values[address], vals[i])
+		}
+		ethClient.AssertNumberOfCalls(t, "CallContract", 3)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("falls back to an individual call for reads that revert in the multicall", func(t *testing.T) {
+		reverting := aggregators[3]
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("CallContract", mock.Anything, isMulticall, (*big.Int)(nil)).Return(tryAggregate(reverting), nil).Once()
+		ethClient.On("CallContract", mock.Anything, isTo(reverting), (*big.Int)(nil)).Return(nil, errors.New("execution reverted")).Once()
+		loader := fluxmonitorv2.NewMulticallLoader(ethClient, multicallAddress, 100*time.Millisecond, 100, logger.TestLogger(t))
+
+		vals, errs := readAll(t, loader)
+		for i, address := range aggregators {
+			if address == reverting {
+				require.EqualError(t, errs[i], "execution reverted")
+				continue
+			}
+			require.NoError(t, errs[i])
+			assert.Equal(t, values[address], vals[i])
+		}
+		ethClient.AssertNumberOfCalls(t, "CallContract", 2)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("falls back to individual calls if the multicall fails", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("CallContract", mock.Anything, isMulticall, (*big.Int)(nil)).Return(nil, errors.New("multicall not deployed")).Once()
+		for _, address := range aggregators {
+			ethClient.On("CallContract", mock.Anything, isTo(address), (*big.Int)(nil)).Return(packValue(values[address]), nil).Once()
+		}
+		loader := fluxmonitorv2.NewMulticallLoader(ethClient, multicallAddress, 100*time.Millisecond, 100, logger.TestLogger(t))
+
+		vals, errs := readAll(t, loader)
+		for i, address := range aggregators {
+			require.NoError(t, errs[i])
+			assert.Equal(t, values[address], vals[i])
+		}
+		ethClient.AssertNumberOfCalls(t, "CallContract", 1+len(aggregators))
+		ethClient.AssertExpectations(t)
+	})
+}
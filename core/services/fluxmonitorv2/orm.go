@@ -2,12 +2,15 @@ package fluxmonitorv2
 
 import (
 	"database/sql"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/sqlx"
 )
@@ -24,25 +27,33 @@ type ORM interface {
 	DeleteFluxMonitorRoundsBackThrough(aggregator common.Address, roundID uint32) error
 	FindOrCreateFluxMonitorRoundStats(aggregator common.Address, roundID uint32, newRoundLogs uint) (FluxMonitorRoundStatsV2, error)
 	UpdateFluxMonitorRoundStats(aggregator common.Address, roundID uint32, runID int64, newRoundLogsAddition uint, qopts ...pg.QOpt) error
-	CreateEthTransaction(fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, qopts ...pg.QOpt) error
+	CountFatalSubmissions(aggregator common.Address, roundID uint32) (int, error)
+	CreateEthTransaction(fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, maxGasPriceWei *big.Int, meta *bulletprooftxmanager.EthTxMeta, deadline *time.Time, onOutcome bulletprooftxmanager.OutcomeCallback, qopts ...pg.QOpt) error
 	CountFluxMonitorRoundStats() (count int, err error)
 }
 
+// TxOpts are how the ORM creates transactions. Priority and Origin are given
+// to every transaction, and are usually the job's TxPriority and identity.
+type TxOpts struct {
+	Txm      transmitter
+	Strategy bulletprooftxmanager.TxStrategy
+	Priority null.Int64
+	Origin   bulletprooftxmanager.EthTxOrigin
+}
+
 type orm struct {
-	q        pg.Q
-	txm      transmitter
-	strategy bulletprooftxmanager.TxStrategy
-	logger   logger.Logger
+	q      pg.Q
+	txOpts TxOpts
+	logger logger.Logger
 }
 
 // NewORM initializes a new ORM
-func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, txm transmitter, strategy bulletprooftxmanager.TxStrategy) ORM {
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, txOpts TxOpts) ORM {
 	namedLogger := lggr.Named("FluxMonitorORM")
 	q := pg.NewQ(db, namedLogger, cfg)
 	return &orm{
 		q,
-		txm,
-		strategy,
+		txOpts,
 		namedLogger,
 	}
 }
@@ -68,7 +79,9 @@ func (o *orm) DeleteFluxMonitorRoundsBackThrough(aggregator common.Address, roun
 }
 
 // FindOrCreateFluxMonitorRoundStats find the round stats record for a given
-// oracle on a given round, or creates it if no record exists
+// oracle on a given round, or creates it if no record exists. Submissions
+// whose transactions fatally errored were never broadcast, so they are not
+// counted in NumSubmissions.
 func (o *orm) FindOrCreateFluxMonitorRoundStats(aggregator common.Address, roundID uint32, newRoundLogs uint) (stats FluxMonitorRoundStatsV2, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
 		err = tx.Get(&stats,
@@ -76,7 +89,10 @@ func (o *orm) FindOrCreateFluxMonitorRoundStats(aggregator common.Address, round
 		ON CONFLICT (aggregator, round_id) DO NOTHING`,
 			aggregator, roundID, newRoundLogs)
 		if errors.Is(err, sql.ErrNoRows) {
-			err = tx.Get(&stats, `SELECT * FROM flux_monitor_round_stats_v2 WHERE aggregator=$1 AND round_id=$2`, aggregator, roundID)
+			err = tx.Get(&stats, `
+SELECT id, pipeline_run_id, aggregator, round_id, num_new_round_logs,
+	GREATEST(num_submissions - (`+fatalSubmissionsQuery+`), 0) AS num_submissions
+FROM flux_monitor_round_stats_v2 WHERE aggregator=$1 AND round_id=$2`, aggregator, roundID)
 		}
 		return err
 	})
@@ -102,27 +118,62 @@ func (o *orm) UpdateFluxMonitorRoundStats(aggregator common.Address, roundID uin
 	return errors.Wrapf(err, "Failed to insert round stats for roundID=%v, runID=%v, newRoundLogsAddition=%v", roundID, runID, newRoundLogsAddition)
 }
 
+// fatalSubmissionsQuery counts the fatally errored transactions of the
+// submissions to round $2 of aggregator $1
+const fatalSubmissionsQuery = `SELECT count(*) FROM eth_txes
+WHERE to_address = $1 AND state = 'fatal_error'
+AND meta->>'RoundID' IS NOT NULL AND (meta->>'RoundID')::int = $2`
+
+// CountFatalSubmissions counts the submissions to the round whose
+// transactions fatally errored
+func (o *orm) CountFatalSubmissions(aggregator common.Address, roundID uint32) (count int, err error) {
+	err = o.q.Get(&count, fatalSubmissionsQuery, aggregator, roundID)
+	return count, errors.Wrapf(err, "CountFatalSubmissions failed for roundID=%v", roundID)
+}
+
 // CountFluxMonitorRoundStats counts the total number of records
 func (o *orm) CountFluxMonitorRoundStats() (count int, err error) {
 	err = o.q.Get(&count, `SELECT count(*) FROM flux_monitor_round_stats_v2`)
 	return count, errors.Wrap(err, "CountFluxMonitorRoundStats failed")
 }
 
-// CreateEthTransaction creates an ethereum transaction for the BPTXM to pick up
+// CountPendingSubmissions returns the number of flux monitor submissions on
+// the given chain that have not yet been confirmed. Submissions are
+// identified by being sent to an aggregator that we track rounds for.
+func CountPendingSubmissions(q pg.Q, chainID *big.Int) (count int64, err error) {
+	err = q.Get(&count, `
+SELECT count(*) FROM eth_txes
+WHERE evm_chain_id = $1
+AND state IN ('unstarted', 'in_progress', 'unconfirmed')
+AND to_address IN (SELECT DISTINCT aggregator FROM flux_monitor_round_stats_v2)`, chainID.String())
+	return count, errors.Wrap(err, "CountPendingSubmissions failed")
+}
+
+// CreateEthTransaction creates an ethereum transaction for the BPTXM to pick
+// up. onOutcome is optional, see bulletprooftxmanager.NewTx.OnOutcome.
 func (o *orm) CreateEthTransaction(
 	fromAddress common.Address,
 	toAddress common.Address,
 	payload []byte,
 	gasLimit uint64,
+	maxGasPriceWei *big.Int,
+	meta *bulletprooftxmanager.EthTxMeta,
+	deadline *time.Time,
+	onOutcome bulletprooftxmanager.OutcomeCallback,
 	qopts ...pg.QOpt,
 ) (err error) {
-	_, err = o.txm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+	_, err = o.txOpts.Txm.CreateEthTransaction(bulletprooftxmanager.NewTx{
 		FromAddress:    fromAddress,
 		ToAddress:      toAddress,
 		EncodedPayload: payload,
 		GasLimit:       gasLimit,
-		Meta:           nil,
-		Strategy:       o.strategy,
+		MaxGasPriceWei: maxGasPriceWei,
+		Meta:           meta,
+		Deadline:       deadline,
+		Strategy:       o.txOpts.Strategy,
+		Priority:       o.txOpts.Priority,
+		Origin:         o.txOpts.Origin,
+		OnOutcome:      onOutcome,
 	}, qopts...)
 	return errors.Wrap(err, "Skipped Flux Monitor submission")
 }
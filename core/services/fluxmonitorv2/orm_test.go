@@ -1,9 +1,12 @@
 package fluxmonitorv2_test
 
 import (
+	"encoding/json"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	uuid "github.com/satori/go.uuid"
 	"gopkg.in/guregu/null.v4"
 
@@ -15,8 +18,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	corenull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg/datatypes"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
 
@@ -135,6 +140,40 @@ func TestORM_UpdateFluxMonitorRoundStats(t *testing.T) {
 		require.True(t, stats.PipelineRunID.Valid)
 		require.Equal(t, run.ID, stats.PipelineRunID.Int64)
 	}
+
+	// Submissions whose transactions fatally errored are not counted, but the
+	// count never drops below zero
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	_, fromAddress := cltest.MustInsertRandomKey(t, keyStore.Eth(), 0)
+	for i, expectedCount := range []uint64{2, 1, 0, 0} {
+		mustInsertFatalSubmissionTx(t, borm, fromAddress, address, roundID)
+
+		nFatal, err := orm.CountFatalSubmissions(address, roundID)
+		require.NoError(t, err)
+		require.Equal(t, i+1, nFatal)
+
+		stats, err := orm.FindOrCreateFluxMonitorRoundStats(address, roundID, 0)
+		require.NoError(t, err)
+		require.Equal(t, expectedCount, stats.NumSubmissions)
+	}
+
+	// Only the round's own submissions count
+	nFatal, err := orm.CountFatalSubmissions(address, roundID+1)
+	require.NoError(t, err)
+	require.Equal(t, 0, nFatal)
+}
+
+func mustInsertFatalSubmissionTx(t *testing.T, borm bulletprooftxmanager.ORM, fromAddress, aggregator common.Address, roundID uint32) {
+	t.Helper()
+
+	meta, err := json.Marshal(bulletprooftxmanager.EthTxMeta{JobID: 1, RoundID: &roundID})
+	require.NoError(t, err)
+	etx := cltest.NewEthTx(t, fromAddress)
+	etx.ToAddress = aggregator
+	etx.Meta = (*datatypes.JSON)(&meta)
+	etx.Error = null.StringFrom("something exploded")
+	etx.State = bulletprooftxmanager.EthTxFatalError
+	require.NoError(t, borm.InsertEthTx(&etx))
 }
 
 func makeJob(t *testing.T) *job.Job {
@@ -167,15 +206,26 @@ func TestORM_CreateEthTransaction(t *testing.T) {
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
 
 	strategy := new(bptxmmocks.TxStrategy)
+	priority := int64(10)
+	jb := job.Job{ID: 7, Name: null.StringFrom("ETH / USD"), TxPriority: &priority}
 
 	var (
 		txm = new(bptxmmocks.TxManager)
-		orm = fluxmonitorv2.NewORM(db, logger.TestLogger(t), cfg, txm, strategy)
+		orm = fluxmonitorv2.NewORM(db, logger.TestLogger(t), cfg, fluxmonitorv2.TxOpts{
+			Txm:      txm,
+			Strategy: strategy,
+			Priority: jb.TransactionPriority(),
+			Origin:   bulletprooftxmanager.JobOrigin(jb.ID, jb.Name.ValueOrZero()),
+		})
 
 		_, from  = cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 		to       = cltest.NewAddress()
 		payload  = []byte{1, 0, 0}
 		gasLimit = uint64(21000)
+		maxGas   = big.NewInt(5000000000)
+		roundID  = uint32(3)
+		meta     = &bulletprooftxmanager.EthTxMeta{JobID: 1, RoundID: &roundID}
+		deadline = time.Unix(1600000000, 0)
 	)
 
 	txm.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
@@ -183,11 +233,15 @@ func TestORM_CreateEthTransaction(t *testing.T) {
 		ToAddress:      to,
 		EncodedPayload: payload,
 		GasLimit:       gasLimit,
-		Meta:           nil,
+		MaxGasPriceWei: maxGas,
+		Meta:           meta,
+		Deadline:       &deadline,
 		Strategy:       strategy,
+		Priority:       corenull.Int64From(10),
+		Origin:         bulletprooftxmanager.EthTxOrigin{JobID: 7, JobName: "ETH / USD"},
 	}).Return(bulletprooftxmanager.EthTx{}, nil).Once()
 
-	orm.CreateEthTransaction(from, to, payload, gasLimit)
+	orm.CreateEthTransaction(from, to, payload, gasLimit, maxGas, meta, &deadline, nil)
 
 	txm.AssertExpectations(t)
 }
@@ -40,7 +40,9 @@ type PollManagerConfig struct {
 //
 // IdleTimer - The idle timer requests a poll after no poll has taken place
 // since the last round was start and the IdleTimerPeriod has elapsed. This can
-// also be known as a heartbeat.
+// also be known as a heartbeat. Disabling this through config means the idle
+// timer never ticks, so that rounds are only submitted on deviation, new
+// rounds and the drumbeat.
 //
 // RoundTimer - The round timer requests a poll when the round state provided by
 // the contract has timed out.
@@ -66,12 +68,16 @@ type PollManager struct {
 // NewPollManager initializes a new PollManager
 func NewPollManager(cfg PollManagerConfig, logger logger.Logger) (*PollManager, error) {
 	minBackoffDuration := cfg.MinRetryBackoffDuration
-	if cfg.IdleTimerPeriod < minBackoffDuration {
-		minBackoffDuration = cfg.IdleTimerPeriod
-	}
 	maxBackoffDuration := cfg.MaxRetryBackoffDuration
-	if cfg.IdleTimerPeriod < maxBackoffDuration {
-		maxBackoffDuration = cfg.IdleTimerPeriod
+	// A disabled idle timer may have no period, which must not bound the
+	// retry backoff
+	if !cfg.IdleTimerDisabled {
+		if cfg.IdleTimerPeriod < minBackoffDuration {
+			minBackoffDuration = cfg.IdleTimerPeriod
+		}
+		if cfg.IdleTimerPeriod < maxBackoffDuration {
+			maxBackoffDuration = cfg.IdleTimerPeriod
+		}
 	}
 	// Always initialize the idle timer so that no matter what it has a ticker
 	// and won't get starved by an old startedAt timestamp from the oracle state on boot.
@@ -348,6 +354,16 @@ func roundStateTimesOutAt(rs flux_aggregator_wrapper.OracleRoundState) uint64 {
 	return rs.StartedAt + rs.Timeout
 }
 
+// roundStateDeadline returns when the round times out, or nil if the round
+// has not started or has no timeout
+func roundStateDeadline(rs flux_aggregator_wrapper.OracleRoundState) *time.Time {
+	if rs.StartedAt == 0 || rs.Timeout == 0 {
+		return nil
+	}
+	deadline := time.Unix(int64(roundStateTimesOutAt(rs)), 0)
+	return &deadline
+}
+
 // ShouldPerformInitialPoll determines whether to perform an initial poll
 func (pm *PollManager) maybeWarnAboutIdleAndPollIntervals() {
 	if !pm.cfg.IdleTimerDisabled && !pm.cfg.PollTickerDisabled && pm.cfg.IdleTimerPeriod < pm.cfg.PollTickerInterval {
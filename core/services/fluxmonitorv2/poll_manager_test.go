@@ -153,6 +153,46 @@ func TestPollManager_RoundTimer(t *testing.T) {
 	assert.True(t, ticks.roundTicked)
 }
 
+func TestPollManager_IdleTimerDisabled(t *testing.T) {
+	t.Parallel()
+
+	pm, err := fluxmonitorv2.NewPollManager(fluxmonitorv2.PollManagerConfig{
+		PollTickerInterval:      pollTickerDefaultDuration,
+		PollTickerDisabled:      false,
+		IdleTimerDisabled:       true,
+		HibernationPollPeriod:   24 * time.Hour,
+		MinRetryBackoffDuration: 200 * time.Millisecond,
+		MaxRetryBackoffDuration: 1 * time.Minute,
+	}, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	pm.Start(false, flux_aggregator_wrapper.OracleRoundState{
+		StartedAt: uint64(time.Now().Unix()) - 10,
+		Timeout:   10000, // in seconds. Don't timeout the round
+	})
+	t.Cleanup(pm.Stop)
+
+	// Deviation checks on the poll ticker still happen
+	ticks := watchTicks(t, pm, 2*time.Second)
+	assert.True(t, ticks.pollTicked)
+	assert.False(t, ticks.idleTicked)
+
+	// New rounds don't start the idle timer either
+	pm.ResetIdleTimer(uint64(time.Now().Unix()))
+	pm.Reset(flux_aggregator_wrapper.OracleRoundState{
+		StartedAt: uint64(time.Now().Unix()),
+		Timeout:   10000,
+	})
+	ticks = watchTicks(t, pm, 2*time.Second)
+	assert.False(t, ticks.idleTicked)
+
+	// The retry backoff is not bounded by the unset idle timer period
+	pm.StartRetryTicker()
+	t.Cleanup(pm.StopRetryTicker)
+	ticks = watchTicks(t, pm, 100*time.Millisecond)
+	assert.False(t, ticks.retryTicked)
+}
+
 func TestPollManager_RetryTimer(t *testing.T) {
 	pm, err := fluxmonitorv2.NewPollManager(fluxmonitorv2.PollManagerConfig{
 		PollTickerInterval:      pollTickerDefaultDuration,
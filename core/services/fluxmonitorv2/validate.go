@@ -48,9 +48,21 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 			DrumbeatRandomDelay: specIntThreshold.DrumbeatRandomDelay,
 			DrumbeatEnabled:     specIntThreshold.DrumbeatEnabled,
 			MinPayment:          specIntThreshold.MinPayment,
+			GasLimit:            specIntThreshold.GasLimit,
 			EVMChainID:          specIntThreshold.EVMChainID,
 		}
 	}
+	// maxGasPriceGWei may be an integer or a float
+	switch v := tree.Get("maxGasPriceGWei").(type) {
+	case nil:
+	case int64:
+		maxGasPriceGWei := float64(v)
+		spec.MaxGasPriceGWei = &maxGasPriceGWei
+	case float64:
+		spec.MaxGasPriceGWei = &v
+	default:
+		return jb, errors.Errorf("maxGasPriceGWei must be a number, got %v", v)
+	}
 	jb.FluxMonitorSpec = &spec
 
 	if jb.Type != job.FluxMonitor {
@@ -88,10 +100,28 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 		}
 	}
 
+	if !spec.IdleTimerDisabled && spec.IdleTimerPeriod <= 0 {
+		return jb, errors.New("idleTimerPeriod must be greater than 0 when the idle timer is enabled, or set idleTimerDisabled to true")
+	}
+
 	if !validatePollTimer(jb.FluxMonitorSpec.PollTimerDisabled, minTimeout, jb.FluxMonitorSpec.PollTimerPeriod) {
 		return jb, errors.Errorf("PollTimerPeriod (%v) must be equal or greater than the smallest value of MaxTaskDuration param, DEFAULT_HTTP_TIMEOUT config var, or MinTimeout of all tasks (%v)", jb.FluxMonitorSpec.PollTimerPeriod, minTimeout)
 	}
 
+	if spec.Threshold < 0 {
+		return jb, errors.Errorf("threshold must be non-negative, got %v", spec.Threshold)
+	}
+	if spec.AbsoluteThreshold < 0 {
+		return jb, errors.Errorf("absoluteThreshold must be non-negative, got %v", spec.AbsoluteThreshold)
+	}
+
+	if spec.GasLimit != nil && *spec.GasLimit == 0 {
+		return jb, errors.New("gasLimit must be greater than 0")
+	}
+	if spec.MaxGasPriceGWei != nil && *spec.MaxGasPriceGWei <= 0 {
+		return jb, errors.Errorf("maxGasPriceGWei must be greater than 0, got %v", *spec.MaxGasPriceGWei)
+	}
+
 	return jb, nil
 }
 
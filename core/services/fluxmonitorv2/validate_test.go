@@ -1,11 +1,14 @@
 package fluxmonitorv2
 
 import (
+	"math/big"
 	"regexp"
 	"testing"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/stretchr/testify/assert"
@@ -44,6 +47,8 @@ drumbeatRandomDelay = "10s"
 
 minPayment = 1000000000000000000
 
+txPriority = 10
+
 observationSource = """
 // data source 1
 ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}"];
@@ -77,6 +82,7 @@ answer1 [type=median index=0];
 				assert.Equal(t, 10*time.Second, spec.DrumbeatRandomDelay)
 				assert.Equal(t, false, spec.PollTimerDisabled)
 				assert.Equal(t, assets.NewLinkFromJuels(1000000000000000000), spec.MinPayment)
+				assert.Equal(t, null.Int64From(10), j.TransactionPriority())
 				assert.NotZero(t, j.Pipeline)
 			},
 		},
@@ -167,6 +173,206 @@ ds1 -> ds1_parse;
 				assert.EqualError(t, err, "When the drumbeat ticker is enabled, the idle timer must be disabled. Please set IdleTimerDisabled to true")
 			},
 		},
+		{
+			name: "gas overrides",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = 0.5
+absoluteThreshold = 0.0
+
+idleTimerPeriod = "1s"
+idleTimerDisabled = false
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+gasLimit = 600000
+maxGasPriceGWei = 0.1
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, j job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, j.FluxMonitorSpec.GasLimit)
+				assert.Equal(t, uint64(600000), *j.FluxMonitorSpec.GasLimit)
+				require.NotNil(t, j.FluxMonitorSpec.MaxGasPriceGWei)
+				assert.Equal(t, 0.1, *j.FluxMonitorSpec.MaxGasPriceGWei)
+				assert.Equal(t, big.NewInt(100000000), j.FluxMonitorSpec.MaxGasPriceWei())
+			},
+		},
+		{
+			name: "integer max gas price",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = 0.5
+absoluteThreshold = 0.0
+
+idleTimerPeriod = "1s"
+idleTimerDisabled = false
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+gasLimit = 600000
+maxGasPriceGWei = 5
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, j job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, big.NewInt(5000000000), j.FluxMonitorSpec.MaxGasPriceWei())
+			},
+		},
+		{
+			name: "invalid max gas price",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = 0.5
+absoluteThreshold = 0.0
+
+idleTimerPeriod = "1s"
+idleTimerDisabled = false
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+gasLimit = 600000
+maxGasPriceGWei = 0
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, j job.Job, err error) {
+				require.Error(t, err)
+				assert.EqualError(t, err, "maxGasPriceGWei must be greater than 0, got 0")
+			},
+		},
+		{
+			name: "idle timer enabled without a period",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = 0.5
+absoluteThreshold = 0.0
+
+idleTimerDisabled = false
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, j job.Job, err error) {
+				require.Error(t, err)
+				assert.EqualError(t, err, "idleTimerPeriod must be greater than 0 when the idle timer is enabled, or set idleTimerDisabled to true")
+			},
+		},
+		{
+			name: "idle timer disabled without a period",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = 0.5
+absoluteThreshold = 0.0
+
+idleTimerDisabled = true
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, j job.Job, err error) {
+				require.NoError(t, err)
+				assert.True(t, j.FluxMonitorSpec.IdleTimerDisabled)
+			},
+		},
+		{
+			name: "negative absolute threshold",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = 0.5
+absoluteThreshold = -0.01
+
+idleTimerPeriod = "1s"
+idleTimerDisabled = false
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, j job.Job, err error) {
+				require.Error(t, err)
+				assert.EqualError(t, err, "absoluteThreshold must be non-negative, got -0.01")
+			},
+		},
+		{
+			name: "negative threshold",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = -0.5
+absoluteThreshold = 0.0
+
+idleTimerPeriod = "1s"
+idleTimerDisabled = false
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, j job.Job, err error) {
+				require.Error(t, err)
+				assert.EqualError(t, err, "threshold must be non-negative, got -0.5")
+			},
+		},
 		{
 			name: "integer thresholds",
 			toml: `
@@ -214,3 +420,39 @@ externalJobID = "cfa3fa6b-2850-446b-b973-8f4c3b29d519"
 		})
 	}
 }
+
+type gasOverridesCfg struct{}
+
+func (gasOverridesCfg) EvmGasLimitMax() uint64      { return 1000000 }
+func (gasOverridesCfg) EvmMaxGasPriceWei() *big.Int { return big.NewInt(100000000000) }
+func (gasOverridesCfg) EvmMinGasPriceWei() *big.Int { return big.NewInt(1000000000) }
+
+func TestValidateGasOverrides(t *testing.T) {
+	gasLimit := func(l uint64) *uint64 { return &l }
+	gwei := func(g float64) *float64 { return &g }
+
+	tt := []struct {
+		name string
+		spec job.FluxMonitorSpec
+		err  string
+	}{
+		{"no overrides", job.FluxMonitorSpec{}, ""},
+		{"within limits", job.FluxMonitorSpec{GasLimit: gasLimit(600000), MaxGasPriceGWei: gwei(5)}, ""},
+		{"above max gas price only warns", job.FluxMonitorSpec{MaxGasPriceGWei: gwei(500)}, ""},
+		{"gas limit above max", job.FluxMonitorSpec{GasLimit: gasLimit(2000000)}, "gasLimit of 2000000 exceeds ETH_GAS_LIMIT_MAX of 1000000"},
+		{"max gas price below min", job.FluxMonitorSpec{MaxGasPriceGWei: gwei(0.1)}, "maxGasPriceGWei of 0.1 (100000000 wei) is below ETH_MIN_GAS_PRICE_WEI of 1000000000 wei, submissions would never be sent"},
+		{"defaults filled in from the chain are not checked", job.FluxMonitorSpec{GasLimit: gasLimit(2000000), GasLimitEnv: true, MaxGasPriceGWei: gwei(0.1), MaxGasPriceGWeiEnv: true}, ""},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateGasOverrides(tc.spec, gasOverridesCfg{}, logger.TestLogger(t))
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
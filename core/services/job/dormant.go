@@ -0,0 +1,91 @@
+package job
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type dormantService struct {
+	lggr        logger.Logger
+	ready       <-chan struct{}
+	newServices func() ([]Service, error)
+
+	mu       sync.Mutex
+	services []Service
+	closed   bool
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewDormantService stands in for the services of a job while something they
+// depend on, e.g. the job's chain, is not available yet. Once ready is
+// closed, it creates the job's services with newServices, and starts them in
+// order. Until then the job is dormant, rather than failed.
+func NewDormantService(lggr logger.Logger, ready <-chan struct{}, newServices func() ([]Service, error)) Service {
+	return &dormantService{
+		lggr:        lggr.Named("DormantService"),
+		ready:       ready,
+		newServices: newServices,
+		chStop:      make(chan struct{}),
+	}
+}
+
+func (d *dormantService) Start() error {
+	d.wg.Add(1)
+	go d.run()
+	return nil
+}
+
+func (d *dormantService) run() {
+	defer d.wg.Done()
+	select {
+	case <-d.chStop:
+		return
+	case <-d.ready:
+	}
+
+	services, err := d.newServices()
+	if err != nil {
+		d.lggr.Errorw("Failed to create services for dormant job", "err", err)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return
+	}
+	d.lggr.Infow("Starting services for dormant job", "count", len(services))
+	for _, service := range services {
+		if err := service.Start(); err != nil {
+			d.lggr.Errorw("Error starting service for dormant job", "err", err)
+			continue
+		}
+		d.services = append(d.services, service)
+	}
+}
+
+// Close stops waiting, and stops the job's services in reverse order if they
+// were started
+func (d *dormantService) Close() (merr error) {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return errors.New("dormant service already closed")
+	}
+	d.closed = true
+	d.mu.Unlock()
+
+	close(d.chStop)
+	d.wg.Wait()
+
+	for i := len(d.services) - 1; i >= 0; i-- {
+		merr = multierr.Combine(merr, d.services[i].Close())
+	}
+	return merr
+}
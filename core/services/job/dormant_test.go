@@ -0,0 +1,54 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/job/mocks"
+)
+
+func TestDormantService(t *testing.T) {
+	t.Parallel()
+
+	t.Run("starts the job's services once ready, and stops them on close", func(t *testing.T) {
+		ready := make(chan struct{})
+		service := new(mocks.Service)
+		started := make(chan struct{})
+		service.On("Start").Return(nil).Once().Run(func(mock.Arguments) { close(started) })
+		service.On("Close").Return(nil).Once()
+		created := make(chan struct{})
+
+		d := job.NewDormantService(logger.TestLogger(t), ready, func() ([]job.Service, error) {
+			close(created)
+			return []job.Service{service}, nil
+		})
+		require.NoError(t, d.Start())
+
+		select {
+		case <-created:
+			t.Fatal("expected the services not to be created before ready")
+		default:
+		}
+
+		close(ready)
+		gomega.NewWithT(t).Eventually(started).Should(gomega.BeClosed())
+
+		require.NoError(t, d.Close())
+		service.AssertExpectations(t)
+	})
+
+	t.Run("never creates the job's services if closed before ready", func(t *testing.T) {
+		d := job.NewDormantService(logger.TestLogger(t), make(chan struct{}), func() ([]job.Service, error) {
+			t.Error("expected the services not to be created")
+			return nil, nil
+		})
+		require.NoError(t, d.Start())
+		assert.NoError(t, d.Close())
+	})
+}
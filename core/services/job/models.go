@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
 	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -117,10 +119,21 @@ type Job struct {
 	SchemaVersion                  uint32
 	Name                           null.String
 	MaxTaskDuration                models.Interval
+	TxPriority                     *int64            `toml:"txPriority"`
 	Pipeline                       pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt                      time.Time
 }
 
+// TransactionPriority is the priority of the transactions created by the job,
+// see bulletprooftxmanager.EthTx.Priority. It is unset unless the job spec
+// sets txPriority.
+func (j Job) TransactionPriority() clnull.Int64 {
+	if j.TxPriority == nil {
+		return clnull.Int64{}
+	}
+	return clnull.Int64From(*j.TxPriority)
+}
+
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
 	return common.BytesToHash([]byte(strings.Replace(id.String(), "-", "", 4)))
 }
@@ -207,7 +220,8 @@ type OffchainReportingOracleSpec struct {
 	EncryptedOCRKeyBundleIDEnv                bool
 	TransmitterAddress                        *ethkey.EIP55Address `toml:"transmitterAddress"`
 	TransmitterAddressEnv                     bool
-	ObservationTimeout                        models.Interval `toml:"observationTimeout"`
+	ForwarderAddress                          *ethkey.EIP55Address `toml:"forwarderAddress"`
+	ObservationTimeout                        models.Interval      `toml:"observationTimeout"`
 	ObservationTimeoutEnv                     bool
 	BlockchainTimeout                         models.Interval `toml:"blockchainTimeout"`
 	BlockchainTimeoutEnv                      bool
@@ -224,8 +238,10 @@ type OffchainReportingOracleSpec struct {
 	ObservationGracePeriodEnv                 bool
 	ContractTransmitterTransmitTimeout        *models.Interval `toml:"contractTransmitterTransmitTimeout"`
 	ContractTransmitterTransmitTimeoutEnv     bool
-	CreatedAt                                 time.Time `toml:"-"`
-	UpdatedAt                                 time.Time `toml:"-"`
+	TransmissionBatchWindow                   *models.Interval `toml:"transmissionBatchWindow"`
+	TransmissionLatestOnly                    bool             `toml:"transmissionLatestOnly"`
+	CreatedAt                                 time.Time        `toml:"-"`
+	UpdatedAt                                 time.Time        `toml:"-"`
 }
 
 func (s OffchainReportingOracleSpec) GetID() string {
@@ -390,6 +406,7 @@ type FluxMonitorSpecIntThreshold struct {
 	DrumbeatRandomDelay time.Duration
 	DrumbeatEnabled     bool
 	MinPayment          *assets.Link
+	GasLimit            *uint64    `toml:"gasLimit"`
 	EVMChainID          *utils.Big `toml:"evmChainID"`
 }
 
@@ -409,15 +426,33 @@ type FluxMonitorSpec struct {
 	DrumbeatRandomDelay time.Duration
 	DrumbeatEnabled     bool
 	MinPayment          *assets.Link
-	EVMChainID          *utils.Big `toml:"evmChainID"`
-	CreatedAt           time.Time  `toml:"-"`
-	UpdatedAt           time.Time  `toml:"-"`
+	// GasLimit and MaxGasPriceGWei are optional, and override the chain's
+	// ETH_GAS_LIMIT_DEFAULT and ETH_MAX_GAS_PRICE_WEI for submissions.
+	// MaxGasPriceGWei can be fractional, so it is parsed separately, see
+	// fluxmonitorv2.ValidatedFluxMonitorSpec.
+	GasLimit           *uint64    `toml:"gasLimit"`
+	GasLimitEnv        bool       `toml:"-"`
+	MaxGasPriceGWei    *float64   `toml:"-" db:"max_gas_price_gwei"`
+	MaxGasPriceGWeiEnv bool       `toml:"-"`
+	EVMChainID         *utils.Big `toml:"evmChainID"`
+	CreatedAt          time.Time  `toml:"-"`
+	UpdatedAt          time.Time  `toml:"-"`
+}
+
+// MaxGasPriceWei returns MaxGasPriceGWei in wei, or nil if it isn't set
+func (s FluxMonitorSpec) MaxGasPriceWei() *big.Int {
+	if s.MaxGasPriceGWei == nil {
+		return nil
+	}
+	return decimal.NewFromFloat(*s.MaxGasPriceGWei).Shift(9).BigInt()
 }
 
 type KeeperSpec struct {
 	ID                       int32               `toml:"-"`
 	ContractAddress          ethkey.EIP55Address `toml:"contractAddress"`
 	MinIncomingConfirmations *uint32             `toml:"minIncomingConfirmations"`
+	MinConfirmations         *uint32             `toml:"minConfirmations"`
+	PerformPacingInterval    *models.Interval    `toml:"performPacingInterval"`
 	FromAddress              ethkey.EIP55Address `toml:"fromAddress"`
 	EVMChainID               *utils.Big          `toml:"evmChainID"`
 	CreatedAt                time.Time           `toml:"-"`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math/big"
 	"reflect"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -136,9 +138,9 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 		case FluxMonitor:
 			var specID int32
 			sql := `INSERT INTO flux_monitor_specs (contract_address, threshold, absolute_threshold, poll_timer_period, poll_timer_disabled, idle_timer_period, idle_timer_disabled,
-					drumbeat_schedule, drumbeat_random_delay, drumbeat_enabled, min_payment, evm_chain_id, created_at, updated_at)
+					drumbeat_schedule, drumbeat_random_delay, drumbeat_enabled, min_payment, gas_limit, max_gas_price_gwei, evm_chain_id, created_at, updated_at)
 			VALUES (:contract_address, :threshold, :absolute_threshold, :poll_timer_period, :poll_timer_disabled, :idle_timer_period, :idle_timer_disabled,
-					:drumbeat_schedule, :drumbeat_random_delay, :drumbeat_enabled, :min_payment, :evm_chain_id, NOW(), NOW())
+					:drumbeat_schedule, :drumbeat_random_delay, :drumbeat_enabled, :min_payment, :gas_limit, :max_gas_price_gwei, :evm_chain_id, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.FluxMonitorSpec); err != nil {
 				return errors.Wrap(err, "failed to create FluxMonitorSpec")
@@ -161,10 +163,12 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 
 			sql := `INSERT INTO offchainreporting_oracle_specs (contract_address, p2p_bootstrap_peers, is_bootstrap_peer, encrypted_ocr_key_bundle_id, transmitter_address,
 					observation_timeout, blockchain_timeout, contract_config_tracker_subscribe_interval, contract_config_tracker_poll_interval, contract_config_confirmations, evm_chain_id,
-					created_at, updated_at, database_timeout, observation_grace_period, contract_transmitter_transmit_timeout)
+					created_at, updated_at, database_timeout, observation_grace_period, contract_transmitter_transmit_timeout, forwarder_address,
+					transmission_batch_window, transmission_latest_only)
 			VALUES (:contract_address, :p2p_bootstrap_peers, :is_bootstrap_peer, :encrypted_ocr_key_bundle_id, :transmitter_address,
 					:observation_timeout, :blockchain_timeout, :contract_config_tracker_subscribe_interval, :contract_config_tracker_poll_interval, :contract_config_confirmations, :evm_chain_id,
-					NOW(), NOW(), :database_timeout, :observation_grace_period, :contract_transmitter_transmit_timeout)
+					NOW(), NOW(), :database_timeout, :observation_grace_period, :contract_transmitter_transmit_timeout, :forwarder_address,
+					:transmission_batch_window, :transmission_latest_only)
 			RETURNING id;`
 			err := pg.PrepareQueryRowx(tx, sql, &specID, jb.OffchainreportingOracleSpec)
 			if err != nil {
@@ -208,8 +212,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.Offchainreporting2OracleSpecID = &specID
 		case Keeper:
 			var specID int32
-			sql := `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :from_address, :evm_chain_id, NOW(), NOW())
+			sql := `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, min_confirmations, perform_pacing_interval, created_at, updated_at)
+			VALUES (:contract_address, :from_address, :evm_chain_id, :min_confirmations, :perform_pacing_interval, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.KeeperSpec); err != nil {
 				return errors.Wrap(err, "failed to create KeeperSpec")
@@ -292,9 +296,9 @@ func (o *orm) InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) erro
 
 func (o *orm) InsertJob(job *Job, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
-	query := `INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, offchainreporting_oracle_spec_id, offchainreporting2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
+	query := `INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, tx_priority, offchainreporting_oracle_spec_id, offchainreporting2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, external_job_id, created_at)
-		VALUES (:pipeline_spec_id, :name, :schema_version, :type, :max_task_duration, :offchainreporting_oracle_spec_id, :offchainreporting2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
+		VALUES (:pipeline_spec_id, :name, :schema_version, :type, :max_task_duration, :tx_priority, :offchainreporting_oracle_spec_id, :offchainreporting2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :external_job_id, NOW())
 		RETURNING *;`
 	return q.GetNamed(query, job, job)
@@ -456,6 +460,12 @@ func (o *orm) LoadEnvConfigVars(jb *Job) error {
 			return err
 		}
 		jb.DirectRequestSpec = LoadEnvConfigVarsDR(ch.Config(), *jb.DirectRequestSpec)
+	} else if jb.FluxMonitorSpec != nil {
+		ch, err := o.chainSet.Get(jb.FluxMonitorSpec.EVMChainID.ToInt())
+		if err != nil {
+			return err
+		}
+		jb.FluxMonitorSpec = LoadEnvConfigVarsFM(ch.Config(), *jb.FluxMonitorSpec)
 	}
 	return nil
 }
@@ -492,6 +502,26 @@ func LoadEnvConfigVarsDR(cfg DRSpecConfig, drs DirectRequestSpec) *DirectRequest
 	return &drs
 }
 
+type FMSpecConfig interface {
+	EvmGasLimitDefault() uint64
+	EvmMaxGasPriceWei() *big.Int
+}
+
+func LoadEnvConfigVarsFM(cfg FMSpecConfig, fms FluxMonitorSpec) *FluxMonitorSpec {
+	if fms.GasLimit == nil {
+		fms.GasLimitEnv = true
+		gasLimit := cfg.EvmGasLimitDefault()
+		fms.GasLimit = &gasLimit
+	}
+	if fms.MaxGasPriceGWei == nil {
+		fms.MaxGasPriceGWeiEnv = true
+		maxGasPriceGWei, _ := decimal.NewFromBigInt(cfg.EvmMaxGasPriceWei(), -9).Float64()
+		fms.MaxGasPriceGWei = &maxGasPriceGWei
+	}
+
+	return &fms
+}
+
 type OCRSpecConfig interface {
 	P2PPeerID() p2pkey.PeerID
 	OCRBlockchainTimeout() time.Duration
@@ -11,13 +11,17 @@ var RegistryABI = evmtypes.MustGetABI(keeper_registry_wrapper.KeeperRegistryABI)
 
 type Config interface {
 	EvmEIP1559DynamicFees() bool
+	EvmFinalityDepth() uint32
 	KeeperDefaultTransactionQueueDepth() uint32
 	KeeperGasPriceBufferPercent() uint32
 	KeeperGasTipCapBufferPercent() uint32
 	KeeperMaximumGracePeriod() int64
+	KeeperMinimumBalanceBufferPercent() uint32
+	KeeperPendingPerformTimeout() time.Duration
 	KeeperRegistryCheckGasOverhead() uint64
 	KeeperRegistryPerformGasOverhead() uint64
 	KeeperRegistrySyncInterval() time.Duration
 	KeeperRegistrySyncUpkeepQueueSize() uint32
+	KeeperUpkeepOrder() string
 	LogSQL() bool
 }
@@ -54,6 +54,9 @@ func (Delegate) AfterJobCreated(spec job.Job) {}
 
 func (Delegate) BeforeJobDeleted(spec job.Job) {}
 
+// ServicesForSpec returns the keeper services for the job spec. If the job's
+// chain is not running yet, e.g. because it failed to start and is being
+// retried, the job is dormant until it is.
 func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err error) {
 	// TODO: we need to fill these out manually, find a better fix
 	spec.PipelineSpec.JobName = spec.Name.ValueOrZero()
@@ -62,6 +65,26 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err er
 	if spec.KeeperSpec == nil {
 		return nil, errors.Errorf("Delegate expects a *job.KeeperSpec to be present, got %v", spec)
 	}
+	chainID := spec.KeeperSpec.EVMChainID.ToInt()
+	if _, err = d.chainSet.Get(chainID); err != nil {
+		return nil, err
+	}
+	running, err := d.chainSet.Running(chainID)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case <-running:
+		return d.servicesForSpec(spec)
+	default:
+		d.logger.Warnw("Chain of keeper job is not running yet, the job is dormant until it is", "jobID", spec.ID, "evmChainID", chainID)
+		return []job.Service{job.NewDormantService(d.logger.With("jobID", spec.ID), running, func() ([]job.Service, error) {
+			return d.servicesForSpec(spec)
+		})}, nil
+	}
+}
+
+func (d *Delegate) servicesForSpec(spec job.Job) (services []job.Service, err error) {
 	chain, err := d.chainSet.Get(spec.KeeperSpec.EVMChainID.ToInt())
 	if err != nil {
 		return nil, err
@@ -99,6 +122,7 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err er
 		MinIncomingConfirmations: minIncomingConfirmations,
 		Logger:                   svcLogger,
 		SyncUpkeepQueueSize:      chain.Config().KeeperRegistrySyncUpkeepQueueSize(),
+		UpkeepRunsRetention:      chain.Config().KeeperUpkeepRunsRetention(),
 	})
 	upkeepExecuter := NewUpkeepExecuter(
 		spec,
@@ -113,6 +137,6 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err er
 
 	return []job.Service{
 		registrySynchronizer,
-		upkeepExecuter,
+		chain.LeaderElector().Gate("UpkeepExecuter", upkeepExecuter),
 	}, nil
 }
@@ -7,3 +7,5 @@ func (rs *RegistrySynchronizer) ExportedFullSync() {
 func (rs *RegistrySynchronizer) ExportedProcessLogs() {
 	rs.processLogs()
 }
+
+var PerformDeadline = performDeadline
@@ -115,6 +115,8 @@ func TestKeeperEthIntegration(t *testing.T) {
 			config.Overrides.GlobalMinIncomingConfirmations = null.IntFrom(1)
 			// avoid waiting to re-submit for upkeeps
 			config.Overrides.KeeperMaximumGracePeriod = null.IntFrom(0)
+			// mark upkeeps as performed one block after the perform transaction is mined
+			config.Overrides.GlobalEvmFinalityDepth = null.IntFrom(1)
 			// helps prevent missed heads
 			config.Overrides.GlobalEvmHeadTrackerMaxBufferSize = null.IntFrom(100)
 			app := cltest.NewApplicationWithConfigAndKeyOnSimulatedBlockchain(t, config, backend, nodeKey)
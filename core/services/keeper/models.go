@@ -1,6 +1,14 @@
 package keeper
 
-import "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
 
 type Registry struct {
 	ID                int64
@@ -17,6 +25,18 @@ func (Registry) TableName() string {
 	return "keeper_registries"
 }
 
+// UpkeepOrder is the order in which eligible upkeeps are returned, see
+// KEEPER_UPKEEP_ORDER
+type UpkeepOrder string
+
+const (
+	// UpkeepOrderID orders upkeeps by the order they were synced in
+	UpkeepOrderID UpkeepOrder = "id"
+	// UpkeepOrderExecuteGas orders upkeeps by their execute gas, cheapest
+	// first, so that they are attempted first when block gas is scarce
+	UpkeepOrderExecuteGas UpkeepOrder = "execute_gas"
+)
+
 type UpkeepRegistration struct {
 	ID                  int32
 	CheckData           []byte
@@ -26,4 +46,80 @@ type UpkeepRegistration struct {
 	Registry            Registry
 	UpkeepID            int64
 	PositioningConstant int32
+	// Balance is nil if the upkeep has not been synced since balances were
+	// first recorded
+	Balance *utils.Big
+	// Paused upkeeps are not performed, but are kept in sync with the registry
+	Paused bool
+	// MaxPerformGasPrice is the highest gas price (or fee cap in EIP-1559
+	// mode) the upkeep is performed at, nil if there is no ceiling
+	MaxPerformGasPrice *utils.Big
+	// GracePeriodBlocks overrides the grace period passed to
+	// EligibleUpkeepsForRegistry for this upkeep, if valid
+	GracePeriodBlocks null.Int
+}
+
+// UpkeepRunStatus is the status of an UpkeepRun
+type UpkeepRunStatus string
+
+const (
+	// UpkeepRunStatusPending is the status of a run whose perform transaction
+	// has not been confirmed yet
+	UpkeepRunStatusPending UpkeepRunStatus = "pending"
+	// UpkeepRunStatusSuccess is the status of a run whose perform transaction
+	// was confirmed and succeeded
+	UpkeepRunStatusSuccess UpkeepRunStatus = "success"
+	// UpkeepRunStatusReverted is the status of a run whose perform
+	// transaction was confirmed but reverted
+	UpkeepRunStatusReverted UpkeepRunStatus = "reverted"
+	// UpkeepRunStatusFailed is the status of a run whose perform transaction
+	// fatally errored and was never confirmed
+	UpkeepRunStatusFailed UpkeepRunStatus = "failed"
+)
+
+// UpkeepRun is a perform transaction this node created for an upkeep, at the
+// head with number BlockHeight
+type UpkeepRun struct {
+	ID          int64
+	RegistryID  int64
+	UpkeepID    int64
+	BlockHeight int64
+	// EthTxID is nil once the perform transaction has been reaped
+	EthTxID   *int64
+	Status    UpkeepRunStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// UpkeepRunStats counts the runs of an upkeep by status, see UpkeepRun
+type UpkeepRunStats struct {
+	Pending  int64
+	Success  int64
+	Reverted int64
+	Failed   int64
+	// LastRunAt is when the most recent run was created, nil if there are
+	// no runs
+	LastRunAt *time.Time
+}
+
+// UpkeepEligibility explains whether an upkeep is eligible to be performed by
+// this keeper at a block, see EligibleUpkeepsForRegistry
+type UpkeepEligibility struct {
+	BlockNumber   int64
+	TurnBlockHash common.Hash
+	Paused        bool
+	// GracePeriodBlocks is the grace period that applies to the upkeep
+	GracePeriodBlocks int64
+	// PerformedRecently is true if the upkeep was last performed within its
+	// grace period, or earlier in the current turn
+	PerformedRecently bool
+	// Position is the key this turn's block hash gives the upkeep, and
+	// TurnKeeperIndex the index of the keeper whose turn it is to perform
+	// it, -1 if the registry has no keepers
+	Position        int64
+	TurnKeeperIndex int64
+	KeeperIndex     int32
+	Eligible        bool
+	// Reason says why the upkeep is not eligible, empty if it is
+	Reason string
 }
@@ -1,16 +1,34 @@
 package keeper
 
 import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/sqlx"
 )
 
+var promUpkeepsSkippedInsufficientBalance = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "keeper_upkeeps_skipped_insufficient_balance",
+	Help: "The number of times an eligible upkeep was not performed because its balance on the registry could not cover the execution",
+},
+	[]string{"registryContract"},
+)
+
 // ORM implements ORM layer using PostgreSQL
 type ORM struct {
 	q        pg.Q
@@ -69,67 +87,377 @@ RETURNING *
 // UpsertUpkeep upserts upkeep by the given input
 func (korm ORM) UpsertUpkeep(registration *UpkeepRegistration) error {
 	stmt := `
-INSERT INTO upkeep_registrations (registry_id, execute_gas, check_data, upkeep_id, positioning_constant, last_run_block_height) VALUES (
-:registry_id, :execute_gas, :check_data, :upkeep_id, :positioning_constant, :last_run_block_height
+INSERT INTO upkeep_registrations (registry_id, execute_gas, check_data, upkeep_id, positioning_constant, last_run_block_height, balance, paused) VALUES (
+:registry_id, :execute_gas, :check_data, :upkeep_id, :positioning_constant, :last_run_block_height, :balance, :paused
 ) ON CONFLICT (registry_id, upkeep_id) DO UPDATE SET
 	execute_gas = :execute_gas,
 	check_data = :check_data,
-	positioning_constant = :positioning_constant
+	positioning_constant = :positioning_constant,
+	balance = :balance
 RETURNING *
 `
 	err := korm.q.GetNamed(stmt, registration, registration)
 	return errors.Wrap(err, "failed to upsert upkeep")
 }
 
-// BatchDeleteUpkeepsForJob deletes all upkeeps by the given IDs for the job with the given ID
-func (korm ORM) BatchDeleteUpkeepsForJob(jobID int32, upkeepIDs []int64) (int64, error) {
+// upsertUpkeepsBatchSize is the number of upkeeps upserted per statement by
+// UpsertUpkeeps. Each upkeep takes 8 parameters, which keeps every statement
+// well under Postgres' limit of 65535 parameters.
+const upsertUpkeepsBatchSize = 1000
+
+// UpsertUpkeeps upserts the given upkeeps with a multi-row insert per batch of
+// upsertUpkeepsBatchSize. Conflicts are handled as in UpsertUpkeep, so
+// last_run_block_height and paused are only set for new upkeeps. If the same
+// upkeep is given more than once, the last one wins.
+func (korm ORM) UpsertUpkeeps(upkeeps []UpkeepRegistration) error {
+	type upkeepKey struct {
+		registryID int64
+		upkeepID   int64
+	}
+	deduped := make([]UpkeepRegistration, 0, len(upkeeps))
+	indexes := make(map[upkeepKey]int, len(upkeeps))
+	for _, upkeep := range upkeeps {
+		key := upkeepKey{upkeep.RegistryID, upkeep.UpkeepID}
+		if i, exists := indexes[key]; exists {
+			deduped[i] = upkeep
+			continue
+		}
+		indexes[key] = len(deduped)
+		deduped = append(deduped, upkeep)
+	}
+
+	err := korm.q.Transaction(func(tx pg.Queryer) error {
+		for start := 0; start < len(deduped); start += upsertUpkeepsBatchSize {
+			end := start + upsertUpkeepsBatchSize
+			if end > len(deduped) {
+				end = len(deduped)
+			}
+			valueStrings := make([]string, 0, end-start)
+			valueArgs := make([]interface{}, 0, (end-start)*8)
+			for _, upkeep := range deduped[start:end] {
+				valueStrings = append(valueStrings, "(?, ?, ?, ?, ?, ?, ?, ?)")
+				valueArgs = append(valueArgs, upkeep.RegistryID, upkeep.ExecuteGas, upkeep.CheckData, upkeep.UpkeepID,
+					upkeep.PositioningConstant, upkeep.LastRunBlockHeight, upkeep.Balance, upkeep.Paused)
+			}
+
+			/* #nosec G201 */
+			stmt := fmt.Sprintf(`
+INSERT INTO upkeep_registrations (registry_id, execute_gas, check_data, upkeep_id, positioning_constant, last_run_block_height, balance, paused)
+VALUES %s
+ON CONFLICT (registry_id, upkeep_id) DO UPDATE SET
+	execute_gas = EXCLUDED.execute_gas,
+	check_data = EXCLUDED.check_data,
+	positioning_constant = EXCLUDED.positioning_constant,
+	balance = EXCLUDED.balance
+`, strings.Join(valueStrings, ","))
+			if _, err := tx.Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), valueArgs...); err != nil {
+				return errors.Wrapf(err, "failed to upsert upkeeps %d to %d", start, end)
+			}
+		}
+		return nil
+	})
+	return errors.Wrap(err, "UpsertUpkeeps failed")
+}
+
+// SetUpkeepPaused pauses or unpauses the upkeep with the given ID on the
+// registry. A paused upkeep is never eligible to be performed. Returns
+// sql.ErrNoRows if there is no such upkeep.
+func (korm ORM) SetUpkeepPaused(registryID int64, upkeepID int64, paused bool) error {
 	res, err := korm.q.Exec(`
-DELETE FROM upkeep_registrations WHERE registry_id IN (
-	SELECT id FROM keeper_registries WHERE job_id = $1
-) AND upkeep_id = ANY($2)
-`, jobID, upkeepIDs)
+UPDATE upkeep_registrations SET paused = $1
+WHERE registry_id = $2 AND upkeep_id = $3
+`, paused, registryID, upkeepID)
+	if err != nil {
+		return errors.Wrap(err, "SetUpkeepPaused failed")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "SetUpkeepPaused failed to get RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return errors.Wrapf(sql.ErrNoRows, "SetUpkeepPaused found no upkeep %d on registry %d", upkeepID, registryID)
+	}
+	return nil
+}
+
+// SetUpkeepMaxPerformGasPrice sets the highest gas price the upkeep with the
+// given ID on the registry is performed at. A nil maxPerformGasPrice removes
+// the ceiling. Returns sql.ErrNoRows if there is no such upkeep.
+func (korm ORM) SetUpkeepMaxPerformGasPrice(registryID int64, upkeepID int64, maxPerformGasPrice *utils.Big) error {
+	res, err := korm.q.Exec(`
+UPDATE upkeep_registrations SET max_perform_gas_price = $1
+WHERE registry_id = $2 AND upkeep_id = $3
+`, maxPerformGasPrice, registryID, upkeepID)
 	if err != nil {
-		return 0, errors.Wrap(err, "BatchDeleteUpkeepsForJob failed to delete")
+		return errors.Wrap(err, "SetUpkeepMaxPerformGasPrice failed")
 	}
 	rowsAffected, err := res.RowsAffected()
 	if err != nil {
-		return 0, errors.Wrap(err, "BatchDeleteUpkeepsForJob failed to get RowsAffected")
+		return errors.Wrap(err, "SetUpkeepMaxPerformGasPrice failed to get RowsAffected")
 	}
-	return rowsAffected, nil
+	if rowsAffected == 0 {
+		return errors.Wrapf(sql.ErrNoRows, "SetUpkeepMaxPerformGasPrice found no upkeep %d on registry %d", upkeepID, registryID)
+	}
+	return nil
 }
 
-func (korm ORM) EligibleUpkeepsForRegistry(
-	registryAddress ethkey.EIP55Address,
-	blockNumber, gracePeriod int64,
-) (upkeeps []UpkeepRegistration, err error) {
+// SetUpkeepGracePeriod sets the grace period of the upkeep with the given ID
+// on the registry, overriding the one passed to EligibleUpkeepsForRegistry.
+// An invalid gracePeriodBlocks removes the override. Returns sql.ErrNoRows if
+// there is no such upkeep.
+func (korm ORM) SetUpkeepGracePeriod(registryID int64, upkeepID int64, gracePeriodBlocks null.Int) error {
+	res, err := korm.q.Exec(`
+UPDATE upkeep_registrations SET grace_period_blocks = $1
+WHERE registry_id = $2 AND upkeep_id = $3
+`, gracePeriodBlocks, registryID, upkeepID)
+	if err != nil {
+		return errors.Wrap(err, "SetUpkeepGracePeriod failed")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "SetUpkeepGracePeriod failed to get RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return errors.Wrapf(sql.ErrNoRows, "SetUpkeepGracePeriod found no upkeep %d on registry %d", upkeepID, registryID)
+	}
+	return nil
+}
+
+// UpkeepForRegistry returns the upkeep with the given ID on the registry, or
+// sql.ErrNoRows if there is no such upkeep
+func (korm ORM) UpkeepForRegistry(registryID int64, upkeepID int64) (upkeep UpkeepRegistration, err error) {
+	err = korm.q.Get(&upkeep, `SELECT * FROM upkeep_registrations WHERE registry_id = $1 AND upkeep_id = $2`, registryID, upkeepID)
+	return upkeep, errors.Wrap(err, "UpkeepForRegistry failed")
+}
+
+// BatchDeleteUpkeepsForJob deletes all upkeeps by the given IDs for the job with the given ID.
+//
+// Perform transactions for the deleted upkeeps are dealt with in the same
+// database transaction: unstarted ones are fatally errored since they can only
+// revert, and ones that have already been broadcast are flagged as expected to
+// revert.
+func (korm ORM) BatchDeleteUpkeepsForJob(jobID int32, upkeepIDs []int64) (rowsAffected int64, err error) {
+	err = korm.q.Transaction(func(tx pg.Queryer) error {
+		res, err := tx.Exec(`
+DELETE FROM upkeep_registrations WHERE registry_id IN (
+	SELECT id FROM keeper_registries WHERE job_id = $1
+) AND upkeep_id = ANY($2)
+`, jobID, upkeepIDs)
+		if err != nil {
+			return errors.Wrap(err, "BatchDeleteUpkeepsForJob failed to delete")
+		}
+		rowsAffected, err = res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "BatchDeleteUpkeepsForJob failed to get RowsAffected")
+		}
+		return errors.Wrap(cancelPerformTxesForUpkeeps(tx, jobID, upkeepIDs), "BatchDeleteUpkeepsForJob failed to cancel perform transactions")
+	})
+	return rowsAffected, err
+}
+
+// DeleteRegistryByJobID deletes the registry of the job with the given ID and
+// all of its upkeeps in one transaction, and returns the number of upkeeps
+// deleted. Perform transactions for the upkeeps are dealt with as in
+// BatchDeleteUpkeepsForJob.
+func (korm ORM) DeleteRegistryByJobID(jobID int32) (rowsAffected int64, err error) {
 	err = korm.q.Transaction(func(tx pg.Queryer) error {
-		stmt := `
-SELECT upkeep_registrations.* FROM upkeep_registrations
+		var upkeepIDs []int64
+		err = tx.Select(&upkeepIDs, `
+DELETE FROM upkeep_registrations WHERE registry_id IN (
+	SELECT id FROM keeper_registries WHERE job_id = $1
+) RETURNING upkeep_id
+`, jobID)
+		if err != nil {
+			return errors.Wrap(err, "DeleteRegistryByJobID failed to delete upkeeps")
+		}
+		rowsAffected = int64(len(upkeepIDs))
+		if _, err = tx.Exec(`DELETE FROM keeper_registries WHERE job_id = $1`, jobID); err != nil {
+			return errors.Wrap(err, "DeleteRegistryByJobID failed to delete registry")
+		}
+		return errors.Wrap(cancelPerformTxesForUpkeeps(tx, jobID, upkeepIDs), "DeleteRegistryByJobID failed to cancel perform transactions")
+	})
+	return rowsAffected, err
+}
+
+// cancelPerformTxesForUpkeeps errors the perform transactions for the upkeeps
+// that have not been sent yet. Those that have been broadcast are flagged as
+// expected to revert instead, while in_progress ones are left alone, since
+// they may be on their way to the eth node and the EthBroadcaster owns them.
+func cancelPerformTxesForUpkeeps(q pg.Queryer, jobID int32, upkeepIDs []int64) error {
+	// The meta->>'UpkeepID' IS NOT NULL is what lets these use
+	// idx_eth_txes_meta_job_id_upkeep_id
+	_, err := q.Exec(`
+UPDATE eth_txes SET state = 'fatal_error', error = 'upkeep canceled'
+WHERE state = 'unstarted' AND meta->>'UpkeepID' IS NOT NULL AND (meta->>'JobID')::int = $1 AND (meta->>'UpkeepID')::bigint = ANY($2)
+`, jobID, upkeepIDs)
+	if err != nil {
+		return errors.Wrap(err, "failed to error unstarted perform transactions")
+	}
+	_, err = q.Exec(`
+UPDATE eth_txes SET meta = jsonb_set(meta, '{ExpectedToRevert}', 'true')
+WHERE state = 'unconfirmed' AND meta->>'UpkeepID' IS NOT NULL AND (meta->>'JobID')::int = $1 AND (meta->>'UpkeepID')::bigint = ANY($2)
+`, jobID, upkeepIDs)
+	return errors.Wrap(err, "failed to flag broadcast perform transactions")
+}
+
+// eligibleUpkeepsQuery selects %s from the upkeeps on the registry $1 that
+// are eligible at block $3 with turn block hash $4. Upkeeps without a grace
+// period of their own use grace period $2.
+//
+// The key of each upkeep is derived from its upkeep ID and the turn block
+// hash alone, see upkeepPositionQuery, so that keepers agree on it even if
+// they have not synced the same upkeeps
+const eligibleUpkeepsQuery = `
+SELECT %s FROM upkeep_registrations
 INNER JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id
 WHERE
 	keeper_registries.contract_address = $1 AND
 	keeper_registries.num_keepers > 0 AND
+	NOT upkeep_registrations.paused AND
 	(
 		upkeep_registrations.last_run_block_height = 0 OR (
-			upkeep_registrations.last_run_block_height + $2 < $3 AND
-			upkeep_registrations.last_run_block_height < ($3 - ($3 % keeper_registries.block_count_per_turn))
+			upkeep_registrations.last_run_block_height + COALESCE(upkeep_registrations.grace_period_blocks, $2) < $3 AND
+			upkeep_registrations.last_run_block_height < ($3 - ($3 %% keeper_registries.block_count_per_turn))
 		)
 	) AND
 	keeper_registries.keeper_index = (
-		upkeep_registrations.positioning_constant + (($3 - ($3 % keeper_registries.block_count_per_turn)) / keeper_registries.block_count_per_turn)
-	) % keeper_registries.num_keepers
-ORDER BY upkeep_registrations.id ASC, upkeep_registrations.upkeep_id ASC
+		('x' || substr(md5(upkeep_registrations.upkeep_id::text || $4), 1, 8))::bit(32)::bigint +
+		(($3 - ($3 %% keeper_registries.block_count_per_turn)) / keeper_registries.block_count_per_turn)
+	) %% keeper_registries.num_keepers
 `
-		if err = tx.Select(&upkeeps, stmt, registryAddress, gracePeriod, blockNumber); err != nil {
-			return errors.Wrap(err, "EligibleUpkeepsForRegistry failed to get upkeep_registrations")
-		}
-		if err = loadUpkeepsRegistry(tx, upkeeps); err != nil {
-			return errors.Wrap(err, "EligibleUpkeepsForRegistry failed to load Registry on upkeeps")
+
+// CountEligibleUpkeepsForRegistry returns the number of upkeeps that
+// EligibleUpkeepsForRegistry would return without a minimum balance, without
+// loading them.
+func (korm ORM) CountEligibleUpkeepsForRegistry(
+	registryAddress ethkey.EIP55Address,
+	blockNumber, gracePeriod int64,
+	turnBlockHash common.Hash,
+) (count int64, err error) {
+	err = korm.q.Get(&count, fmt.Sprintf(eligibleUpkeepsQuery, "count(*)"), registryAddress, gracePeriod, blockNumber, turnBlockHash.Hex())
+	return count, errors.Wrap(err, "CountEligibleUpkeepsForRegistry failed")
+}
+
+// EligibleUpkeepsForRegistry returns the upkeeps on the registry that it is
+// this keeper's turn to perform at the given block. Paused upkeeps are never
+// eligible.
+//
+// Every turn, each upkeep gets a pseudo-random key from the hash of its
+// upkeep ID and turnBlockHash, the hash of the block the turn started at, and
+// is assigned to the keeper whose index is the key plus the turn number
+// modulo num_keepers. The key does not depend on the other upkeeps of the
+// registry, so all keepers assign an upkeep the same way even if their synced
+// upkeeps differ, within a turn they work disjoint sets of upkeeps, and over
+// num_keepers turns with the same seed every keeper is responsible for every
+// upkeep exactly once.
+//
+// If minBalancePerGas is not nil, upkeeps whose balance on the registry is
+// known to be below executeGas * minBalancePerGas are left out, since
+// performing them would revert. Upkeeps whose balance has not been synced yet
+// are always included.
+//
+// The eligible upkeeps are returned in the given order. The order never
+// changes which upkeeps are eligible.
+func (korm ORM) EligibleUpkeepsForRegistry(
+	registryAddress ethkey.EIP55Address,
+	blockNumber, gracePeriod int64,
+	turnBlockHash common.Hash,
+	minBalancePerGas *big.Int,
+	order UpkeepOrder,
+) (upkeeps []UpkeepRegistration, err error) {
+	err = korm.q.Transaction(func(tx pg.Queryer) error {
+		upkeeps, err = korm.selectEligibleUpkeeps(tx, registryAddress, blockNumber, gracePeriod, turnBlockHash, minBalancePerGas, order, 0, 0)
+		return err
+	}, pg.OptReadOnlyTx())
+	return upkeeps, err
+}
+
+// EligibleUpkeepsForRegistryPaged is like EligibleUpkeepsForRegistry, but
+// returns at most limit upkeeps starting from offset, along with the total
+// number of eligible upkeeps. A limit of 0 means no limit.
+//
+// The balance filter is applied to each page after it has been loaded, so
+// total counts upkeeps regardless of their balance, and a page may hold
+// fewer than limit upkeeps even if it is not the last one.
+func (korm ORM) EligibleUpkeepsForRegistryPaged(
+	registryAddress ethkey.EIP55Address,
+	blockNumber, gracePeriod int64,
+	turnBlockHash common.Hash,
+	minBalancePerGas *big.Int,
+	order UpkeepOrder,
+	limit, offset int,
+) (upkeeps []UpkeepRegistration, total int, err error) {
+	err = korm.q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&total, fmt.Sprintf(eligibleUpkeepsQuery, "count(*)"), registryAddress, gracePeriod, blockNumber, turnBlockHash.Hex()); err != nil {
+			return errors.Wrap(err, "EligibleUpkeepsForRegistry failed to count upkeep_registrations")
 		}
-		return nil
+		upkeeps, err = korm.selectEligibleUpkeeps(tx, registryAddress, blockNumber, gracePeriod, turnBlockHash, minBalancePerGas, order, limit, offset)
+		return err
 	}, pg.OptReadOnlyTx())
 
-	return upkeeps, err
+	return upkeeps, total, err
+}
+
+// selectEligibleUpkeeps loads a page of the upkeeps returned by
+// EligibleUpkeepsForRegistry, see EligibleUpkeepsForRegistryPaged
+func (korm ORM) selectEligibleUpkeeps(
+	tx pg.Queryer,
+	registryAddress ethkey.EIP55Address,
+	blockNumber, gracePeriod int64,
+	turnBlockHash common.Hash,
+	minBalancePerGas *big.Int,
+	order UpkeepOrder,
+	limit, offset int,
+) (upkeeps []UpkeepRegistration, err error) {
+	var orderBy string
+	switch order {
+	case UpkeepOrderID:
+		orderBy = "upkeep_registrations.id ASC, upkeep_registrations.upkeep_id ASC"
+	case UpkeepOrderExecuteGas:
+		orderBy = "upkeep_registrations.execute_gas ASC, upkeep_registrations.id ASC"
+	default:
+		return nil, errors.Errorf("EligibleUpkeepsForRegistry got unknown upkeep order %q", order)
+	}
+	var pageLimit interface{}
+	if limit > 0 {
+		pageLimit = limit
+	}
+	stmt := fmt.Sprintf(eligibleUpkeepsQuery, "upkeep_registrations.*") + `ORDER BY ` + orderBy + `
+LIMIT $5 OFFSET $6
+`
+	if err = tx.Select(&upkeeps, stmt, registryAddress, gracePeriod, blockNumber, turnBlockHash.Hex(), pageLimit, offset); err != nil {
+		return nil, errors.Wrap(err, "EligibleUpkeepsForRegistry failed to get upkeep_registrations")
+	}
+	if minBalancePerGas != nil {
+		upkeeps = korm.filterUnderfundedUpkeeps(registryAddress, upkeeps, minBalancePerGas)
+	}
+	if err = loadUpkeepsRegistry(tx, upkeeps); err != nil {
+		return nil, errors.Wrap(err, "EligibleUpkeepsForRegistry failed to load Registry on upkeeps")
+	}
+	return upkeeps, nil
+}
+
+func (korm ORM) filterUnderfundedUpkeeps(registryAddress ethkey.EIP55Address, upkeeps []UpkeepRegistration, minBalancePerGas *big.Int) []UpkeepRegistration {
+	funded := upkeeps[:0]
+	for _, upkeep := range upkeeps {
+		if upkeep.Balance == nil {
+			funded = append(funded, upkeep)
+			continue
+		}
+		minBalance := new(big.Int).Mul(new(big.Int).SetUint64(upkeep.ExecuteGas), minBalancePerGas)
+		if upkeep.Balance.ToInt().Cmp(minBalance) >= 0 {
+			funded = append(funded, upkeep)
+			continue
+		}
+		korm.logger.Debugw("Skipping upkeep with insufficient balance",
+			"upkeepID", upkeep.UpkeepID,
+			"registryContract", registryAddress.Hex(),
+			"balance", upkeep.Balance.String(),
+			"minBalance", minBalance.String(),
+		)
+		promUpkeepsSkippedInsufficientBalance.WithLabelValues(registryAddress.Hex()).Inc()
+	}
+	return funded
 }
 
 func loadUpkeepsRegistry(q pg.Queryer, upkeeps []UpkeepRegistration) error {
@@ -155,6 +483,33 @@ func loadUpkeepsRegistry(q pg.Queryer, upkeeps []UpkeepRegistration) error {
 	return nil
 }
 
+// StaleUpkeeps returns the upkeeps on the registry that have not been
+// performed in the last olderThanBlocks blocks before currentBlock, or have
+// never been performed. Paused upkeeps are left out, since they are not
+// expected to be performed.
+//
+// An upkeep that has not been performed for much longer than a turn is a
+// sign of a stuck job or an underfunded registry.
+func (korm ORM) StaleUpkeeps(registryID int64, olderThanBlocks int64, currentBlock int64) (upkeeps []UpkeepRegistration, err error) {
+	err = korm.q.Transaction(func(tx pg.Queryer) error {
+		err = tx.Select(&upkeeps, `
+SELECT * FROM upkeep_registrations
+WHERE registry_id = $1 AND NOT paused AND (
+	last_run_block_height = 0 OR last_run_block_height + $2 < $3
+)
+ORDER BY upkeep_id ASC
+`, registryID, olderThanBlocks, currentBlock)
+		if err != nil {
+			return errors.Wrap(err, "StaleUpkeeps failed to get upkeep_registrations")
+		}
+		if err = loadUpkeepsRegistry(tx, upkeeps); err != nil {
+			return errors.Wrap(err, "StaleUpkeeps failed to load Registry on upkeeps")
+		}
+		return nil
+	}, pg.OptReadOnlyTx())
+	return upkeeps, err
+}
+
 // LowestUnsyncedID returns the largest upkeepID + 1, indicating the expected next upkeepID
 // to sync from the contract
 func (korm ORM) LowestUnsyncedID(regID int64) (nextID int64, err error) {
@@ -176,3 +531,209 @@ registry_id = (
 )`, height, upkeepID, jobID)
 	return errors.Wrap(err, "SetLastRunHeightForUpkeepOnJob failed")
 }
+
+// CountPendingPerformTxes returns the number of keeper perform transactions on
+// the given chain that have not yet been confirmed
+func CountPendingPerformTxes(q pg.Q, chainID *big.Int) (count int64, err error) {
+	err = q.Get(&count, `
+SELECT count(*) FROM eth_txes
+WHERE evm_chain_id = $1
+AND state IN ('unstarted', 'in_progress', 'unconfirmed')
+AND meta->>'UpkeepID' IS NOT NULL`, chainID.String())
+	return count, errors.Wrap(err, "CountPendingPerformTxes failed")
+}
+
+// LatestPerformTxID returns the ID of the most recent perform transaction
+// created by the job for the upkeep
+func (korm ORM) LatestPerformTxID(jobID int32, upkeepID int64, qopts ...pg.QOpt) (id int64, err error) {
+	err = korm.q.WithOpts(qopts...).Get(&id, `
+SELECT id FROM eth_txes
+WHERE (meta->>'JobID')::int = $1 AND (meta->>'UpkeepID')::bigint = $2 AND meta->>'UpkeepID' IS NOT NULL
+ORDER BY id DESC
+LIMIT 1`, jobID, upkeepID)
+	return id, errors.Wrap(err, "LatestPerformTxID failed")
+}
+
+// PendingPerformTx is a perform transaction that has not been accounted for
+// yet
+type PendingPerformTx struct {
+	ID        int64
+	UpkeepID  int64
+	CreatedAt time.Time
+}
+
+// PendingPerformTxes returns the latest perform transaction created by the job
+// for each upkeep that is still pending, i.e. has neither been confirmed nor
+// fatally errored. If confirmedAfter is not nil, transactions that were
+// confirmed in a block after it are returned too.
+func (korm ORM) PendingPerformTxes(jobID int32, confirmedAfter *int64, qopts ...pg.QOpt) (txes []PendingPerformTx, err error) {
+	err = korm.q.WithOpts(qopts...).Select(&txes, `
+SELECT DISTINCT ON ((eth_txes.meta->>'UpkeepID')::bigint)
+	eth_txes.id, (eth_txes.meta->>'UpkeepID')::bigint AS upkeep_id, eth_txes.created_at
+FROM eth_txes
+WHERE (eth_txes.meta->>'JobID')::int = $1 AND eth_txes.meta->>'UpkeepID' IS NOT NULL AND (
+	eth_txes.state IN ('unstarted', 'in_progress', 'unconfirmed', 'confirmed_missing_receipt', 'awaiting_funds') OR (
+		eth_txes.state = 'confirmed' AND $2::bigint IS NOT NULL AND EXISTS (
+			SELECT 1 FROM eth_tx_attempts
+			INNER JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+			WHERE eth_tx_attempts.eth_tx_id = eth_txes.id AND eth_receipts.block_number > $2
+		)
+	)
+)
+ORDER BY (eth_txes.meta->>'UpkeepID')::bigint, eth_txes.id DESC`, jobID, confirmedAfter)
+	return txes, errors.Wrap(err, "PendingPerformTxes failed")
+}
+
+// PerformTx is the state of a perform transaction. BlockNumber is the block
+// the transaction was included in, if a receipt has been fetched.
+type PerformTx struct {
+	ID          int64
+	State       bulletprooftxmanager.EthTxState
+	BlockNumber *int64
+}
+
+// PerformTxes returns the state of the given perform transactions. Any that
+// no longer exist are left out.
+func (korm ORM) PerformTxes(ids []int64, qopts ...pg.QOpt) (txes []PerformTx, err error) {
+	err = korm.q.WithOpts(qopts...).Select(&txes, `
+SELECT eth_txes.id, eth_txes.state, max(eth_receipts.block_number) AS block_number FROM eth_txes
+LEFT JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+LEFT JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+WHERE eth_txes.id = ANY($1)
+GROUP BY eth_txes.id`, pq.Array(ids))
+	return txes, errors.Wrap(err, "PerformTxes failed")
+}
+
+// InsertUpkeepRun records a perform transaction created for an upkeep
+func (korm ORM) InsertUpkeepRun(run *UpkeepRun, qopts ...pg.QOpt) error {
+	err := korm.q.WithOpts(qopts...).Get(run, `
+INSERT INTO keeper_upkeep_runs (registry_id, upkeep_id, block_height, eth_tx_id, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, 'pending', NOW(), NOW())
+RETURNING *`, run.RegistryID, run.UpkeepID, run.BlockHeight, run.EthTxID)
+	return errors.Wrap(err, "InsertUpkeepRun failed")
+}
+
+// ResolveUpkeepRuns updates the pending runs on the registry whose perform
+// transaction has been confirmed or has fatally errored, and returns how many
+// were updated. A confirmed run succeeded if any of its attempts got a receipt
+// with a successful status.
+func (korm ORM) ResolveUpkeepRuns(registryID int64, qopts ...pg.QOpt) (rowsAffected int64, err error) {
+	res, err := korm.q.WithOpts(qopts...).Exec(`
+UPDATE keeper_upkeep_runs SET
+	status = CASE
+		WHEN eth_txes.state = 'fatal_error' THEN 'failed'
+		WHEN EXISTS (
+			SELECT 1 FROM eth_tx_attempts
+			INNER JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+			WHERE eth_tx_attempts.eth_tx_id = eth_txes.id AND eth_receipts.receipt->>'status' = '0x1'
+		) THEN 'success'
+		ELSE 'reverted'
+	END,
+	updated_at = NOW()
+FROM eth_txes
+WHERE keeper_upkeep_runs.eth_tx_id = eth_txes.id
+AND keeper_upkeep_runs.registry_id = $1
+AND keeper_upkeep_runs.status = 'pending'
+AND eth_txes.state IN ('confirmed', 'fatal_error')`, registryID)
+	if err != nil {
+		return 0, errors.Wrap(err, "ResolveUpkeepRuns failed")
+	}
+	rowsAffected, err = res.RowsAffected()
+	return rowsAffected, errors.Wrap(err, "ResolveUpkeepRuns failed to get RowsAffected")
+}
+
+// UpkeepRunsForUpkeep returns the latest runs of the upkeep on the registry,
+// newest first. A limit of 0 means no limit.
+func (korm ORM) UpkeepRunsForUpkeep(registryID, upkeepID int64, limit int, qopts ...pg.QOpt) (runs []UpkeepRun, err error) {
+	var runsLimit interface{}
+	if limit > 0 {
+		runsLimit = limit
+	}
+	err = korm.q.WithOpts(qopts...).Select(&runs, `
+SELECT * FROM keeper_upkeep_runs
+WHERE registry_id = $1 AND upkeep_id = $2
+ORDER BY id DESC
+LIMIT $3`, registryID, upkeepID, runsLimit)
+	return runs, errors.Wrap(err, "UpkeepRunsForUpkeep failed")
+}
+
+// UpkeepRunStats counts the runs of the upkeep on the registry by status.
+// Runs removed by PruneUpkeepRuns are not counted.
+func (korm ORM) UpkeepRunStats(registryID, upkeepID int64, qopts ...pg.QOpt) (stats UpkeepRunStats, err error) {
+	err = korm.q.WithOpts(qopts...).Get(&stats, `
+SELECT
+	count(*) FILTER (WHERE status = 'pending') AS pending,
+	count(*) FILTER (WHERE status = 'success') AS success,
+	count(*) FILTER (WHERE status = 'reverted') AS reverted,
+	count(*) FILTER (WHERE status = 'failed') AS failed,
+	max(created_at) AS last_run_at
+FROM keeper_upkeep_runs
+WHERE registry_id = $1 AND upkeep_id = $2`, registryID, upkeepID)
+	return stats, errors.Wrap(err, "UpkeepRunStats failed")
+}
+
+// upkeepPositionQuery selects the key of upkeep $1 for turn block hash $2,
+// the first 32 bits of the md5 of the two, as used by eligibleUpkeepsQuery
+const upkeepPositionQuery = `
+SELECT ('x' || substr(md5($1::bigint::text || $2), 1, 8))::bit(32)::bigint
+`
+
+// UpkeepEligibility explains whether the upkeep is eligible at the given
+// block, following the same rules as EligibleUpkeepsForRegistry. It does not
+// consider the balance of the upkeep, nor whether it has a pending perform
+// transaction.
+func (korm ORM) UpkeepEligibility(
+	registry Registry,
+	upkeep UpkeepRegistration,
+	blockNumber, gracePeriod int64,
+	turnBlockHash common.Hash,
+) (e UpkeepEligibility, err error) {
+	if registry.BlockCountPerTurn <= 0 {
+		return e, errors.Errorf("invalid block count per turn %d", registry.BlockCountPerTurn)
+	}
+	if err = korm.q.Get(&e.Position, upkeepPositionQuery, upkeep.UpkeepID, turnBlockHash.Hex()); err != nil {
+		return e, errors.Wrap(err, "UpkeepEligibility failed to get the key of the upkeep")
+	}
+
+	e.BlockNumber = blockNumber
+	e.TurnBlockHash = turnBlockHash
+	e.Paused = upkeep.Paused
+	e.KeeperIndex = registry.KeeperIndex
+	e.GracePeriodBlocks = gracePeriod
+	if upkeep.GracePeriodBlocks.Valid {
+		e.GracePeriodBlocks = upkeep.GracePeriodBlocks.Int64
+	}
+	turnStart := blockNumber - (blockNumber % int64(registry.BlockCountPerTurn))
+	e.PerformedRecently = upkeep.LastRunBlockHeight != 0 &&
+		(upkeep.LastRunBlockHeight+e.GracePeriodBlocks >= blockNumber || upkeep.LastRunBlockHeight >= turnStart)
+	e.TurnKeeperIndex = -1
+	if registry.NumKeepers > 0 {
+		e.TurnKeeperIndex = (e.Position + turnStart/int64(registry.BlockCountPerTurn)) % int64(registry.NumKeepers)
+	}
+
+	switch {
+	case registry.NumKeepers <= 0:
+		e.Reason = "the registry has no keepers"
+	case e.Paused:
+		e.Reason = "the upkeep is paused"
+	case e.PerformedRecently:
+		e.Reason = fmt.Sprintf("the upkeep was performed at block %d, within its grace period of %d blocks or earlier in this turn", upkeep.LastRunBlockHeight, e.GracePeriodBlocks)
+	case e.TurnKeeperIndex != int64(registry.KeeperIndex):
+		e.Reason = fmt.Sprintf("it is the turn of keeper %d", e.TurnKeeperIndex)
+	default:
+		e.Eligible = true
+	}
+	return e, nil
+}
+
+// PruneUpkeepRuns deletes the runs on the registry that were created before
+// the given time, and returns how many were deleted
+func (korm ORM) PruneUpkeepRuns(registryID int64, before time.Time, qopts ...pg.QOpt) (rowsAffected int64, err error) {
+	res, err := korm.q.WithOpts(qopts...).Exec(`
+DELETE FROM keeper_upkeep_runs WHERE registry_id = $1 AND created_at < $2`, registryID, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "PruneUpkeepRuns failed")
+	}
+	rowsAffected, err = res.RowsAffected()
+	return rowsAffected, errors.Wrap(err, "PruneUpkeepRuns failed to get RowsAffected")
+}
@@ -1,6 +1,11 @@
 package keeper_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -8,6 +13,7 @@ import (
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
@@ -16,15 +22,71 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/pg/datatypes"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/sqlx"
 )
 
 var (
-	checkData  = common.Hex2Bytes("ABC123")
-	executeGas = uint64(10_000)
+	checkData     = common.Hex2Bytes("ABC123")
+	executeGas    = uint64(10_000)
+	turnBlockHash = common.HexToHash("0x9a7b0f3e8c2d4a6b1e5f7c9d0b2a4c6e8f1a3b5c7d9e0f2a4b6c8d0e1f3a5b7c")
 )
 
-func setupKeeperDB(t *testing.T) (
+// mustInsertPerformTx inserts a perform transaction for the upkeep, as
+// created by the job
+func mustInsertPerformTx(t *testing.T, borm bulletprooftxmanager.ORM, jobID int32, fromAddress common.Address, upkeepID int64, state bulletprooftxmanager.EthTxState, nonce int64) bulletprooftxmanager.EthTx {
+	t.Helper()
+
+	meta, err := json.Marshal(bulletprooftxmanager.EthTxMeta{JobID: jobID, UpkeepID: &upkeepID})
+	require.NoError(t, err)
+	etx := cltest.NewEthTx(t, fromAddress)
+	etx.State = state
+	etx.Meta = (*datatypes.JSON)(&meta)
+	switch state {
+	case bulletprooftxmanager.EthTxUnstarted:
+	case bulletprooftxmanager.EthTxFatalError:
+		etx.Error = null.StringFrom("something exploded")
+	default:
+		broadcastAt := time.Now()
+		etx.BroadcastAt = &broadcastAt
+		etx.Nonce = &nonce
+	}
+	require.NoError(t, borm.InsertEthTx(&etx))
+	return etx
+}
+
+// mustConfirmPerformTx marks the perform transaction as confirmed with a
+// successful receipt in the given block
+func mustConfirmPerformTx(t *testing.T, db *sqlx.DB, borm bulletprooftxmanager.ORM, etx bulletprooftxmanager.EthTx, blockNumber int64) {
+	t.Helper()
+	mustConfirmPerformTxWithStatus(t, db, borm, etx, blockNumber, 1)
+}
+
+// mustConfirmPerformTxWithStatus marks the perform transaction as confirmed
+// with a receipt in the given block, with the given receipt status
+func mustConfirmPerformTxWithStatus(t *testing.T, db *sqlx.DB, borm bulletprooftxmanager.ORM, etx bulletprooftxmanager.EthTx, blockNumber int64, status uint64) {
+	t.Helper()
+
+	attempt := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+	attempt.State = bulletprooftxmanager.EthTxAttemptBroadcast
+	attempt.BroadcastBeforeBlockNum = &blockNumber
+	require.NoError(t, borm.InsertEthTxAttempt(&attempt))
+	receipt := cltest.NewEthReceipt(t, blockNumber, utils.NewHash(), attempt.Hash)
+	data, err := json.Marshal(bulletprooftxmanager.Receipt{
+		BlockNumber: big.NewInt(blockNumber),
+		BlockHash:   receipt.BlockHash,
+		TxHash:      attempt.Hash,
+		Status:      status,
+	})
+	require.NoError(t, err)
+	receipt.Receipt = data
+	require.NoError(t, borm.InsertEthReceipt(&receipt))
+	_, err = db.Exec(`UPDATE eth_txes SET state = 'confirmed' WHERE id = $1`, etx.ID)
+	require.NoError(t, err)
+}
+
+func setupKeeperDB(t testing.TB) (
 	*sqlx.DB,
 	evmconfig.ChainScopedConfig,
 	keeper.ORM,
@@ -56,6 +118,15 @@ func waitLastRunHeight(t *testing.T, db *sqlx.DB, upkeep keeper.UpkeepRegistrati
 	}, time.Second*2, time.Millisecond*100).Should(gomega.Equal(height))
 }
 
+// assertEligibleCount asserts that CountEligibleUpkeepsForRegistry agrees with
+// the upkeeps returned by EligibleUpkeepsForRegistry for the same arguments
+func assertEligibleCount(t *testing.T, orm keeper.ORM, registry keeper.Registry, blockNumber, gracePeriod int64, hash common.Hash, eligible []keeper.UpkeepRegistration) {
+	t.Helper()
+	count, err := orm.CountEligibleUpkeepsForRegistry(registry.ContractAddress, blockNumber, gracePeriod, hash)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(eligible)), count)
+}
+
 func assertLastRunHeight(t *testing.T, db *sqlx.DB, upkeep keeper.UpkeepRegistration, height int64) {
 	err := db.Get(&upkeep, `SELECT * FROM upkeep_registrations WHERE id = $1`, upkeep.ID)
 	require.NoError(t, err)
@@ -112,6 +183,202 @@ func TestKeeperDB_UpsertUpkeep(t *testing.T) {
 	require.Equal(t, int64(1), upkeepFromDB.LastRunBlockHeight) // shouldn't change on upsert
 }
 
+func TestKeeperDB_UpsertUpkeeps(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+
+	// More than one batch
+	upkeeps := newUpkeeps(registry, 1500)
+	require.NoError(t, orm.UpsertUpkeeps(upkeeps))
+	cltest.AssertCount(t, db, "upkeep_registrations", 1500)
+
+	require.NoError(t, orm.SetUpkeepPaused(registry.ID, 1, true))
+
+	// update upkeeps, with a duplicate in the same batch
+	for i := range upkeeps {
+		upkeeps[i].ExecuteGas = 20_000
+		upkeeps[i].CheckData = common.Hex2Bytes("8888")
+		upkeeps[i].LastRunBlockHeight = 2
+	}
+	duplicate := upkeeps[0]
+	duplicate.ExecuteGas = 30_000
+	upkeeps = append(upkeeps, duplicate)
+	require.NoError(t, orm.UpsertUpkeeps(upkeeps))
+	cltest.AssertCount(t, db, "upkeep_registrations", 1500)
+
+	var upkeepsFromDB []keeper.UpkeepRegistration
+	require.NoError(t, db.Select(&upkeepsFromDB, `SELECT * FROM upkeep_registrations ORDER BY upkeep_id`))
+	require.Len(t, upkeepsFromDB, 1500)
+	// last one wins
+	assert.Equal(t, uint64(30_000), upkeepsFromDB[0].ExecuteGas)
+	for i, upkeep := range upkeepsFromDB {
+		if i > 0 {
+			assert.Equal(t, uint64(20_000), upkeep.ExecuteGas)
+		}
+		assert.Equal(t, "8888", common.Bytes2Hex(upkeep.CheckData))
+		assert.Equal(t, int64(1), upkeep.LastRunBlockHeight) // shouldn't change on upsert
+		// a registry sync shouldn't unpause the upkeep
+		assert.Equal(t, upkeep.UpkeepID == 1, upkeep.Paused)
+	}
+
+	require.NoError(t, orm.UpsertUpkeeps(nil))
+}
+
+func TestKeeperDB_UpsertUpkeeps_MatchesSerialUpsert(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	serialRegistry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	batchRegistry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	serialUpkeeps := newUpkeeps(serialRegistry, 300)
+	batchUpkeeps := newUpkeeps(batchRegistry, 300)
+
+	upsert := func() {
+		for i := range serialUpkeeps {
+			require.NoError(t, orm.UpsertUpkeep(&serialUpkeeps[i]))
+		}
+		require.NoError(t, orm.UpsertUpkeeps(batchUpkeeps))
+	}
+	assertSame := func() {
+		var serial, batch []keeper.UpkeepRegistration
+		require.NoError(t, db.Select(&serial, `SELECT * FROM upkeep_registrations WHERE registry_id = $1 ORDER BY upkeep_id`, serialRegistry.ID))
+		require.NoError(t, db.Select(&batch, `SELECT * FROM upkeep_registrations WHERE registry_id = $1 ORDER BY upkeep_id`, batchRegistry.ID))
+		require.Len(t, serial, len(serialUpkeeps))
+		require.Len(t, batch, len(serial))
+		for i := range serial {
+			serial[i].ID, batch[i].ID = 0, 0
+			serial[i].RegistryID, batch[i].RegistryID = 0, 0
+			assert.Equal(t, serial[i], batch[i])
+		}
+	}
+
+	// inserts
+	upsert()
+	assertSame()
+
+	// updates, which must not clobber last_run_block_height
+	for _, upkeeps := range [][]keeper.UpkeepRegistration{serialUpkeeps, batchUpkeeps} {
+		for i := range upkeeps {
+			upkeeps[i].ExecuteGas = 20_000 + uint64(i)
+			upkeeps[i].CheckData = common.Hex2Bytes("8888")
+			upkeeps[i].LastRunBlockHeight = 42
+			upkeeps[i].Balance = utils.NewBigI(int64(i))
+		}
+	}
+	upsert()
+	assertSame()
+
+	var lastRunBlockHeights []int64
+	require.NoError(t, db.Select(&lastRunBlockHeights, `SELECT DISTINCT last_run_block_height FROM upkeep_registrations WHERE registry_id = $1`, batchRegistry.ID))
+	assert.Equal(t, []int64{1}, lastRunBlockHeights)
+}
+
+func newUpkeeps(registry keeper.Registry, n int) []keeper.UpkeepRegistration {
+	upkeeps := make([]keeper.UpkeepRegistration, n)
+	for i := range upkeeps {
+		upkeeps[i] = keeper.UpkeepRegistration{
+			UpkeepID:            int64(i),
+			ExecuteGas:          executeGas,
+			RegistryID:          registry.ID,
+			CheckData:           checkData,
+			LastRunBlockHeight:  1,
+			PositioningConstant: int32(i),
+			Balance:             utils.NewBigI(1),
+		}
+	}
+	return upkeeps
+}
+
+func BenchmarkKeeperDB_UpsertUpkeep(b *testing.B) {
+	db, config, orm := setupKeeperDB(b)
+	ethKeyStore := cltest.NewKeyStore(b, db, config).Eth()
+	registry, _ := cltest.MustInsertKeeperRegistry(b, db, orm, ethKeyStore)
+	upkeeps := newUpkeeps(registry, 1000)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range upkeeps {
+			require.NoError(b, orm.UpsertUpkeep(&upkeeps[i]))
+		}
+	}
+}
+
+func BenchmarkKeeperDB_UpsertUpkeeps(b *testing.B) {
+	db, config, orm := setupKeeperDB(b)
+	ethKeyStore := cltest.NewKeyStore(b, db, config).Eth()
+	registry, _ := cltest.MustInsertKeeperRegistry(b, db, orm, ethKeyStore)
+	upkeeps := newUpkeeps(registry, 1000)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		require.NoError(b, orm.UpsertUpkeeps(upkeeps))
+	}
+}
+
+func TestKeeperDB_SetUpkeepPaused(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+
+	require.NoError(t, orm.SetUpkeepPaused(registry.ID, upkeep.UpkeepID, true))
+
+	// a registry sync shouldn't unpause the upkeep
+	upkeep.Paused = false
+	upkeep.ExecuteGas = 20_000
+	require.NoError(t, orm.UpsertUpkeep(&upkeep))
+	assert.True(t, upkeep.Paused)
+
+	var upkeepFromDB keeper.UpkeepRegistration
+	require.NoError(t, db.Get(&upkeepFromDB, `SELECT * FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+	assert.True(t, upkeepFromDB.Paused)
+	assert.Equal(t, uint64(20_000), upkeepFromDB.ExecuteGas)
+
+	err := orm.SetUpkeepPaused(registry.ID, upkeep.UpkeepID+1, true)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, sql.ErrNoRows))
+}
+
+func TestKeeperDB_SetUpkeepMaxPerformGasPrice(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+	assert.Nil(t, upkeep.MaxPerformGasPrice)
+
+	require.NoError(t, orm.SetUpkeepMaxPerformGasPrice(registry.ID, upkeep.UpkeepID, utils.NewBigI(50e9)))
+
+	// a registry sync shouldn't clear the ceiling
+	upkeep.ExecuteGas = 20_000
+	require.NoError(t, orm.UpsertUpkeep(&upkeep))
+
+	upkeepFromDB, err := orm.UpkeepForRegistry(registry.ID, upkeep.UpkeepID)
+	require.NoError(t, err)
+	require.NotNil(t, upkeepFromDB.MaxPerformGasPrice)
+	assert.Equal(t, "50000000000", upkeepFromDB.MaxPerformGasPrice.String())
+	assert.Equal(t, uint64(20_000), upkeepFromDB.ExecuteGas)
+
+	require.NoError(t, orm.SetUpkeepMaxPerformGasPrice(registry.ID, upkeep.UpkeepID, nil))
+	upkeepFromDB, err = orm.UpkeepForRegistry(registry.ID, upkeep.UpkeepID)
+	require.NoError(t, err)
+	assert.Nil(t, upkeepFromDB.MaxPerformGasPrice)
+
+	err = orm.SetUpkeepMaxPerformGasPrice(registry.ID, upkeep.UpkeepID+1, nil)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, sql.ErrNoRows))
+
+	_, err = orm.UpkeepForRegistry(registry.ID, upkeep.UpkeepID+1)
+	assert.True(t, errors.Is(err, sql.ErrNoRows))
+}
+
 func TestKeeperDB_BatchDeleteUpkeepsForJob(t *testing.T) {
 	t.Parallel()
 	db, config, orm := setupKeeperDB(t)
@@ -135,6 +402,163 @@ func TestKeeperDB_BatchDeleteUpkeepsForJob(t *testing.T) {
 	require.Equal(t, int64(1), remainingUpkeep.UpkeepID)
 }
 
+func TestKeeperDB_BatchDeleteUpkeepsForJob_CancelsPerformTxes(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+	borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+
+	registry, job := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	for i := int64(0); i < 3; i++ {
+		cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+	}
+
+	insertPerformTx := func(upkeepID int64, state bulletprooftxmanager.EthTxState, nonce int64) bulletprooftxmanager.EthTx {
+		return mustInsertPerformTx(t, borm, job.ID, registry.FromAddress.Address(), upkeepID, state, nonce)
+	}
+
+	// upkeep 0 has an unstarted perform tx, upkeep 1 has an unconfirmed one
+	// and upkeep 2 has none
+	unstarted := insertPerformTx(0, bulletprooftxmanager.EthTxUnstarted, 0)
+	unconfirmed := insertPerformTx(1, bulletprooftxmanager.EthTxUnconfirmed, 0)
+	// an in_progress perform tx may already have been sent, so is left alone
+	inProgress := insertPerformTx(1, bulletprooftxmanager.EthTxUnstarted, 0)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET state = 'in_progress', nonce = 1 WHERE id = $1`, inProgress.ID)
+	// a perform tx for an upkeep that is not being deleted is left alone
+	untouched := insertPerformTx(42, bulletprooftxmanager.EthTxUnstarted, 0)
+
+	affected, err := orm.BatchDeleteUpkeepsForJob(job.ID, []int64{0, 1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), affected)
+	cltest.AssertCount(t, db, "upkeep_registrations", 0)
+
+	etx, err := borm.FindEthTxWithAttempts(unstarted.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+	assert.Equal(t, "upkeep canceled", etx.Error.String)
+
+	etx, err = borm.FindEthTxWithAttempts(unconfirmed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+	var meta bulletprooftxmanager.EthTxMeta
+	require.NoError(t, json.Unmarshal([]byte(*etx.Meta), &meta))
+	assert.True(t, meta.ExpectedToRevert)
+
+	etx, err = borm.FindEthTxWithAttempts(inProgress.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxInProgress, etx.State)
+	meta = bulletprooftxmanager.EthTxMeta{}
+	require.NoError(t, json.Unmarshal([]byte(*etx.Meta), &meta))
+	assert.False(t, meta.ExpectedToRevert)
+
+	etx, err = borm.FindEthTxWithAttempts(untouched.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+}
+
+func TestKeeperDB_DeleteRegistryByJobID(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, job := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	for i := int64(0); i < 3; i++ {
+		cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+	}
+	cltest.AssertCount(t, db, "keeper_registries", 1)
+	cltest.AssertCount(t, db, "upkeep_registrations", 3)
+
+	deleted, err := orm.DeleteRegistryByJobID(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+	cltest.AssertCount(t, db, "keeper_registries", 0)
+	cltest.AssertCount(t, db, "upkeep_registrations", 0)
+
+	deleted, err = orm.DeleteRegistryByJobID(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+}
+
+func TestKeeperDB_PerformTxes(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+	borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+
+	registry, job := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	from := registry.FromAddress.Address()
+
+	_, err := orm.LatestPerformTxID(job.ID, 0)
+	require.Error(t, err)
+
+	mustInsertPerformTx(t, borm, job.ID, from, 0, bulletprooftxmanager.EthTxFatalError, 0)
+	latest := mustInsertPerformTx(t, borm, job.ID, from, 0, bulletprooftxmanager.EthTxUnconfirmed, 1)
+	other := mustInsertPerformTx(t, borm, job.ID, from, 1, bulletprooftxmanager.EthTxUnconfirmed, 2)
+	mustConfirmPerformTx(t, db, borm, other, 42)
+
+	id, err := orm.LatestPerformTxID(job.ID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, latest.ID, id)
+
+	txes, err := orm.PerformTxes([]int64{latest.ID, other.ID, other.ID + 1})
+	require.NoError(t, err)
+	require.Len(t, txes, 2)
+	byID := map[int64]keeper.PerformTx{txes[0].ID: txes[0], txes[1].ID: txes[1]}
+
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, byID[latest.ID].State)
+	assert.Nil(t, byID[latest.ID].BlockNumber)
+	assert.Equal(t, bulletprooftxmanager.EthTxConfirmed, byID[other.ID].State)
+	require.NotNil(t, byID[other.ID].BlockNumber)
+	assert.Equal(t, int64(42), *byID[other.ID].BlockNumber)
+}
+
+func TestKeeperDB_PendingPerformTxes(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+	borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+
+	registry, job := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	from := registry.FromAddress.Address()
+
+	txes, err := orm.PendingPerformTxes(job.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, txes, 0)
+
+	// upkeep 0 has an older pending transaction than its latest one
+	mustInsertPerformTx(t, borm, job.ID, from, 0, bulletprooftxmanager.EthTxUnstarted, 0)
+	pending := mustInsertPerformTx(t, borm, job.ID, from, 0, bulletprooftxmanager.EthTxUnconfirmed, 1)
+	// upkeep 1 fatally errored
+	mustInsertPerformTx(t, borm, job.ID, from, 1, bulletprooftxmanager.EthTxFatalError, 0)
+	// upkeep 2 was confirmed in block 42
+	confirmed := mustInsertPerformTx(t, borm, job.ID, from, 2, bulletprooftxmanager.EthTxUnconfirmed, 2)
+	mustConfirmPerformTx(t, db, borm, confirmed, 42)
+
+	txes, err = orm.PendingPerformTxes(job.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, txes, 1)
+	assert.Equal(t, pending.ID, txes[0].ID)
+	assert.Equal(t, int64(0), txes[0].UpkeepID)
+	assert.False(t, txes[0].CreatedAt.IsZero())
+
+	confirmedAfter := int64(42)
+	txes, err = orm.PendingPerformTxes(job.ID, &confirmedAfter)
+	require.NoError(t, err)
+	require.Len(t, txes, 1)
+
+	confirmedAfter = 41
+	txes, err = orm.PendingPerformTxes(job.ID, &confirmedAfter)
+	require.NoError(t, err)
+	require.Len(t, txes, 2)
+	assert.Equal(t, pending.ID, txes[0].ID)
+	assert.Equal(t, confirmed.ID, txes[1].ID)
+	assert.Equal(t, int64(2), txes[1].UpkeepID)
+
+	txes, err = orm.PendingPerformTxes(job.ID+1, &confirmedAfter)
+	require.NoError(t, err)
+	require.Len(t, txes, 0)
+}
+
 func TestKeeperDB_EligibleUpkeeps_BlockCountPerTurn(t *testing.T) {
 	t.Parallel()
 	db, config, orm := setupKeeperDB(t)
@@ -166,13 +590,14 @@ func TestKeeperDB_EligibleUpkeeps_BlockCountPerTurn(t *testing.T) {
 
 	cltest.AssertCount(t, db, "upkeep_registrations", 5)
 
-	eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, blockheight, gracePeriod)
+	eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, blockheight, gracePeriod, turnBlockHash, nil, keeper.UpkeepOrderID)
 	assert.NoError(t, err)
 
 	require.Len(t, eligibleUpkeeps, 3)
 	assert.Equal(t, int64(0), eligibleUpkeeps[0].UpkeepID)
 	assert.Equal(t, int64(1), eligibleUpkeeps[1].UpkeepID)
 	assert.Equal(t, int64(2), eligibleUpkeeps[2].UpkeepID)
+	assertEligibleCount(t, orm, registry, blockheight, gracePeriod, turnBlockHash, eligibleUpkeeps)
 
 	// preloads registry data
 	assert.Equal(t, registry.ID, eligibleUpkeeps[0].RegistryID)
@@ -201,19 +626,221 @@ func TestKeeperDB_EligibleUpkeeps_GracePeriod(t *testing.T) {
 	upkeep2.LastRunBlockHeight = 19
 	upkeep3 := newUpkeep(registry, 2)
 	upkeep3.LastRunBlockHeight = 20
+	// Per-upkeep grace periods override the default
+	upkeep4 := newUpkeep(registry, 3)
+	upkeep4.LastRunBlockHeight = 100 // eligible with a grace period of 10
+	upkeep5 := newUpkeep(registry, 4)
+	upkeep5.LastRunBlockHeight = 19 // not eligible with a grace period of 101
+	upkeep6 := newUpkeep(registry, 5)
+	upkeep6.LastRunBlockHeight = 20 // override removed, not eligible
+
+	for _, upkeep := range [6]keeper.UpkeepRegistration{upkeep1, upkeep2, upkeep3, upkeep4, upkeep5, upkeep6} {
+		err := orm.UpsertUpkeep(&upkeep)
+		require.NoError(t, err)
+	}
+	require.NoError(t, orm.SetUpkeepGracePeriod(registry.ID, 3, null.IntFrom(10)))
+	require.NoError(t, orm.SetUpkeepGracePeriod(registry.ID, 4, null.IntFrom(101)))
+	require.NoError(t, orm.SetUpkeepGracePeriod(registry.ID, 5, null.IntFrom(0)))
+	require.NoError(t, orm.SetUpkeepGracePeriod(registry.ID, 5, null.Int{}))
+
+	cltest.AssertCount(t, db, "upkeep_registrations", 6)
+
+	eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, blockheight, gracePeriod, turnBlockHash, nil, keeper.UpkeepOrderID)
+	assert.NoError(t, err)
+	require.Len(t, eligibleUpkeeps, 3)
+	assert.Equal(t, int64(0), eligibleUpkeeps[0].UpkeepID)
+	assert.Equal(t, int64(1), eligibleUpkeeps[1].UpkeepID)
+	assert.Equal(t, int64(3), eligibleUpkeeps[2].UpkeepID)
+	assert.Equal(t, null.IntFrom(10), eligibleUpkeeps[2].GracePeriodBlocks)
+	assertEligibleCount(t, orm, registry, blockheight, gracePeriod, turnBlockHash, eligibleUpkeeps)
+}
+
+func TestKeeperDB_EligibleUpkeeps_Paused(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	blockheight := int64(120)
+	gracePeriod := int64(100)
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	upkeep1 := newUpkeep(registry, 0)
+	upkeep1.LastRunBlockHeight = 0
+	upkeep2 := newUpkeep(registry, 1)
+	upkeep2.LastRunBlockHeight = 19
+	upkeep3 := newUpkeep(registry, 2)
+	upkeep3.LastRunBlockHeight = 0
 
 	for _, upkeep := range [3]keeper.UpkeepRegistration{upkeep1, upkeep2, upkeep3} {
 		err := orm.UpsertUpkeep(&upkeep)
 		require.NoError(t, err)
 	}
+	require.NoError(t, orm.SetUpkeepPaused(registry.ID, 1, true))
 
 	cltest.AssertCount(t, db, "upkeep_registrations", 3)
 
-	eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, blockheight, gracePeriod)
+	eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, blockheight, gracePeriod, turnBlockHash, nil, keeper.UpkeepOrderID)
 	assert.NoError(t, err)
 	assert.Len(t, eligibleUpkeeps, 2)
 	assert.Equal(t, int64(0), eligibleUpkeeps[0].UpkeepID)
-	assert.Equal(t, int64(1), eligibleUpkeeps[1].UpkeepID)
+	assert.Equal(t, int64(2), eligibleUpkeeps[1].UpkeepID)
+	assertEligibleCount(t, orm, registry, blockheight, gracePeriod, turnBlockHash, eligibleUpkeeps)
+
+	require.NoError(t, orm.SetUpkeepPaused(registry.ID, 1, false))
+
+	eligibleUpkeeps, err = orm.EligibleUpkeepsForRegistry(registry.ContractAddress, blockheight, gracePeriod, turnBlockHash, nil, keeper.UpkeepOrderID)
+	assert.NoError(t, err)
+	assert.Len(t, eligibleUpkeeps, 3)
+	assertEligibleCount(t, orm, registry, blockheight, gracePeriod, turnBlockHash, eligibleUpkeeps)
+}
+
+func TestKeeperDB_StaleUpkeeps(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	otherRegistry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+
+	// never run
+	upkeep0 := newUpkeep(registry, 0)
+	// stale
+	upkeep1 := newUpkeep(registry, 1)
+	upkeep1.LastRunBlockHeight = 10
+	// exactly olderThanBlocks behind, so not stale yet
+	upkeep2 := newUpkeep(registry, 2)
+	upkeep2.LastRunBlockHeight = 50
+	// recently run
+	upkeep3 := newUpkeep(registry, 3)
+	upkeep3.LastRunBlockHeight = 90
+	// stale, but paused
+	upkeep4 := newUpkeep(registry, 4)
+	upkeep4.LastRunBlockHeight = 10
+	// stale, but on another registry
+	upkeep5 := newUpkeep(otherRegistry, 0)
+
+	for _, upkeep := range []keeper.UpkeepRegistration{upkeep0, upkeep1, upkeep2, upkeep3, upkeep4, upkeep5} {
+		require.NoError(t, orm.UpsertUpkeep(&upkeep))
+	}
+	require.NoError(t, orm.SetUpkeepPaused(registry.ID, 4, true))
+
+	stale, err := orm.StaleUpkeeps(registry.ID, 50, 100)
+	require.NoError(t, err)
+	require.Len(t, stale, 2)
+	assert.Equal(t, int64(0), stale[0].UpkeepID)
+	assert.Equal(t, int64(1), stale[1].UpkeepID)
+	for _, upkeep := range stale {
+		assert.Equal(t, registry.ID, upkeep.Registry.ID)
+		assert.Equal(t, registry.ContractAddress, upkeep.Registry.ContractAddress)
+	}
+
+	stale, err = orm.StaleUpkeeps(registry.ID, 50, 101)
+	require.NoError(t, err)
+	require.Len(t, stale, 3)
+	assert.Equal(t, int64(2), stale[2].UpkeepID)
+}
+
+func TestKeeperDB_UpkeepRuns(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+	borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+
+	registry, job := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	otherRegistry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	from := registry.FromAddress.Address()
+
+	insertRun := func(registryID, upkeepID, blockHeight int64, etx bulletprooftxmanager.EthTx) keeper.UpkeepRun {
+		run := keeper.UpkeepRun{
+			RegistryID:  registryID,
+			UpkeepID:    upkeepID,
+			BlockHeight: blockHeight,
+			EthTxID:     &etx.ID,
+		}
+		require.NoError(t, orm.InsertUpkeepRun(&run))
+		return run
+	}
+
+	succeeded := mustInsertPerformTx(t, borm, job.ID, from, 0, bulletprooftxmanager.EthTxUnconfirmed, 0)
+	reverted := mustInsertPerformTx(t, borm, job.ID, from, 0, bulletprooftxmanager.EthTxUnconfirmed, 1)
+	failed := mustInsertPerformTx(t, borm, job.ID, from, 0, bulletprooftxmanager.EthTxFatalError, 0)
+	pending := mustInsertPerformTx(t, borm, job.ID, from, 0, bulletprooftxmanager.EthTxUnconfirmed, 2)
+	other := mustInsertPerformTx(t, borm, job.ID, from, 0, bulletprooftxmanager.EthTxUnconfirmed, 3)
+
+	run0 := insertRun(registry.ID, 0, 10, succeeded)
+	run1 := insertRun(registry.ID, 0, 11, reverted)
+	run2 := insertRun(registry.ID, 0, 12, failed)
+	run3 := insertRun(registry.ID, 0, 13, pending)
+	insertRun(registry.ID, 1, 13, other)
+	insertRun(otherRegistry.ID, 0, 13, other)
+	assert.Equal(t, keeper.UpkeepRunStatusPending, run0.Status)
+	assert.False(t, run0.CreatedAt.IsZero())
+
+	mustConfirmPerformTx(t, db, borm, succeeded, 20)
+	mustConfirmPerformTxWithStatus(t, db, borm, reverted, 21, 0)
+	mustConfirmPerformTx(t, db, borm, other, 22)
+
+	resolved, err := orm.ResolveUpkeepRuns(registry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), resolved)
+
+	runs, err := orm.UpkeepRunsForUpkeep(registry.ID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, runs, 4)
+	assert.Equal(t, run3.ID, runs[0].ID)
+	assert.Equal(t, keeper.UpkeepRunStatusPending, runs[0].Status)
+	assert.Equal(t, run2.ID, runs[1].ID)
+	assert.Equal(t, keeper.UpkeepRunStatusFailed, runs[1].Status)
+	assert.Equal(t, run1.ID, runs[2].ID)
+	assert.Equal(t, keeper.UpkeepRunStatusReverted, runs[2].Status)
+	assert.Equal(t, run0.ID, runs[3].ID)
+	assert.Equal(t, keeper.UpkeepRunStatusSuccess, runs[3].Status)
+	assert.Equal(t, int64(10), runs[3].BlockHeight)
+	require.NotNil(t, runs[3].EthTxID)
+	assert.Equal(t, succeeded.ID, *runs[3].EthTxID)
+
+	// runs on the other registry are left alone
+	runs, err = orm.UpkeepRunsForUpkeep(otherRegistry.ID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, keeper.UpkeepRunStatusPending, runs[0].Status)
+
+	t.Run("stats", func(t *testing.T) {
+		stats, err := orm.UpkeepRunStats(registry.ID, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), stats.Pending)
+		assert.Equal(t, int64(1), stats.Success)
+		assert.Equal(t, int64(1), stats.Reverted)
+		assert.Equal(t, int64(1), stats.Failed)
+		require.NotNil(t, stats.LastRunAt)
+
+		stats, err = orm.UpkeepRunStats(registry.ID, 2)
+		require.NoError(t, err)
+		assert.Equal(t, keeper.UpkeepRunStats{}, stats)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		runs, err := orm.UpkeepRunsForUpkeep(registry.ID, 0, 2)
+		require.NoError(t, err)
+		require.Len(t, runs, 2)
+		assert.Equal(t, run3.ID, runs[0].ID)
+		assert.Equal(t, run2.ID, runs[1].ID)
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		_, err := db.Exec(`UPDATE keeper_upkeep_runs SET created_at = NOW() - interval '2 hours' WHERE id IN ($1, $2)`, run0.ID, run1.ID)
+		require.NoError(t, err)
+
+		pruned, err := orm.PruneUpkeepRuns(registry.ID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), pruned)
+
+		runs, err := orm.UpkeepRunsForUpkeep(registry.ID, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, runs, 2)
+		assert.Equal(t, run3.ID, runs[0].ID)
+		assert.Equal(t, run2.ID, runs[1].ID)
+	})
 }
 
 func TestKeeperDB_EligibleUpkeeps_KeepersRotate(t *testing.T) {
@@ -231,21 +858,77 @@ func TestKeeperDB_EligibleUpkeeps_KeepersRotate(t *testing.T) {
 
 	// out of 5 valid block ranges, with 5 keepers, we are eligible
 	// to submit on exactly 1 of them
-	list1, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 20, 0)
+	list1, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
 	require.NoError(t, err)
-	list2, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 41, 0)
+	list2, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 41, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
 	require.NoError(t, err)
-	list3, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 62, 0)
+	list3, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 62, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
 	require.NoError(t, err)
-	list4, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 83, 0)
+	list4, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 83, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
 	require.NoError(t, err)
-	list5, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 104, 0)
+	list5, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 104, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
 	require.NoError(t, err)
+	assertEligibleCount(t, orm, registry, 20, 0, turnBlockHash, list1)
+	assertEligibleCount(t, orm, registry, 41, 0, turnBlockHash, list2)
+	assertEligibleCount(t, orm, registry, 62, 0, turnBlockHash, list3)
+	assertEligibleCount(t, orm, registry, 83, 0, turnBlockHash, list4)
+	assertEligibleCount(t, orm, registry, 104, 0, turnBlockHash, list5)
 
 	totalEligible := len(list1) + len(list2) + len(list3) + len(list4) + len(list5)
 	require.Equal(t, 1, totalEligible)
 }
 
+func TestKeeperDB_UpkeepEligibility(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	require.NoError(t, db.Get(&registry, `UPDATE keeper_registries SET num_keepers = 5 WHERE id = $1 RETURNING *`, registry.ID))
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+
+	t.Run("agrees with EligibleUpkeepsForRegistry", func(t *testing.T) {
+		for _, blockNumber := range []int64{20, 41, 62, 83, 104} {
+			eligible, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, blockNumber, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
+			require.NoError(t, err)
+
+			e, err := orm.UpkeepEligibility(registry, upkeep, blockNumber, 0, turnBlockHash)
+			require.NoError(t, err)
+			assert.Equal(t, len(eligible) == 1, e.Eligible, "block %d", blockNumber)
+			if e.Eligible {
+				assert.Empty(t, e.Reason)
+			} else {
+				assert.Equal(t, fmt.Sprintf("it is the turn of keeper %d", e.TurnKeeperIndex), e.Reason)
+			}
+		}
+	})
+
+	t.Run("paused", func(t *testing.T) {
+		paused := upkeep
+		paused.Paused = true
+		e, err := orm.UpkeepEligibility(registry, paused, 20, 0, turnBlockHash)
+		require.NoError(t, err)
+		assert.False(t, e.Eligible)
+		assert.Equal(t, "the upkeep is paused", e.Reason)
+	})
+
+	t.Run("within the grace period", func(t *testing.T) {
+		performed := upkeep
+		performed.LastRunBlockHeight = 15
+		e, err := orm.UpkeepEligibility(registry, performed, 20, 10, turnBlockHash)
+		require.NoError(t, err)
+		assert.False(t, e.Eligible)
+		assert.True(t, e.PerformedRecently)
+		assert.Equal(t, int64(10), e.GracePeriodBlocks)
+
+		performed.GracePeriodBlocks = null.IntFrom(2)
+		e, err = orm.UpkeepEligibility(registry, performed, 20, 10, turnBlockHash)
+		require.NoError(t, err)
+		assert.False(t, e.PerformedRecently)
+		assert.Equal(t, int64(2), e.GracePeriodBlocks)
+	})
+}
+
 func TestKeeperDB_EligibleUpkeeps_KeepersCycleAllUpkeeps(t *testing.T) {
 	t.Parallel()
 	db, config, orm := setupKeeperDB(t)
@@ -262,21 +945,125 @@ func TestKeeperDB_EligibleUpkeeps_KeepersCycleAllUpkeeps(t *testing.T) {
 	cltest.AssertCount(t, db, "upkeep_registrations", 1000)
 
 	// in a full cycle, each node should be responsible for each upkeep exactly once
-	list1, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 20, 0) // someone eligible
+	list1, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID) // someone eligible
 	require.NoError(t, err)
-	list2, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 40, 0) // someone eligible
+	list2, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 40, 0, turnBlockHash, nil, keeper.UpkeepOrderID) // someone eligible
 	require.NoError(t, err)
-	list3, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 60, 0) // someone eligible
+	list3, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 60, 0, turnBlockHash, nil, keeper.UpkeepOrderID) // someone eligible
 	require.NoError(t, err)
-	list4, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 80, 0) // someone eligible
+	list4, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 80, 0, turnBlockHash, nil, keeper.UpkeepOrderID) // someone eligible
 	require.NoError(t, err)
-	list5, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 100, 0) // someone eligible
+	list5, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 100, 0, turnBlockHash, nil, keeper.UpkeepOrderID) // someone eligible
 	require.NoError(t, err)
+	assertEligibleCount(t, orm, registry, 20, 0, turnBlockHash, list1)
+	assertEligibleCount(t, orm, registry, 40, 0, turnBlockHash, list2)
+	assertEligibleCount(t, orm, registry, 60, 0, turnBlockHash, list3)
+	assertEligibleCount(t, orm, registry, 80, 0, turnBlockHash, list4)
+	assertEligibleCount(t, orm, registry, 100, 0, turnBlockHash, list5)
 
 	totalEligible := len(list1) + len(list2) + len(list3) + len(list4) + len(list5)
 	require.Equal(t, 1000, totalEligible)
 }
 
+func TestKeeperDB_EligibleUpkeeps_KeepersSplitTurn(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	require.NoError(t, db.Get(&registry, `UPDATE keeper_registries SET num_keepers = 5 WHERE id = $1 RETURNING *`, registry.ID))
+
+	for i := 0; i < 100; i++ {
+		cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+	}
+
+	eligibleForKeeper := func(keeperIndex int, hash common.Hash) map[int64]struct{} {
+		_, err := db.Exec(`UPDATE keeper_registries SET keeper_index = $1 WHERE id = $2`, keeperIndex, registry.ID)
+		require.NoError(t, err)
+		upkeeps, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 20, 0, hash, nil, keeper.UpkeepOrderID)
+		require.NoError(t, err)
+		assertEligibleCount(t, orm, registry, 20, 0, hash, upkeeps)
+		ids := make(map[int64]struct{})
+		for _, upkeep := range upkeeps {
+			ids[upkeep.UpkeepID] = struct{}{}
+		}
+		return ids
+	}
+
+	// within a turn, the keepers split the upkeeps between them
+	seen := make(map[int64]struct{})
+	for keeperIndex := 0; keeperIndex < 5; keeperIndex++ {
+		ids := eligibleForKeeper(keeperIndex, turnBlockHash)
+		assert.NotEmpty(t, ids)
+		for id := range ids {
+			assert.NotContains(t, seen, id)
+			seen[id] = struct{}{}
+		}
+	}
+	assert.Len(t, seen, 100)
+
+	// a different block hash shuffles the upkeeps differently
+	assert.NotEqual(t, eligibleForKeeper(0, turnBlockHash), eligibleForKeeper(0, utils.NewHash()))
+}
+
+func TestKeeperDB_EligibleUpkeeps_AgreeAcrossUpkeepSets(t *testing.T) {
+	t.Parallel()
+
+	// two keepers of the same registry that have synced different upkeeps
+	registryAddress := cltest.NewEIP55Address()
+	setupRegistry := func(upkeepIDs []int64) (*sqlx.DB, keeper.ORM, keeper.Registry) {
+		db, config, orm := setupKeeperDB(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+		registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+		require.NoError(t, db.Get(&registry, `UPDATE keeper_registries SET num_keepers = 5, contract_address = $1 WHERE id = $2 RETURNING *`, registryAddress, registry.ID))
+		for _, upkeepID := range upkeepIDs {
+			upkeep := newUpkeep(registry, upkeepID)
+			require.NoError(t, orm.UpsertUpkeep(&upkeep))
+		}
+		return db, orm, registry
+	}
+	var idsA, idsB []int64
+	for i := int64(0); i < 30; i++ {
+		if i < 20 {
+			idsA = append(idsA, i)
+		}
+		if i >= 10 {
+			idsB = append(idsB, i)
+		}
+	}
+	dbA, ormA, registryA := setupRegistry(idsA)
+	dbB, ormB, registryB := setupRegistry(idsB)
+
+	eligibleForKeeper := func(db *sqlx.DB, orm keeper.ORM, registry keeper.Registry, keeperIndex int) map[int64]struct{} {
+		_, err := db.Exec(`UPDATE keeper_registries SET keeper_index = $1 WHERE id = $2`, keeperIndex, registry.ID)
+		require.NoError(t, err)
+		upkeeps, err := orm.EligibleUpkeepsForRegistry(registryAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
+		require.NoError(t, err)
+		ids := make(map[int64]struct{})
+		for _, upkeep := range upkeeps {
+			if upkeep.UpkeepID >= 10 && upkeep.UpkeepID < 20 {
+				ids[upkeep.UpkeepID] = struct{}{}
+			}
+		}
+		return ids
+	}
+
+	for keeperIndex := 0; keeperIndex < 5; keeperIndex++ {
+		assert.Equal(t,
+			eligibleForKeeper(dbA, ormA, registryA, keeperIndex),
+			eligibleForKeeper(dbB, ormB, registryB, keeperIndex),
+			"keeper %d", keeperIndex)
+	}
+
+	for upkeepID := int64(10); upkeepID < 20; upkeepID++ {
+		eA, err := ormA.UpkeepEligibility(registryA, newUpkeep(registryA, upkeepID), 20, 0, turnBlockHash)
+		require.NoError(t, err)
+		eB, err := ormB.UpkeepEligibility(registryB, newUpkeep(registryB, upkeepID), 20, 0, turnBlockHash)
+		require.NoError(t, err)
+		assert.Equal(t, eA.TurnKeeperIndex, eB.TurnKeeperIndex, "upkeep %d", upkeepID)
+	}
+}
+
 func TestKeeperDB_EligibleUpkeeps_FiltersByRegistry(t *testing.T) {
 	t.Parallel()
 	db, config, orm := setupKeeperDB(t)
@@ -291,15 +1078,162 @@ func TestKeeperDB_EligibleUpkeeps_FiltersByRegistry(t *testing.T) {
 	cltest.AssertCount(t, db, "keeper_registries", 2)
 	cltest.AssertCount(t, db, "upkeep_registrations", 2)
 
-	list1, err := orm.EligibleUpkeepsForRegistry(registry1.ContractAddress, 20, 0)
+	list1, err := orm.EligibleUpkeepsForRegistry(registry1.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
 	require.NoError(t, err)
-	list2, err := orm.EligibleUpkeepsForRegistry(registry2.ContractAddress, 20, 0)
+	list2, err := orm.EligibleUpkeepsForRegistry(registry2.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
 	require.NoError(t, err)
 
 	assert.Equal(t, 1, len(list1))
 	assert.Equal(t, 1, len(list2))
 }
 
+func TestKeeperDB_EligibleUpkeepsForRegistryPaged(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	for i := 0; i < 5; i++ {
+		cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+	}
+
+	all, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
+	require.NoError(t, err)
+	require.Len(t, all, 5)
+
+	page1, total, err := orm.EligibleUpkeepsForRegistryPaged(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID, 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 5, total)
+	require.Len(t, page1, 2)
+	assert.Equal(t, registry.ID, page1[0].Registry.ID)
+
+	page2, total, err := orm.EligibleUpkeepsForRegistryPaged(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 5, total)
+	require.Len(t, page2, 2)
+
+	page3, total, err := orm.EligibleUpkeepsForRegistryPaged(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID, 2, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 5, total)
+	require.Len(t, page3, 1)
+
+	paged := append(append(page1, page2...), page3...)
+	for i := range all {
+		assert.Equal(t, all[i].ID, paged[i].ID)
+	}
+
+	// turn filtering still applies when paging
+	totals := 0
+	for keeperIndex := 0; keeperIndex < 2; keeperIndex++ {
+		_, err = db.Exec(`UPDATE keeper_registries SET num_keepers = 2, keeper_index = $1 WHERE id = $2`, keeperIndex, registry.ID)
+		require.NoError(t, err)
+		upkeeps, total, err := orm.EligibleUpkeepsForRegistryPaged(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID, 10, 0)
+		require.NoError(t, err)
+		assert.Len(t, upkeeps, total)
+		totals += total
+	}
+	assert.Equal(t, 5, totals)
+}
+
+func TestKeeperDB_EligibleUpkeeps_OrderByExecuteGas(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+	executeGases := []uint64{50_000, 10_000, 30_000, 10_000, 20_000, 40_000}
+	for _, gas := range executeGases {
+		upkeep := cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+		pgtest.MustExec(t, db, `UPDATE upkeep_registrations SET execute_gas = $1 WHERE id = $2`, gas, upkeep.ID)
+	}
+
+	// the order never changes which upkeeps are eligible, including when
+	// upkeeps are split between keepers
+	for _, numKeepers := range []int{1, 2} {
+		pgtest.MustExec(t, db, `UPDATE keeper_registries SET num_keepers = $1 WHERE id = $2`, numKeepers, registry.ID)
+
+		byID, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
+		require.NoError(t, err)
+		byGas, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderExecuteGas)
+		require.NoError(t, err)
+		require.NotEmpty(t, byGas)
+
+		var idsByID, idsByGas []int64
+		for i := range byID {
+			idsByID = append(idsByID, byID[i].ID)
+			idsByGas = append(idsByGas, byGas[i].ID)
+		}
+		assert.ElementsMatch(t, idsByID, idsByGas)
+
+		for i := 1; i < len(byGas); i++ {
+			prev, cur := byGas[i-1], byGas[i]
+			assert.LessOrEqual(t, prev.ExecuteGas, cur.ExecuteGas)
+			if prev.ExecuteGas == cur.ExecuteGas {
+				assert.Less(t, prev.ID, cur.ID, "ties are broken by ID")
+			}
+		}
+		assert.Equal(t, registry.ID, byGas[0].Registry.ID)
+	}
+
+	// paging follows the same order
+	pgtest.MustExec(t, db, `UPDATE keeper_registries SET num_keepers = 1 WHERE id = $1`, registry.ID)
+	page, total, err := orm.EligibleUpkeepsForRegistryPaged(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderExecuteGas, 3, 0)
+	require.NoError(t, err)
+	assert.Equal(t, len(executeGases), total)
+	require.Len(t, page, 3)
+	assert.Equal(t, uint64(10_000), page[0].ExecuteGas)
+	assert.Equal(t, uint64(10_000), page[1].ExecuteGas)
+	assert.Equal(t, uint64(20_000), page[2].ExecuteGas)
+
+	_, err = orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrder("random"))
+	require.Error(t, err)
+}
+
+func TestKeeperDB_EligibleUpkeeps_MinimumBalance(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore)
+
+	// executeGas * minBalancePerGas
+	minBalancePerGas := big.NewInt(100)
+	minBalance := new(big.Int).Mul(big.NewInt(int64(executeGas)), minBalancePerGas)
+
+	upkeeps := [4]keeper.UpkeepRegistration{
+		newUpkeep(registry, 0),
+		newUpkeep(registry, 1),
+		newUpkeep(registry, 2),
+		newUpkeep(registry, 3),
+	}
+	upkeeps[0].Balance = utils.NewBig(new(big.Int).Add(minBalance, big.NewInt(1))) // Above the minimum
+	upkeeps[1].Balance = utils.NewBig(minBalance)                                  // Exactly the minimum
+	upkeeps[2].Balance = utils.NewBig(new(big.Int).Sub(minBalance, big.NewInt(1))) // Below the minimum (EXCLUDE)
+	upkeeps[3].Balance = nil                                                       // Not synced yet
+
+	for _, upkeep := range upkeeps {
+		err := orm.UpsertUpkeep(&upkeep)
+		require.NoError(t, err)
+	}
+
+	t.Run("returns every upkeep without a minimum", func(t *testing.T) {
+		eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 20, 0, turnBlockHash, nil, keeper.UpkeepOrderID)
+		require.NoError(t, err)
+		assert.Len(t, eligibleUpkeeps, 4)
+	})
+
+	t.Run("leaves out upkeeps with a balance below the minimum", func(t *testing.T) {
+		eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(registry.ContractAddress, 20, 0, turnBlockHash, minBalancePerGas, keeper.UpkeepOrderID)
+		require.NoError(t, err)
+		require.Len(t, eligibleUpkeeps, 3)
+		assert.Equal(t, int64(0), eligibleUpkeeps[0].UpkeepID)
+		assert.Equal(t, int64(1), eligibleUpkeeps[1].UpkeepID)
+		assert.Equal(t, int64(3), eligibleUpkeeps[2].UpkeepID)
+		assert.Equal(t, minBalance.String(), eligibleUpkeeps[1].Balance.String())
+		assert.Equal(t, registry.ContractAddress, eligibleUpkeeps[2].Registry.ContractAddress)
+	})
+}
+
 func TestKeeperDB_NextUpkeepID(t *testing.T) {
 	t.Parallel()
 	db, config, orm := setupKeeperDB(t)
@@ -37,9 +37,13 @@ type RegistrySynchronizerOptions struct {
 	MinIncomingConfirmations uint32
 	Logger                   logger.Logger
 	SyncUpkeepQueueSize      uint32
+	// UpkeepRunsRetention is how long upkeep runs are kept for, zero means
+	// forever
+	UpkeepRunsRetention time.Duration
 }
 
 type RegistrySynchronizer struct {
+	canceledUpkeeps          *canceledUpkeepSet
 	chStop                   chan struct{}
 	contract                 *keeper_registry_wrapper.KeeperRegistry
 	interval                 time.Duration
@@ -52,6 +56,7 @@ type RegistrySynchronizer struct {
 	logger                   logger.Logger
 	wgDone                   sync.WaitGroup
 	syncUpkeepQueueSize      uint32 //Represents the max number of upkeeps that can be synced in parallel
+	upkeepRunsRetention      time.Duration
 	utils.StartStopOnce
 }
 
@@ -64,6 +69,7 @@ func NewRegistrySynchronizer(opts RegistrySynchronizerOptions) *RegistrySynchron
 		mbUpkeepRegistered: utils.NewMailbox(50),
 	}
 	return &RegistrySynchronizer{
+		canceledUpkeeps:          newCanceledUpkeepSet(),
 		chStop:                   make(chan struct{}),
 		contract:                 opts.Contract,
 		interval:                 opts.SyncInterval,
@@ -75,6 +81,7 @@ func NewRegistrySynchronizer(opts RegistrySynchronizerOptions) *RegistrySynchron
 		orm:                      opts.ORM,
 		logger:                   opts.Logger.Named("RegistrySynchronizer"),
 		syncUpkeepQueueSize:      opts.SyncUpkeepQueueSize,
+		upkeepRunsRetention:      opts.UpkeepRunsRetention,
 	}
 }
 
@@ -129,6 +136,9 @@ func (rs *RegistrySynchronizer) run() {
 
 	rs.fullSync()
 
+	// logs are processed as soon as they are delivered, the log ticker only
+	// retries logs that could not be processed, and the full sync reconciles
+	// anything the logs missed
 	for {
 		select {
 		case <-rs.chStop:
@@ -137,6 +147,14 @@ func (rs *RegistrySynchronizer) run() {
 			rs.fullSync()
 		case <-logTicker.C:
 			rs.processLogs()
+		case <-rs.mailRoom.mbSyncRegistry.Notify():
+			rs.processLogs()
+		case <-rs.mailRoom.mbUpkeepCanceled.Notify():
+			rs.processLogs()
+		case <-rs.mailRoom.mbUpkeepRegistered.Notify():
+			rs.processLogs()
+		case <-rs.mailRoom.mbUpkeepPerformed.Notify():
+			rs.processLogs()
 		}
 	}
 }
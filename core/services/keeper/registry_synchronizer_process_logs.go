@@ -9,10 +9,12 @@ import (
 )
 
 func (rs *RegistrySynchronizer) processLogs() {
+	// canceled upkeeps are handled first, so that an upkeep registered and
+	// canceled in the same batch of logs is not re-added
+	rs.handleUpkeepCanceledLogs(func() {})
 	wg := sync.WaitGroup{}
-	wg.Add(4)
+	wg.Add(3)
 	go rs.handleSyncRegistryLog(wg.Done)
-	go rs.handleUpkeepCanceledLogs(wg.Done)
 	go rs.handleUpkeepRegisteredLogs(wg.Done)
 	go rs.handleUpkeepPerformedLogs(wg.Done)
 	wg.Wait()
@@ -81,7 +83,9 @@ func (rs *RegistrySynchronizer) handleUpkeepCancelled(broadcast log.Broadcast) {
 		rs.logger.Errorf("invariant violation, expected UpkeepCanceled log but got %T", broadcastedLog)
 		return
 	}
-	affected, err := rs.orm.BatchDeleteUpkeepsForJob(rs.job.ID, []int64{broadcastedLog.Id.Int64()})
+	upkeepID := broadcastedLog.Id.Int64()
+	rs.canceledUpkeeps.add(upkeepID)
+	affected, err := rs.orm.BatchDeleteUpkeepsForJob(rs.job.ID, []int64{upkeepID})
 	if err != nil {
 		rs.logger.With("error", err).Error("unable to batch delete upkeeps")
 		return
@@ -130,7 +134,7 @@ func (rs *RegistrySynchronizer) HandleUpkeepRegistered(broadcast log.Broadcast,
 		rs.logger.Errorf("invariant violation, expected UpkeepRegistered log but got %T", broadcastedLog)
 		return
 	}
-	err = rs.syncUpkeep(registry, broadcastedLog.Id.Int64())
+	err = rs.syncRegisteredUpkeep(registry, broadcastedLog.Id.Int64())
 	if err != nil {
 		rs.logger.With("error", err).Error("failed to sync upkeep, log: %v", broadcast.String())
 		return
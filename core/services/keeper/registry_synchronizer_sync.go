@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -20,14 +21,23 @@ func (rs *RegistrySynchronizer) fullSync() {
 		rs.logger.With("error", err).Error("failed to sync registry during fullSyncing registry")
 		return
 	}
-	if err := rs.addNewUpkeeps(registry); err != nil {
+	canceled, err := rs.fetchCanceledUpkeeps()
+	if err != nil {
+		rs.logger.With("error", err).Error("failed to get canceled upkeeps during fullSyncing registry")
+		return
+	}
+	if err := rs.addNewUpkeeps(registry, canceled); err != nil {
 		rs.logger.With("error", err).Error("failed to add new upkeeps during fullSyncing registry")
 		return
 	}
-	if err := rs.deleteCanceledUpkeeps(); err != nil {
+	if err := rs.deleteCanceledUpkeeps(canceled); err != nil {
 		rs.logger.With("error", err).Error("failed to delete canceled upkeeps during fullSyncing registry")
 		return
 	}
+	if err := rs.pruneUpkeepRuns(registry); err != nil {
+		rs.logger.With("error", err).Error("failed to prune upkeep runs during fullSyncing registry")
+		return
+	}
 }
 
 func (rs *RegistrySynchronizer) syncRegistry() (Registry, error) {
@@ -43,7 +53,7 @@ func (rs *RegistrySynchronizer) syncRegistry() (Registry, error) {
 	return registry, nil
 }
 
-func (rs *RegistrySynchronizer) addNewUpkeeps(reg Registry) error {
+func (rs *RegistrySynchronizer) addNewUpkeeps(reg Registry, canceled map[int64]struct{}) error {
 	nextUpkeepID, err := rs.orm.LowestUnsyncedID(reg.ID)
 	if err != nil {
 		return errors.Wrap(err, "unable to find next ID for registry")
@@ -59,72 +69,114 @@ func (rs *RegistrySynchronizer) addNewUpkeeps(reg Registry) error {
 		return errors.New("invariant, contract should always have at least as many upkeeps as DB")
 	}
 
-	rs.batchSyncUpkeepsOnRegistry(reg, nextUpkeepID, countOnContract)
-	return nil
+	return rs.batchSyncUpkeepsOnRegistry(reg, nextUpkeepID, countOnContract, canceled)
 }
 
-// batchSyncUpkeepsOnRegistry syncs <syncUpkeepQueueSize> upkeeps at a time in parallel
-// starting at upkeep ID <start> and up to (but not including) <end>
-func (rs *RegistrySynchronizer) batchSyncUpkeepsOnRegistry(reg Registry, start, end int64) {
+// batchSyncUpkeepsOnRegistry fetches <syncUpkeepQueueSize> upkeeps at a time
+// in parallel starting at upkeep ID <start> and up to (but not including)
+// <end>, and then saves all of them at once. Upkeeps that fail to be fetched
+// are logged and skipped, as are canceled upkeeps.
+func (rs *RegistrySynchronizer) batchSyncUpkeepsOnRegistry(reg Registry, start, end int64, canceled map[int64]struct{}) error {
 	wg := sync.WaitGroup{}
-	wg.Add(int(end - start))
 	chSyncUpkeepQueue := make(chan struct{}, rs.syncUpkeepQueueSize)
 
+	var mu sync.Mutex
+	upkeeps := make([]UpkeepRegistration, 0, end-start)
+
 	done := func() { <-chSyncUpkeepQueue; wg.Done() }
 	for upkeepID := start; upkeepID < end; upkeepID++ {
+		if _, isCanceled := canceled[upkeepID]; isCanceled {
+			continue
+		}
 		select {
 		case <-rs.chStop:
-			return
+			wg.Wait()
+			return nil
 		case chSyncUpkeepQueue <- struct{}{}:
-			go rs.syncUpkeepWithCallback(reg, upkeepID, done)
+			wg.Add(1)
+			go func(upkeepID int64) {
+				defer done()
+				upkeep, err := rs.fetchUpkeep(reg, upkeepID)
+				if err != nil {
+					rs.logger.With("error", err).With(
+						"upkeepID", upkeepID,
+						"registryContract", reg.ContractAddress.Hex(),
+					).Error("unable to sync upkeep on registry")
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				upkeeps = append(upkeeps, upkeep)
+			}(upkeepID)
 		}
 	}
 
 	wg.Wait()
+	return errors.Wrap(rs.orm.UpsertUpkeeps(upkeeps), "failed to upsert upkeeps")
 }
 
-func (rs *RegistrySynchronizer) syncUpkeepWithCallback(registry Registry, upkeepID int64, doneCallback func()) {
-	defer doneCallback()
-
-	if err := rs.syncUpkeep(registry, upkeepID); err != nil {
-		rs.logger.With("error", err).With(
-			"upkeepID", upkeepID,
-			"registryContract", registry.ContractAddress.Hex(),
-		).Error("unable to sync upkeep on registry")
+// syncRegisteredUpkeep syncs an upkeep from an UpkeepRegistered log, unless
+// it has since been canceled
+func (rs *RegistrySynchronizer) syncRegisteredUpkeep(registry Registry, upkeepID int64) error {
+	if rs.canceledUpkeeps.contains(upkeepID) {
+		return nil
 	}
+	return rs.syncUpkeep(registry, upkeepID)
 }
 
+// syncUpkeep fetches a single upkeep from the registry and saves it
 func (rs *RegistrySynchronizer) syncUpkeep(registry Registry, upkeepID int64) error {
+	newUpkeep, err := rs.fetchUpkeep(registry, upkeepID)
+	if err != nil {
+		return err
+	}
+	if err := rs.orm.UpsertUpkeep(&newUpkeep); err != nil {
+		return errors.Wrap(err, "failed to upsert upkeep")
+	}
+
+	return nil
+}
+
+func (rs *RegistrySynchronizer) fetchUpkeep(registry Registry, upkeepID int64) (UpkeepRegistration, error) {
 	upkeepConfig, err := rs.contract.GetUpkeep(nil, big.NewInt(upkeepID))
 	if err != nil {
-		return errors.Wrap(err, "failed to get upkeep config")
+		return UpkeepRegistration{}, errors.Wrap(err, "failed to get upkeep config")
 	}
 	positioningConstant, err := CalcPositioningConstant(upkeepID, registry.ContractAddress)
 	if err != nil {
-		return errors.Wrap(err, "failed to calc positioning constant")
+		return UpkeepRegistration{}, errors.Wrap(err, "failed to calc positioning constant")
 	}
-	newUpkeep := UpkeepRegistration{
+	return UpkeepRegistration{
 		CheckData:           upkeepConfig.CheckData,
 		ExecuteGas:          uint64(upkeepConfig.ExecuteGas),
 		RegistryID:          registry.ID,
 		PositioningConstant: positioningConstant,
 		UpkeepID:            upkeepID,
-	}
-	if err := rs.orm.UpsertUpkeep(&newUpkeep); err != nil {
-		return errors.Wrap(err, "failed to upsert upkeep")
-	}
-
-	return nil
+		Balance:             utils.NewBig(upkeepConfig.Balance),
+	}, nil
 }
 
-func (rs *RegistrySynchronizer) deleteCanceledUpkeeps() error {
+// fetchCanceledUpkeeps returns the upkeeps canceled on the registry, along
+// with those canceled by UpkeepCanceled logs that the registry we read from
+// doesn't reflect yet, e.g. because the eth node is lagging
+func (rs *RegistrySynchronizer) fetchCanceledUpkeeps() (map[int64]struct{}, error) {
 	canceledBigs, err := rs.contract.GetCanceledUpkeepList(nil)
 	if err != nil {
-		return errors.Wrap(err, "failed to get canceled upkeep list")
+		return nil, errors.Wrap(err, "failed to get canceled upkeep list")
 	}
-	canceled := make([]int64, len(canceledBigs))
+	onChain := make([]int64, len(canceledBigs))
 	for idx, upkeepID := range canceledBigs {
-		canceled[idx] = upkeepID.Int64()
+		onChain[idx] = upkeepID.Int64()
+	}
+	canceled := rs.canceledUpkeeps.union(onChain)
+	rs.canceledUpkeeps.forget(onChain)
+	return canceled, nil
+}
+
+func (rs *RegistrySynchronizer) deleteCanceledUpkeeps(canceledSet map[int64]struct{}) error {
+	canceled := make([]int64, 0, len(canceledSet))
+	for upkeepID := range canceledSet {
+		canceled = append(canceled, upkeepID)
 	}
 	if _, err := rs.orm.BatchDeleteUpkeepsForJob(rs.job.ID, canceled); err != nil {
 		return errors.Wrap(err, "failed to batch delete upkeeps from job")
@@ -133,6 +185,22 @@ func (rs *RegistrySynchronizer) deleteCanceledUpkeeps() error {
 	return nil
 }
 
+// pruneUpkeepRuns deletes the runs on the registry that are older than
+// KEEPER_UPKEEP_RUNS_RETENTION
+func (rs *RegistrySynchronizer) pruneUpkeepRuns(registry Registry) error {
+	if rs.upkeepRunsRetention <= 0 {
+		return nil
+	}
+	pruned, err := rs.orm.PruneUpkeepRuns(registry.ID, time.Now().Add(-rs.upkeepRunsRetention))
+	if err != nil {
+		return err
+	}
+	if pruned > 0 {
+		rs.logger.Debugw("pruned upkeep runs", "count", pruned)
+	}
+	return nil
+}
+
 // newRegistryFromChain returns a Registry stuct with fields synched from those on chain
 func (rs *RegistrySynchronizer) newRegistryFromChain() (Registry, error) {
 	fromAddress := rs.job.KeeperSpec.FromAddress
@@ -167,6 +235,55 @@ func (rs *RegistrySynchronizer) newRegistryFromChain() (Registry, error) {
 	}, nil
 }
 
+// canceledUpkeepSet holds the IDs of upkeeps canceled by UpkeepCanceled logs
+// that have not been seen in the registry's canceled upkeep list yet, so that
+// neither the full sync nor an UpkeepRegistered log re-adds them
+type canceledUpkeepSet struct {
+	mu  sync.Mutex
+	ids map[int64]struct{}
+}
+
+func newCanceledUpkeepSet() *canceledUpkeepSet {
+	return &canceledUpkeepSet{ids: make(map[int64]struct{})}
+}
+
+func (s *canceledUpkeepSet) add(upkeepID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ids[upkeepID] = struct{}{}
+}
+
+func (s *canceledUpkeepSet) contains(upkeepID int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, exists := s.ids[upkeepID]
+	return exists
+}
+
+// union returns a copy of the set with upkeepIDs added
+func (s *canceledUpkeepSet) union(upkeepIDs []int64) map[int64]struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := make(map[int64]struct{}, len(s.ids)+len(upkeepIDs))
+	for upkeepID := range s.ids {
+		ids[upkeepID] = struct{}{}
+	}
+	for _, upkeepID := range upkeepIDs {
+		ids[upkeepID] = struct{}{}
+	}
+	return ids
+}
+
+// forget removes upkeepIDs from the set, once the registry reflects that
+// they are canceled
+func (s *canceledUpkeepSet) forget(upkeepIDs []int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, upkeepID := range upkeepIDs {
+		delete(s.ids, upkeepID)
+	}
+}
+
 // CalcPositioningConstant calculates a positioning constant.
 // The positioning constant is fixed because upkeepID and registryAddress are immutable
 func CalcPositioningConstant(upkeepID int64, registryAddress ethkey.EIP55Address) (int32, error) {
@@ -146,7 +146,7 @@ func Test_RegistrySynchronizer_FullSync(t *testing.T) {
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getCanceledUpkeepList", canceledUpkeeps).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(3)).Once()
-	registryMock.MockResponse("getUpkeep", upkeepConfig).Times(3) // sync all 3, then delete
+	registryMock.MockResponse("getUpkeep", upkeepConfig).Times(2) // canceled upkeeps aren't synced
 
 	synchronizer.ExportedFullSync()
 
@@ -164,6 +164,8 @@ func Test_RegistrySynchronizer_FullSync(t *testing.T) {
 	require.Equal(t, int32(1), registry.NumKeepers)
 	require.Equal(t, upkeepConfig.CheckData, upkeepRegistration.CheckData)
 	require.Equal(t, uint64(upkeepConfig.ExecuteGas), upkeepRegistration.ExecuteGas)
+	require.NotNil(t, upkeepRegistration.Balance)
+	require.Equal(t, upkeepConfig.Balance.String(), upkeepRegistration.Balance.String())
 
 	assertUpkeepIDs(t, db, []int64{0, 2})
 	ethMock.AssertExpectations(t)
@@ -174,7 +176,7 @@ func Test_RegistrySynchronizer_FullSync(t *testing.T) {
 	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
 	registryMock.MockResponse("getCanceledUpkeepList", canceledUpkeeps).Once()
 	registryMock.MockResponse("getUpkeepCount", big.NewInt(5)).Once()
-	registryMock.MockResponse("getUpkeep", upkeepConfig).Times(2) // two new upkeeps to sync
+	registryMock.MockResponse("getUpkeep", upkeepConfig).Once() // two new upkeeps, one already canceled
 
 	synchronizer.ExportedFullSync()
 
@@ -309,6 +311,63 @@ func Test_RegistrySynchronizer_UpkeepCanceledLog(t *testing.T) {
 	logBroadcast.AssertExpectations(t)
 }
 
+func Test_RegistrySynchronizer_UpkeepCanceledLogBetweenSyncs(t *testing.T) {
+	db, synchronizer, ethMock, lb, job := setupRegistrySync(t)
+
+	contractAddress := job.KeeperSpec.ContractAddress.Address()
+	fromAddress := job.KeeperSpec.FromAddress.Address()
+
+	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, contractAddress)
+	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
+	registryMock.MockResponse("getCanceledUpkeepList", []*big.Int{}).Once()
+	registryMock.MockResponse("getUpkeepCount", big.NewInt(3)).Once()
+	registryMock.MockResponse("getUpkeep", upkeepConfig).Times(3)
+
+	require.NoError(t, synchronizer.Start())
+	defer func() { require.NoError(t, synchronizer.Close()) }()
+	cltest.WaitForCount(t, db, "keeper_registries", 1)
+	cltest.WaitForCount(t, db, "upkeep_registrations", 3)
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	head := cltest.MustInsertHead(t, db, cfg, 1)
+	rawLog := types.Log{BlockHash: head.Hash}
+	log := keeper_registry_wrapper.KeeperRegistryUpkeepCanceled{Id: big.NewInt(2)}
+	logBroadcast := new(logmocks.Broadcast)
+	logBroadcast.On("DecodedLog").Return(&log)
+	logBroadcast.On("RawLog").Return(rawLog)
+	logBroadcast.On("String").Maybe().Return("")
+	lb.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil)
+	lb.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+
+	// the log is applied without waiting for the next full sync
+	synchronizer.HandleLog(logBroadcast)
+	cltest.WaitForCount(t, db, "upkeep_registrations", 2)
+	assertUpkeepIDs(t, db, []int64{0, 1})
+
+	// the next full sync reads from a node that doesn't reflect the
+	// cancellation yet, but must not re-add the upkeep
+	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
+	registryMock.MockResponse("getCanceledUpkeepList", []*big.Int{}).Once()
+	registryMock.MockResponse("getUpkeepCount", big.NewInt(3)).Once()
+
+	synchronizer.ExportedFullSync()
+	assertUpkeepIDs(t, db, []int64{0, 1})
+
+	// once the registry reflects the cancellation, it is reconciled as usual
+	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
+	registryMock.MockResponse("getCanceledUpkeepList", []*big.Int{big.NewInt(2)}).Once()
+	registryMock.MockResponse("getUpkeepCount", big.NewInt(3)).Once()
+
+	synchronizer.ExportedFullSync()
+	assertUpkeepIDs(t, db, []int64{0, 1})
+
+	ethMock.AssertExpectations(t)
+	logBroadcast.AssertExpectations(t)
+}
+
 func Test_RegistrySynchronizer_UpkeepRegisteredLog(t *testing.T) {
 	db, synchronizer, ethMock, lb, job := setupRegistrySync(t)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
@@ -41,6 +42,12 @@ var (
 	},
 		[]string{"upkeepID"},
 	)
+	promUpkeepsSkippedGasPriceCeiling = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_upkeeps_skipped_gas_price_ceiling",
+		Help: "The number of times an eligible upkeep was not performed because the gas price was above its maximum perform gas price",
+	},
+		[]string{"registryContract"},
+	)
 )
 
 // UpkeepExecuter implements the logic to communicate with KeeperRegistry
@@ -58,6 +65,23 @@ type UpkeepExecuter struct {
 	logger          logger.Logger
 	wgDone          sync.WaitGroup
 	utils.StartStopOnce
+
+	// pendingPerforms holds the upkeeps whose perform transaction is in
+	// flight or waiting for confirmations, by upkeep ID. These upkeeps are not
+	// performed again until the transaction has been accounted for.
+	pendingMu       sync.Mutex
+	pendingPerforms map[int64]pendingPerform
+}
+
+// pendingPerform is a perform transaction that was created at headNumber, or
+// loaded from the database on start, in which case headNumber is zero.
+// lastRunSet is true if the last run height of the upkeep has already been
+// set for it.
+type pendingPerform struct {
+	ethTxID    int64
+	headNumber int64
+	createdAt  time.Time
+	lastRunSet bool
 }
 
 // NewUpkeepExecuter is the constructor of UpkeepExecuter
@@ -83,6 +107,7 @@ func NewUpkeepExecuter(
 		orm:             orm,
 		pr:              pr,
 		logger:          logger.Named("UpkeepExecuter"),
+		pendingPerforms: make(map[int64]pendingPerform),
 	}
 }
 
@@ -90,8 +115,9 @@ func NewUpkeepExecuter(
 func (ex *UpkeepExecuter) Start() error {
 	return ex.StartOnce("UpkeepExecuter", func() error {
 		ex.wgDone.Add(2)
-		go ex.run()
 		latestHead, unsubscribeHeads := ex.headBroadcaster.Subscribe(ex)
+		ex.loadPendingPerforms(latestHead)
+		go ex.run()
 		if latestHead != nil {
 			ex.mailbox.Deliver(latestHead)
 		}
@@ -143,15 +169,37 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 
 	ex.logger.Debugw("checking active upkeeps", "blockheight", head.Number)
 
+	ex.processPendingPerforms(head)
+
+	registry, err := ex.orm.RegistryForJob(ex.job.ID)
+	if err != nil {
+		ex.logger.With("error", err).Error("unable to load registry")
+		return
+	}
+	if n, err := ex.orm.ResolveUpkeepRuns(registry.ID); err != nil {
+		ex.logger.With("error", err).Error("unable to resolve upkeep runs")
+	} else if n > 0 {
+		ex.logger.Debugw("resolved upkeep runs", "count", n)
+	}
+	turnBlockHash, err := ex.turnBlockHash(head, registry.BlockCountPerTurn)
+	if err != nil {
+		ex.logger.With("error", err).Error("unable to get the hash of the block the turn started at")
+		return
+	}
+
 	activeUpkeeps, err := ex.orm.EligibleUpkeepsForRegistry(
 		ex.job.KeeperSpec.ContractAddress,
 		head.Number,
 		ex.config.KeeperMaximumGracePeriod(),
+		turnBlockHash,
+		ex.minBalancePerGas(),
+		UpkeepOrder(ex.config.KeeperUpkeepOrder()),
 	)
 	if err != nil {
 		ex.logger.With("error", err).Error("unable to load active registrations")
 		return
 	}
+	activeUpkeeps = ex.withoutPendingPerforms(activeUpkeeps)
 
 	wg := sync.WaitGroup{}
 	wg.Add(len(activeUpkeeps))
@@ -161,16 +209,39 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 	}
 	for _, reg := range activeUpkeeps {
 		ex.executionQueue <- struct{}{}
-		go ex.execute(reg, head.Number, done)
+		go ex.execute(reg, head, done)
 	}
 
 	wg.Wait()
 }
 
+// turnBlockHash returns the hash of the block the current turn started at,
+// from the head's chain if it is long enough, otherwise from the eth node
+func (ex *UpkeepExecuter) turnBlockHash(head *evmtypes.Head, blockCountPerTurn int32) (common.Hash, error) {
+	if blockCountPerTurn <= 0 {
+		return common.Hash{}, errors.Errorf("invalid block count per turn %d", blockCountPerTurn)
+	}
+	turnStart := head.Number - (head.Number % int64(blockCountPerTurn))
+	if hash := head.HashAtHeight(turnStart); hash != (common.Hash{}) {
+		return hash, nil
+	}
+	ctx, cancel := utils.ContextFromChanWithDeadline(ex.chStop, time.Minute)
+	defer cancel()
+	turnHead, err := ex.ethClient.HeadByNumber(ctx, big.NewInt(turnStart))
+	if err != nil {
+		return common.Hash{}, errors.Wrapf(err, "failed to get head %d", turnStart)
+	} else if turnHead == nil {
+		return common.Hash{}, errors.Errorf("head %d not found", turnStart)
+	}
+	return turnHead.Hash, nil
+}
+
 // execute triggers the pipeline run
-func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, done func()) {
+func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head, done func()) {
 	defer done()
 
+	headNumber := head.Number
+
 	start := time.Now()
 	svcLogger := ex.logger.With("blockNum", headNumber, "upkeepID", upkeep.UpkeepID)
 	svcLogger.Debug("checking upkeep")
@@ -183,6 +254,9 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 		svcLogger.Error(errors.Wrap(err, "estimating gas price"))
 		return
 	}
+	if ex.exceedsMaxPerformGasPrice(upkeep, gasPrice, fee, svcLogger) {
+		return
+	}
 
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
 		"jobSpec": map[string]interface{}{
@@ -196,6 +270,11 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 			"gasPrice":  gasPrice,
 			"gasTipCap": fee.TipCap,
 			"gasFeeCap": fee.FeeCap,
+			// performDeadline is empty when the turn end cannot be estimated
+			"performDeadline": performDeadline(head, upkeep.Registry.BlockCountPerTurn),
+			// performPacingInterval is empty unless the job paces its perform
+			// transactions
+			"performPacingInterval": ex.performPacingInterval(),
 		},
 	})
 
@@ -207,10 +286,7 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 
 	// Only after task runs where a tx was broadcast
 	if run.State == pipeline.RunStatusCompleted {
-		err := ex.orm.SetLastRunHeightForUpkeepOnJob(ex.job.ID, upkeep.UpkeepID, headNumber, pg.WithParentCtx(ctxService))
-		if err != nil {
-			ex.logger.With("error", err).Errorw("failed to set last run height for upkeep")
-		}
+		ex.markPerformed(ctxService, upkeep, headNumber)
 
 		elapsed := time.Since(start)
 		promCheckUpkeepExecutionTime.
@@ -219,6 +295,172 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 	}
 }
 
+// minConfirmations is how many confirmations a perform transaction needs
+// before the upkeep is marked as performed
+func (ex *UpkeepExecuter) minConfirmations() uint32 {
+	if ex.job.KeeperSpec != nil && ex.job.KeeperSpec.MinConfirmations != nil {
+		return *ex.job.KeeperSpec.MinConfirmations
+	}
+	return ex.config.EvmFinalityDepth()
+}
+
+// performPacingInterval is the least time between the broadcasts of perform
+// transactions to the registry, across all keys, or empty if they are not
+// paced
+func (ex *UpkeepExecuter) performPacingInterval() string {
+	if ex.job.KeeperSpec != nil && ex.job.KeeperSpec.PerformPacingInterval != nil && !ex.job.KeeperSpec.PerformPacingInterval.IsZero() {
+		return ex.job.KeeperSpec.PerformPacingInterval.Duration().String()
+	}
+	return ""
+}
+
+// markPerformed sets the last run height of the upkeep once the perform
+// transaction created at headNumber has been confirmed, or straight away if
+// no confirmations are required. Either way, the upkeep is not performed
+// again until the transaction has been confirmed. The transaction is also
+// recorded as a run of the upkeep.
+func (ex *UpkeepExecuter) markPerformed(ctx context.Context, upkeep UpkeepRegistration, headNumber int64) {
+	upkeepID := upkeep.UpkeepID
+	var lastRunSet bool
+	if ex.minConfirmations() == 0 {
+		err := ex.orm.SetLastRunHeightForUpkeepOnJob(ex.job.ID, upkeepID, headNumber, pg.WithParentCtx(ctx))
+		if err != nil {
+			ex.logger.With("error", err).Errorw("failed to set last run height for upkeep")
+		}
+		lastRunSet = err == nil
+	}
+	ethTxID, err := ex.orm.LatestPerformTxID(ex.job.ID, upkeepID, pg.WithParentCtx(ctx))
+	if err != nil {
+		ex.logger.With("error", err, "upkeepID", upkeepID).Errorw("failed to find perform transaction for upkeep")
+		return
+	}
+	run := UpkeepRun{
+		RegistryID:  upkeep.RegistryID,
+		UpkeepID:    upkeepID,
+		BlockHeight: headNumber,
+		EthTxID:     &ethTxID,
+	}
+	if err = ex.orm.InsertUpkeepRun(&run, pg.WithParentCtx(ctx)); err != nil {
+		ex.logger.With("error", err, "upkeepID", upkeepID).Errorw("failed to record run for upkeep")
+	}
+	ex.pendingMu.Lock()
+	defer ex.pendingMu.Unlock()
+	ex.pendingPerforms[upkeepID] = pendingPerform{
+		ethTxID:    ethTxID,
+		headNumber: headNumber,
+		createdAt:  time.Now(),
+		lastRunSet: lastRunSet,
+	}
+}
+
+// loadPendingPerforms rebuilds pendingPerforms from the perform transactions
+// that were still pending when the node stopped, so that their upkeeps are
+// not performed again after a restart. Transactions that were confirmed but
+// did not have enough confirmations as of latestHead are loaded too.
+func (ex *UpkeepExecuter) loadPendingPerforms(latestHead *evmtypes.Head) {
+	var confirmedAfter *int64
+	if minConfs := ex.minConfirmations(); minConfs > 0 && latestHead != nil {
+		n := latestHead.Number - int64(minConfs)
+		confirmedAfter = &n
+	}
+	ctx, cancel := utils.ContextFromChanWithDeadline(ex.chStop, time.Minute)
+	defer cancel()
+	txes, err := ex.orm.PendingPerformTxes(ex.job.ID, confirmedAfter, pg.WithParentCtx(ctx))
+	if err != nil {
+		ex.logger.With("error", err).Error("unable to load pending perform transactions")
+		return
+	}
+	ex.pendingMu.Lock()
+	defer ex.pendingMu.Unlock()
+	for _, tx := range txes {
+		ex.pendingPerforms[tx.UpkeepID] = pendingPerform{ethTxID: tx.ID, createdAt: tx.CreatedAt}
+	}
+	if len(txes) > 0 {
+		ex.logger.Infow("loaded pending perform transactions", "count", len(txes))
+	}
+}
+
+// processPendingPerforms sets the last run height of the upkeeps whose
+// perform transaction has enough confirmations at head, unless it has been
+// set already. Upkeeps whose perform transaction fatally errored, or has not
+// been confirmed within KeeperPendingPerformTimeout, are dropped without
+// setting the last run height, so that they are eligible again straight
+// away.
+func (ex *UpkeepExecuter) processPendingPerforms(head *evmtypes.Head) {
+	ex.pendingMu.Lock()
+	pending := make(map[int64]pendingPerform, len(ex.pendingPerforms))
+	ethTxIDs := make([]int64, 0, len(ex.pendingPerforms))
+	for upkeepID, p := range ex.pendingPerforms {
+		pending[upkeepID] = p
+		ethTxIDs = append(ethTxIDs, p.ethTxID)
+	}
+	ex.pendingMu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	ctx, cancel := utils.ContextFromChanWithDeadline(ex.chStop, time.Minute)
+	defer cancel()
+	txes, err := ex.orm.PerformTxes(ethTxIDs, pg.WithParentCtx(ctx))
+	if err != nil {
+		ex.logger.With("error", err).Error("unable to load pending perform transactions")
+		return
+	}
+	byID := make(map[int64]PerformTx, len(txes))
+	for _, tx := range txes {
+		byID[tx.ID] = tx
+	}
+
+	confirmedAt := head.Number - int64(ex.minConfirmations())
+	timeout := ex.config.KeeperPendingPerformTimeout()
+	for upkeepID, p := range pending {
+		tx, exists := byID[p.ethTxID]
+		switch {
+		case !exists || tx.State == bulletprooftxmanager.EthTxFatalError:
+			ex.logger.Warnw("perform transaction failed, upkeep is eligible again", "upkeepID", upkeepID, "ethTxID", p.ethTxID)
+		case tx.State == bulletprooftxmanager.EthTxConfirmed && tx.BlockNumber != nil && *tx.BlockNumber <= confirmedAt:
+			if p.lastRunSet {
+				break
+			}
+			height := p.headNumber
+			if height == 0 {
+				// Loaded on start, so the head it was created at is unknown
+				height = *tx.BlockNumber
+			}
+			err = ex.orm.SetLastRunHeightForUpkeepOnJob(ex.job.ID, upkeepID, height, pg.WithParentCtx(ctx))
+			if err != nil {
+				ex.logger.With("error", err).Errorw("failed to set last run height for upkeep")
+				continue
+			}
+		case timeout > 0 && tx.State != bulletprooftxmanager.EthTxConfirmed && time.Since(p.createdAt) > timeout:
+			ex.logger.Warnw("perform transaction was not confirmed in time, upkeep is eligible again",
+				"upkeepID", upkeepID, "ethTxID", p.ethTxID, "state", tx.State, "timeout", timeout)
+		default:
+			continue
+		}
+		ex.pendingMu.Lock()
+		delete(ex.pendingPerforms, upkeepID)
+		ex.pendingMu.Unlock()
+	}
+}
+
+// withoutPendingPerforms leaves out the upkeeps whose perform transaction is
+// in flight or waiting for confirmations
+func (ex *UpkeepExecuter) withoutPendingPerforms(upkeeps []UpkeepRegistration) []UpkeepRegistration {
+	ex.pendingMu.Lock()
+	defer ex.pendingMu.Unlock()
+	if len(ex.pendingPerforms) == 0 {
+		return upkeeps
+	}
+	filtered := upkeeps[:0]
+	for _, upkeep := range upkeeps {
+		if _, exists := ex.pendingPerforms[upkeep.UpkeepID]; !exists {
+			filtered = append(filtered, upkeep)
+		}
+	}
+	return filtered
+}
+
 func (ex *UpkeepExecuter) estimateGasPrice(upkeep UpkeepRegistration) (gasPrice *big.Int, fee gas.DynamicFee, err error) {
 	var performTxData []byte
 	performTxData, err = RegistryABI.Pack(
@@ -242,6 +484,76 @@ func (ex *UpkeepExecuter) estimateGasPrice(upkeep UpkeepRegistration) (gasPrice
 	return gasPrice, fee, nil
 }
 
+// exceedsMaxPerformGasPrice returns true if the estimated gas price, or fee
+// cap in EIP-1559 mode, is above the upkeep's maximum perform gas price. The
+// upkeep is then skipped without setting its last run height, so that it is
+// eligible again on the next head.
+func (ex *UpkeepExecuter) exceedsMaxPerformGasPrice(upkeep UpkeepRegistration, gasPrice *big.Int, fee gas.DynamicFee, lggr logger.Logger) bool {
+	if upkeep.MaxPerformGasPrice == nil {
+		return false
+	}
+	price := gasPrice
+	if ex.config.EvmEIP1559DynamicFees() {
+		price = fee.FeeCap
+	}
+	if price == nil || price.Cmp(upkeep.MaxPerformGasPrice.ToInt()) <= 0 {
+		return false
+	}
+	lggr.Infow("Skipping upkeep, gas price is above its maximum perform gas price",
+		"gasPrice", price.String(),
+		"maxPerformGasPrice", upkeep.MaxPerformGasPrice.String(),
+	)
+	promUpkeepsSkippedGasPriceCeiling.WithLabelValues(upkeep.Registry.ContractAddress.Hex()).Inc()
+	return true
+}
+
+// minBalancePerGas returns the current gas price (or fee cap in EIP-1559 mode)
+// plus KeeperMinimumBalanceBufferPercent, or nil if the balance check is
+// disabled or the gas price could not be estimated
+func (ex *UpkeepExecuter) minBalancePerGas() *big.Int {
+	bufferPercent := ex.config.KeeperMinimumBalanceBufferPercent()
+	if bufferPercent == 0 {
+		return nil
+	}
+	var gasPrice *big.Int
+	var err error
+	if ex.config.EvmEIP1559DynamicFees() {
+		var fee gas.DynamicFee
+		fee, _, err = ex.gasEstimator.GetDynamicFee(0)
+		gasPrice = fee.FeeCap
+	} else {
+		gasPrice, _, err = ex.gasEstimator.GetLegacyGas(nil, 0)
+	}
+	if err != nil {
+		ex.logger.Warnw("Unable to estimate gas price, not checking upkeep balances", "error", err)
+		return nil
+	}
+	return addBuffer(gasPrice, bufferPercent)
+}
+
+// performDeadline estimates when this keeper's turn ends, as a unix timestamp
+// in seconds, so that the perform transaction can be deadline boosted. The
+// block time is averaged over the head's chain, and an empty string is
+// returned if it cannot be estimated.
+func performDeadline(head *evmtypes.Head, blockCountPerTurn int32) string {
+	if blockCountPerTurn <= 0 {
+		return ""
+	}
+	earliest := head.EarliestInChain()
+	blocks := head.Number - earliest.Number
+	if blocks <= 0 || earliest.Timestamp.IsZero() {
+		return ""
+	}
+	blockTime := head.Timestamp.Sub(earliest.Timestamp) / time.Duration(blocks)
+	if blockTime <= 0 {
+		return ""
+	}
+	turnSize := int64(blockCountPerTurn)
+	turnEnd := head.Number - head.Number%turnSize + turnSize
+	deadline := head.Timestamp.Add(time.Duration(turnEnd-head.Number) * blockTime)
+	return strconv.FormatInt(deadline.Unix(), 10)
+}
+
 func addBuffer(val *big.Int, prct uint32) *big.Int {
 	return bigmath.Div(
 		bigmath.Mul(val, 100+prct),
@@ -47,6 +47,21 @@ func setup(t *testing.T) (
 	job.Job,
 	cltest.JobPipelineV2TestHelper,
 	*bptxmmocks.TxManager,
+) {
+	// Mark upkeeps as performed as soon as the perform transaction is created
+	return setupWithMinConfirmations(t, 0)
+}
+
+func setupWithMinConfirmations(t *testing.T, minConfirmations uint32) (
+	*sqlx.DB,
+	*configtest.TestGeneralConfig,
+	*evmmocks.Client,
+	*keeper.UpkeepExecuter,
+	keeper.Registry,
+	keeper.UpkeepRegistration,
+	job.Job,
+	cltest.JobPipelineV2TestHelper,
+	*bptxmmocks.TxManager,
 ) {
 	cfg := cltest.NewTestGeneralConfig(t)
 	cfg.Overrides.KeeperMaximumGracePeriod = null.IntFrom(0)
@@ -64,6 +79,7 @@ func setup(t *testing.T) (
 	ch := evmtest.MustGetDefaultChain(t, cc)
 	orm := keeper.NewORM(db, logger.TestLogger(t), txm, ch.Config(), bulletprooftxmanager.SendEveryStrategy{})
 	registry, job := cltest.MustInsertKeeperRegistry(t, db, orm, keyStore.Eth())
+	job.KeeperSpec.MinConfirmations = &minConfirmations
 	lggr := logger.TestLogger(t)
 	executer := keeper.NewUpkeepExecuter(job, orm, jpv2.Pr, ethClient, ch.HeadBroadcaster(), ch.TxManager().GetGasEstimator(), lggr, ch.Config())
 	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, ch.Config(), registry)
@@ -158,6 +174,10 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 		// heads 20 thru 35 were skipped (e.g. due to node reboot)
 		head := cltest.Head(36)
 
+		// the hash of the block the turn started at is not in the head's
+		// chain, so it is fetched from the eth node
+		ethMock.On("HeadByNumber", mock.Anything, big.NewInt(20)).Return(cltest.Head(20), nil)
+
 		executer.OnNewLongestChain(context.Background(), head)
 		runs := cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 5, jpv2.Jrm, time.Second, 100*time.Millisecond)
 		require.Len(t, runs, 1)
@@ -193,6 +213,173 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 	})
 }
 
+func Test_UpkeepExecuter_PerformsUpkeep_WaitsForConfirmations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("marks the upkeep as performed once the perform transaction is confirmed", func(t *testing.T) {
+		db, config, ethMock, executer, registry, upkeep, job, jpv2, txm := setupWithMinConfirmations(t, 2)
+		borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+
+		etx := mustInsertPerformTx(t, borm, job.ID, registry.FromAddress.Address(), upkeep.UpkeepID, bulletprooftxmanager.EthTxUnconfirmed, 0)
+		txm.On("CreateEthTransaction", mock.Anything).Once().Return(etx, nil)
+
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
+		registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+		ethMock.On("HeadByNumber", mock.Anything, big.NewInt(20)).Maybe().Return(cltest.Head(20), nil)
+
+		executer.OnNewLongestChain(context.Background(), cltest.Head(20))
+		runs := cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 5, jpv2.Jrm, time.Second, 100*time.Millisecond)
+		require.Len(t, runs, 1)
+		assert.False(t, runs[0].HasErrors())
+
+		// not performed again while the perform transaction is pending
+		executer.OnNewLongestChain(context.Background(), cltest.Head(21))
+		cltest.AssertCountStays(t, db, "pipeline_runs", 1)
+		assertLastRunHeight(t, db, upkeep, 0)
+
+		// included in block 21, which does not have 2 confirmations at head 22
+		mustConfirmPerformTx(t, db, borm, etx, 21)
+		executer.OnNewLongestChain(context.Background(), cltest.Head(22))
+		gomega.NewWithT(t).Consistently(func() int64 {
+			var height int64
+			require.NoError(t, db.Get(&height, `SELECT last_run_block_height FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+			return height
+		}, time.Second, 100*time.Millisecond).Should(gomega.Equal(int64(0)))
+
+		executer.OnNewLongestChain(context.Background(), cltest.Head(23))
+		waitLastRunHeight(t, db, upkeep, 20)
+
+		ethMock.AssertExpectations(t)
+		txm.AssertExpectations(t)
+	})
+
+	t.Run("performs the upkeep again if the perform transaction fatally errors", func(t *testing.T) {
+		db, config, ethMock, executer, registry, upkeep, job, jpv2, txm := setupWithMinConfirmations(t, 2)
+		borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+
+		etx := mustInsertPerformTx(t, borm, job.ID, registry.FromAddress.Address(), upkeep.UpkeepID, bulletprooftxmanager.EthTxUnconfirmed, 0)
+		txm.On("CreateEthTransaction", mock.Anything).Twice().Return(etx, nil)
+
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
+		registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+		ethMock.On("HeadByNumber", mock.Anything, big.NewInt(20)).Maybe().Return(cltest.Head(20), nil)
+
+		executer.OnNewLongestChain(context.Background(), cltest.Head(20))
+		cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 5, jpv2.Jrm, time.Second, 100*time.Millisecond)
+
+		_, err := db.Exec(`UPDATE eth_txes SET state = 'fatal_error', nonce = NULL, broadcast_at = NULL, error = 'something exploded' WHERE id = $1`, etx.ID)
+		require.NoError(t, err)
+
+		executer.OnNewLongestChain(context.Background(), cltest.Head(21))
+		runs := cltest.WaitForPipelineComplete(t, 0, job.ID, 2, 5, jpv2.Jrm, time.Second, 100*time.Millisecond)
+		require.Len(t, runs, 2)
+		assertLastRunHeight(t, db, upkeep, 0)
+
+		ethMock.AssertExpectations(t)
+		txm.AssertExpectations(t)
+	})
+}
+
+func Test_UpkeepExecuter_PerformsUpkeep_SkipsPendingPerforms(t *testing.T) {
+	t.Parallel()
+
+	t.Run("creates exactly one perform transaction while it is pending", func(t *testing.T) {
+		db, config, ethMock, executer, registry, upkeep, job, jpv2, txm := setup(t)
+		borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+
+		etx := mustInsertPerformTx(t, borm, job.ID, registry.FromAddress.Address(), upkeep.UpkeepID, bulletprooftxmanager.EthTxUnconfirmed, 0)
+		txm.On("CreateEthTransaction", mock.Anything).Once().Return(etx, nil)
+
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
+		registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+		ethMock.On("HeadByNumber", mock.Anything, big.NewInt(20)).Maybe().Return(cltest.Head(20), nil)
+
+		// performed on the last head of the turn
+		executer.OnNewLongestChain(context.Background(), cltest.Head(39))
+		runs := cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 5, jpv2.Jrm, time.Second, 100*time.Millisecond)
+		require.Len(t, runs, 1)
+		waitLastRunHeight(t, db, upkeep, 39)
+
+		// eligible again in the next turn, but the perform transaction has
+		// not been confirmed yet
+		executer.OnNewLongestChain(context.Background(), cltest.Head(40))
+		executer.OnNewLongestChain(context.Background(), cltest.Head(41))
+		cltest.AssertCountStays(t, db, "pipeline_runs", 1)
+		cltest.AssertCount(t, db, "eth_txes", 1)
+
+		ethMock.AssertExpectations(t)
+		txm.AssertExpectations(t)
+	})
+
+	t.Run("performs the upkeep again once the pending perform timeout has passed", func(t *testing.T) {
+		db, config, ethMock, executer, registry, upkeep, job, jpv2, txm := setup(t)
+		borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+		timeout := time.Millisecond
+		config.Overrides.KeeperPendingPerformTimeout = &timeout
+
+		etx := mustInsertPerformTx(t, borm, job.ID, registry.FromAddress.Address(), upkeep.UpkeepID, bulletprooftxmanager.EthTxUnconfirmed, 0)
+		txm.On("CreateEthTransaction", mock.Anything).Twice().Return(etx, nil)
+
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
+		registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+		ethMock.On("HeadByNumber", mock.Anything, big.NewInt(20)).Maybe().Return(cltest.Head(20), nil)
+
+		executer.OnNewLongestChain(context.Background(), cltest.Head(39))
+		cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 5, jpv2.Jrm, time.Second, 100*time.Millisecond)
+		waitLastRunHeight(t, db, upkeep, 39)
+
+		time.Sleep(10 * time.Millisecond)
+		executer.OnNewLongestChain(context.Background(), cltest.Head(40))
+		runs := cltest.WaitForPipelineComplete(t, 0, job.ID, 2, 5, jpv2.Jrm, time.Second, 100*time.Millisecond)
+		require.Len(t, runs, 2)
+		waitLastRunHeight(t, db, upkeep, 40)
+
+		ethMock.AssertExpectations(t)
+		txm.AssertExpectations(t)
+	})
+}
+
+func Test_UpkeepExecuter_PerformsUpkeep_RecordsRuns(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewWithT(t)
+
+	db, config, ethMock, executer, registry, upkeep, job, jpv2, txm := setup(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, config)
+	korm := keeper.NewORM(db, logger.TestLogger(t), nil, evmtest.NewChainScopedConfig(t, config), nil)
+
+	etx := mustInsertPerformTx(t, borm, job.ID, registry.FromAddress.Address(), upkeep.UpkeepID, bulletprooftxmanager.EthTxUnconfirmed, 0)
+	txm.On("CreateEthTransaction", mock.Anything).Return(etx, nil)
+
+	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
+	registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+	ethMock.On("HeadByNumber", mock.Anything, big.NewInt(20)).Maybe().Return(cltest.Head(20), nil)
+
+	executer.OnNewLongestChain(context.Background(), cltest.Head(39))
+	cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 5, jpv2.Jrm, time.Second, 100*time.Millisecond)
+
+	var runs []keeper.UpkeepRun
+	g.Eventually(func() []keeper.UpkeepRun {
+		var err error
+		runs, err = korm.UpkeepRunsForUpkeep(registry.ID, upkeep.UpkeepID, 0)
+		require.NoError(t, err)
+		return runs
+	}, cltest.WaitTimeout(t), cltest.DBPollingInterval).Should(gomega.HaveLen(1))
+	run := runs[0]
+	assert.Equal(t, int64(39), run.BlockHeight)
+	assert.Equal(t, keeper.UpkeepRunStatusPending, run.Status)
+	require.NotNil(t, run.EthTxID)
+	assert.Equal(t, etx.ID, *run.EthTxID)
+
+	// the run is resolved on the next head once its transaction is confirmed
+	mustConfirmPerformTx(t, db, borm, etx, 39)
+	executer.OnNewLongestChain(context.Background(), cltest.Head(40))
+	g.Eventually(func() keeper.UpkeepRunStatus {
+		var status keeper.UpkeepRunStatus
+		require.NoError(t, db.Get(&status, `SELECT status FROM keeper_upkeep_runs WHERE id = $1`, run.ID))
+		return status
+	}, cltest.WaitTimeout(t), cltest.DBPollingInterval).Should(gomega.Equal(keeper.UpkeepRunStatusSuccess))
+}
+
 func Test_UpkeepExecuter_PerformsUpkeep_Error(t *testing.T) {
 	t.Parallel()
 	g := gomega.NewWithT(t)
@@ -212,3 +399,87 @@ func Test_UpkeepExecuter_PerformsUpkeep_Error(t *testing.T) {
 	cltest.AssertCountStays(t, db, "eth_txes", 0)
 	ethMock.AssertExpectations(t)
 }
+
+func Test_UpkeepExecuter_SkipsUpkeepWithInsufficientBalance(t *testing.T) {
+	t.Parallel()
+
+	db, config, ethMock, executer, _, upkeep, _, _, _ := setup(t)
+	config.Overrides.KeeperMinimumBalanceBufferPercent = null.IntFrom(20)
+
+	// Just short of executeGas * 60 gwei * 1.2
+	minBalance := bigmath.Div(bigmath.Mul(bigmath.Mul(assets.GWei(60), upkeep.ExecuteGas), 120), 100)
+	balance := bigmath.Sub(minBalance, big.NewInt(1))
+	_, err := db.Exec(`UPDATE upkeep_registrations SET balance = $1 WHERE id = $2`, balance.String(), upkeep.ID)
+	require.NoError(t, err)
+
+	head := newHead()
+	executer.OnNewLongestChain(context.Background(), &head)
+
+	cltest.AssertCountStays(t, db, "pipeline_runs", 0)
+	ethMock.AssertExpectations(t)
+}
+
+func Test_UpkeepExecuter_SkipsUpkeepAboveMaxPerformGasPrice(t *testing.T) {
+	t.Parallel()
+
+	db, config, ethMock, executer, registry, upkeep, job, jpv2, txm := setup(t)
+
+	// The estimator returns 60 gwei, plus the gas price buffer
+	_, err := db.Exec(`UPDATE upkeep_registrations SET max_perform_gas_price = $1 WHERE id = $2`, assets.GWei(50).String(), upkeep.ID)
+	require.NoError(t, err)
+
+	head := newHead()
+	executer.OnNewLongestChain(context.Background(), &head)
+
+	cltest.AssertCountStays(t, db, "pipeline_runs", 0)
+	assertLastRunHeight(t, db, upkeep, 0)
+
+	// Once the gas price is below the ceiling, the upkeep is performed on the
+	// next head
+	_, err = db.Exec(`UPDATE upkeep_registrations SET max_perform_gas_price = $1 WHERE id = $2`, assets.GWei(100).String(), upkeep.ID)
+	require.NoError(t, err)
+
+	gasLimit := upkeep.ExecuteGas + config.KeeperRegistryPerformGasOverhead()
+	ethTxCreated := cltest.NewAwaiter()
+	txm.On("CreateEthTransaction",
+		mock.MatchedBy(func(newTx bulletprooftxmanager.NewTx) bool { return newTx.GasLimit == gasLimit }),
+	).
+		Once().
+		Return(bulletprooftxmanager.EthTx{ID: 1}, nil).
+		Run(func(mock.Arguments) { ethTxCreated.ItHappened() })
+
+	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
+	registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+	ethMock.On("HeadByNumber", mock.Anything, big.NewInt(20)).Return(cltest.Head(20), nil)
+
+	executer.OnNewLongestChain(context.Background(), cltest.Head(21))
+	ethTxCreated.AwaitOrFail(t)
+	runs := cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 5, jpv2.Jrm, time.Second, 100*time.Millisecond)
+	require.Len(t, runs, 1)
+	assert.False(t, runs[0].HasErrors())
+	waitLastRunHeight(t, db, upkeep, 21)
+
+	ethMock.AssertExpectations(t)
+	txm.AssertExpectations(t)
+}
+
+func Test_UpkeepExecuter_PerformDeadline(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without parent heads", func(t *testing.T) {
+		head := newHead()
+		assert.Equal(t, "", keeper.PerformDeadline(&head, 20))
+	})
+
+	t.Run("estimates the end of the turn from the average block time", func(t *testing.T) {
+		// heads 10..20, 15s apart; the turn of 8 blocks ends at block 24
+		var parent *evmtypes.Head
+		for i := int64(10); i <= 20; i++ {
+			h := evmtypes.NewHead(big.NewInt(i), utils.NewHash(), utils.NewHash(), uint64(1000+15*(i-10)), utils.NewBigI(0))
+			h.Parent = parent
+			parent = &h
+		}
+		assert.Equal(t, "1210", keeper.PerformDeadline(parent, 8))
+		assert.Equal(t, "", keeper.PerformDeadline(parent, 0))
+	})
+}
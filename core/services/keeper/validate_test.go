@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 )
 
@@ -20,6 +21,8 @@ func TestValidatedKeeperSpec(t *testing.T) {
 		contractAddr             string
 		fromAddr                 string
 		minIncomingConfirmations uint32
+		minConfirmations         *uint32
+		performPacingInterval    *models.Interval
 		createdAt                time.Time
 		updatedAt                time.Time
 	}
@@ -59,6 +62,8 @@ fromAddress     			= "0xa8037A20989AFcBC51798de9762b351D63ff462e"
 evmChainID      			= 4
 externalJobID   			=  "123e4567-e89b-12d3-a456-426655440002"
 minIncomingConfirmations	= 2
+minConfirmations			= 5
+performPacingInterval		= "1s"
 
 
 observationSource = """
@@ -80,10 +85,12 @@ encode_perform_upkeep_tx [type=ethabiencode
                           data="{\\"id\\": $(jobSpec.upkeepID),\\"performData\\":$(decode_check_upkeep_tx.performData)}"]
 perform_upkeep_tx        [type=ethtx
                           gasLimit="$(jobSpec.performUpkeepGasLimit)"
+                          deadline="$(jobSpec.performDeadline)"
+                          pacingInterval="$(jobSpec.performPacingInterval)"
                           minConfirmations=0
                           to="$(jobSpec.contractAddress)"
                           data="$(encode_perform_upkeep_tx)"
-                          txMeta="{\\"jobID\\":$(jobSpec.jobID)}"]
+                          txMeta="{\\"jobID\\":$(jobSpec.jobID),\\"upkeepID\\":$(jobSpec.upkeepID)}"]
 encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> encode_perform_upkeep_tx -> perform_upkeep_tx
 """
 `,
@@ -93,6 +100,8 @@ encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> encode_pe
 				contractAddr:             "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
 				fromAddr:                 "0xa8037A20989AFcBC51798de9762b351D63ff462e",
 				minIncomingConfirmations: 2,
+				minConfirmations:         func() *uint32 { n := uint32(5); return &n }(),
+				performPacingInterval:    models.NewInterval(time.Second),
 				createdAt:                time.Time{},
 				updatedAt:                time.Time{},
 			},
@@ -129,10 +138,12 @@ encode_perform_upkeep_tx [type=ethabiencode
                           data="{\\"id\\": $(jobSpec.upkeepID),\\"performData\\":$(decode_check_upkeep_tx.performData)}"]
 perform_upkeep_tx        [type=ethtx
                           gasLimit="$(jobSpec.performUpkeepGasLimit)"
+                          deadline="$(jobSpec.performDeadline)"
+                          pacingInterval="$(jobSpec.performPacingInterval)"
                           minConfirmations=0
                           to="$(jobSpec.contractAddress)"
                           data="$(encode_perform_upkeep_tx)"
-                          txMeta="{\\"jobID\\":$(jobSpec.jobID)}"]
+                          txMeta="{\\"jobID\\":$(jobSpec.jobID),\\"upkeepID\\":$(jobSpec.upkeepID)}"]
 encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> encode_perform_upkeep_tx -> perform_upkeep_tx
 """
 `,
@@ -154,6 +165,8 @@ encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> encode_pe
 			require.Equal(t, tt.want.contractAddr, got.KeeperSpec.ContractAddress.Hex())
 			require.Equal(t, tt.want.fromAddr, got.KeeperSpec.FromAddress.Hex())
 			require.Equal(t, tt.want.minIncomingConfirmations, *got.KeeperSpec.MinIncomingConfirmations)
+			require.Equal(t, tt.want.minConfirmations, got.KeeperSpec.MinConfirmations)
+			require.Equal(t, tt.want.performPacingInterval, got.KeeperSpec.PerformPacingInterval)
 			require.Equal(t, tt.want.createdAt, got.KeeperSpec.CreatedAt)
 			require.Equal(t, tt.want.updatedAt, got.KeeperSpec.UpdatedAt)
 		})
@@ -0,0 +1,363 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/sqlx"
+	"go.uber.org/atomic"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ScopeGlobal is the scope of the lock used with LEADER_ELECTION_MODE=global.
+// With LEADER_ELECTION_MODE=chain the scope is the chain ID.
+const ScopeGlobal = "global"
+
+var promIsLeader = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "leader_election_is_leader",
+	Help: "Whether this node is the leader (1) or a standby (0) for the scope",
+}, []string{"scope"})
+
+const checkLockStmt = `SELECT EXISTS (SELECT 1 FROM pg_locks WHERE locktype = 'advisory' AND pid = pg_backend_pid() AND (classid::bigint << 32) | objid::bigint = $1)`
+
+// LockID derives the ID of the advisory lock for the scope from the
+// application's advisory lock ID, so that all nodes sharing the database and
+// ADVISORY_LOCK_ID contend for the same lock
+func LockID(advisoryLockID int64, scope string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("leader:" + scope))
+	return advisoryLockID ^ int64(h.Sum64())
+}
+
+// Elector elects a single leader among the nodes sharing a database, using a
+// session level Postgres advisory lock. Services wrapped with Gate are only
+// started once this node holds the lock. A standby node polls for the lock
+// every checkInterval, so it takes over within checkInterval of the leader
+// releasing the lock or its database session dying.
+//
+// A leader that finds it has lost the lock, or fails to check that it still
+// holds it, stops its gated services and exits, like the application advisory
+// lock does, since its gated services can't be safely started again.
+//
+// A nil *Elector is valid, and gates nothing.
+type Elector struct {
+	utils.StartStopOnce
+
+	db            *sqlx.DB
+	lockID        int64
+	scope         string
+	checkInterval time.Duration
+	lggr          logger.Logger
+
+	// conn is only used by the run loop, and by Close once it has exited
+	conn *sqlx.Conn
+
+	// mu guards isLeader and gates. It is never held while gated services are
+	// started or stopped, since that may take a while.
+	mu       sync.Mutex
+	isLeader bool
+	gates    []*gate
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewElector instantiates an Elector for the lock with the given ID
+func NewElector(db *sqlx.DB, lockID int64, scope string, checkInterval time.Duration, lggr logger.Logger) *Elector {
+	return &Elector{
+		db:            db,
+		lockID:        lockID,
+		scope:         scope,
+		checkInterval: checkInterval,
+		lggr:          lggr.Named("LeaderElector").With("scope", scope),
+		chStop:        make(chan struct{}),
+	}
+}
+
+// Start begins contending for leadership
+func (e *Elector) Start() error {
+	return e.StartOnce("LeaderElector", func() error {
+		promIsLeader.WithLabelValues(e.scope).Set(0)
+		e.wg.Add(1)
+		go e.run()
+		return nil
+	})
+}
+
+// Close stops the gated services if this node is the leader, and then
+// releases the lock
+func (e *Elector) Close() error {
+	return e.StopOnce("LeaderElector", func() (merr error) {
+		close(e.chStop)
+		e.wg.Wait()
+
+		wasLeader, gates := e.resign()
+		if wasLeader {
+			for i := len(gates) - 1; i >= 0; i-- {
+				merr = multierr.Append(merr, gates[i].stopInner())
+			}
+		}
+
+		return multierr.Combine(merr, e.releaseLock(wasLeader))
+	})
+}
+
+// Ready returns an error while this node is a standby
+func (e *Elector) Ready() error {
+	if err := e.StartStopOnce.Ready(); err != nil {
+		return err
+	}
+	if !e.IsLeader() {
+		return errors.Errorf("standby: another node is the leader for scope %s", e.scope)
+	}
+	return nil
+}
+
+// IsLeader returns true if this node holds the lock
+func (e *Elector) IsLeader() bool {
+	if e == nil {
+		return false
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.isLeader
+}
+
+// Scope returns the scope of the lock
+func (e *Elector) Scope() string {
+	return e.scope
+}
+
+// Gate wraps the service so that it is only started while this node is the
+// leader. The wrapper's Start returns immediately, and the service is started
+// whenever this node becomes the leader, which may be long after boot. Errors
+// starting the service are logged. The wrapper's Close stops the service if it
+// was started. If e is nil, the service is returned as is.
+func (e *Elector) Gate(name string, svc services.Service) services.Service {
+	if e == nil {
+		return svc
+	}
+	return &gate{name: name, elector: e, inner: svc}
+}
+
+func (e *Elector) run() {
+	defer e.wg.Done()
+	ctx, cancel := utils.ContextFromChan(e.chStop)
+	defer cancel()
+
+	for {
+		if e.IsLeader() {
+			e.checkLeadership(ctx)
+		} else {
+			e.tryTakeLeadership(ctx)
+		}
+		select {
+		case <-e.chStop:
+			return
+		case <-time.After(utils.WithJitter(e.checkInterval)):
+		}
+	}
+}
+
+func (e *Elector) tryTakeLeadership(ctx context.Context) {
+	gotLock, err := e.tryLock(ctx)
+	if err != nil {
+		e.lggr.Warnw("Failed to try leader election lock", "err", err)
+		return
+	}
+	if !gotLock {
+		e.lggr.Trace("Another node is the leader, waiting")
+		return
+	}
+
+	e.mu.Lock()
+	e.isLeader = true
+	gates := append([]*gate(nil), e.gates...)
+	e.mu.Unlock()
+	promIsLeader.WithLabelValues(e.scope).Set(1)
+	e.lggr.Infow("Became the leader, starting gated services", "nServices", len(gates))
+	for _, g := range gates {
+		g.startInner()
+	}
+}
+
+// resign marks this node as a standby, and returns whether it was the leader
+// and the gates to stop if it was
+func (e *Elector) resign() (wasLeader bool, gates []*gate) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	wasLeader = e.isLeader
+	e.isLeader = false
+	promIsLeader.WithLabelValues(e.scope).Set(0)
+	return wasLeader, append(gates, e.gates...)
+}
+
+// stepDown stops the gated services and exits. The node can no longer be sure
+// it is the only leader, and its gated services can't be started again.
+func (e *Elector) stepDown(msg string, err error) {
+	_, gates := e.resign()
+	for i := len(gates) - 1; i >= 0; i-- {
+		if serr := gates[i].stopInner(); serr != nil {
+			e.lggr.Errorw("Failed to stop gated service while stepping down", "err", serr)
+		}
+	}
+	e.lggr.Fatalw(msg, "err", err)
+}
+
+func (e *Elector) checkLeadership(ctx context.Context) {
+	qctx, cancel := pg.DefaultQueryCtxWithParent(ctx)
+	defer cancel()
+	var gotLock bool
+	err := e.conn.QueryRowContext(qctx, checkLockStmt, e.lockID).Scan(&gotLock)
+	if errors.Is(err, sql.ErrConnDone) {
+		// the session and with it the lock are gone, re-take it unless
+		// another node already did
+		e.lggr.Warnw("DB connection was unexpectedly closed; checking out a new one", "err", err)
+		e.conn = nil
+		gotLock, err = e.tryLock(ctx)
+	}
+	if err != nil {
+		// the lock may have been lost with the session, so another node may
+		// already be the leader
+		e.stepDown("Error while checking leader election lock, stepping down and exiting", err)
+	} else if !gotLock {
+		e.stepDown("Another node has taken leadership, exiting", nil)
+	}
+}
+
+// tryLock takes the lock if it's available, on a dedicated connection since
+// the lock is held by the session
+func (e *Elector) tryLock(ctx context.Context) (gotLock bool, err error) {
+	qctx, cancel := pg.DefaultQueryCtxWithParent(ctx)
+	defer cancel()
+	if e.conn == nil {
+		if e.conn, err = e.db.Connx(qctx); err != nil {
+			return false, errors.Wrap(err, "failed checking out connection from pool")
+		}
+	}
+	err = e.conn.QueryRowContext(qctx, `SELECT pg_try_advisory_lock($1)`, e.lockID).Scan(&gotLock)
+	if errors.Is(err, sql.ErrConnDone) {
+		e.conn = nil
+	}
+	return gotLock, errors.WithStack(err)
+}
+
+// releaseLock unlocks explicitly, since closing the connection only returns
+// it to the pool and the session keeps the lock
+func (e *Elector) releaseLock(locked bool) (err error) {
+	if e.conn == nil {
+		return nil
+	}
+	ctx, cancel := pg.DefaultQueryCtx()
+	defer cancel()
+	if locked {
+		err = utils.JustError(e.conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, e.lockID))
+	}
+	return multierr.Combine(err, e.conn.Close())
+}
+
+// gate is a service that is only started while its elector is the leader
+type gate struct {
+	name    string
+	elector *Elector
+	inner   services.Service
+
+	// mu serializes starting and stopping the inner service, and guards
+	// closed
+	mu      sync.Mutex
+	closed  bool
+	started atomic.Bool
+}
+
+// Start registers the gate with the elector, and starts the inner service
+// right away if this node is already the leader
+func (g *gate) Start() error {
+	g.mu.Lock()
+	closed := g.closed
+	g.mu.Unlock()
+	if closed {
+		return errors.Errorf("%s: cannot start closed gated service", g.name)
+	}
+
+	e := g.elector
+	e.mu.Lock()
+	e.gates = append(e.gates, g)
+	isLeader := e.isLeader
+	e.mu.Unlock()
+	if isLeader {
+		g.startInner()
+	} else {
+		e.lggr.Infow("Not the leader, deferring start", "service", g.name)
+	}
+	return nil
+}
+
+// Close deregisters the gate, and stops the inner service if it was started
+func (g *gate) Close() error {
+	e := g.elector
+	e.mu.Lock()
+	for i, other := range e.gates {
+		if other == g {
+			e.gates = append(e.gates[:i], e.gates[i+1:]...)
+			break
+		}
+	}
+	e.mu.Unlock()
+	return g.stopInner()
+}
+
+// Ready is only checked once the inner service has been started
+func (g *gate) Ready() error {
+	if !g.started.Load() {
+		return nil
+	}
+	return g.inner.Ready()
+}
+
+// Healthy is only checked once the inner service has been started
+func (g *gate) Healthy() error {
+	if !g.started.Load() {
+		return nil
+	}
+	return g.inner.Healthy()
+}
+
+// startInner starts the inner service, unless it was already started or the
+// gate was closed. A service that failed to start is not closed later.
+func (g *gate) startInner() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.started.Load() || g.closed {
+		return
+	}
+	if err := g.inner.Start(); err != nil {
+		g.elector.lggr.Errorw("Failed to start gated service", "service", g.name, "err", err)
+		return
+	}
+	g.started.Store(true)
+}
+
+// stopInner closes the gate, and stops the inner service if it was started
+func (g *gate) stopInner() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return nil
+	}
+	g.closed = true
+	if !g.started.Load() {
+		return nil
+	}
+	return errors.Wrapf(g.inner.Close(), "failed to close %s", g.name)
+}
@@ -0,0 +1,143 @@
+package leader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/leader"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type fakeService struct {
+	utils.StartStopOnce
+	started atomic.Bool
+}
+
+func (f *fakeService) Start() error {
+	return f.StartOnce("fakeService", func() error {
+		f.started.Store(true)
+		return nil
+	})
+}
+
+func (f *fakeService) Close() error {
+	return f.StopOnce("fakeService", func() error {
+		f.started.Store(false)
+		return nil
+	})
+}
+
+// blockingService fails to start, after blocking until unblock is closed
+type blockingService struct {
+	fakeService
+	unblock chan struct{}
+}
+
+func (b *blockingService) Start() error {
+	<-b.unblock
+	return errors.New("failed to start")
+}
+
+func Test_Elector(t *testing.T) {
+	cfg, db := heavyweight.FullTestDB(t, "leader_elector", true, false)
+	checkInterval := 100 * time.Millisecond
+	lockID := leader.LockID(cfg.AdvisoryLockID(), leader.ScopeGlobal)
+
+	t.Run("nil elector does not gate", func(t *testing.T) {
+		var e *leader.Elector
+		svc := new(fakeService)
+		assert.Equal(t, svc, e.Gate("fake", svc))
+		assert.False(t, e.IsLeader())
+	})
+
+	t.Run("only the leader starts gated services, and a standby takes over when the leader closes", func(t *testing.T) {
+		e1 := leader.NewElector(db, lockID, leader.ScopeGlobal, checkInterval, logger.TestLogger(t))
+		e2 := leader.NewElector(db, lockID, leader.ScopeGlobal, checkInterval, logger.TestLogger(t))
+		svc1, svc2 := new(fakeService), new(fakeService)
+		gated1, gated2 := e1.Gate("fake", svc1), e2.Gate("fake", svc2)
+
+		require.NoError(t, gated1.Start())
+		require.NoError(t, e1.Start())
+		gomega.NewWithT(t).Eventually(e1.IsLeader).Should(gomega.BeTrue())
+		assert.True(t, svc1.started.Load())
+		require.NoError(t, e1.Ready())
+
+		// e2 starts long after boot, and waits for the lock
+		require.NoError(t, e2.Start())
+		require.NoError(t, gated2.Start())
+		gomega.NewWithT(t).Consistently(e2.IsLeader, 5*checkInterval).Should(gomega.BeFalse())
+		assert.False(t, svc2.started.Load())
+		assert.Error(t, e2.Ready())
+		assert.NoError(t, gated2.Healthy())
+
+		require.NoError(t, e1.Close())
+		assert.False(t, svc1.started.Load())
+		assert.False(t, e1.IsLeader())
+
+		gomega.NewWithT(t).Eventually(e2.IsLeader, cltest.WaitTimeout(t)).Should(gomega.BeTrue())
+		assert.True(t, svc2.started.Load())
+
+		require.NoError(t, gated2.Close())
+		assert.False(t, svc2.started.Load())
+		require.NoError(t, e2.Close())
+	})
+
+	t.Run("a gated service that is slow to start does not block the elector, and is not closed if it fails to start", func(t *testing.T) {
+		e := leader.NewElector(db, lockID, leader.ScopeGlobal, checkInterval, logger.TestLogger(t))
+		svc := &blockingService{unblock: make(chan struct{})}
+		gated := e.Gate("blocking", svc)
+		require.NoError(t, gated.Start())
+		require.NoError(t, e.Start())
+
+		gomega.NewWithT(t).Eventually(e.IsLeader).Should(gomega.BeTrue())
+		assert.NoError(t, e.Ready())
+		assert.NoError(t, gated.Ready())
+
+		close(svc.unblock)
+		require.NoError(t, e.Close())
+		assert.NoError(t, gated.Close())
+	})
+}
+
+func Test_Electors_TwoApplications(t *testing.T) {
+	cfg, _ := heavyweight.FullTestDB(t, "leader_electors", true, true)
+	checkInterval := 100 * time.Millisecond
+	cfg.Overrides.LeaderElectionMode = null.StringFrom("global")
+	cfg.Overrides.LeaderElectionCheckInterval = &checkInterval
+
+	ethClient1, _, assertMocksCalled1 := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled1()
+	ethClient2, _, assertMocksCalled2 := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled2()
+
+	app1 := cltest.NewApplicationWithConfig(t, cfg, ethClient1)
+	require.NoError(t, app1.Start())
+	chain1, err := app1.ChainSet.Default()
+	require.NoError(t, err)
+	gomega.NewWithT(t).Eventually(chain1.LeaderElector().IsLeader).Should(gomega.BeTrue())
+	assert.NoError(t, chain1.TxManager().Ready())
+
+	app2 := cltest.NewApplicationWithConfig(t, cfg, ethClient2)
+	require.NoError(t, app2.Start())
+	chain2, err := app2.ChainSet.Default()
+	require.NoError(t, err)
+	gomega.NewWithT(t).Consistently(chain2.LeaderElector().IsLeader, 5*checkInterval).Should(gomega.BeFalse())
+	// the standby's txm is not started
+	assert.Error(t, chain2.TxManager().Ready())
+	assert.True(t, chain1.LeaderElector().IsLeader())
+
+	require.NoError(t, app1.Stop())
+
+	gomega.NewWithT(t).Eventually(chain2.LeaderElector().IsLeader, cltest.WaitTimeout(t)).Should(gomega.BeTrue())
+	gomega.NewWithT(t).Eventually(func() error { return chain2.TxManager().Ready() }).Should(gomega.Succeed())
+}
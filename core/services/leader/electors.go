@@ -0,0 +1,130 @@
+package leader
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/sqlx"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Config encompasses the config used by Electors
+type Config interface {
+	AdvisoryLockID() int64
+	LeaderElectionCheckInterval() time.Duration
+	LeaderElectionMode() string
+}
+
+// Electors holds the Elector for each scope according to
+// LEADER_ELECTION_MODE. Electors are created on demand by For, and are
+// started with Electors, or right away if Electors was already started.
+//
+// A nil *Electors is valid, and returns nil from For.
+type Electors struct {
+	utils.StartStopOnce
+
+	db   *sqlx.DB
+	cfg  Config
+	lggr logger.Logger
+
+	mu       sync.Mutex
+	started  bool
+	electors map[string]*Elector
+}
+
+// NewElectors returns nil if LEADER_ELECTION_MODE=none
+func NewElectors(db *sqlx.DB, cfg Config, lggr logger.Logger) *Electors {
+	if cfg.LeaderElectionMode() == "none" {
+		return nil
+	}
+	return &Electors{
+		db:       db,
+		cfg:      cfg,
+		lggr:     lggr,
+		electors: make(map[string]*Elector),
+	}
+}
+
+// For returns the Elector that gates the services for the chain, or nil if
+// leader election is disabled
+func (es *Electors) For(chainID *big.Int) *Elector {
+	if es == nil {
+		return nil
+	}
+	scope := ScopeGlobal
+	if es.cfg.LeaderElectionMode() == "chain" {
+		scope = chainID.String()
+	}
+
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if e, exists := es.electors[scope]; exists {
+		return e
+	}
+	e := NewElector(es.db, LockID(es.cfg.AdvisoryLockID(), scope), scope, es.cfg.LeaderElectionCheckInterval(), es.lggr)
+	es.electors[scope] = e
+	if es.started {
+		if err := e.Start(); err != nil {
+			es.lggr.Errorw("Failed to start leader elector", "scope", scope, "err", err)
+		}
+	}
+	return e
+}
+
+// IsLeader returns whether this node is the leader of each scope
+func (es *Electors) IsLeader() map[string]bool {
+	if es == nil {
+		return nil
+	}
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	leading := make(map[string]bool, len(es.electors))
+	for scope, e := range es.electors {
+		leading[scope] = e.IsLeader()
+	}
+	return leading
+}
+
+// Start starts contending for leadership of every scope
+func (es *Electors) Start() error {
+	return es.StartOnce("LeaderElectors", func() (merr error) {
+		es.mu.Lock()
+		defer es.mu.Unlock()
+		es.started = true
+		for _, e := range es.electors {
+			merr = multierr.Append(merr, e.Start())
+		}
+		return merr
+	})
+}
+
+// Close stops the gated services of every scope this node leads, and
+// releases the locks
+func (es *Electors) Close() error {
+	return es.StopOnce("LeaderElectors", func() (merr error) {
+		es.mu.Lock()
+		defer es.mu.Unlock()
+		for _, e := range es.electors {
+			merr = multierr.Append(merr, e.Close())
+		}
+		return merr
+	})
+}
+
+// Ready returns an error while this node is a standby for any scope, so that
+// only the leader is reported ready
+func (es *Electors) Ready() (merr error) {
+	if err := es.StartStopOnce.Ready(); err != nil {
+		return err
+	}
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	for _, e := range es.electors {
+		merr = multierr.Append(merr, e.Ready())
+	}
+	return merr
+}
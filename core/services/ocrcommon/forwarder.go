@@ -0,0 +1,48 @@
+package ocrcommon
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+)
+
+// ForwarderABI is the ABI of the forward and isAuthorizedSender methods of an
+// authorized forwarder contract, which is all a forwarding transmitter uses
+var ForwarderABI = evmtypes.MustGetABI(`[{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"forward","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"}],"name":"isAuthorizedSender","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"}]`)
+
+// ContractCaller makes eth_calls, e.g. an evmclient.Client
+type ContractCaller interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// CheckForwarderAuthorized returns an error unless fromAddress is an
+// authorized sender on the forwarder contract at forwarderAddress
+func CheckForwarderAuthorized(ctx context.Context, caller ContractCaller, forwarderAddress, fromAddress common.Address) error {
+	data, err := ForwarderABI.Pack("isAuthorizedSender", fromAddress)
+	if err != nil {
+		return errors.Wrap(err, "failed to pack isAuthorizedSender")
+	}
+	b, err := caller.CallContract(ctx, ethereum.CallMsg{To: &forwarderAddress, Data: data}, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check whether %s is authorized on forwarder %s", fromAddress.Hex(), forwarderAddress.Hex())
+	}
+	out, err := ForwarderABI.Unpack("isAuthorizedSender", b)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unpack isAuthorizedSender from forwarder %s", forwarderAddress.Hex())
+	}
+	if authorized, ok := out[0].(bool); !ok || !authorized {
+		return errors.Errorf("transmitter address %s is not an authorized sender on forwarder %s", fromAddress.Hex(), forwarderAddress.Hex())
+	}
+	return nil
+}
+
+// forwardPayload wraps payload in a call to forward it to toAddress
+func forwardPayload(toAddress common.Address, payload []byte) ([]byte, error) {
+	data, err := ForwarderABI.Pack("forward", toAddress, payload)
+	return data, errors.Wrap(err, "failed to pack forward")
+}
@@ -0,0 +1,48 @@
+package ocrcommon_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+)
+
+type fakeForwarder struct {
+	authorized map[common.Address]bool
+	err        error
+}
+
+func (f fakeForwarder) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	args, err := ocrcommon.ForwarderABI.Methods["isAuthorizedSender"].Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	return ocrcommon.ForwarderABI.Methods["isAuthorizedSender"].Outputs.Pack(f.authorized[args[0].(common.Address)])
+}
+
+func Test_CheckForwarderAuthorized(t *testing.T) {
+	forwarderAddress := cltest.NewAddress()
+	authorized, unauthorized := cltest.NewAddress(), cltest.NewAddress()
+	caller := fakeForwarder{authorized: map[common.Address]bool{authorized: true}}
+
+	require.NoError(t, ocrcommon.CheckForwarderAuthorized(context.Background(), caller, forwarderAddress, authorized))
+
+	err := ocrcommon.CheckForwarderAuthorized(context.Background(), caller, forwarderAddress, unauthorized)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not an authorized sender on forwarder")
+
+	err = ocrcommon.CheckForwarderAuthorized(context.Background(), fakeForwarder{err: errors.New("connection refused")}, forwarderAddress, authorized)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connection refused")
+}
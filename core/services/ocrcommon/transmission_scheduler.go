@@ -0,0 +1,199 @@
+package ocrcommon
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var _ Transmitter = (*TransmissionScheduler)(nil)
+
+type transmissionKey struct {
+	toAddress common.Address
+	epoch     uint32
+	round     uint8
+}
+
+func (k transmissionKey) less(other transmissionKey) bool {
+	if k.epoch != other.epoch {
+		return k.epoch < other.epoch
+	}
+	return k.round < other.round
+}
+
+type pendingTransmission struct {
+	transmissionKey
+	payload []byte
+	// gasLimit is nil for transmissions with the inner transmitter's gas limit
+	gasLimit *uint64
+	meta     *bulletprooftxmanager.EthTxMeta
+}
+
+// TransmissionScheduler delays the transmissions of a job by a window, so
+// that reports that are superseded while waiting, e.g. during a gas spike,
+// are never sent. Transmissions are keyed by contract, epoch and round, taken
+// from their meta, and only the latest payload per key is sent when the
+// window ends. With latestOnly, only the transmission with the highest epoch
+// and round per contract is sent, and older ones are pruned.
+//
+// A window of 0 sends every transmission immediately, as does a transmission
+// without an epoch and round in its meta. Pending transmissions are sent
+// synchronously on Close, so none are lost on shutdown.
+type TransmissionScheduler struct {
+	utils.StartStopOnce
+	inner      Transmitter
+	window     time.Duration
+	latestOnly bool
+	lggr       logger.Logger
+
+	mu      sync.Mutex
+	pending map[common.Address][]pendingTransmission
+	timers  map[common.Address]*time.Timer
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+// NewTransmissionScheduler wraps inner in a TransmissionScheduler. It must be
+// closed after whatever transmits through it, so that it can flush.
+func NewTransmissionScheduler(inner Transmitter, window time.Duration, latestOnly bool, lggr logger.Logger) *TransmissionScheduler {
+	return &TransmissionScheduler{
+		inner:      inner,
+		window:     window,
+		latestOnly: latestOnly,
+		lggr:       lggr.Named("TransmissionScheduler"),
+		pending:    make(map[common.Address][]pendingTransmission),
+		timers:     make(map[common.Address]*time.Timer),
+	}
+}
+
+func (s *TransmissionScheduler) Start() error {
+	return s.StartOnce("TransmissionScheduler", func() error { return nil })
+}
+
+// Close stops waiting for the window of pending transmissions, and sends them
+// before returning
+func (s *TransmissionScheduler) Close() error {
+	return s.StopOnce("TransmissionScheduler", func() error {
+		s.mu.Lock()
+		s.closed = true
+		for toAddress, timer := range s.timers {
+			if timer.Stop() {
+				s.wg.Done()
+			}
+			delete(s.timers, toAddress)
+		}
+		var toAddresses []common.Address
+		for toAddress := range s.pending {
+			toAddresses = append(toAddresses, toAddress)
+		}
+		s.mu.Unlock()
+
+		// Flushes that were already underway finish first
+		s.wg.Wait()
+		for _, toAddress := range toAddresses {
+			s.flush(toAddress)
+		}
+		return nil
+	})
+}
+
+// CreateEthTransaction schedules a transmission with the gas limit of the
+// inner transmitter
+func (s *TransmissionScheduler) CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte, meta *bulletprooftxmanager.EthTxMeta) error {
+	return s.schedule(ctx, toAddress, payload, nil, meta)
+}
+
+// CreateEthTransactionWithGasLimit schedules a transmission with the given
+// gas limit
+func (s *TransmissionScheduler) CreateEthTransactionWithGasLimit(ctx context.Context, toAddress common.Address, payload []byte, gasLimit uint64, meta *bulletprooftxmanager.EthTxMeta) error {
+	return s.schedule(ctx, toAddress, payload, &gasLimit, meta)
+}
+
+func (s *TransmissionScheduler) FromAddress() common.Address {
+	return s.inner.FromAddress()
+}
+
+func (s *TransmissionScheduler) FromAddresses() []common.Address {
+	return s.inner.FromAddresses()
+}
+
+func (s *TransmissionScheduler) schedule(ctx context.Context, toAddress common.Address, payload []byte, gasLimit *uint64, meta *bulletprooftxmanager.EthTxMeta) error {
+	if s.window <= 0 || meta == nil || meta.Epoch == nil || meta.Round == nil {
+		return s.transmit(ctx, pendingTransmission{transmissionKey{toAddress: toAddress}, payload, gasLimit, meta})
+	}
+	tx := pendingTransmission{transmissionKey{toAddress, *meta.Epoch, *meta.Round}, payload, gasLimit, meta}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return s.transmit(ctx, tx)
+	}
+	s.pending[toAddress] = s.insert(s.pending[toAddress], tx)
+	if _, exists := s.timers[toAddress]; !exists {
+		s.wg.Add(1)
+		s.timers[toAddress] = time.AfterFunc(s.window, func() {
+			defer s.wg.Done()
+			s.mu.Lock()
+			delete(s.timers, toAddress)
+			s.mu.Unlock()
+			s.flush(toAddress)
+		})
+	}
+	return nil
+}
+
+// insert adds tx to the pending transmissions to a contract, which are sorted
+// by epoch and round, replacing or pruning those it supersedes
+func (s *TransmissionScheduler) insert(pending []pendingTransmission, tx pendingTransmission) []pendingTransmission {
+	if s.latestOnly {
+		if len(pending) > 0 && tx.less(pending[0].transmissionKey) {
+			s.lggr.Debugw("Pruned transmission superseded by a pending one", "contractAddress", tx.toAddress, "epoch", tx.epoch, "round", tx.round)
+			return pending
+		}
+		for _, p := range pending {
+			s.lggr.Debugw("Pruned superseded pending transmission", "contractAddress", p.toAddress, "epoch", p.epoch, "round", p.round)
+		}
+		return []pendingTransmission{tx}
+	}
+	for i, p := range pending {
+		if p.transmissionKey == tx.transmissionKey {
+			s.lggr.Debugw("Replaced pending transmission with a later payload", "contractAddress", p.toAddress, "epoch", p.epoch, "round", p.round)
+			pending[i] = tx
+			return pending
+		}
+	}
+	pending = append(pending, tx)
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].less(pending[j].transmissionKey)
+	})
+	return pending
+}
+
+// flush sends the pending transmissions to a contract, oldest first
+func (s *TransmissionScheduler) flush(toAddress common.Address) {
+	s.mu.Lock()
+	pending := s.pending[toAddress]
+	delete(s.pending, toAddress)
+	s.mu.Unlock()
+
+	for _, tx := range pending {
+		// The context the transmission was scheduled with has likely expired
+		if err := s.transmit(context.Background(), tx); err != nil {
+			s.lggr.Errorw("Failed to send scheduled transmission", "contractAddress", tx.toAddress, "epoch", tx.epoch, "round", tx.round, "err", err)
+		}
+	}
+}
+
+func (s *TransmissionScheduler) transmit(ctx context.Context, tx pendingTransmission) error {
+	if tx.gasLimit != nil {
+		return s.inner.CreateEthTransactionWithGasLimit(ctx, tx.toAddress, tx.payload, *tx.gasLimit, tx.meta)
+	}
+	return s.inner.CreateEthTransaction(ctx, tx.toAddress, tx.payload, tx.meta)
+}
@@ -0,0 +1,103 @@
+package ocrcommon_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+)
+
+func reportMeta(epoch uint32, round uint8) *bulletprooftxmanager.EthTxMeta {
+	return &bulletprooftxmanager.EthTxMeta{Epoch: &epoch, Round: &round}
+}
+
+func expectTransmission(txm *bptxmmocks.TxManager, toAddress common.Address, payload []byte, meta *bulletprooftxmanager.EthTxMeta) *mock.Call {
+	return txm.On("CreateEthTransaction", mock.MatchedBy(func(newTx bulletprooftxmanager.NewTx) bool {
+		return newTx.ToAddress == toAddress && string(newTx.EncodedPayload) == string(payload) && newTx.Meta == meta
+	}), mock.Anything).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+}
+
+func Test_TransmissionScheduler_NoWindow(t *testing.T) {
+	txm := new(bptxmmocks.TxManager)
+	toAddress := cltest.NewAddress()
+	transmitter := ocrcommon.NewTransmitter(txm, cltest.NewAddress(), 1000, nil, bulletprooftxmanager.EthTxOrigin{})
+	scheduler := ocrcommon.NewTransmissionScheduler(transmitter, 0, true, logger.TestLogger(t))
+	require.NoError(t, scheduler.Start())
+
+	meta1, meta2 := reportMeta(1, 1), reportMeta(1, 2)
+	expectTransmission(txm, toAddress, []byte{1}, meta1)
+	expectTransmission(txm, toAddress, []byte{2}, meta2)
+
+	// Every transmission is sent immediately
+	require.NoError(t, scheduler.CreateEthTransaction(context.Background(), toAddress, []byte{1}, meta1))
+	require.NoError(t, scheduler.CreateEthTransaction(context.Background(), toAddress, []byte{2}, meta2))
+	txm.AssertExpectations(t)
+
+	require.NoError(t, scheduler.Close())
+	txm.AssertExpectations(t)
+}
+
+func Test_TransmissionScheduler_Window(t *testing.T) {
+	txm := new(bptxmmocks.TxManager)
+	toAddress := cltest.NewAddress()
+	transmitter := ocrcommon.NewTransmitter(txm, cltest.NewAddress(), 1000, nil, bulletprooftxmanager.EthTxOrigin{})
+	scheduler := ocrcommon.NewTransmissionScheduler(transmitter, 100*time.Millisecond, false, logger.TestLogger(t))
+	require.NoError(t, scheduler.Start())
+	defer scheduler.Close()
+
+	meta1, meta2, meta2Again := reportMeta(1, 1), reportMeta(1, 2), reportMeta(1, 2)
+	sent := make(chan struct{}, 2)
+	expectTransmission(txm, toAddress, []byte{1}, meta1).Run(func(mock.Arguments) { sent <- struct{}{} })
+	expectTransmission(txm, toAddress, []byte{3}, meta2Again).Run(func(mock.Arguments) { sent <- struct{}{} })
+
+	require.NoError(t, scheduler.CreateEthTransaction(context.Background(), toAddress, []byte{3}, meta2))
+	require.NoError(t, scheduler.CreateEthTransaction(context.Background(), toAddress, []byte{1}, meta1))
+	// Supersedes the pending payload for the same epoch and round
+	require.NoError(t, scheduler.CreateEthTransaction(context.Background(), toAddress, []byte{3}, meta2Again))
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-sent:
+		case <-time.After(cltest.WaitTimeout(t)):
+			t.Fatal("timed out waiting for scheduled transmissions")
+		}
+	}
+	txm.AssertExpectations(t)
+}
+
+func Test_TransmissionScheduler_LatestOnly(t *testing.T) {
+	txm := new(bptxmmocks.TxManager)
+	toAddress1, toAddress2 := cltest.NewAddress(), cltest.NewAddress()
+	transmitter := ocrcommon.NewTransmitter(txm, cltest.NewAddress(), 1000, nil, bulletprooftxmanager.EthTxOrigin{})
+	scheduler := ocrcommon.NewTransmissionScheduler(transmitter, time.Hour, true, logger.TestLogger(t))
+	require.NoError(t, scheduler.Start())
+
+	latest1, latest2 := reportMeta(2, 1), reportMeta(1, 1)
+	require.NoError(t, scheduler.CreateEthTransaction(context.Background(), toAddress1, []byte{1}, reportMeta(1, 1)))
+	require.NoError(t, scheduler.CreateEthTransaction(context.Background(), toAddress1, []byte{2}, latest1))
+	// Older than the pending transmission, so pruned right away
+	require.NoError(t, scheduler.CreateEthTransaction(context.Background(), toAddress1, []byte{3}, reportMeta(1, 5)))
+	require.NoError(t, scheduler.CreateEthTransactionWithGasLimit(context.Background(), toAddress2, []byte{4}, 5000, latest2))
+
+	// Transmissions without an epoch and round are never delayed
+	expectTransmission(txm, toAddress2, []byte{5}, nil)
+	require.NoError(t, scheduler.CreateEthTransaction(context.Background(), toAddress2, []byte{5}, nil))
+	txm.AssertExpectations(t)
+
+	// Pending transmissions are sent on close, well before the window ends
+	expectTransmission(txm, toAddress1, []byte{2}, latest1)
+	txm.On("CreateEthTransaction", mock.MatchedBy(func(newTx bulletprooftxmanager.NewTx) bool {
+		return newTx.ToAddress == toAddress2 && newTx.GasLimit == 5000 && newTx.Meta == latest2
+	}), mock.Anything).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+	require.NoError(t, scheduler.Close())
+	txm.AssertExpectations(t)
+}
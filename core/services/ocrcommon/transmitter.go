@@ -2,9 +2,12 @@ package ocrcommon
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
@@ -14,39 +17,166 @@ type txManager interface {
 }
 
 type Transmitter interface {
-	CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte) error
+	CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte, meta *bulletprooftxmanager.EthTxMeta) error
+	CreateEthTransactionWithGasLimit(ctx context.Context, toAddress common.Address, payload []byte, gasLimit uint64, meta *bulletprooftxmanager.EthTxMeta) error
 	FromAddress() common.Address
+	FromAddresses() []common.Address
+}
+
+// MetaBuilder adds to the meta of every transaction a transmitter creates,
+// e.g. to record the job it transmits for, so that transactions can be traced
+// back to it. meta is never nil.
+type MetaBuilder func(meta *bulletprooftxmanager.EthTxMeta)
+
+// JobMeta records the ID and external ID of the job in the meta
+func JobMeta(jobID int32, externalJobID uuid.UUID) MetaBuilder {
+	return func(meta *bulletprooftxmanager.EthTxMeta) {
+		meta.JobID = jobID
+		meta.ExternalJobID = &externalJobID
+	}
+}
+
+// ExternalJobIDMeta records only the external ID of the job in the meta, for
+// callers that do not know the job's ID
+func ExternalJobIDMeta(externalJobID uuid.UUID) MetaBuilder {
+	return func(meta *bulletprooftxmanager.EthTxMeta) {
+		meta.ExternalJobID = &externalJobID
+	}
 }
 
 type transmitter struct {
-	txm         txManager
-	fromAddress common.Address
-	gasLimit    uint64
-	strategy    bulletprooftxmanager.TxStrategy
+	txm txManager
+	// fromAddresses has at least one address, see NewMultiKeyTransmitter
+	fromAddresses []common.Address
+	next          uint32
+	gasLimit      uint64
+	strategy      bulletprooftxmanager.TxStrategy
+	origin        bulletprooftxmanager.EthTxOrigin
+	// forwarderAddress is optional, see NewForwardingTransmitter
+	forwarderAddress *common.Address
+	metaBuilders     []MetaBuilder
 }
 
-// NewTransmitter creates a new eth transmitter
-func NewTransmitter(txm txManager, fromAddress common.Address, gasLimit uint64, strategy bulletprooftxmanager.TxStrategy) Transmitter {
+// NewTransmitter creates a new eth transmitter. origin is given to every
+// transaction it creates, and metaBuilders are optional, see MetaBuilder.
+func NewTransmitter(txm txManager, fromAddress common.Address, gasLimit uint64, strategy bulletprooftxmanager.TxStrategy, origin bulletprooftxmanager.EthTxOrigin, metaBuilders ...MetaBuilder) Transmitter {
 	return &transmitter{
-		txm:         txm,
-		fromAddress: fromAddress,
-		gasLimit:    gasLimit,
-		strategy:    strategy,
+		txm:           txm,
+		fromAddresses: []common.Address{fromAddress},
+		gasLimit:      gasLimit,
+		strategy:      strategy,
+		origin:        origin,
+		metaBuilders:  metaBuilders,
 	}
 }
 
-func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte) error {
+// NewMultiKeyTransmitter creates a new eth transmitter that sends each
+// transaction from the next of fromAddresses in turn, so that a job is not
+// held back by the ETH_MAX_IN_FLIGHT_TRANSACTIONS limit of a single key.
+// Every address must be allowed to transmit to the contracts the job
+// transmits to, see FromAddresses.
+//
+// FromAddress returns the first of fromAddresses, since that is the address
+// OCR identifies the node's oracle by.
+func NewMultiKeyTransmitter(txm txManager, fromAddresses []common.Address, gasLimit uint64, strategy bulletprooftxmanager.TxStrategy, origin bulletprooftxmanager.EthTxOrigin, metaBuilders ...MetaBuilder) (Transmitter, error) {
+	if len(fromAddresses) == 0 {
+		return nil, errors.New("at least one from address is required")
+	}
+	return &transmitter{
+		txm:           txm,
+		fromAddresses: append([]common.Address(nil), fromAddresses...),
+		gasLimit:      gasLimit,
+		strategy:      strategy,
+		origin:        origin,
+		metaBuilders:  metaBuilders,
+	}, nil
+}
+
+// NewForwardingTransmitter creates a new eth transmitter that sends every
+// transaction through the authorized forwarder contract at forwarderAddress,
+// so that the transmitting key can be rotated without updating the target
+// contract. fromAddress must be an authorized sender on the forwarder, see
+// CheckForwarderAuthorized.
+func NewForwardingTransmitter(txm txManager, fromAddress, forwarderAddress common.Address, gasLimit uint64, strategy bulletprooftxmanager.TxStrategy, origin bulletprooftxmanager.EthTxOrigin, metaBuilders ...MetaBuilder) Transmitter {
+	return &transmitter{
+		txm:              txm,
+		fromAddresses:    []common.Address{fromAddress},
+		gasLimit:         gasLimit,
+		strategy:         strategy,
+		origin:           origin,
+		forwarderAddress: &forwarderAddress,
+		metaBuilders:     metaBuilders,
+	}
+}
+
+// CreateEthTransaction creates a transaction with the gas limit the
+// transmitter was constructed with
+func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte, meta *bulletprooftxmanager.EthTxMeta) error {
+	return t.CreateEthTransactionWithGasLimit(ctx, toAddress, payload, t.gasLimit, meta)
+}
+
+// CreateEthTransactionWithGasLimit creates a transaction with the given gas
+// limit, for protocols whose reports, and so the gas they use, vary in size
+func (t *transmitter) CreateEthTransactionWithGasLimit(ctx context.Context, toAddress common.Address, payload []byte, gasLimit uint64, meta *bulletprooftxmanager.EthTxMeta) error {
+	meta = t.buildMeta(meta)
+	if t.forwarderAddress != nil {
+		var err error
+		if payload, err = forwardPayload(toAddress, payload); err != nil {
+			return errors.Wrap(err, "Skipped OCR transmission")
+		}
+		forwardedMeta := bulletprooftxmanager.EthTxMeta{}
+		if meta != nil {
+			forwardedMeta = *meta
+		}
+		forwardedMeta.ForwarderDestAddress = &toAddress
+		meta = &forwardedMeta
+		toAddress = *t.forwarderAddress
+	}
 	_, err := t.txm.CreateEthTransaction(bulletprooftxmanager.NewTx{
-		FromAddress:    t.fromAddress,
+		FromAddress:    t.nextFromAddress(),
 		ToAddress:      toAddress,
 		EncodedPayload: payload,
-		GasLimit:       t.gasLimit,
-		Meta:           nil,
+		GasLimit:       gasLimit,
+		Meta:           meta,
+		Origin:         t.origin,
 		Strategy:       t.strategy,
 	}, pg.WithParentCtx(ctx))
 	return errors.Wrap(err, "Skipped OCR transmission")
 }
 
+// buildMeta returns a copy of meta with the transmitter's metaBuilders
+// applied, so that the caller's meta is never modified
+func (t *transmitter) buildMeta(meta *bulletprooftxmanager.EthTxMeta) *bulletprooftxmanager.EthTxMeta {
+	if len(t.metaBuilders) == 0 {
+		return meta
+	}
+	built := bulletprooftxmanager.EthTxMeta{}
+	if meta != nil {
+		built = *meta
+	}
+	for _, build := range t.metaBuilders {
+		build(&built)
+	}
+	return &built
+}
+
+// nextFromAddress returns the address to send the next transaction from,
+// round-robin across fromAddresses. The chosen address is recorded as the
+// from address of the transaction.
+func (t *transmitter) nextFromAddress() common.Address {
+	if len(t.fromAddresses) == 1 {
+		return t.fromAddresses[0]
+	}
+	i := atomic.AddUint32(&t.next, 1) - 1
+	return t.fromAddresses[i%uint32(len(t.fromAddresses))]
+}
+
 func (t *transmitter) FromAddress() common.Address {
-	return t.fromAddress
+	return t.fromAddresses[0]
+}
+
+// FromAddresses returns every address the transmitter may send transactions
+// from, e.g. to check that all of them are allowed to transmit to a contract
+func (t *transmitter) FromAddresses() []common.Address {
+	return append([]common.Address(nil), t.fromAddresses...)
 }
@@ -6,10 +6,13 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
 
+	"github.com/ethereum/go-ethereum/common"
+	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	bptxmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -24,20 +27,167 @@ func Test_Transmitter_CreateEthTransaction(t *testing.T) {
 	gasLimit := uint64(1000)
 	toAddress := cltest.NewAddress()
 	payload := []byte{1, 2, 3}
+	epoch, round := uint32(2), uint8(3)
+	meta := &bulletprooftxmanager.EthTxMeta{Epoch: &epoch, Round: &round}
 	txm := new(bptxmmocks.TxManager)
 	strategy := new(bptxmmocks.TxStrategy)
 
-	transmitter := ocrcommon.NewTransmitter(txm, fromAddress, gasLimit, strategy)
+	transmitter := ocrcommon.NewTransmitter(txm, fromAddress, gasLimit, strategy, bulletprooftxmanager.JobOrigin(1, "ocr"))
 
 	txm.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
 		FromAddress:    fromAddress,
 		ToAddress:      toAddress,
 		EncodedPayload: payload,
 		GasLimit:       gasLimit,
-		Meta:           nil,
+		Meta:           meta,
+		Origin:         bulletprooftxmanager.EthTxOrigin{JobID: 1, JobName: "ocr"},
 		Strategy:       strategy,
 	}, mock.Anything).Return(bulletprooftxmanager.EthTx{}, nil).Once()
-	require.NoError(t, transmitter.CreateEthTransaction(context.Background(), toAddress, payload))
+	require.NoError(t, transmitter.CreateEthTransaction(context.Background(), toAddress, payload, meta))
+
+	txm.AssertExpectations(t)
+}
+
+func Test_Transmitter_CreateEthTransactionWithGasLimit(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	defaultGasLimit := uint64(1000)
+	gasLimit := uint64(250000)
+	toAddress := cltest.NewAddress()
+	payload := []byte{1, 2, 3}
+	txm := new(bptxmmocks.TxManager)
+	strategy := new(bptxmmocks.TxStrategy)
+
+	transmitter := ocrcommon.NewTransmitter(txm, fromAddress, defaultGasLimit, strategy, bulletprooftxmanager.EthTxOrigin{})
+
+	txm.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
+		FromAddress:    fromAddress,
+		ToAddress:      toAddress,
+		EncodedPayload: payload,
+		GasLimit:       gasLimit,
+		Strategy:       strategy,
+	}, mock.Anything).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+	require.NoError(t, transmitter.CreateEthTransactionWithGasLimit(context.Background(), toAddress, payload, gasLimit, nil))
+
+	txm.AssertExpectations(t)
+}
+
+func Test_Transmitter_CreateEthTransaction_Forwarder(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	gasLimit := uint64(1000)
+	forwarderAddress := cltest.NewAddress()
+	toAddress := cltest.NewAddress()
+	payload := []byte{1, 2, 3}
+	epoch, round := uint32(2), uint8(3)
+	txm := new(bptxmmocks.TxManager)
+	strategy := new(bptxmmocks.TxStrategy)
+
+	transmitter := ocrcommon.NewForwardingTransmitter(txm, fromAddress, forwarderAddress, gasLimit, strategy, bulletprooftxmanager.EthTxOrigin{})
+
+	forwardedPayload, err := ocrcommon.ForwarderABI.Pack("forward", toAddress, payload)
+	require.NoError(t, err)
+	meta := &bulletprooftxmanager.EthTxMeta{Epoch: &epoch, Round: &round}
+	txm.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
+		FromAddress:    fromAddress,
+		ToAddress:      forwarderAddress,
+		EncodedPayload: forwardedPayload,
+		GasLimit:       gasLimit,
+		Meta:           &bulletprooftxmanager.EthTxMeta{Epoch: &epoch, Round: &round, ForwarderDestAddress: &toAddress},
+		Strategy:       strategy,
+	}, mock.Anything).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+	require.NoError(t, transmitter.CreateEthTransaction(context.Background(), toAddress, payload, meta))
+
+	// The forwarded calldata decodes to the original destination and payload
+	args, err := ocrcommon.ForwarderABI.Methods["forward"].Inputs.Unpack(forwardedPayload[4:])
+	require.NoError(t, err)
+	assert.Equal(t, toAddress, args[0])
+	assert.Equal(t, payload, args[1])
+	// The caller's meta is not modified
+	assert.Nil(t, meta.ForwarderDestAddress)
+
+	txm.AssertExpectations(t)
+}
+
+func Test_Transmitter_CreateEthTransaction_MetaBuilders(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	gasLimit := uint64(1000)
+	toAddress := cltest.NewAddress()
+	payload := []byte{1, 2, 3}
+	epoch, round := uint32(2), uint8(3)
+	externalJobID := uuid.NewV4()
+	txm := new(bptxmmocks.TxManager)
+	strategy := new(bptxmmocks.TxStrategy)
+
+	transmitter := ocrcommon.NewTransmitter(txm, fromAddress, gasLimit, strategy, bulletprooftxmanager.EthTxOrigin{}, ocrcommon.JobMeta(42, externalJobID))
+
+	meta := &bulletprooftxmanager.EthTxMeta{Epoch: &epoch, Round: &round}
+	txm.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
+		FromAddress:    fromAddress,
+		ToAddress:      toAddress,
+		EncodedPayload: payload,
+		GasLimit:       gasLimit,
+		Meta:           &bulletprooftxmanager.EthTxMeta{JobID: 42, ExternalJobID: &externalJobID, Epoch: &epoch, Round: &round},
+		Strategy:       strategy,
+	}, mock.Anything).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+	require.NoError(t, transmitter.CreateEthTransaction(context.Background(), toAddress, payload, meta))
+	// The caller's meta is not modified
+	assert.Nil(t, meta.ExternalJobID)
+
+	// Transmissions without a meta get one
+	txm.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
+		FromAddress:    fromAddress,
+		ToAddress:      toAddress,
+		EncodedPayload: payload,
+		GasLimit:       gasLimit,
+		Meta:           &bulletprooftxmanager.EthTxMeta{JobID: 42, ExternalJobID: &externalJobID},
+		Strategy:       strategy,
+	}, mock.Anything).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+	require.NoError(t, transmitter.CreateEthTransaction(context.Background(), toAddress, payload, nil))
+
+	txm.AssertExpectations(t)
+}
+
+func Test_MultiKeyTransmitter_CreateEthTransaction(t *testing.T) {
+	gasLimit := uint64(1000)
+	fromAddresses := []common.Address{cltest.NewAddress(), cltest.NewAddress(), cltest.NewAddress()}
+	toAddress := cltest.NewAddress()
+	payload := []byte{1, 2, 3}
+	txm := new(bptxmmocks.TxManager)
+	strategy := new(bptxmmocks.TxStrategy)
+
+	_, err := ocrcommon.NewMultiKeyTransmitter(txm, nil, gasLimit, strategy, bulletprooftxmanager.EthTxOrigin{})
+	require.Error(t, err)
+
+	transmitter, err := ocrcommon.NewMultiKeyTransmitter(txm, fromAddresses, gasLimit, strategy, bulletprooftxmanager.EthTxOrigin{})
+	require.NoError(t, err)
+	assert.Equal(t, fromAddresses[0], transmitter.FromAddress())
+	assert.Equal(t, fromAddresses, transmitter.FromAddresses())
+
+	// Transactions are sent from each address in turn
+	for _, fromAddress := range append(fromAddresses, fromAddresses[0]) {
+		txm.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			GasLimit:       gasLimit,
+			Strategy:       strategy,
+		}, mock.Anything).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+		require.NoError(t, transmitter.CreateEthTransaction(context.Background(), toAddress, payload, nil))
+	}
 
 	txm.AssertExpectations(t)
 }
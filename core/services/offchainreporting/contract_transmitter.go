@@ -2,6 +2,7 @@ package offchainreporting
 
 import (
 	"context"
+	"encoding/binary"
 	"math/big"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/libocr/gethwrappers/offchainaggregator"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
@@ -56,7 +58,19 @@ func (oc *OCRContractTransmitter) Transmit(ctx context.Context, report []byte, r
 		return errors.Wrap(err, "abi.Pack failed")
 	}
 
-	return errors.Wrap(oc.transmitter.CreateEthTransaction(ctx, oc.contractAddress, payload), "failed to send Eth transaction")
+	return errors.Wrap(oc.transmitter.CreateEthTransaction(ctx, oc.contractAddress, payload, reportMeta(report)), "failed to send Eth transaction")
+}
+
+// reportMeta extracts the epoch and round from the raw report context, which
+// is the first word of the report: 11 bytes of padding, the 16 byte config
+// digest, the 4 byte epoch and the 1 byte round
+func reportMeta(report []byte) *bulletprooftxmanager.EthTxMeta {
+	if len(report) < 32 {
+		return nil
+	}
+	epoch := binary.BigEndian.Uint32(report[27:31])
+	round := report[31]
+	return &bulletprooftxmanager.EthTxMeta{Epoch: &epoch, Round: &round}
 }
 
 func (oc *OCRContractTransmitter) LatestTransmissionDetails(ctx context.Context) (configDigest ocrtypes.ConfigDigest, epoch uint32, round uint8, latestAnswer ocrtypes.Observation, latestTimestamp time.Time, err error) {
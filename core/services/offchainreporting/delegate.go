@@ -1,6 +1,7 @@
 package offchainreporting
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -178,12 +179,35 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) (services []job.Service, err
 		}
 
 		strategy := bulletprooftxmanager.NewQueueingTxStrategy(jobSpec.ExternalJobID, chain.Config().OCRDefaultTransactionQueueDepth(), chain.Config().OCRSimulateTransactions())
+		origin := bulletprooftxmanager.JobOrigin(jobSpec.ID, jobSpec.Name.ValueOrZero())
+
+		var transmitter ocrcommon.Transmitter
+		if concreteSpec.ForwarderAddress != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), lc.BlockchainTimeout)
+			err = ocrcommon.CheckForwarderAuthorized(ctx, chain.Client(), concreteSpec.ForwarderAddress.Address(), concreteSpec.TransmitterAddress.Address())
+			cancel()
+			if err != nil {
+				return nil, errors.Wrap(err, "cannot transmit through forwarder")
+			}
+			transmitter = ocrcommon.NewForwardingTransmitter(chain.TxManager(), concreteSpec.TransmitterAddress.Address(), concreteSpec.ForwarderAddress.Address(), chain.Config().EvmGasLimitDefault(), strategy, origin, ocrcommon.JobMeta(jobSpec.ID, jobSpec.ExternalJobID))
+		} else {
+			transmitter = ocrcommon.NewTransmitter(chain.TxManager(), concreteSpec.TransmitterAddress.Address(), chain.Config().EvmGasLimitDefault(), strategy, origin, ocrcommon.JobMeta(jobSpec.ID, jobSpec.ExternalJobID))
+		}
+
+		var transmissionBatchWindow time.Duration
+		if concreteSpec.TransmissionBatchWindow != nil {
+			transmissionBatchWindow = concreteSpec.TransmissionBatchWindow.Duration()
+		}
+		// The scheduler is closed after the oracle, so that transmissions
+		// still pending when the job stops are sent
+		scheduler := ocrcommon.NewTransmissionScheduler(transmitter, transmissionBatchWindow, concreteSpec.TransmissionLatestOnly, loggerWith)
+		services = append(services, scheduler)
 
 		contractTransmitter := NewOCRContractTransmitter(
 			concreteSpec.ContractAddress.Address(),
 			contractCaller,
 			contractABI,
-			ocrcommon.NewTransmitter(chain.TxManager(), concreteSpec.TransmitterAddress.Address(), chain.Config().EvmGasLimitDefault(), strategy),
+			scheduler,
 			chain.LogBroadcaster(),
 			tracker,
 			chain.ID(),
@@ -146,6 +146,9 @@ func validateNonBootstrapSpec(tree *toml.Tree, config ValidationConfig, spec job
 			return errors.Errorf("individual max task duration must be < observation timeout")
 		}
 	}
+	if window := spec.OffchainreportingOracleSpec.TransmissionBatchWindow; window != nil && window.Duration() < 0 {
+		return errors.Errorf("transmission batch window must be >= 0")
+	}
 	return nil
 }
 
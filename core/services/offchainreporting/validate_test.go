@@ -79,6 +79,45 @@ answer1      [type=median index=0];
 				assert.False(t, os.OffchainreportingOracleSpec.IsBootstrapPeer)
 			},
 		},
+		{
+			name: "transmission batching",
+			toml: `
+type               = "offchainreporting"
+schemaVersion      = 1
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pPeerID          = "12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq"
+p2pBootstrapPeers  = ["/dns4/chain.link/tcp/1234/p2p/16Uiu2HAm58SP7UL8zsnpeuwHfytLocaqgnyaYKP8wu7qRdrixLju"]
+isBootstrapPeer    = false
+transmissionBatchWindow = "5s"
+transmissionLatestOnly = true
+observationSource = """
+ds1          [type=bridge name=voter_turnout];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 5*time.Second, os.OffchainreportingOracleSpec.TransmissionBatchWindow.Duration())
+				assert.True(t, os.OffchainreportingOracleSpec.TransmissionLatestOnly)
+			},
+		},
+		{
+			name: "negative transmission batch window",
+			toml: `
+type               = "offchainreporting"
+schemaVersion      = 1
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pPeerID          = "12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq"
+p2pBootstrapPeers  = ["/dns4/chain.link/tcp/1234/p2p/16Uiu2HAm58SP7UL8zsnpeuwHfytLocaqgnyaYKP8wu7qRdrixLju"]
+isBootstrapPeer    = false
+transmissionBatchWindow = "-5s"
+observationSource = """
+ds1          [type=bridge name=voter_turnout];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+			},
+		},
 		{
 			name: "decodes bootstrap toml",
 			toml: `
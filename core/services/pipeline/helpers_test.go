@@ -50,3 +50,8 @@ func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore)
 	t.chainSet = cc
 	t.keyStore = keyStore
 }
+
+func (t *ETHTxTask) HelperSetJob(jobID int32, jobName string) {
+	t.jobID = jobID
+	t.jobName = jobName
+}
@@ -268,3 +268,26 @@ func (_m *ORM) UpdateTaskRunResult(taskID uuid.UUID, result pipeline.Result) (pi
 
 	return r0, r1, r2
 }
+
+// UpdateTaskRunResults provides a mock function with given fields: updates
+func (_m *ORM) UpdateTaskRunResults(updates []pipeline.TaskRunResultUpdate) ([]pipeline.Run, error) {
+	ret := _m.Called(updates)
+
+	var r0 []pipeline.Run
+	if rf, ok := ret.Get(0).(func([]pipeline.TaskRunResultUpdate) []pipeline.Run); ok {
+		r0 = rf(updates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]pipeline.TaskRunResultUpdate) error); ok {
+		r1 = rf(updates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
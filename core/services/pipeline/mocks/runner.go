@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+
 	context "context"
 
 	logger "github.com/smartcontractkit/chainlink/core/logger"
@@ -160,6 +162,20 @@ func (_m *Runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) erro
 	return r0
 }
 
+// ResumeRuns provides a mock function with given fields: reqs
+func (_m *Runner) ResumeRuns(reqs []bulletprooftxmanager.ResumeRequest) error {
+	ret := _m.Called(reqs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]bulletprooftxmanager.ResumeRequest) error); ok {
+		r0 = rf(reqs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Run provides a mock function with given fields: ctx, run, l, saveSuccessfulTaskRuns, fn
 func (_m *Runner) Run(ctx context.Context, run *pipeline.Run, l logger.Logger, saveSuccessfulTaskRuns bool, fn func(pg.Queryer) error) (bool, error) {
 	ret := _m.Called(ctx, run, l, saveSuccessfulTaskRuns, fn)
@@ -27,6 +27,7 @@ type ORM interface {
 	DeleteRun(id int64) error
 	StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error)
 	UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error)
+	UpdateTaskRunResults(updates []TaskRunResultUpdate) (runs []Run, err error)
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) (err error)
 	DeleteRunsOlderThan(context.Context, time.Duration) error
 	FindRun(id int64) (Run, error)
@@ -186,43 +187,87 @@ func (o *orm) DeleteRun(id int64) error {
 
 func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
-		sql := `
-		SELECT pipeline_runs.*, pipeline_specs.dot_dag_source "pipeline_spec.dot_dag_source"
-		FROM pipeline_runs
-		JOIN pipeline_task_runs ON (pipeline_task_runs.pipeline_run_id = pipeline_runs.id)
-		JOIN pipeline_specs ON (pipeline_specs.id = pipeline_runs.pipeline_spec_id)
-		WHERE pipeline_task_runs.id = $1 AND pipeline_runs.state in ('running', 'suspended')
-		FOR UPDATE`
-		if err = tx.Get(&run, sql, taskID); err != nil {
+		run, start, err = updateTaskRunResult(tx, taskID, result)
+		if err != nil || !start {
 			return err
 		}
+		// NOTE: can't join and preload in a single query unless explicitly listing all the struct fields...
+		// https://snippets.aktagon.com/snippets/757-how-to-join-two-tables-with-jmoiron-sqlx
+		sql := `SELECT * FROM pipeline_task_runs WHERE pipeline_run_id = $1`
+		return tx.Select(&run.PipelineTaskRuns, sql, run.ID)
+	})
 
-		// Update the task with result
-		sql = `UPDATE pipeline_task_runs SET output = $2, error = $3, finished_at = $4 WHERE id = $1`
-		if _, err = tx.Exec(sql, taskID, result.OutputDB(), result.ErrorDB(), time.Now()); err != nil {
-			return errors.Wrap(err, "UpdateTaskRunResult")
-		}
+	return run, start, err
+}
 
-		if run.State == RunStatusSuspended {
-			start = true
-			run.State = RunStatusRunning
+// TaskRunResultUpdate is the result to save for a task run
+type TaskRunResultUpdate struct {
+	TaskRunID uuid.UUID
+	Result    Result
+}
 
-			// We're going to restart the run, so set it back to "in progress"
-			sql = `UPDATE pipeline_runs SET state = $2 WHERE id = $1`
-			if _, err = tx.Exec(sql, run.ID, run.State); err != nil {
-				return errors.Wrap(err, "UpdateTaskRunResult")
+// UpdateTaskRunResults is UpdateTaskRunResult for several task runs in one
+// database transaction. It returns the suspended runs that must be started
+// again, each once, with all of their task runs. Task runs whose run is no
+// longer running or suspended, e.g. because they were already resumed, are
+// skipped.
+func (o *orm) UpdateTaskRunResults(updates []TaskRunResultUpdate) (runs []Run, err error) {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		runs = nil
+		for _, update := range updates {
+			run, start, err := updateTaskRunResult(tx, update.TaskRunID, update.Result)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			if start {
+				runs = append(runs, run)
+			}
+		}
+		// Task runs are loaded last, so that runs with several task runs in
+		// the batch are started with all of their results
+		for i := range runs {
+			if err := tx.Select(&runs[i].PipelineTaskRuns, `SELECT * FROM pipeline_task_runs WHERE pipeline_run_id = $1`, runs[i].ID); err != nil {
+				return errors.Wrap(err, "UpdateTaskRunResults")
 			}
-
-			// NOTE: can't join and preload in a single query unless explicitly listing all the struct fields...
-			// https://snippets.aktagon.com/snippets/757-how-to-join-two-tables-with-jmoiron-sqlx
-			sql = `SELECT * FROM pipeline_task_runs WHERE pipeline_run_id = $1`
-			return tx.Select(&run.PipelineTaskRuns, sql, run.ID)
 		}
-
 		return nil
 	})
+	return runs, err
+}
 
-	return run, start, err
+// updateTaskRunResult saves the result of the task run, and sets its run back
+// to running if it was suspended, in which case start is true
+func updateTaskRunResult(tx pg.Queryer, taskID uuid.UUID, result Result) (run Run, start bool, err error) {
+	sql := `
+	SELECT pipeline_runs.*, pipeline_specs.dot_dag_source "pipeline_spec.dot_dag_source"
+	FROM pipeline_runs
+	JOIN pipeline_task_runs ON (pipeline_task_runs.pipeline_run_id = pipeline_runs.id)
+	JOIN pipeline_specs ON (pipeline_specs.id = pipeline_runs.pipeline_spec_id)
+	WHERE pipeline_task_runs.id = $1 AND pipeline_runs.state in ('running', 'suspended')
+	FOR UPDATE`
+	if err = tx.Get(&run, sql, taskID); err != nil {
+		return run, false, err
+	}
+
+	// Update the task with result
+	sql = `UPDATE pipeline_task_runs SET output = $2, error = $3, finished_at = $4 WHERE id = $1`
+	if _, err = tx.Exec(sql, taskID, result.OutputDB(), result.ErrorDB(), time.Now()); err != nil {
+		return run, false, errors.Wrap(err, "UpdateTaskRunResult")
+	}
+
+	if run.State == RunStatusSuspended {
+		start = true
+		run.State = RunStatusRunning
+
+		// We're going to restart the run, so set it back to "in progress"
+		sql = `UPDATE pipeline_runs SET state = $2 WHERE id = $1`
+		if _, err = tx.Exec(sql, run.ID, run.State); err != nil {
+			return run, false, errors.Wrap(err, "UpdateTaskRunResult")
+		}
+	}
+	return run, start, nil
 }
 
 // If saveSuccessfulTaskRuns = false, we only save errored runs.
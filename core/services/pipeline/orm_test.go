@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -308,6 +309,43 @@ func Test_PipelineORM_StoreRun_UpdateTaskRunResult(t *testing.T) {
 	require.Equal(t, pipeline.JSONSerializable{Val: "foo", Valid: true}, task.Output)
 }
 
+func Test_PipelineORM_UpdateTaskRunResults(t *testing.T) {
+	_, orm := setupORM(t)
+
+	// A suspended run waiting on two tasks
+	run := mustInsertAsyncRun(t, orm)
+	now := time.Now()
+	ds1ID, ds2ID := uuid.NewV4(), uuid.NewV4()
+	run.PipelineTaskRuns = []pipeline.TaskRun{
+		{ID: ds1ID, PipelineRunID: run.ID, Type: "bridge", DotID: "ds1", CreatedAt: now},
+		{ID: ds2ID, PipelineRunID: run.ID, Type: "bridge", DotID: "ds2", CreatedAt: now},
+	}
+	restart, err := orm.StoreRun(run)
+	require.NoError(t, err)
+	require.False(t, restart)
+	require.Equal(t, pipeline.RunStatusSuspended, run.State)
+
+	runs, err := orm.UpdateTaskRunResults([]pipeline.TaskRunResultUpdate{
+		{TaskRunID: ds1ID, Result: pipeline.Result{Value: "foo"}},
+		{TaskRunID: ds2ID, Result: pipeline.Result{Error: errors.New("bar")}},
+		// Task runs without a running or suspended run are skipped
+		{TaskRunID: uuid.NewV4(), Result: pipeline.Result{Value: "baz"}},
+	})
+	require.NoError(t, err)
+
+	// The run is started once, with both results
+	require.Len(t, runs, 1)
+	assert.Equal(t, run.ID, runs[0].ID)
+	assert.Equal(t, pipeline.RunStatusRunning, runs[0].State)
+	require.Len(t, runs[0].PipelineTaskRuns, 2)
+	ds1 := runs[0].ByDotID("ds1")
+	require.True(t, ds1.FinishedAt.Valid)
+	assert.Equal(t, pipeline.JSONSerializable{Val: "foo", Valid: true}, ds1.Output)
+	ds2 := runs[0].ByDotID("ds2")
+	require.True(t, ds2.FinishedAt.Valid)
+	assert.Equal(t, "bar", ds2.Error.String)
+}
+
 func Test_PipelineORM_DeleteRun(t *testing.T) {
 	_, orm := setupORM(t)
 
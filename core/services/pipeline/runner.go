@@ -14,6 +14,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/recovery"
 	"github.com/smartcontractkit/chainlink/core/services"
@@ -32,6 +33,9 @@ type Runner interface {
 	// Note that `saveSuccessfulTaskRuns` value is ignored if the run contains async tasks.
 	Run(ctx context.Context, run *Run, l logger.Logger, saveSuccessfulTaskRuns bool, fn func(tx pg.Queryer) error) (incomplete bool, err error)
 	ResumeRun(taskID uuid.UUID, value interface{}, err error) error
+	// ResumeRuns is ResumeRun for several task runs at once, see
+	// bulletprooftxmanager.ResumeBatchCallback
+	ResumeRuns(reqs []bulletprooftxmanager.ResumeRequest) error
 
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
@@ -242,6 +246,8 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		case TaskTypeETHTx:
 			task.(*ETHTxTask).keyStore = r.ethKeyStore
 			task.(*ETHTxTask).chainSet = r.chainSet
+			task.(*ETHTxTask).jobID = run.PipelineSpec.JobID
+			task.(*ETHTxTask).jobName = run.PipelineSpec.JobName
 		default:
 		}
 	}
@@ -571,6 +577,27 @@ func (r *runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error
 	return nil
 }
 
+func (r *runner) ResumeRuns(reqs []bulletprooftxmanager.ResumeRequest) error {
+	updates := make([]TaskRunResultUpdate, len(reqs))
+	for i, req := range reqs {
+		updates[i] = TaskRunResultUpdate{TaskRunID: req.ID, Result: Result{Value: req.Result, Error: req.Err}}
+	}
+	runs, err := r.orm.UpdateTaskRunResults(updates)
+	if err != nil {
+		return err
+	}
+
+	for i := range runs {
+		run := runs[i]
+		go func() {
+			if _, err := r.Run(context.Background(), &run, r.lggr, false, nil); err != nil {
+				r.lggr.Errorw("Resume", "err", err)
+			}
+		}()
+	}
+	return nil
+}
+
 func (r *runner) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
 	return r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...)
 }
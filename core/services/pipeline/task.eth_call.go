@@ -94,7 +94,11 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 	}
 
 	start := time.Now()
-	resp, err := chain.Client().CallContract(ctx, call, nil)
+	// Identical calls within one block, e.g. the keeper's checkUpkeep for the
+	// same upkeep, are only sent to the eth node once
+	resp, err := chain.SimulationCache().Call(call, func() ([]byte, error) {
+		return chain.Client().CallContract(ctx, call, nil)
+	})
 	elapsed := time.Since(start)
 	if err != nil {
 		if t.ExtractRevertReason {
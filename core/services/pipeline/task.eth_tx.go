@@ -4,6 +4,8 @@ import (
 	"context"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mitchellh/mapstructure"
@@ -19,6 +21,7 @@ import (
 //
 // Return types:
 //     nil
+//     common.Hash (only when waitForBroadcast is set with minConfirmations=0)
 //
 type ETHTxTask struct {
 	BaseTask         `mapstructure:",squash"`
@@ -30,9 +33,17 @@ type ETHTxTask struct {
 	MinConfirmations string `json:"minConfirmations"`
 	EVMChainID       string `json:"evmChainID" mapstructure:"evmChainID"`
 	Simulate         string `json:"simulate" mapstructure:"simulate"`
+	WaitForBroadcast string `json:"waitForBroadcast" mapstructure:"waitForBroadcast"`
+	Deadline         string `json:"deadline"`
+	PacingTag        string `json:"pacingTag"`
+	PacingInterval   string `json:"pacingInterval"`
 
 	keyStore ETHKeyStore
 	chainSet evm.ChainSet
+	// jobID and jobName identify the job that runs the task, they are
+	// recorded as the origin of the transaction
+	jobID   int32
+	jobName string
 }
 
 //go:generate mockery --name ETHKeyStore --output ./mocks/ --case=underscore
@@ -67,6 +78,10 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		txMetaMap             MapParam
 		maybeMinConfirmations MaybeUint64Param
 		simulate              BoolParam
+		waitForBroadcast      BoolParam
+		maybeDeadline         MaybeUint64Param
+		pacingTag             StringParam
+		pacingInterval        StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -76,6 +91,10 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		errors.Wrap(ResolveParam(&txMetaMap, From(VarExpr(t.TxMeta, vars), JSONWithVarExprs(t.TxMeta, vars, false), MapParam{})), "txMeta"),
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(t.MinConfirmations)), "minConfirmations"),
 		errors.Wrap(ResolveParam(&simulate, From(VarExpr(t.Simulate, vars), NonemptyString(t.Simulate), false)), "simulate"),
+		errors.Wrap(ResolveParam(&waitForBroadcast, From(NonemptyString(t.WaitForBroadcast), false)), "waitForBroadcast"),
+		errors.Wrap(ResolveParam(&maybeDeadline, From(VarExpr(t.Deadline, vars), t.Deadline)), "deadline"),
+		errors.Wrap(ResolveParam(&pacingTag, From(VarExpr(t.PacingTag, vars), t.PacingTag)), "pacingTag"),
+		errors.Wrap(ResolveParam(&pacingInterval, From(VarExpr(t.PacingInterval, vars), t.PacingInterval)), "pacingInterval"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -133,12 +152,41 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		GasLimit:       uint64(gasLimit),
 		Meta:           &txMeta,
 		Strategy:       strategy,
+		Origin:         bulletprooftxmanager.JobOrigin(t.jobID, t.jobName),
 	}
 
+	// The deadline is a unix timestamp in seconds, gas bumping is accelerated
+	// as it approaches
+	if deadline, isSet := maybeDeadline.Uint64(); isSet {
+		d := time.Unix(int64(deadline), 0)
+		newTx.Deadline = &d
+	}
+
+	// Transactions with the same pacing tag, by default those to the same
+	// address, are broadcast at least pacingInterval apart across all keys
+	if strings.TrimSpace(string(pacingInterval)) != "" {
+		interval, err2 := time.ParseDuration(strings.TrimSpace(string(pacingInterval)))
+		if err2 != nil || interval < 0 {
+			return Result{Error: errors.Wrapf(ErrBadInput, "pacingInterval: invalid duration %q", pacingInterval)}, runInfo
+		}
+		newTx.PacingInterval = interval
+		newTx.PacingTag = string(pacingTag)
+		if newTx.PacingTag == "" {
+			newTx.PacingTag = newTx.ToAddress.Hex()
+		}
+	}
+
+	// Only meaningful with minConfirmations=0, otherwise we wait for confirmation anyway
+	resumeOnBroadcast := minOutgoingConfirmations == 0 && bool(waitForBroadcast)
+
 	if minOutgoingConfirmations > 0 {
 		// Store the task run ID, so we can resume the pipeline when tx is confirmed
 		newTx.PipelineTaskRunID = &t.uuid
 		newTx.MinConfirmations = null.Uint32From(uint32(minOutgoingConfirmations))
+	} else if resumeOnBroadcast {
+		// Store the task run ID, so we can resume the pipeline with the tx hash when tx is broadcast
+		newTx.PipelineTaskRunID = &t.uuid
+		txMeta.ResumeOnBroadcast = true
 	}
 
 	_, err = txManager.CreateEthTransaction(newTx)
@@ -146,7 +194,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}, retryableRunInfo()
 	}
 
-	if minOutgoingConfirmations > 0 {
+	if minOutgoingConfirmations > 0 || resumeOnBroadcast {
 		return Result{}, pendingRunInfo()
 	}
 
@@ -3,6 +3,7 @@ package pipeline_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -423,3 +424,225 @@ func TestETHTxTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHTxTask_WaitForBroadcast(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+
+	tests := []struct {
+		name             string
+		minConfirmations string
+		expectResume     bool
+		expectedRunInfo  pipeline.RunInfo
+	}{
+		{"resumes on broadcast with 0 minConfirmations", `0`, true, pipeline.RunInfo{IsPending: true}},
+		{"ignored with > 0 minConfirmations", `3`, false, pipeline.RunInfo{IsPending: true}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ETHTxTask{
+				BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+				To:               "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+				Data:             "foobar",
+				GasLimit:         "12345",
+				TxMeta:           `{ "jobID": 321 }`,
+				MinConfirmations: test.minConfirmations,
+				WaitForBroadcast: "true",
+			}
+
+			keyStore := new(keystoremocks.Eth)
+			keyStore.Test(t)
+			txManager := new(bptxmmocks.TxManager)
+			txManager.Test(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewTestGeneralConfig(t)
+
+			cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+
+			keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+			txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx bulletprooftxmanager.NewTx) bool {
+				if tx.Meta == nil || tx.Meta.ResumeOnBroadcast != test.expectResume || tx.PipelineTaskRunID == nil {
+					return false
+				}
+				return tx.MinConfirmations.Valid != test.expectResume
+			})).Return(bulletprooftxmanager.EthTx{}, nil)
+			task.HelperSetDependencies(cc, keyStore)
+
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			assert.Equal(t, test.expectedRunInfo, runInfo)
+			require.NoError(t, result.Error)
+
+			keyStore.AssertExpectations(t)
+			txManager.AssertExpectations(t)
+		})
+	}
+}
+
+func TestETHTxTask_Deadline(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	deadline := time.Unix(1600000000, 0)
+
+	tests := []struct {
+		name             string
+		deadline         string
+		vars             pipeline.Vars
+		expectedDeadline *time.Time
+	}{
+		{"no deadline", "", pipeline.NewVarsFrom(nil), nil},
+		{"literal deadline", "1600000000", pipeline.NewVarsFrom(nil), &deadline},
+		{"deadline from vars", "$(deadline)", pipeline.NewVarsFrom(map[string]interface{}{"deadline": "1600000000"}), &deadline},
+		{"empty deadline from vars", "$(deadline)", pipeline.NewVarsFrom(map[string]interface{}{"deadline": ""}), nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ETHTxTask{
+				BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+				To:               "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+				Data:             "foobar",
+				GasLimit:         "12345",
+				TxMeta:           `{ "jobID": 321 }`,
+				MinConfirmations: "0",
+				Deadline:         test.deadline,
+			}
+
+			keyStore := new(keystoremocks.Eth)
+			keyStore.Test(t)
+			txManager := new(bptxmmocks.TxManager)
+			txManager.Test(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewTestGeneralConfig(t)
+
+			cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+
+			keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+			txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx bulletprooftxmanager.NewTx) bool {
+				if test.expectedDeadline == nil {
+					return tx.Deadline == nil
+				}
+				return tx.Deadline != nil && tx.Deadline.Equal(*test.expectedDeadline)
+			})).Return(bulletprooftxmanager.EthTx{}, nil)
+			task.HelperSetDependencies(cc, keyStore)
+
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, nil)
+			assert.Equal(t, pipeline.RunInfo{}, runInfo)
+			require.NoError(t, result.Error)
+
+			keyStore.AssertExpectations(t)
+			txManager.AssertExpectations(t)
+		})
+	}
+}
+
+func TestETHTxTask_Pacing(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	tests := []struct {
+		name             string
+		pacingTag        string
+		pacingInterval   string
+		vars             pipeline.Vars
+		expectedTag      string
+		expectedInterval time.Duration
+		expectedErr      bool
+	}{
+		{"not paced", "", "", pipeline.NewVarsFrom(nil), "", 0, false},
+		{"defaults tag to the to address", "", "1s", pipeline.NewVarsFrom(nil), to.Hex(), time.Second, false},
+		{"literal tag", "registry", "1s", pipeline.NewVarsFrom(nil), "registry", time.Second, false},
+		{"from vars", "$(tag)", "$(interval)", pipeline.NewVarsFrom(map[string]interface{}{"tag": "registry", "interval": "500ms"}), "registry", 500 * time.Millisecond, false},
+		{"empty interval from vars", "registry", "$(interval)", pipeline.NewVarsFrom(map[string]interface{}{"interval": ""}), "", 0, false},
+		{"invalid interval", "", "soon", pipeline.NewVarsFrom(nil), "", 0, true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ETHTxTask{
+				BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+				To:               to.Hex(),
+				Data:             "foobar",
+				GasLimit:         "12345",
+				TxMeta:           `{ "jobID": 321 }`,
+				MinConfirmations: "0",
+				PacingTag:        test.pacingTag,
+				PacingInterval:   test.pacingInterval,
+			}
+
+			keyStore := new(keystoremocks.Eth)
+			keyStore.Test(t)
+			txManager := new(bptxmmocks.TxManager)
+			txManager.Test(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewTestGeneralConfig(t)
+
+			cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+
+			keyStore.On("GetRoundRobinAddress", from).Return(from, nil).Maybe()
+			if !test.expectedErr {
+				txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx bulletprooftxmanager.NewTx) bool {
+					return tx.PacingTag == test.expectedTag && tx.PacingInterval == test.expectedInterval
+				})).Return(bulletprooftxmanager.EthTx{}, nil)
+			}
+			task.HelperSetDependencies(cc, keyStore)
+
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, nil)
+			assert.Equal(t, pipeline.RunInfo{}, runInfo)
+			if test.expectedErr {
+				require.Error(t, result.Error)
+				assert.True(t, errors.Is(result.Error, pipeline.ErrBadInput))
+			} else {
+				require.NoError(t, result.Error)
+			}
+
+			txManager.AssertExpectations(t)
+		})
+	}
+}
+
+func TestETHTxTask_Origin(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+
+	task := pipeline.ETHTxTask{
+		BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+		From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+		To:               "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+		Data:             "foobar",
+		GasLimit:         "12345",
+		TxMeta:           `{ "jobID": 321 }`,
+		MinConfirmations: "0",
+	}
+
+	keyStore := new(keystoremocks.Eth)
+	keyStore.Test(t)
+	txManager := new(bptxmmocks.TxManager)
+	txManager.Test(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+
+	keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+	txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx bulletprooftxmanager.NewTx) bool {
+		return tx.Origin == bulletprooftxmanager.JobOrigin(321, "keeper")
+	})).Return(bulletprooftxmanager.EthTx{}, nil)
+	task.HelperSetDependencies(cc, keyStore)
+	task.HelperSetJob(321, "keeper")
+
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+
+	keyStore.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/logger"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -24,7 +25,7 @@ var (
 )
 
 type Transmitter interface {
-	CreateEthTransaction(ctx context.Context, toAddress gethCommon.Address, payload []byte) error
+	CreateEthTransaction(ctx context.Context, toAddress gethCommon.Address, payload []byte, meta *bulletprooftxmanager.EthTxMeta) error
 	FromAddress() gethCommon.Address
 }
 
@@ -77,7 +78,10 @@ func (oc *ContractTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.
 		return errors.Wrap(err, "abi.Pack failed")
 	}
 
-	return errors.Wrap(oc.transmitter.CreateEthTransaction(ctx, oc.contractAddress, payload), "failed to send Eth transaction")
+	epoch, round := reportCtx.Epoch, reportCtx.Round
+	meta := &bulletprooftxmanager.EthTxMeta{Epoch: &epoch, Round: &round}
+
+	return errors.Wrap(oc.transmitter.CreateEthTransaction(ctx, oc.contractAddress, payload, meta), "failed to send Eth transaction")
 }
 
 func (oc *ContractTransmitter) LatestConfigDigestAndEpoch(ctx context.Context) (ocrtypes.ConfigDigest, uint32, error) {
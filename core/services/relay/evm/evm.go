@@ -134,7 +134,7 @@ func (r *Relayer) NewOCR2Provider(externalJobID uuid.UUID, s interface{}) (types
 		contract.Address(),
 		contractCaller,
 		contractABI,
-		ocrcommon.NewTransmitter(chain.TxManager(), transmitterAddress, chain.Config().EvmGasLimitDefault(), strategy),
+		ocrcommon.NewTransmitter(chain.TxManager(), transmitterAddress, chain.Config().EvmGasLimitDefault(), strategy, txm.EthTxOrigin{}, ocrcommon.ExternalJobIDMeta(externalJobID)),
 		tracker,
 		r.lggr,
 	)
@@ -371,6 +371,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 					SubID:     vrfRequest.SubId,
 				},
 				MinConfirmations: null.Uint32From(uint32(lsn.cfg.MinRequiredOutgoingConfirmations())),
+				Origin:           bulletprooftxmanager.JobOrigin(lsn.job.ID, lsn.job.Name.ValueOrZero()),
 				Strategy:         bulletprooftxmanager.NewSendEveryStrategy(false), // We already simd
 			}, pg.WithQueryer(tx))
 			return err
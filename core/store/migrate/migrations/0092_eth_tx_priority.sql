@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE eth_txes ADD COLUMN priority smallint;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE eth_txes DROP COLUMN priority;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+UPDATE pipeline_specs
+SET dot_dag_source = replace(dot_dag_source, 'txMeta="{\"jobID\":$(jobSpec.jobID)}"', 'txMeta="{\"jobID\":$(jobSpec.jobID),\"upkeepID\":$(jobSpec.upkeepID)}"')
+WHERE id IN (
+    SELECT pipeline_spec_id
+    FROM jobs
+    WHERE type = 'keeper'
+);
+
+-- +goose Down
+UPDATE pipeline_specs
+SET dot_dag_source = replace(dot_dag_source, 'txMeta="{\"jobID\":$(jobSpec.jobID),\"upkeepID\":$(jobSpec.upkeepID)}"', 'txMeta="{\"jobID\":$(jobSpec.jobID)}"')
+WHERE id IN (
+    SELECT pipeline_spec_id
+    FROM jobs
+    WHERE type = 'keeper'
+);
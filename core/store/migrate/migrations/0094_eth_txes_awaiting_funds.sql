@@ -0,0 +1,42 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- ALTER TYPE ... ADD VALUE cannot run inside a transaction block on Postgres v11
+ALTER TYPE eth_txes_state ADD VALUE IF NOT EXISTS 'awaiting_funds';
+
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+	state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+	OR
+	state = 'awaiting_funds'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+);
+
+-- +goose Down
+-- Postgres does not support removing a value from an enum, so the
+-- awaiting_funds value is left in place and any such transactions are
+-- returned to the queue instead
+UPDATE eth_txes SET state = 'unstarted' WHERE state = 'awaiting_funds';
+
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+	state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+	OR
+	state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+);
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_tx_attempts ADD COLUMN is_manual boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE eth_tx_attempts DROP COLUMN is_manual;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN simulation_mode text NOT NULL DEFAULT 'fatal_on_revert';
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN simulation_mode;
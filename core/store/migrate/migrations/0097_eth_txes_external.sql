@@ -0,0 +1,48 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- ALTER TYPE ... ADD VALUE cannot run inside a transaction block on Postgres v11
+ALTER TYPE eth_txes_state ADD VALUE IF NOT EXISTS 'external';
+
+-- external transactions are placeholders for a nonce that was reserved for a
+-- transaction sent from outside of the node. broadcast_at is set once the
+-- nonce has been seen to be consumed on chain.
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+	state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+	OR
+	state = 'awaiting_funds'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'external'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL
+	OR
+	state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+);
+
+-- +goose Down
+-- Postgres does not support removing a value from an enum, so the external
+-- value is left in place and the placeholders are deleted instead
+DELETE FROM eth_txes WHERE state = 'external';
+
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+	state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+	OR
+	state = 'awaiting_funds'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+);
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN max_fee_wei numeric(78,0) CHECK (max_fee_wei > 0);
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN max_fee_wei;
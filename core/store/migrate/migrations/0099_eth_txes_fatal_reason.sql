@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TYPE eth_txes_fatal_reason AS ENUM (
+	'simulation_reverted',
+	'too_expensive',
+	'send_fatal',
+	'in_progress_max_age',
+	'max_fee_exceeded',
+	'gas_limit_max_exceeded',
+	'missing_receipt'
+);
+ALTER TABLE eth_txes ADD COLUMN fatal_reason eth_txes_fatal_reason;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fatal_reason CHECK (fatal_reason IS NULL OR state = 'fatal_error'::eth_txes_state);
+CREATE INDEX idx_eth_txes_fatal_reason ON eth_txes (fatal_reason) WHERE fatal_reason IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN fatal_reason;
+DROP TYPE eth_txes_fatal_reason;
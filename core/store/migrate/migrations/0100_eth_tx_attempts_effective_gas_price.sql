@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE eth_tx_attempts ADD COLUMN effective_gas_price numeric(78,0), ADD COLUMN gas_used bigint;
+ALTER TABLE eth_tx_attempts ADD CONSTRAINT chk_eth_tx_attempts_cost_broadcast CHECK ((effective_gas_price IS NULL AND gas_used IS NULL) OR state = 'broadcast'::eth_tx_attempts_state);
+
+-- +goose Down
+ALTER TABLE eth_tx_attempts DROP COLUMN effective_gas_price, DROP COLUMN gas_used;
@@ -0,0 +1,21 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- ALTER TYPE ... ADD VALUE cannot run inside a transaction block on Postgres v11
+ALTER TYPE eth_txes_fatal_reason ADD VALUE IF NOT EXISTS 'max_gas_price_exceeded';
+
+-- skip_reason is set on an unstarted transaction that was held back rather
+-- than sent, skipped_at is when that last happened. Both are cleared once the
+-- transaction is sent.
+ALTER TABLE eth_txes ADD COLUMN skip_reason text, ADD COLUMN skipped_at timestamptz;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_skip_reason CHECK ((skip_reason IS NULL) = (skipped_at IS NULL));
+
+-- unclamped_gas_price is set on an attempt whose gas price (or fee cap) was
+-- lowered to the max gas price, together with the policy that was in effect
+ALTER TABLE eth_tx_attempts ADD COLUMN unclamped_gas_price numeric(78,0), ADD COLUMN max_gas_price_policy text;
+ALTER TABLE eth_tx_attempts ADD CONSTRAINT chk_eth_tx_attempts_unclamped_gas_price CHECK ((unclamped_gas_price IS NULL) = (max_gas_price_policy IS NULL));
+
+-- +goose Down
+ALTER TABLE eth_tx_attempts DROP COLUMN unclamped_gas_price, DROP COLUMN max_gas_price_policy;
+ALTER TABLE eth_txes DROP COLUMN skip_reason, DROP COLUMN skipped_at;
+-- Postgres does not support removing a value from an enum, so the
+-- max_gas_price_exceeded value is left in place
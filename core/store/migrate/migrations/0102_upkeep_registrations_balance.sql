@@ -0,0 +1,7 @@
+-- +goose Up
+-- balance is the upkeep's LINK balance on the registry as of the last sync, it
+-- is NULL until the upkeep is next synced
+ALTER TABLE upkeep_registrations ADD COLUMN balance numeric(78,0);
+
+-- +goose Down
+ALTER TABLE upkeep_registrations DROP COLUMN balance;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- estimator_inputs records what the gas estimator was given and returned for
+-- the attempt, it is only set if GAS_ESTIMATOR_RECORD_INPUTS is enabled
+ALTER TABLE eth_tx_attempts ADD COLUMN estimator_inputs jsonb;
+
+-- +goose Down
+ALTER TABLE eth_tx_attempts DROP COLUMN estimator_inputs;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- deadline is when a time critical transaction must be mined by, gas bumping
+-- accelerates as it approaches (see ETH_TX_DEADLINE_BOOST_CURVE)
+ALTER TABLE eth_txes ADD COLUMN deadline timestamptz;
+CREATE INDEX idx_eth_txes_unconfirmed_deadline ON eth_txes (evm_chain_id, from_address, deadline) WHERE state = 'unconfirmed' AND deadline IS NOT NULL;
+
+-- deadline_boost_factor is set on an attempt created by a boosted bump
+ALTER TABLE eth_tx_attempts ADD COLUMN deadline_boost_factor integer;
+
+-- +goose Down
+ALTER TABLE eth_tx_attempts DROP COLUMN deadline_boost_factor;
+DROP INDEX idx_eth_txes_unconfirmed_deadline;
+ALTER TABLE eth_txes DROP COLUMN deadline;
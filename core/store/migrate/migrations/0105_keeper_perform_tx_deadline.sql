@@ -0,0 +1,17 @@
+-- +goose Up
+UPDATE pipeline_specs
+SET dot_dag_source = replace(dot_dag_source, 'gasLimit="$(jobSpec.performUpkeepGasLimit)"', 'gasLimit="$(jobSpec.performUpkeepGasLimit)" deadline="$(jobSpec.performDeadline)"')
+WHERE id IN (
+    SELECT pipeline_spec_id
+    FROM jobs
+    WHERE type = 'keeper'
+);
+
+-- +goose Down
+UPDATE pipeline_specs
+SET dot_dag_source = replace(dot_dag_source, 'gasLimit="$(jobSpec.performUpkeepGasLimit)" deadline="$(jobSpec.performDeadline)"', 'gasLimit="$(jobSpec.performUpkeepGasLimit)"')
+WHERE id IN (
+    SELECT pipeline_spec_id
+    FROM jobs
+    WHERE type = 'keeper'
+);
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE upkeep_registrations ADD COLUMN paused boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE upkeep_registrations DROP COLUMN paused;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN initial_bumps integer NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN initial_bumps;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE keeper_specs ADD COLUMN min_confirmations integer;
+
+-- +goose Down
+ALTER TABLE keeper_specs DROP COLUMN min_confirmations;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_tx_attempts ADD COLUMN send_log jsonb;
+
+-- +goose Down
+ALTER TABLE eth_tx_attempts DROP COLUMN send_log;
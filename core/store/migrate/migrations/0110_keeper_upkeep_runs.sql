@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE keeper_upkeep_runs (
+    id BIGSERIAL PRIMARY KEY,
+    registry_id bigint NOT NULL REFERENCES keeper_registries(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    upkeep_id bigint NOT NULL,
+    block_height bigint NOT NULL,
+    eth_tx_id bigint REFERENCES eth_txes(id) ON DELETE SET NULL,
+    status text NOT NULL DEFAULT 'pending',
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT chk_keeper_upkeep_runs_status CHECK (status IN ('pending', 'success', 'reverted', 'failed'))
+);
+
+CREATE INDEX idx_keeper_upkeep_runs_registry_id_upkeep_id ON keeper_upkeep_runs (registry_id, upkeep_id, id DESC);
+CREATE INDEX idx_keeper_upkeep_runs_eth_tx_id ON keeper_upkeep_runs (eth_tx_id) WHERE status = 'pending';
+CREATE INDEX idx_keeper_upkeep_runs_created_at ON keeper_upkeep_runs USING brin (created_at);
+
+-- +goose Down
+DROP TABLE keeper_upkeep_runs;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- max_perform_gas_price is the highest gas price (or fee cap) in wei that the
+-- upkeep is performed at, or NULL if there is no ceiling
+ALTER TABLE upkeep_registrations ADD COLUMN max_perform_gas_price numeric(78,0);
+
+-- +goose Down
+ALTER TABLE upkeep_registrations DROP COLUMN max_perform_gas_price;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- eth_broadcaster_heartbeats has a row for every key of every running
+-- EthBroadcaster, refreshed periodically, so that a second instance
+-- broadcasting for the same keys can be detected on start
+CREATE TABLE eth_broadcaster_heartbeats (
+    instance_id uuid NOT NULL,
+    evm_chain_id numeric(78,0) NOT NULL,
+    address bytea NOT NULL,
+    hostname text NOT NULL,
+    heartbeat_at timestamptz NOT NULL,
+    PRIMARY KEY (evm_chain_id, address, instance_id)
+);
+
+-- +goose Down
+DROP TABLE eth_broadcaster_heartbeats;
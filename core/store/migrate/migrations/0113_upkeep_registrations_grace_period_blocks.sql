@@ -0,0 +1,7 @@
+-- +goose Up
+-- grace_period_blocks overrides the keeper's grace period for the upkeep, it
+-- is NULL if the upkeep uses the default
+ALTER TABLE upkeep_registrations ADD COLUMN grace_period_blocks bigint;
+
+-- +goose Down
+ALTER TABLE upkeep_registrations DROP COLUMN grace_period_blocks;
@@ -0,0 +1,28 @@
+-- +goose Up
+-- Transactions with the same pacing_tag are broadcast at least
+-- pacing_interval (in nanoseconds) apart, across all keys
+ALTER TABLE eth_txes ADD COLUMN pacing_tag text, ADD COLUMN pacing_interval bigint NOT NULL DEFAULT 0;
+
+-- perform_pacing_interval is the pacing interval of the perform transactions
+-- of a keeper job, which are paced per registry
+ALTER TABLE keeper_specs ADD COLUMN perform_pacing_interval bigint;
+
+UPDATE pipeline_specs
+SET dot_dag_source = replace(dot_dag_source, 'deadline="$(jobSpec.performDeadline)"', 'deadline="$(jobSpec.performDeadline)" pacingInterval="$(jobSpec.performPacingInterval)"')
+WHERE id IN (
+    SELECT pipeline_spec_id
+    FROM jobs
+    WHERE type = 'keeper'
+);
+
+-- +goose Down
+UPDATE pipeline_specs
+SET dot_dag_source = replace(dot_dag_source, 'deadline="$(jobSpec.performDeadline)" pacingInterval="$(jobSpec.performPacingInterval)"', 'deadline="$(jobSpec.performDeadline)"')
+WHERE id IN (
+    SELECT pipeline_spec_id
+    FROM jobs
+    WHERE type = 'keeper'
+);
+
+ALTER TABLE keeper_specs DROP COLUMN perform_pacing_interval;
+ALTER TABLE eth_txes DROP COLUMN pacing_tag, DROP COLUMN pacing_interval;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- tx_priority is the priority given to the transactions created by the job,
+-- see ETH_TX_QUEUE_ORDERING=priority. It is NULL if the job doesn't set one.
+ALTER TABLE jobs ADD COLUMN tx_priority bigint;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN tx_priority;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- gas_limit and max_gas_price_gwei override the chain's defaults for the job's
+-- submissions, they are NULL if the job uses the defaults
+ALTER TABLE flux_monitor_specs ADD COLUMN gas_limit bigint CHECK (gas_limit > 0);
+ALTER TABLE flux_monitor_specs ADD COLUMN max_gas_price_gwei numeric CHECK (max_gas_price_gwei > 0);
+
+-- +goose Down
+ALTER TABLE flux_monitor_specs DROP COLUMN gas_limit;
+ALTER TABLE flux_monitor_specs DROP COLUMN max_gas_price_gwei;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- evm_chain_config_profiles records the config profile last applied to each
+-- chain, and the overrides it expanded into, so that later changes away from
+-- the profile can be reported
+CREATE TABLE evm_chain_config_profiles (
+    evm_chain_id numeric(78,0) PRIMARY KEY REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    name text NOT NULL,
+    version integer NOT NULL,
+    cfg jsonb NOT NULL,
+    applied_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE evm_chain_config_profiles;
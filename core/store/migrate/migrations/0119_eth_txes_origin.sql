@@ -0,0 +1,16 @@
+-- +goose Up
+-- The origin of a transaction is the job that created it, or the user and
+-- credential that created it through the API. There is no foreign key to
+-- jobs, so that the origin of a deleted job's transactions is kept.
+ALTER TABLE eth_txes
+    ADD COLUMN origin_job_id integer,
+    ADD COLUMN origin_job_name text,
+    ADD COLUMN origin_user text,
+    ADD COLUMN origin_credential text;
+
+-- +goose Down
+ALTER TABLE eth_txes
+    DROP COLUMN origin_job_id,
+    DROP COLUMN origin_job_name,
+    DROP COLUMN origin_user,
+    DROP COLUMN origin_credential;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN strict_sequencing boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN strict_sequencing;
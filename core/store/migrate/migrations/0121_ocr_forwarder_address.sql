@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE offchainreporting_oracle_specs ADD COLUMN forwarder_address bytea CHECK (octet_length(forwarder_address) = 20);
+
+-- +goose Down
+ALTER TABLE offchainreporting_oracle_specs DROP COLUMN forwarder_address;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Rolling statistics of the gas used by recent successful transactions to
+-- each contract method, identified by its address and 4-byte selector,
+-- aggregated from stored receipts
+CREATE TABLE eth_gas_used_stats (
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    to_address bytea NOT NULL CHECK (octet_length(to_address) = 20),
+    selector bytea NOT NULL CHECK (octet_length(selector) = 4),
+    samples integer NOT NULL,
+    p50_gas_used bigint NOT NULL,
+    p99_gas_used bigint NOT NULL,
+    max_gas_used bigint NOT NULL,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (evm_chain_id, to_address, selector)
+);
+
+-- +goose Down
+DROP TABLE eth_gas_used_stats;
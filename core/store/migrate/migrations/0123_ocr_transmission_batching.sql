@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE offchainreporting_oracle_specs ADD COLUMN transmission_batch_window BIGINT;
+ALTER TABLE offchainreporting_oracle_specs ADD COLUMN transmission_latest_only BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE offchainreporting_oracle_specs DROP COLUMN transmission_batch_window;
+ALTER TABLE offchainreporting_oracle_specs DROP COLUMN transmission_latest_only;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN external_hash bytea;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_external_hash CHECK (external_hash IS NULL OR (state = 'external' AND octet_length(external_hash) = 32));
+
+-- +goose Down
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_external_hash;
+ALTER TABLE eth_txes DROP COLUMN external_hash;
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX idx_eth_txes_meta_job_id_upkeep_id ON eth_txes (((meta->>'JobID')::int), ((meta->>'UpkeepID')::bigint)) WHERE meta->>'UpkeepID' IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_eth_txes_meta_job_id_upkeep_id;
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX idx_eth_txes_fatal_round_submissions ON eth_txes (to_address, ((meta->>'RoundID')::int)) WHERE state = 'fatal_error' AND meta->>'RoundID' IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_eth_txes_fatal_round_submissions;
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX idx_eth_txes_confirmed_method ON eth_txes (evm_chain_id, to_address, substring(encoded_payload FROM 1 FOR 4), id) WHERE state = 'confirmed';
+
+-- +goose Down
+DROP INDEX idx_eth_txes_confirmed_method;
@@ -0,0 +1,8 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- ALTER TYPE ... ADD VALUE cannot run inside a transaction block on Postgres v11
+ALTER TYPE eth_txes_fatal_reason ADD VALUE IF NOT EXISTS 'max_initial_bumps';
+
+-- +goose Down
+-- Postgres does not support removing a value from an enum, so the
+-- max_initial_bumps value is left in place
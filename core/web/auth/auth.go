@@ -32,6 +32,10 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionAuthenticatedByTokenKey is set in the session map when the User
+	// was authenticated by their API token rather than a session cookie
+	SessionAuthenticatedByTokenKey = "authenticated_by_token"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -96,6 +100,7 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 	}
 
 	c.Set(SessionUserKey, &user)
+	c.Set(SessionAuthenticatedByTokenKey, true)
 
 	return nil
 }
@@ -169,6 +174,12 @@ func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	return user, ok
 }
 
+// IsAuthenticatedByToken returns true if the authenticated user was
+// authenticated by their API token rather than a session cookie.
+func IsAuthenticatedByToken(c *gin.Context) bool {
+	return c.GetBool(SessionAuthenticatedByTokenKey)
+}
+
 // GetAuthenticatedExternalInitiator extracts the external initiator from the
 // context.
 func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiator, bool) {
@@ -58,6 +58,7 @@ func TestAuthenticateByToken_Success(t *testing.T) {
 	router.Use(webauth.Authenticate(authr, webauth.AuthenticateByToken))
 	router.GET("/", func(c *gin.Context) {
 		called = true
+		assert.True(t, webauth.IsAuthenticatedByToken(c))
 		c.String(http.StatusOK, "")
 	})
 
@@ -1,12 +1,20 @@
 package web
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
@@ -27,7 +35,7 @@ func (cc *ChainsController) Index(c *gin.Context, size, page, offset int) {
 
 	var resources []presenters.ChainResource
 	for _, chain := range chains {
-		resources = append(resources, presenters.NewChainResource(chain))
+		resources = append(resources, cc.newChainResource(chain))
 	}
 
 	paginatedResponse(c, "chain", size, page, resources, count, err)
@@ -52,7 +60,19 @@ func (cc *ChainsController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewChainResource(chain), "chain")
+	jsonAPIResponse(c, cc.newChainResource(chain), "chain")
+}
+
+// newChainResource includes the startup status of the chain, if it is loaded
+func (cc *ChainsController) newChainResource(chain types.Chain) presenters.ChainResource {
+	r := presenters.NewChainResource(chain)
+	if !chain.Enabled {
+		return r
+	}
+	if status, err := cc.App.GetChainSet().Status(chain.ID.ToInt()); err == nil {
+		r.Status = &status
+	}
+	return r
 }
 
 func (cc *ChainsController) Create(c *gin.Context) {
@@ -70,12 +90,17 @@ func (cc *ChainsController) Create(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponseWithStatus(c, presenters.NewChainResource(chain), "chain", http.StatusCreated)
+	jsonAPIResponseWithStatus(c, cc.newChainResource(chain), "chain", http.StatusCreated)
 }
 
 type UpdateChainRequest struct {
 	Enabled bool           `json:"enabled"`
 	Config  types.ChainCfg `json:"config"`
+	// Validate checks the new config against the live chain before applying
+	// it, and refuses to apply it if any errors are found
+	Validate bool `json:"validate"`
+	// Force applies the config even if validation found errors
+	Force bool `json:"force"`
 }
 
 func (cc *ChainsController) Update(c *gin.Context) {
@@ -92,6 +117,18 @@ func (cc *ChainsController) Update(c *gin.Context) {
 		return
 	}
 
+	if request.Validate {
+		report, verr := cc.App.GetChainSet().ValidateConfig(c.Request.Context(), id.ToInt(), evm.ReplaceConfig(request.Config))
+		if verr != nil {
+			jsonAPIError(c, http.StatusBadRequest, verr)
+			return
+		}
+		if report.HasErrors() && !request.Force {
+			jsonAPIError(c, http.StatusUnprocessableEntity, report.Err())
+			return
+		}
+	}
+
 	chain, err := cc.App.GetChainSet().Configure(id.ToInt(), request.Enabled, request.Config)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -102,9 +139,203 @@ func (cc *ChainsController) Update(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewChainResource(chain), "chain")
+	jsonAPIResponse(c, cc.newChainResource(chain), "chain")
+}
+
+// ValidateConfig checks a proposed config delta against the live chain
+// without persisting anything.
+// Example:
+// "POST <application>/chains/evm/:ID/config/validate"
+func (cc *ChainsController) ValidateConfig(c *gin.Context) {
+	id := utils.Big{}
+	err := id.UnmarshalText([]byte(c.Param("ID")))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var delta types.ChainCfg
+	if err = c.ShouldBindJSON(&delta); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	report, err := cc.App.GetChainSet().ValidateConfig(c.Request.Context(), id.ToInt(), evm.MergeConfig(delta))
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewChainConfigValidationResource(id, report), "chainConfigValidation")
+}
+
+// Profiles lists the config profiles that can be applied to a chain.
+// Example:
+// "GET <application>/chain_profiles/evm"
+func (cc *ChainsController) Profiles(c *gin.Context) {
+	var resources []presenters.ChainConfigProfileResource
+	for _, profile := range evm.ConfigProfiles() {
+		resources = append(resources, presenters.NewChainConfigProfileResource(profile))
+	}
+
+	jsonAPIResponse(c, resources, "chainConfigProfile")
+}
+
+type ApplyChainProfileRequest struct {
+	Name string `json:"name"`
+	// Force applies the profile even if validation found errors
+	Force bool `json:"force"`
+}
+
+// ApplyProfile expands a config profile into the chain's config, after
+// validating the result against the live chain.
+// Example:
+// "POST <application>/chains/evm/:ID/profile"
+func (cc *ChainsController) ApplyProfile(c *gin.Context) {
+	id := utils.Big{}
+	err := id.UnmarshalText([]byte(c.Param("ID")))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var request ApplyChainProfileRequest
+	if err = c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if _, err = evm.GetConfigProfile(request.Name); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	chain, _, err := cc.App.GetChainSet().ApplyConfigProfile(c.Request.Context(), id.ToInt(), request.Name, request.Force)
+	if errors.Is(err, evm.ErrConfigValidationFailed) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, cc.newChainResource(chain), "chain")
 }
 
+// ProfileDrift reports the settings of a chain that were changed away from
+// the config profile last applied to it.
+// Example:
+// "GET <application>/chains/evm/:ID/profile/drift"
+func (cc *ChainsController) ProfileDrift(c *gin.Context) {
+	id := utils.Big{}
+	err := id.UnmarshalText([]byte(c.Param("ID")))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	drift, err := cc.App.GetChainSet().ConfigProfileDrift(id.ToInt())
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("no config profile has been applied to this chain"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewChainConfigProfileDriftResource(id, drift), "chainConfigProfileDrift")
+}
+
+// Pending summarizes the outstanding work on a chain, and whether it is safe
+// to restart the node with respect to it. Thresholds for each kind of work
+// may be given as query params and default to zero. Unstarted transactions
+// survive a restart and never make it unsafe.
+// Example:
+// "GET <application>/chains/evm/:ID/pending?maxUnconfirmed=5"
+func (cc *ChainsController) Pending(c *gin.Context) {
+	id := utils.Big{}
+	err := id.UnmarshalText([]byte(c.Param("ID")))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	thresholds := make(map[string]int64, len(pendingWorkThresholdParams))
+	for _, param := range pendingWorkThresholdParams {
+		v := c.Query(param)
+		if v == "" {
+			continue
+		}
+		thresholds[param], err = strconv.ParseInt(v, 10, 64)
+		if err != nil || thresholds[param] < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("%s must be a non-negative integer", param))
+			return
+		}
+	}
+
+	chain, err := cc.App.GetChainSet().Get(id.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), pg.DefaultQueryTimeout)
+	defer cancel()
+	qopt := pg.WithParentCtx(ctx)
+
+	q := pg.NewQ(cc.App.GetSqlxDB(), cc.App.GetLogger(), cc.App.GetConfig()).WithOpts(qopt)
+	counts, err := cc.App.BPTXMORM().CountEthTxesByState(id.ToInt(), qopt)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	callbacks, err := cc.App.BPTXMORM().CountUnresumedPipelineCallbacks(id.ToInt(), qopt)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	performs, err := keeper.CountPendingPerformTxes(q, id.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	submissions, err := fluxmonitorv2.CountPendingSubmissions(q, id.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	r := presenters.ChainPendingWorkResource{
+		JAID:                          presenters.NewJAIDInt64(id.ToInt().Int64()),
+		EthTxesUnstarted:              counts[bulletprooftxmanager.EthTxUnstarted],
+		EthTxesInProgress:             counts[bulletprooftxmanager.EthTxInProgress],
+		EthTxesUnconfirmed:            counts[bulletprooftxmanager.EthTxUnconfirmed],
+		EthTxesStuckInProgress:        len(chain.TxManager().StuckInProgress()),
+		KeeperPerformsPending:         performs,
+		FluxMonitorSubmissionsPending: submissions,
+		PipelineCallbacksPending:      callbacks,
+		Reasons:                       []string{},
+	}
+	for _, check := range []struct {
+		param string
+		count int64
+	}{
+		{"maxInProgress", r.EthTxesInProgress},
+		{"maxUnconfirmed", r.EthTxesUnconfirmed},
+		{"maxKeeperPerforms", r.KeeperPerformsPending},
+		{"maxFluxMonitorSubmissions", r.FluxMonitorSubmissionsPending},
+		{"maxPipelineCallbacks", r.PipelineCallbacksPending},
+	} {
+		if check.count > thresholds[check.param] {
+			r.Reasons = append(r.Reasons, fmt.Sprintf("%d exceeds %s of %d", check.count, check.param, thresholds[check.param]))
+		}
+	}
+	r.SafeToRestart = len(r.Reasons) == 0
+
+	jsonAPIResponse(c, r, "chainPendingWork")
+}
+
+var pendingWorkThresholdParams = []string{"maxInProgress", "maxUnconfirmed", "maxKeeperPerforms", "maxFluxMonitorSubmissions", "maxPipelineCallbacks"}
+
 func (cc *ChainsController) Delete(c *gin.Context) {
 	id := utils.Big{}
 	err := id.UnmarshalText([]byte(c.Param("ID")))
@@ -10,8 +10,10 @@ import (
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
@@ -320,6 +322,129 @@ func Test_ChainsController_Update(t *testing.T) {
 	}
 }
 
+func Test_ChainsController_ValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	delta := types.ChainCfg{EvmFinalityDepth: null.IntFrom(10)}
+	body, err := json.Marshal(delta)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		inputId        string
+		wantStatusCode int
+	}{
+		{
+			inputId:        "invalidid",
+			name:           "invalid id",
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			inputId:        "341212",
+			name:           "chain not loaded",
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := setupChainsControllerTest(t)
+
+			resp, cleanup := controller.client.Post(
+				fmt.Sprintf("/v2/chains/evm/%s/config/validate", tc.inputId),
+				bytes.NewReader(body),
+			)
+			t.Cleanup(cleanup)
+			require.Equal(t, tc.wantStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func Test_ChainsController_Profiles(t *testing.T) {
+	t.Parallel()
+
+	controller := setupChainsControllerTest(t)
+
+	resp, cleanup := controller.client.Get("/v2/chain_profiles/evm")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var resources []presenters.ChainConfigProfileResource
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources)
+	require.NoError(t, err)
+	require.Len(t, resources, len(evm.ConfigProfiles()))
+
+	names := make([]string, len(resources))
+	for i, r := range resources {
+		names[i] = r.ID
+	}
+	assert.Contains(t, names, "fast-l2")
+	assert.Contains(t, names, "congested-l1")
+}
+
+func Test_ChainsController_ApplyProfile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		inputId        string
+		profile        string
+		wantStatusCode int
+	}{
+		{
+			inputId:        "invalidid",
+			profile:        "fast-l2",
+			name:           "invalid id",
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			inputId:        "0",
+			profile:        "no-such-chain",
+			name:           "unknown profile",
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			inputId:        "341212",
+			profile:        "fast-l2",
+			name:           "chain not loaded",
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := setupChainsControllerTest(t)
+
+			body, err := json.Marshal(web.ApplyChainProfileRequest{Name: tc.profile})
+			require.NoError(t, err)
+			resp, cleanup := controller.client.Post(
+				fmt.Sprintf("/v2/chains/evm/%s/profile", tc.inputId),
+				bytes.NewReader(body),
+			)
+			t.Cleanup(cleanup)
+			require.Equal(t, tc.wantStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func Test_ChainsController_ProfileDrift_NoProfile(t *testing.T) {
+	t.Parallel()
+
+	controller := setupChainsControllerTest(t)
+
+	resp, cleanup := controller.client.Get("/v2/chains/evm/0/profile/drift")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
 func Test_ChainsController_Delete(t *testing.T) {
 	t.Parallel()
 
@@ -388,6 +513,66 @@ type TestChainsController struct {
 	client cltest.HTTPClientCleaner
 }
 
+func Test_ChainsController_Pending(t *testing.T) {
+	t.Parallel()
+
+	controller := setupChainsControllerTest(t)
+	app := controller.app
+	borm := app.BPTXMORM()
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+
+	getPending := func(t *testing.T, query string) presenters.ChainPendingWorkResource {
+		resp, cleanup := controller.client.Get(fmt.Sprintf("/v2/chains/evm/%s/pending%s", cltest.FixtureChainID.String(), query))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resource presenters.ChainPendingWorkResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resource))
+		return resource
+	}
+
+	t.Run("with only unstarted transactions, is safe to restart", func(t *testing.T) {
+		etx := cltest.NewEthTx(t, fromAddress)
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		resource := getPending(t, "")
+		assert.Equal(t, int64(1), resource.EthTxesUnstarted)
+		assert.Equal(t, int64(0), resource.EthTxesInProgress)
+		assert.Equal(t, int64(0), resource.EthTxesUnconfirmed)
+		assert.True(t, resource.SafeToRestart)
+		assert.Empty(t, resource.Reasons)
+	})
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+	pgtest.MustExec(t, app.GetSqlxDB(), `UPDATE eth_txes SET meta = '{"UpkeepID": 1}' WHERE id = $1`, etx.ID)
+
+	t.Run("with an unconfirmed keeper perform, is not safe to restart", func(t *testing.T) {
+		resource := getPending(t, "")
+		assert.Equal(t, int64(1), resource.EthTxesUnconfirmed)
+		assert.Equal(t, int64(1), resource.KeeperPerformsPending)
+		assert.False(t, resource.SafeToRestart)
+		assert.Len(t, resource.Reasons, 2)
+	})
+
+	t.Run("with thresholds covering the pending work, is safe to restart", func(t *testing.T) {
+		resource := getPending(t, "?maxUnconfirmed=1&maxKeeperPerforms=1")
+		assert.True(t, resource.SafeToRestart)
+		assert.Empty(t, resource.Reasons)
+	})
+
+	t.Run("rejects invalid thresholds", func(t *testing.T) {
+		resp, cleanup := controller.client.Get(fmt.Sprintf("/v2/chains/evm/%s/pending?maxUnconfirmed=-1", cltest.FixtureChainID.String()))
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("chain not loaded", func(t *testing.T) {
+		resp, cleanup := controller.client.Get("/v2/chains/evm/341212/pending")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
 func setupChainsControllerTest(t *testing.T) *TestChainsController {
 	// Using this instead of `NewApplicationEVMDisabled` since we need the chain set to be loaded in the app
 	// for the sake of the API endpoints to work properly
@@ -10,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/balancemonitor"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -58,7 +59,9 @@ func (ekc *ETHKeysController) Index(c *gin.Context) {
 		r, err := presenters.NewETHKeyResource(key, state,
 			ekc.setEthBalance(c.Request.Context(), state),
 			ekc.setLinkBalance(state),
+			ekc.setTokenBalances(state),
 			ekc.setKeyMaxGasPriceWei(state, key.Address.Address()),
+			ekc.setKeyParked(state),
 		)
 		if err != nil {
 			jsonAPIError(c, http.StatusInternalServerError, err)
@@ -124,6 +127,7 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 	r, err := presenters.NewETHKeyResource(key, state,
 		ekc.setEthBalance(c.Request.Context(), state),
 		ekc.setLinkBalance(state),
+		ekc.setTokenBalances(state),
 		ekc.setKeyMaxGasPriceWei(state, key.Address.Address()),
 	)
 	if err != nil {
@@ -174,6 +178,7 @@ func (ekc *ETHKeysController) Update(c *gin.Context) {
 	r, err := presenters.NewETHKeyResource(key, state,
 		ekc.setEthBalance(c.Request.Context(), state),
 		ekc.setLinkBalance(state),
+		ekc.setTokenBalances(state),
 		ekc.setKeyMaxGasPriceWei(state, key.Address.Address()),
 	)
 	if err != nil {
@@ -226,6 +231,7 @@ func (ekc *ETHKeysController) Delete(c *gin.Context) {
 	r, err := presenters.NewETHKeyResource(key, state,
 		ekc.setEthBalance(c.Request.Context(), state),
 		ekc.setLinkBalance(state),
+		ekc.setTokenBalances(state),
 	)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
@@ -273,6 +279,7 @@ func (ekc *ETHKeysController) Import(c *gin.Context) {
 	r, err := presenters.NewETHKeyResource(key, state,
 		ekc.setEthBalance(c.Request.Context(), state),
 		ekc.setLinkBalance(state),
+		ekc.setTokenBalances(state),
 	)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
@@ -296,6 +303,66 @@ func (ekc *ETHKeysController) Export(c *gin.Context) {
 	c.Data(http.StatusOK, MediaType, bytes)
 }
 
+// BumpAllRequest is the request body of BumpAll. GasPriceOrFeeCapWei is the
+// gas price of legacy transactions and the fee cap of dynamic fee
+// transactions, TipCapWei is only required if there are dynamic fee
+// transactions to bump.
+type BumpAllRequest struct {
+	GasPriceOrFeeCapWei *utils.Big `json:"gasPriceOrFeeCapWei"`
+	TipCapWei           *utils.Big `json:"tipCapWei"`
+	DryRun              bool       `json:"dryRun"`
+}
+
+// BumpAll replaces every unconfirmed transaction of the key with a new
+// attempt at the given pricing, and returns what was done with each of them.
+// With dryRun nothing is sent, and it only reports which transactions would
+// be bumped and at what additional cost.
+// Example:
+// "POST <application>/keys/eth/:keyID/bump_all"
+func (ekc *ETHKeysController) BumpAll(c *gin.Context) {
+	var request BumpAllRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.GasPriceOrFeeCapWei == nil || request.GasPriceOrFeeCapWei.ToInt().Sign() <= 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("gasPriceOrFeeCapWei must be greater than zero"))
+		return
+	}
+	var tipCapWei *big.Int
+	if request.TipCapWei != nil {
+		tipCapWei = request.TipCapWei.ToInt()
+	}
+
+	keyID := c.Param("keyID")
+	if !common.IsHexAddress(keyID) {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("invalid address %s", keyID))
+		return
+	}
+	state, err := ekc.App.GetKeyStore().Eth().GetState(keyID)
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	chain, err := ekc.App.GetChainSet().Get(state.EVMChainID.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	results, err := chain.TxManager().BumpAllUnconfirmed(c.Request.Context(), state.Address.Address(), request.GasPriceOrFeeCapWei.ToInt(), tipCapWei, request.DryRun)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := make([]presenters.EthTxBumpResource, len(results))
+	for i, result := range results {
+		resources[i] = presenters.NewEthTxBumpResource(result)
+	}
+	jsonAPIResponse(c, resources, "ethTxBumps")
+}
+
 // setEthBalance is a custom functional option for NewEthKeyResource which
 // queries the EthClient for the ETH balance at the address and sets it on the
 // resource.
@@ -347,6 +414,43 @@ func (ekc *ETHKeysController) setLinkBalance(state ethkey.State) presenters.NewE
 	}
 }
 
+// setTokenBalances is a custom functional option for NewEthKeyResource which
+// sets the latest ERC-20 token balances recorded by the BalanceMonitor. No
+// tokens are set if the BalanceMonitor is disabled.
+func (ekc *ETHKeysController) setTokenBalances(state ethkey.State) presenters.NewETHKeyOption {
+	var balances []balancemonitor.TokenBalance
+	chain, err := ekc.App.GetChainSet().Get(state.EVMChainID.ToInt())
+	if err == nil && chain.BalanceMonitor() != nil {
+		balances = chain.BalanceMonitor().GetTokenBalances(state.Address.Address())
+	}
+
+	return func(r *presenters.ETHKeyResource) error {
+		if errors.Cause(err) == evm.ErrNoChains {
+			return nil
+		}
+		if err != nil {
+			return errors.Errorf("error getting EVM Chain: %v", err)
+		}
+
+		tokenBalances := make([]presenters.ETHKeyTokenBalance, len(balances))
+		for i, b := range balances {
+			tokenBalances[i] = presenters.ETHKeyTokenBalance{
+				Address:      b.Token.Hex(),
+				Symbol:       b.Symbol,
+				Decimals:     b.Decimals,
+				Balance:      *utils.NewBig(b.Balance),
+				BelowMinimum: b.BelowMinimum(),
+			}
+			if b.MinBalance != nil {
+				tokenBalances[i].MinBalance = utils.NewBig(b.MinBalance)
+			}
+		}
+		r.TokenBalances = tokenBalances
+
+		return nil
+	}
+}
+
 // setKeyMaxGasPriceWei is a custom functional option for NewEthKeyResource which
 // gets the key specific max gas price from the chain config and sets it on the
 // resource.
@@ -370,3 +474,27 @@ func (ekc *ETHKeysController) setKeyMaxGasPriceWei(state ethkey.State, keyAddres
 		return nil
 	}
 }
+
+// setKeyParked is a custom functional option for NewEthKeyResource which
+// sets whether the key is currently parked by the EthBroadcaster for being
+// idle. A parked key is still healthy.
+func (ekc *ETHKeysController) setKeyParked(state ethkey.State) presenters.NewETHKeyOption {
+	var parked bool
+	chain, err := ekc.App.GetChainSet().Get(state.EVMChainID.ToInt())
+	if err == nil {
+		parked = chain.TxManager().KeyParked(state.Address.Address())
+	}
+
+	return func(r *presenters.ETHKeyResource) error {
+		if errors.Cause(err) == evm.ErrNoChains {
+			return nil
+		}
+		if err != nil {
+			return errors.Errorf("error getting EVM Chain: %v", err)
+		}
+
+		r.Parked = parked
+
+		return nil
+	}
+}
@@ -0,0 +1,41 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// GasUsedStatsController displays the gas used statistics of the contract
+// methods transactions were sent to
+type GasUsedStatsController struct {
+	App chainlink.Application
+}
+
+// Index returns the gas used statistics of every contract method on a chain
+// Example:
+// "GET <application>/gas_used_stats?evmChainID=1"
+func (gc *GasUsedStatsController) Index(c *gin.Context) {
+	chain, err := getChain(gc.App.GetChainSet(), c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	stats, err := chain.TxManager().GasUsedStats()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewGasUsedStatsResources(stats), "gasUsedStats")
+}
@@ -0,0 +1,42 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestGasUsedStatsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	toAddress := cltest.NewAddress()
+	pgtest.MustExec(t, app.GetSqlxDB(), `INSERT INTO eth_gas_used_stats (evm_chain_id, to_address, selector, samples, p50_gas_used, p99_gas_used, max_gas_used, updated_at)
+VALUES ($1, $2, '\xc9807539', 3, 100000, 120000, 120000, NOW())`, cltest.FixtureChainID.String(), toAddress)
+
+	response, cleanup := client.Get("/v2/gas_used_stats")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var stats []presenters.GasUsedStatsResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &stats))
+	require.Len(t, stats, 1)
+	assert.Equal(t, toAddress, stats[0].ToAddress)
+	assert.Equal(t, "0xc9807539", stats[0].Selector.String())
+	assert.Equal(t, int32(3), stats[0].Samples)
+	assert.Equal(t, int64(120000), stats[0].P99GasUsed)
+
+	response, cleanup = client.Get("/v2/gas_used_stats?evmChainID=foo")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,105 @@
+package web
+
+import (
+	"sync"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// jobTxQueueCacheTTL is how long a job's tx queue summary is cached, since
+// listing jobs would otherwise query eth_txes for every job on every request
+const jobTxQueueCacheTTL = 5 * time.Second
+
+// hasTxSubject returns true for job types that queue their transactions
+// under the job's external job ID (see TxStrategy#Subject)
+func hasTxSubject(t job.Type) bool {
+	switch t {
+	case job.Keeper, job.FluxMonitor, job.OffchainReporting:
+		return true
+	default:
+		return false
+	}
+}
+
+type cachedTxQueueSummary struct {
+	summary   bulletprooftxmanager.TxQueueSummary
+	expiresAt time.Time
+}
+
+// jobTxQueueCache caches tx queue summaries by subject
+type jobTxQueueCache struct {
+	mu      sync.Mutex
+	entries map[uuid.UUID]cachedTxQueueSummary
+}
+
+func newJobTxQueueCache() *jobTxQueueCache {
+	return &jobTxQueueCache{entries: make(map[uuid.UUID]cachedTxQueueSummary)}
+}
+
+// summaries returns the tx queue summary of each subject, only querying the
+// ORM for those that are not cached
+func (c *jobTxQueueCache) summaries(orm bulletprooftxmanager.ORM, subjects []uuid.UUID, qopts ...pg.QOpt) (map[uuid.UUID]bulletprooftxmanager.TxQueueSummary, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for s, e := range c.entries {
+		if now.After(e.expiresAt) {
+			delete(c.entries, s)
+		}
+	}
+
+	summaries := make(map[uuid.UUID]bulletprooftxmanager.TxQueueSummary, len(subjects))
+	var missing []uuid.UUID
+	for _, s := range subjects {
+		if e, exists := c.entries[s]; exists {
+			summaries[s] = e.summary
+		} else {
+			missing = append(missing, s)
+		}
+	}
+	if len(missing) == 0 {
+		return summaries, nil
+	}
+
+	fetched, err := orm.TxQueueSummaries(missing, qopts...)
+	if err != nil {
+		return nil, err
+	}
+	for s, summary := range fetched {
+		summaries[s] = summary
+		c.entries[s] = cachedTxQueueSummary{summary, now.Add(jobTxQueueCacheTTL)}
+	}
+	return summaries, nil
+}
+
+// setTxQueues sets the tx queue summary on every resource for a job with a tx
+// subject
+func (c *jobTxQueueCache) setTxQueues(orm bulletprooftxmanager.ORM, jobs []job.Job, resources []presenters.JobResource, qopts ...pg.QOpt) error {
+	var subjects []uuid.UUID
+	for _, j := range jobs {
+		if hasTxSubject(j.Type) {
+			subjects = append(subjects, j.ExternalJobID)
+		}
+	}
+	if len(subjects) == 0 {
+		return nil
+	}
+	summaries, err := c.summaries(orm, subjects, qopts...)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for i, j := range jobs {
+		if summary, exists := summaries[j.ExternalJobID]; exists && hasTxSubject(j.Type) {
+			resources[i].TxQueue = presenters.NewJobTxQueue(summary, now)
+		}
+	}
+	return nil
+}
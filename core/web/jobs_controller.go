@@ -3,7 +3,9 @@ package web
 import (
 	"context"
 	"database/sql"
+	"math/big"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting2"
@@ -11,7 +13,9 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
@@ -23,12 +27,20 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
 // JobsController manages jobs
 type JobsController struct {
 	App chainlink.Application
+
+	txQueues *jobTxQueueCache
+}
+
+// NewJobsController initializes a new JobsController
+func NewJobsController(app chainlink.Application) *JobsController {
+	return &JobsController{app, newJobTxQueueCache()}
 }
 
 // Index lists all jobs
@@ -49,6 +61,10 @@ func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 	for _, job := range jobs {
 		resources = append(resources, *presenters.NewJobResource(job))
 	}
+	if err = jc.txQueues.setTxQueues(jc.App.BPTXMORM(), jobs, resources, pg.WithParentCtx(c.Request.Context())); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
 
 	paginatedResponse(c, "jobs", size, page, resources, count, err)
 }
@@ -58,8 +74,340 @@ func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 // Example:
 // "GET <application>/jobs/:ID"
 func (jc *JobsController) Show(c *gin.Context) {
+	jobSpec, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+
+	resources := []presenters.JobResource{*presenters.NewJobResource(jobSpec)}
+	if err := jc.txQueues.setTxQueues(jc.App.BPTXMORM(), []job.Job{jobSpec}, resources, pg.WithParentCtx(c.Request.Context())); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, &resources[0], "jobs")
+}
+
+// TransactionCosts returns the total gas used and fees paid by the
+// transactions of a job on a chain. The optional from and to query params
+// (RFC3339) limit it to transactions created in [from, to), by default all
+// transactions up until now are included.
+// :ID could be both job ID and external job ID
+// Example:
+// "GET <application>/jobs/:ID/transaction_costs?evmChainID=1&from=2022-01-01T00:00:00Z"
+func (jc *JobsController) TransactionCosts(c *gin.Context) {
+	jobSpec, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+
+	chain, err := getChain(jc.App.GetChainSet(), c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	from, to := time.Unix(0, 0).UTC(), time.Now().UTC()
+	if s := c.Query("from"); s != "" {
+		if from, err = time.Parse(time.RFC3339, s); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid from"))
+			return
+		}
+	}
+	if s := c.Query("to"); s != "" {
+		if to, err = time.Parse(time.RFC3339, s); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid to"))
+			return
+		}
+	}
+	if !from.Before(to) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("from must be before to"))
+		return
+	}
+
+	costs, err := chain.TxManager().TransactionCosts(jobSpec.ExternalJobID, from, to)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobTransactionCostsResource(jobSpec, *utils.NewBig(chain.ID()), from, to, costs), "jobTransactionCosts")
+}
+
+// UpdateUpkeepRequest represents a request to update the settings of an
+// upkeep of a keeper job. Settings that are not given are left unchanged.
+type UpdateUpkeepRequest struct {
+	Paused *bool `json:"paused"`
+	// MaxPerformGasPrice is in wei, 0 removes the ceiling
+	MaxPerformGasPrice *utils.Big `json:"maxPerformGasPrice"`
+	// GracePeriodBlocks overrides KEEPER_MAXIMUM_GRACE_PERIOD for the upkeep,
+	// a negative value removes the override
+	GracePeriodBlocks *int64 `json:"gracePeriodBlocks"`
+}
+
+// UpdateUpkeep pauses or unpauses an upkeep of a keeper job, or sets the
+// highest gas price it is performed at or its grace period. A paused upkeep is not performed, but
+// stays in sync with the registry.
+// :ID could be both job ID and external job ID
+// Example:
+// "PATCH <application>/jobs/:ID/upkeeps/:upkeepID"
+func (jc *JobsController) UpdateUpkeep(c *gin.Context) {
+	jobSpec, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+	if jobSpec.Type != job.Keeper || jobSpec.KeeperSpec == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d is not a keeper job", jobSpec.ID))
+		return
+	}
+	upkeepID, err := strconv.ParseInt(c.Param("upkeepID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid upkeepID"))
+		return
+	}
+	var request UpdateUpkeepRequest
+	if err = c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Paused == nil && request.MaxPerformGasPrice == nil && request.GracePeriodBlocks == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("paused, maxPerformGasPrice or gracePeriodBlocks is required"))
+		return
+	}
+	if request.MaxPerformGasPrice != nil && request.MaxPerformGasPrice.ToInt().Sign() < 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("maxPerformGasPrice must not be negative"))
+		return
+	}
+
+	chain, err := getChain(jc.App.GetChainSet(), jobSpec.KeeperSpec.EVMChainID.String())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	orm := keeper.NewORM(jc.App.GetSqlxDB(), jc.App.GetLogger(), nil, chain.Config(), nil)
+	registry, err := orm.RegistryForJob(jobSpec.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("keeper registry not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	upkeep, err := orm.UpkeepForRegistry(registry.ID, upkeepID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("upkeep not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if request.Paused != nil {
+		if err = orm.SetUpkeepPaused(registry.ID, upkeepID, *request.Paused); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		upkeep.Paused = *request.Paused
+	}
+	if request.MaxPerformGasPrice != nil {
+		maxPerformGasPrice := request.MaxPerformGasPrice
+		if maxPerformGasPrice.ToInt().Sign() == 0 {
+			maxPerformGasPrice = nil
+		}
+		if err = orm.SetUpkeepMaxPerformGasPrice(registry.ID, upkeepID, maxPerformGasPrice); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		upkeep.MaxPerformGasPrice = maxPerformGasPrice
+	}
+	if request.GracePeriodBlocks != nil {
+		var gracePeriodBlocks null.Int
+		if *request.GracePeriodBlocks >= 0 {
+			gracePeriodBlocks = null.IntFrom(*request.GracePeriodBlocks)
+		}
+		if err = orm.SetUpkeepGracePeriod(registry.ID, upkeepID, gracePeriodBlocks); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		upkeep.GracePeriodBlocks = gracePeriodBlocks
+	}
+
+	jsonAPIResponse(c, presenters.NewUpkeepResource(jobSpec, upkeep), "upkeeps")
+}
+
+// defaultUpkeepRunsLimit is the number of runs returned by UpkeepRuns if no
+// limit is given
+const defaultUpkeepRunsLimit = 100
+
+// UpkeepRuns returns the most recent runs of an upkeep of a keeper job, newest
+// first. The number of runs returned is set by the limit query param.
+// :ID could be both job ID and external job ID
+// Example:
+// "GET <application>/jobs/:ID/upkeeps/:upkeepID/runs?limit=10"
+func (jc *JobsController) UpkeepRuns(c *gin.Context) {
+	limit := defaultUpkeepRunsLimit
+	if l := c.Query("limit"); l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid limit %q", l))
+			return
+		}
+	}
+	ku, ok := jc.findKeeperUpkeep(c)
+	if !ok {
+		return
+	}
+	runs, err := ku.orm.UpkeepRunsForUpkeep(ku.registry.ID, ku.upkeepID, limit)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewUpkeepRunResources(runs), "upkeepRuns")
+}
+
+// UpkeepStats returns the settings of an upkeep of a keeper job, along with
+// the number of its runs by status.
+// :ID could be both job ID and external job ID
+// Example:
+// "GET <application>/jobs/:ID/upkeeps/:upkeepID/stats"
+func (jc *JobsController) UpkeepStats(c *gin.Context) {
+	ku, ok := jc.findKeeperUpkeep(c)
+	if !ok {
+		return
+	}
+	upkeep, ok := ku.findUpkeep(c)
+	if !ok {
+		return
+	}
+	stats, err := ku.orm.UpkeepRunStats(ku.registry.ID, ku.upkeepID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewUpkeepStatsResource(ku.job, upkeep, stats), "upkeepStats")
+}
+
+// ExplainUpkeep explains whether an upkeep of a keeper job is eligible to be
+// performed by this node at the latest head, and if not, why. The balance of
+// the upkeep and pending perform transactions are not considered.
+// :ID could be both job ID and external job ID
+// Example:
+// "GET <application>/jobs/:ID/upkeeps/:upkeepID/explain"
+func (jc *JobsController) ExplainUpkeep(c *gin.Context) {
+	ku, ok := jc.findKeeperUpkeep(c)
+	if !ok {
+		return
+	}
+	upkeep, ok := ku.findUpkeep(c)
+	if !ok {
+		return
+	}
+	if ku.registry.BlockCountPerTurn <= 0 {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Errorf("invalid block count per turn %d", ku.registry.BlockCountPerTurn))
+		return
+	}
+
+	ctx := c.Request.Context()
+	head, err := ku.chain.Client().HeadByNumber(ctx, nil)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "failed to get the latest head"))
+		return
+	} else if head == nil {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("latest head not found"))
+		return
+	}
+	turnHead := head
+	if turnStart := head.Number - (head.Number % int64(ku.registry.BlockCountPerTurn)); turnStart != head.Number {
+		turnHead, err = ku.chain.Client().HeadByNumber(ctx, big.NewInt(turnStart))
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, errors.Wrapf(err, "failed to get head %d", turnStart))
+			return
+		} else if turnHead == nil {
+			jsonAPIError(c, http.StatusInternalServerError, errors.Errorf("head %d not found", turnStart))
+			return
+		}
+	}
+	eligibility, err := ku.orm.UpkeepEligibility(ku.registry, upkeep, head.Number, ku.chain.Config().KeeperMaximumGracePeriod(), turnHead.Hash)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewUpkeepEligibilityResource(upkeep.UpkeepID, eligibility), "upkeepEligibility")
+}
+
+// keeperUpkeep is an upkeep of a keeper job, see findKeeperUpkeep
+type keeperUpkeep struct {
+	job      job.Job
+	chain    evm.Chain
+	orm      keeper.ORM
+	registry keeper.Registry
+	upkeepID int64
+}
+
+// findKeeperUpkeep loads the keeper job identified by the :ID param, along
+// with its chain and registry, and parses the :upkeepID param. It writes the
+// error response and returns false if any of them can't be loaded.
+func (jc *JobsController) findKeeperUpkeep(c *gin.Context) (ku keeperUpkeep, ok bool) {
+	ku.job, ok = jc.findJob(c)
+	if !ok {
+		return ku, false
+	}
+	if ku.job.Type != job.Keeper || ku.job.KeeperSpec == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d is not a keeper job", ku.job.ID))
+		return ku, false
+	}
+	var err error
+	ku.upkeepID, err = strconv.ParseInt(c.Param("upkeepID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid upkeepID"))
+		return ku, false
+	}
+
+	ku.chain, err = getChain(jc.App.GetChainSet(), ku.job.KeeperSpec.EVMChainID.String())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return ku, false
+	}
+	ku.orm = keeper.NewORM(jc.App.GetSqlxDB(), jc.App.GetLogger(), nil, ku.chain.Config(), nil)
+	ku.registry, err = ku.orm.RegistryForJob(ku.job.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("keeper registry not found"))
+		return ku, false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return ku, false
+	}
+	return ku, true
+}
+
+// findUpkeep loads the upkeep from the registry. It writes the error response
+// and returns false if it can't be loaded.
+func (ku keeperUpkeep) findUpkeep(c *gin.Context) (upkeep keeper.UpkeepRegistration, ok bool) {
+	upkeep, err := ku.orm.UpkeepForRegistry(ku.registry.ID, ku.upkeepID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("upkeep not found"))
+		return upkeep, false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return upkeep, false
+	}
+	return upkeep, true
+}
+
+// findJob loads the job identified by the :ID param, which could be both job
+// ID and external job ID. It writes the error response and returns false if
+// the job can't be loaded.
+func (jc *JobsController) findJob(c *gin.Context) (jobSpec job.Job, ok bool) {
 	var err error
-	jobSpec := job.Job{}
 	if externalJobID, pErr := uuid.FromString(c.Param("ID")); pErr == nil {
 		// Find a job by external job ID
 		jobSpec, err = jc.App.JobORM().FindJobByExternalJobID(externalJobID, pg.WithParentCtx(c.Request.Context()))
@@ -68,7 +416,7 @@ func (jc *JobsController) Show(c *gin.Context) {
 		jobSpec, err = jc.App.JobORM().FindJobTx(jobSpec.ID)
 	} else {
 		jsonAPIError(c, http.StatusUnprocessableEntity, pErr)
-		return
+		return jobSpec, false
 	}
 	if err != nil {
 		if errors.Cause(err) == sql.ErrNoRows {
@@ -76,10 +424,9 @@ func (jc *JobsController) Show(c *gin.Context) {
 		} else {
 			jsonAPIError(c, http.StatusInternalServerError, err)
 		}
-		return
+		return jobSpec, false
 	}
-
-	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+	return jobSpec, true
 }
 
 // CreateJobRequest represents a request to create and start a job (V2).
@@ -17,11 +17,16 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
@@ -30,6 +35,7 @@ import (
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestJobsController_Create_ValidationFailure_OffchainReportingSpec(t *testing.T) {
@@ -445,6 +451,233 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_TransactionCosts(t *testing.T) {
+	app, client, ocrJobSpecFromFile, jobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	borm := app.BPTXMORM()
+	etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, app.Key.Address.Address())
+	pgtest.MustExec(t, app.GetSqlxDB(), `UPDATE eth_txes SET subject = $1 WHERE id = $2`, ocrJobSpecFromFile.ExternalJobID, etx.ID)
+	pgtest.MustExec(t, app.GetSqlxDB(), `UPDATE eth_tx_attempts SET gas_used = 21000, effective_gas_price = 20 WHERE eth_tx_id = $1`, etx.ID)
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/transaction_costs", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	costs := presenters.JobTransactionCostsResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &costs))
+	assert.Equal(t, ocrJobSpecFromFile.ExternalJobID, costs.Subject)
+	assert.Equal(t, int64(1), costs.TxCount)
+	assert.Equal(t, int64(21000), costs.TotalGasUsed)
+	assert.Equal(t, "420000", costs.TotalFeeWei.String())
+	assert.Equal(t, "20", costs.AverageGasPriceWei.String())
+
+	from := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%s/transaction_costs?from=%s&to=%s", ocrJobSpecFromFile.ExternalJobID, from, time.Now().Add(2*time.Hour).UTC().Format(time.RFC3339)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	costs = presenters.JobTransactionCostsResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &costs))
+	assert.Equal(t, int64(0), costs.TxCount)
+
+	response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%d/transaction_costs?from=yesterday", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
+
+func TestJobsController_UpdateUpkeep(t *testing.T) {
+	app, client := setupJobsControllerTests(t)
+
+	db := app.GetSqlxDB()
+	cfg := evmtest.NewChainScopedConfig(t, app.Config)
+	korm := keeper.NewORM(db, logger.TestLogger(t), nil, cfg, nil)
+	registry, keeperJob := cltest.MustInsertKeeperRegistry(t, db, korm, app.KeyStore.Eth())
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, cfg, registry)
+
+	body, err := json.Marshal(map[string]interface{}{"paused": true})
+	require.NoError(t, err)
+	response, cleanup := client.Patch(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d", keeperJob.ID, upkeep.UpkeepID), bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.UpkeepResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.Equal(t, strconv.FormatInt(upkeep.UpkeepID, 10), resource.ID)
+	assert.Equal(t, keeperJob.ID, resource.JobID)
+	assert.True(t, resource.Paused)
+
+	var paused bool
+	require.NoError(t, db.Get(&paused, `SELECT paused FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+	assert.True(t, paused)
+
+	t.Run("unknown upkeep", func(t *testing.T) {
+		response, cleanup := client.Patch(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d", keeperJob.ID, upkeep.UpkeepID+1), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+
+	t.Run("missing paused", func(t *testing.T) {
+		response, cleanup := client.Patch(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d", keeperJob.ID, upkeep.UpkeepID), bytes.NewReader([]byte(`{}`)))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("max perform gas price", func(t *testing.T) {
+		body := []byte(`{"maxPerformGasPrice": "50000000000"}`)
+		response, cleanup := client.Patch(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d", keeperJob.ID, upkeep.UpkeepID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		resource := presenters.UpkeepResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		require.NotNil(t, resource.MaxPerformGasPrice)
+		assert.Equal(t, "50000000000", resource.MaxPerformGasPrice.String())
+		// left unchanged
+		assert.True(t, resource.Paused)
+
+		// 0 removes the ceiling
+		response, cleanup = client.Patch(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d", keeperJob.ID, upkeep.UpkeepID), bytes.NewReader([]byte(`{"maxPerformGasPrice": "0"}`)))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var maxPerformGasPrice *utils.Big
+		require.NoError(t, db.Get(&maxPerformGasPrice, `SELECT max_perform_gas_price FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+		assert.Nil(t, maxPerformGasPrice)
+	})
+
+	t.Run("grace period", func(t *testing.T) {
+		response, cleanup := client.Patch(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d", keeperJob.ID, upkeep.UpkeepID), bytes.NewReader([]byte(`{"gracePeriodBlocks": 5}`)))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		resource := presenters.UpkeepResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.Equal(t, null.IntFrom(5), resource.GracePeriodBlocks)
+
+		// a negative value removes the override
+		response, cleanup = client.Patch(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d", keeperJob.ID, upkeep.UpkeepID), bytes.NewReader([]byte(`{"gracePeriodBlocks": -1}`)))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var gracePeriodBlocks null.Int
+		require.NoError(t, db.Get(&gracePeriodBlocks, `SELECT grace_period_blocks FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+		assert.False(t, gracePeriodBlocks.Valid)
+	})
+}
+
+func TestJobsController_UpkeepRuns(t *testing.T) {
+	app, client := setupJobsControllerTests(t)
+
+	db := app.GetSqlxDB()
+	cfg := evmtest.NewChainScopedConfig(t, app.Config)
+	korm := keeper.NewORM(db, logger.TestLogger(t), nil, cfg, nil)
+	registry, keeperJob := cltest.MustInsertKeeperRegistry(t, db, korm, app.KeyStore.Eth())
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, cfg, registry)
+
+	var runIDs []int64
+	for i := int64(0); i < 3; i++ {
+		run := keeper.UpkeepRun{RegistryID: registry.ID, UpkeepID: upkeep.UpkeepID, BlockHeight: 10 + i}
+		require.NoError(t, korm.InsertUpkeepRun(&run))
+		runIDs = append(runIDs, run.ID)
+	}
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d/runs?limit=2", keeperJob.ID, upkeep.UpkeepID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var resources []presenters.UpkeepRunResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources))
+	require.Len(t, resources, 2)
+	assert.Equal(t, strconv.FormatInt(runIDs[2], 10), resources[0].ID)
+	assert.Equal(t, int64(12), resources[0].BlockHeight)
+	assert.Equal(t, upkeep.UpkeepID, resources[0].UpkeepID)
+	assert.Equal(t, keeper.UpkeepRunStatusPending, resources[0].Status)
+	assert.Equal(t, strconv.FormatInt(runIDs[1], 10), resources[1].ID)
+
+	t.Run("unknown upkeep", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d/runs", keeperJob.ID, upkeep.UpkeepID+1))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resources []presenters.UpkeepRunResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources))
+		assert.Len(t, resources, 0)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d/runs?limit=-1", keeperJob.ID, upkeep.UpkeepID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+}
+
+func TestJobsController_UpkeepStats(t *testing.T) {
+	app, client := setupJobsControllerTests(t)
+
+	db := app.GetSqlxDB()
+	cfg := evmtest.NewChainScopedConfig(t, app.Config)
+	korm := keeper.NewORM(db, logger.TestLogger(t), nil, cfg, nil)
+	registry, keeperJob := cltest.MustInsertKeeperRegistry(t, db, korm, app.KeyStore.Eth())
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, cfg, registry)
+
+	for i := int64(0); i < 2; i++ {
+		run := keeper.UpkeepRun{RegistryID: registry.ID, UpkeepID: upkeep.UpkeepID, BlockHeight: 10 + i}
+		require.NoError(t, korm.InsertUpkeepRun(&run))
+	}
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d/stats", keeperJob.ID, upkeep.UpkeepID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var resource presenters.UpkeepStatsResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.Equal(t, strconv.FormatInt(upkeep.UpkeepID, 10), resource.ID)
+	assert.Equal(t, keeperJob.ID, resource.JobID)
+	assert.Equal(t, int64(2), resource.PendingRuns)
+	assert.Equal(t, int64(0), resource.SuccessfulRuns)
+	assert.NotNil(t, resource.LastRunAt)
+
+	t.Run("unknown upkeep", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d/stats", keeperJob.ID, upkeep.UpkeepID+1))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
+func TestJobsController_ExplainUpkeep(t *testing.T) {
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app := cltest.NewApplicationWithConfigAndKey(t, cltest.NewTestGeneralConfig(t), ethClient)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	db := app.GetSqlxDB()
+	cfg := evmtest.NewChainScopedConfig(t, app.Config)
+	korm := keeper.NewORM(db, logger.TestLogger(t), nil, cfg, nil)
+	registry, keeperJob := cltest.MustInsertKeeperRegistry(t, db, korm, app.KeyStore.Eth())
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, cfg, registry)
+
+	explain := func(t *testing.T) presenters.UpkeepEligibilityResource {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/upkeeps/%d/explain", keeperJob.ID, upkeep.UpkeepID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resource presenters.UpkeepEligibilityResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		return resource
+	}
+
+	resource := explain(t)
+	assert.Equal(t, strconv.FormatInt(upkeep.UpkeepID, 10), resource.ID)
+	assert.True(t, resource.Eligible)
+	assert.Empty(t, resource.Reason)
+
+	require.NoError(t, korm.SetUpkeepPaused(registry.ID, upkeep.UpkeepID, true))
+	resource = explain(t)
+	assert.False(t, resource.Eligible)
+	assert.Equal(t, "the upkeep is paused", resource.Reason)
+}
+
 func runOCRJobSpecAssertions(t *testing.T, ocrJobSpecFromFileDB job.Job, ocrJobSpecFromServer presenters.JobResource) {
 	ocrJobSpecFromFile := ocrJobSpecFromFileDB.OffchainreportingOracleSpec
 	assert.Equal(t, ocrJobSpecFromFile.ContractAddress, ocrJobSpecFromServer.OffChainReportingSpec.ContractAddress)
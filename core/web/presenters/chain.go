@@ -3,6 +3,7 @@ package presenters
 import (
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"gopkg.in/guregu/null.v4"
@@ -14,6 +15,9 @@ type ChainResource struct {
 	Config    types.ChainCfg `json:"config"`
 	CreatedAt time.Time      `json:"createdAt"`
 	UpdatedAt time.Time      `json:"updatedAt"`
+	// Status is whether the chain is starting, running or errored, it is only
+	// set for chains that are loaded, i.e. enabled
+	Status *evm.ChainStatus `json:"status,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -31,6 +35,101 @@ func NewChainResource(chain types.Chain) ChainResource {
 	}
 }
 
+// ChainConfigValidationResource is the result of checking a proposed chain
+// config against the live chain
+type ChainConfigValidationResource struct {
+	JAID
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ChainConfigValidationResource) GetName() string {
+	return "chainConfigValidation"
+}
+
+func NewChainConfigValidationResource(id utils.Big, report evm.ConfigValidationReport) ChainConfigValidationResource {
+	return ChainConfigValidationResource{
+		JAID:     NewJAIDInt64(id.ToInt().Int64()),
+		Errors:   report.Errors,
+		Warnings: report.Warnings,
+	}
+}
+
+// ChainConfigProfileResource is a named bundle of recommended chain config
+// overrides that can be applied to a chain
+type ChainConfigProfileResource struct {
+	JAID
+	Version     int32          `json:"version"`
+	Description string         `json:"description"`
+	Config      types.ChainCfg `json:"config"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ChainConfigProfileResource) GetName() string {
+	return "chainConfigProfile"
+}
+
+func NewChainConfigProfileResource(profile evm.ConfigProfile) ChainConfigProfileResource {
+	return ChainConfigProfileResource{
+		JAID:        NewJAID(profile.Name),
+		Version:     profile.Version,
+		Description: profile.Description,
+		Config:      profile.Config,
+	}
+}
+
+// ChainConfigProfileDriftResource lists the settings of a chain that were
+// changed away from the config profile last applied to it
+type ChainConfigProfileDriftResource struct {
+	JAID
+	Profile       string                   `json:"profile"`
+	Version       int32                    `json:"version"`
+	LatestVersion int32                    `json:"latestVersion"`
+	AppliedAt     time.Time                `json:"appliedAt"`
+	Drifted       []evm.ConfigSettingDrift `json:"drifted"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ChainConfigProfileDriftResource) GetName() string {
+	return "chainConfigProfileDrift"
+}
+
+func NewChainConfigProfileDriftResource(id utils.Big, drift evm.ConfigProfileDrift) ChainConfigProfileDriftResource {
+	drifted := drift.Drifted
+	if drifted == nil {
+		drifted = []evm.ConfigSettingDrift{}
+	}
+	return ChainConfigProfileDriftResource{
+		JAID:          NewJAIDInt64(id.ToInt().Int64()),
+		Profile:       drift.Profile,
+		Version:       drift.Version,
+		LatestVersion: drift.LatestVersion,
+		AppliedAt:     drift.AppliedAt,
+		Drifted:       drifted,
+	}
+}
+
+// ChainPendingWorkResource summarizes the outstanding work on a chain, and
+// whether it is safe to restart the node with respect to it
+type ChainPendingWorkResource struct {
+	JAID
+	EthTxesUnstarted              int64    `json:"ethTxesUnstarted"`
+	EthTxesInProgress             int64    `json:"ethTxesInProgress"`
+	EthTxesUnconfirmed            int64    `json:"ethTxesUnconfirmed"`
+	EthTxesStuckInProgress        int      `json:"ethTxesStuckInProgress"`
+	KeeperPerformsPending         int64    `json:"keeperPerformsPending"`
+	FluxMonitorSubmissionsPending int64    `json:"fluxMonitorSubmissionsPending"`
+	PipelineCallbacksPending      int64    `json:"pipelineCallbacksPending"`
+	SafeToRestart                 bool     `json:"safeToRestart"`
+	Reasons                       []string `json:"reasons"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ChainPendingWorkResource) GetName() string {
+	return "chainPendingWork"
+}
+
 type NodeResource struct {
 	JAID
 	Name       string      `json:"name"`
@@ -12,14 +12,28 @@ import (
 // representation of the address plus its ETH & LINK balances
 type ETHKeyResource struct {
 	JAID
-	EVMChainID     utils.Big    `json:"evmChainID"`
-	Address        string       `json:"address"`
-	EthBalance     *assets.Eth  `json:"ethBalance"`
-	LinkBalance    *assets.Link `json:"linkBalance"`
-	IsFunding      bool         `json:"isFunding"`
-	CreatedAt      time.Time    `json:"createdAt"`
-	UpdatedAt      time.Time    `json:"updatedAt"`
-	MaxGasPriceWei utils.Big    `json:"maxGasPriceWei"`
+	EVMChainID     utils.Big            `json:"evmChainID"`
+	Address        string               `json:"address"`
+	EthBalance     *assets.Eth          `json:"ethBalance"`
+	LinkBalance    *assets.Link         `json:"linkBalance"`
+	TokenBalances  []ETHKeyTokenBalance `json:"tokenBalances"`
+	IsFunding      bool                 `json:"isFunding"`
+	CreatedAt      time.Time            `json:"createdAt"`
+	UpdatedAt      time.Time            `json:"updatedAt"`
+	MaxGasPriceWei utils.Big            `json:"maxGasPriceWei"`
+	Parked         bool                 `json:"parked"`
+}
+
+// ETHKeyTokenBalance is the latest balance of an ERC-20 token monitored by
+// the BalanceMonitor (see BALANCE_MONITOR_TOKENS). Symbol and Decimals are
+// omitted if the token contract could not be introspected.
+type ETHKeyTokenBalance struct {
+	Address      string     `json:"address"`
+	Symbol       string     `json:"symbol,omitempty"`
+	Decimals     *uint8     `json:"decimals,omitempty"`
+	Balance      utils.Big  `json:"balance"`
+	MinBalance   *utils.Big `json:"minBalance,omitempty"`
+	BelowMinimum bool       `json:"belowMinimum"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -76,6 +90,14 @@ func SetETHKeyLinkBalance(linkBalance *assets.Link) NewETHKeyOption {
 	}
 }
 
+func SetETHKeyTokenBalances(tokenBalances []ETHKeyTokenBalance) NewETHKeyOption {
+	return func(r *ETHKeyResource) error {
+		r.TokenBalances = tokenBalances
+
+		return nil
+	}
+}
+
 func SetETHKeyMaxGasPriceWei(maxGasPriceWei utils.Big) NewETHKeyOption {
 	return func(r *ETHKeyResource) error {
 		r.MaxGasPriceWei = maxGasPriceWei
@@ -63,7 +63,9 @@ func TestETHKeyResource(t *testing.T) {
 			  "isFunding":true,
 			  "createdAt":"2000-01-01T00:00:00Z",
 			  "updatedAt":"2000-01-01T00:00:00Z",
-			  "maxGasPriceWei":"12345"
+			  "maxGasPriceWei":"12345",
+			  "tokenBalances":null,
+			  "parked":false
 		   }
 		}
 	 }
@@ -71,9 +73,14 @@ func TestETHKeyResource(t *testing.T) {
 
 	assert.JSONEq(t, expected, string(b))
 
+	decimals := uint8(18)
 	r, err = NewETHKeyResource(key, state,
 		SetETHKeyEthBalance(assets.NewEth(1)),
 		SetETHKeyLinkBalance(assets.NewLinkFromJuels(1)),
+		SetETHKeyTokenBalances([]ETHKeyTokenBalance{
+			{Address: "0x6B175474E89094C44Da98b954EedeAC495271d0F", Symbol: "DAI", Decimals: &decimals, Balance: *utils.NewBigI(5), MinBalance: utils.NewBigI(10), BelowMinimum: true},
+			{Address: "0x514910771AF9Ca656af840dff83E8264EcF986CA", Balance: *utils.NewBigI(7)},
+		}),
 		SetETHKeyMaxGasPriceWei(*utils.NewBigI(12345)),
 	)
 	require.NoError(t, err)
@@ -93,7 +100,12 @@ func TestETHKeyResource(t *testing.T) {
 				"isFunding":true,
 				"createdAt":"2000-01-01T00:00:00Z",
 				"updatedAt":"2000-01-01T00:00:00Z",
-				"maxGasPriceWei":"12345"
+				"maxGasPriceWei":"12345",
+				"tokenBalances":[
+					{"address":"0x6B175474E89094C44Da98b954EedeAC495271d0F","symbol":"DAI","decimals":18,"balance":"5","minBalance":"10","belowMinimum":true},
+					{"address":"0x514910771AF9Ca656af840dff83E8264EcF986CA","balance":"7","belowMinimum":false}
+				],
+				"parked":false
 			}
 		}
 	}`,
@@ -2,6 +2,7 @@ package presenters
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -24,6 +25,21 @@ type EthTxResource struct {
 	To         *common.Address `json:"to"`
 	Value      string          `json:"value"`
 	EVMChainID utils.Big       `json:"evmChainID"`
+	// EffectiveGasPrice and GasUsed are only set once the attempt is mined
+	EffectiveGasPrice string `json:"effectiveGasPrice,omitempty"`
+	GasUsed           string `json:"gasUsed,omitempty"`
+	// Deadline is only set on time critical transactions, and
+	// DeadlineBoostFactor on attempts whose gas bump was boosted by it
+	Deadline            *time.Time `json:"deadline,omitempty"`
+	DeadlineBoostFactor string     `json:"deadlineBoostFactor,omitempty"`
+	// SendLog records which eth nodes the attempt was sent to, and how each
+	// of them responded
+	SendLog bulletprooftxmanager.SendLog `json:"sendLog,omitempty"`
+	// Origin is what created the transaction, if known
+	Origin *bulletprooftxmanager.EthTxOrigin `json:"origin,omitempty"`
+	// EthTxID identifies the transaction across its attempts, e.g. to cancel
+	// it before it has been sent
+	EthTxID int64 `json:"ethTxID,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -45,6 +61,9 @@ func NewEthTxResource(tx bulletprooftxmanager.EthTx) EthTxResource {
 		To:         &tx.ToAddress,
 		Value:      tx.Value.String(),
 		EVMChainID: tx.EVMChainID,
+		Deadline:   tx.Deadline,
+		Origin:     tx.Origin().OrNil(),
+		EthTxID:    tx.ID,
 	}
 }
 
@@ -57,6 +76,7 @@ func NewEthTxResourceFromAttempt(txa bulletprooftxmanager.EthTxAttempt) EthTxRes
 	r.Hash = txa.Hash
 	r.Hex = hexutil.Encode(txa.SignedRawTx)
 	r.EVMChainID = txa.EthTx.EVMChainID
+	r.SendLog = txa.SendLog
 
 	if tx.Nonce != nil {
 		r.Nonce = strconv.FormatUint(uint64(*tx.Nonce), 10)
@@ -64,5 +84,61 @@ func NewEthTxResourceFromAttempt(txa bulletprooftxmanager.EthTxAttempt) EthTxRes
 	if txa.BroadcastBeforeBlockNum != nil {
 		r.SentAt = strconv.FormatUint(uint64(*txa.BroadcastBeforeBlockNum), 10)
 	}
+	if txa.EffectiveGasPrice != nil {
+		r.EffectiveGasPrice = txa.EffectiveGasPrice.String()
+	}
+	if txa.GasUsed != nil {
+		r.GasUsed = strconv.FormatInt(*txa.GasUsed, 10)
+	}
+	if txa.DeadlineBoostFactor != nil {
+		r.DeadlineBoostFactor = strconv.FormatUint(uint64(*txa.DeadlineBoostFactor), 10)
+	}
+	return r
+}
+
+// EthTxBumpResource represents what an administrative gas bump did, or would
+// do in a dry run, with a single unconfirmed transaction
+type EthTxBumpResource struct {
+	JAID
+	Nonce    int64  `json:"nonce"`
+	TxType   int    `json:"txType"`
+	GasLimit uint64 `json:"gasLimit"`
+	// PreviousPrice and NewPrice are the gas price of legacy transactions,
+	// or the fee cap of dynamic fee transactions
+	PreviousPrice     *utils.Big   `json:"previousPrice"`
+	PreviousTipCap    *utils.Big   `json:"previousTipCap,omitempty"`
+	NewPrice          *utils.Big   `json:"newPrice"`
+	NewTipCap         *utils.Big   `json:"newTipCap,omitempty"`
+	AdditionalCostWei *utils.Big   `json:"additionalCostWei"`
+	Hash              *common.Hash `json:"hash,omitempty"`
+	Status            string       `json:"status"`
+	Error             string       `json:"error,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EthTxBumpResource) GetName() string {
+	return "ethTxBumps"
+}
+
+// NewEthTxBumpResource generates an EthTxBumpResource from the result of
+// BumpAllUnconfirmed for a single transaction
+func NewEthTxBumpResource(result bulletprooftxmanager.BumpResult) EthTxBumpResource {
+	r := EthTxBumpResource{
+		JAID:              NewJAIDInt64(result.EthTxID),
+		Nonce:             result.Nonce,
+		TxType:            result.TxType,
+		GasLimit:          result.GasLimit,
+		PreviousPrice:     utils.NewBig(result.PreviousPrice),
+		PreviousTipCap:    utils.NewBig(result.PreviousTipCap),
+		NewPrice:          utils.NewBig(result.NewPrice),
+		NewTipCap:         utils.NewBig(result.NewTipCap),
+		AdditionalCostWei: utils.NewBig(result.AdditionalCostWei),
+		Status:            string(result.Status),
+		Error:             result.Error,
+	}
+	if result.Hash != (common.Hash{}) {
+		hash := result.Hash
+		r.Hash = &hash
+	}
 	return r
 }
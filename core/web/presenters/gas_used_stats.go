@@ -0,0 +1,57 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// GasUsedStatsResource represents the gas used statistics of a contract
+// method, see bulletprooftxmanager.GasUsedStats
+type GasUsedStatsResource struct {
+	JAID
+	EVMChainID utils.Big      `json:"evmChainID"`
+	ToAddress  common.Address `json:"toAddress"`
+	Selector   hexutil.Bytes  `json:"selector"`
+	Samples    int32          `json:"samples"`
+	P50GasUsed int64          `json:"p50GasUsed"`
+	P99GasUsed int64          `json:"p99GasUsed"`
+	MaxGasUsed int64          `json:"maxGasUsed"`
+	UpdatedAt  time.Time      `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r GasUsedStatsResource) GetName() string {
+	return "gasUsedStats"
+}
+
+// NewGasUsedStatsResource initializes a new JSONAPI gas used statistics
+// resource, identified by the contract address and selector
+func NewGasUsedStatsResource(s bulletprooftxmanager.GasUsedStats) *GasUsedStatsResource {
+	return &GasUsedStatsResource{
+		JAID:       NewJAID(fmt.Sprintf("%s-%s", s.ToAddress.Hex(), hexutil.Encode(s.Selector))),
+		EVMChainID: s.EVMChainID,
+		ToAddress:  s.ToAddress,
+		Selector:   s.Selector,
+		Samples:    s.Samples,
+		P50GasUsed: s.P50GasUsed,
+		P99GasUsed: s.P99GasUsed,
+		MaxGasUsed: s.MaxGasUsed,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+// NewGasUsedStatsResources initializes a slice of JSONAPI gas used
+// statistics resources
+func NewGasUsedStatsResources(stats []bulletprooftxmanager.GasUsedStats) []GasUsedStatsResource {
+	rs := []GasUsedStatsResource{}
+	for _, s := range stats {
+		rs = append(rs, *NewGasUsedStatsResource(s))
+	}
+	return rs
+}
@@ -10,9 +10,13 @@ import (
 	"github.com/lib/pq"
 	uuid "github.com/satori/go.uuid"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
@@ -81,6 +85,10 @@ type FluxMonitorSpec struct {
 	DrumbeatSchedule    *string             `json:"drumbeatSchedule"`
 	DrumbeatRandomDelay *string             `json:"drumbeatRandomDelay"`
 	MinPayment          *assets.Link        `json:"minPayment"`
+	GasLimit            *uint64             `json:"gasLimit"`
+	GasLimitEnv         bool                `json:"gasLimitEnv,omitempty"`
+	MaxGasPriceGWei     *float64            `json:"maxGasPriceGWei"`
+	MaxGasPriceGWeiEnv  bool                `json:"maxGasPriceGWeiEnv,omitempty"`
 	CreatedAt           time.Time           `json:"createdAt"`
 	UpdatedAt           time.Time           `json:"updatedAt"`
 	EVMChainID          *utils.Big          `json:"evmChainID"`
@@ -110,6 +118,10 @@ func NewFluxMonitorSpec(spec *job.FluxMonitorSpec) *FluxMonitorSpec {
 		DrumbeatSchedule:    drumbeatSchedulePtr,
 		DrumbeatRandomDelay: drumbeatRandomDelayPtr,
 		MinPayment:          spec.MinPayment,
+		GasLimit:            spec.GasLimit,
+		GasLimitEnv:         spec.GasLimitEnv,
+		MaxGasPriceGWei:     spec.MaxGasPriceGWei,
+		MaxGasPriceGWeiEnv:  spec.MaxGasPriceGWeiEnv,
 		CreatedAt:           spec.CreatedAt,
 		UpdatedAt:           spec.UpdatedAt,
 		EVMChainID:          spec.EVMChainID,
@@ -340,6 +352,226 @@ type JobResource struct {
 	WebhookSpec            *WebhookSpec            `json:"webhookSpec"`
 	PipelineSpec           PipelineSpec            `json:"pipelineSpec"`
 	Errors                 []JobError              `json:"errors"`
+	// TxQueue is only set for jobs that queue their transactions under a
+	// subject
+	TxQueue *JobTxQueue `json:"txQueue,omitempty"`
+}
+
+// JobTxQueue summarizes the transactions queued by a job
+type JobTxQueue struct {
+	Subject             uuid.UUID        `json:"subject"`
+	Unstarted           int64            `json:"unstarted"`
+	Unconfirmed         int64            `json:"unconfirmed"`
+	OldestPendingAt     *time.Time       `json:"oldestPendingAt"`
+	OldestPendingAge    *models.Interval `json:"oldestPendingAge"`
+	LastConfirmedTxHash *common.Hash     `json:"lastConfirmedTxHash"`
+	LastConfirmedAt     *time.Time       `json:"lastConfirmedAt"`
+}
+
+// NewJobTxQueue initializes a job's tx queue summary. The age of the oldest
+// pending transaction is calculated as of now.
+func NewJobTxQueue(s bulletprooftxmanager.TxQueueSummary, now time.Time) *JobTxQueue {
+	q := &JobTxQueue{
+		Subject:             s.Subject,
+		Unstarted:           s.Unstarted,
+		Unconfirmed:         s.Unconfirmed,
+		OldestPendingAt:     s.OldestPendingAt,
+		LastConfirmedTxHash: s.LastConfirmedTxHash,
+		LastConfirmedAt:     s.LastConfirmedAt,
+	}
+	if s.OldestPendingAt != nil {
+		q.OldestPendingAge = models.NewInterval(now.Sub(*s.OldestPendingAt).Round(time.Second))
+	}
+	return q
+}
+
+// JobTransactionCostsResource represents what a job's transactions created in
+// [from, to) cost on a single chain
+type JobTransactionCostsResource struct {
+	JAID
+	EVMChainID         utils.Big `json:"evmChainID"`
+	Subject            uuid.UUID `json:"subject"`
+	From               time.Time `json:"from"`
+	To                 time.Time `json:"to"`
+	TxCount            int64     `json:"txCount"`
+	TotalGasUsed       int64     `json:"totalGasUsed"`
+	TotalFeeWei        utils.Big `json:"totalFeeWei"`
+	AverageGasPriceWei utils.Big `json:"averageGasPriceWei"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobTransactionCostsResource) GetName() string {
+	return "jobTransactionCosts"
+}
+
+// NewJobTransactionCostsResource initializes a new JSONAPI job transaction
+// costs resource
+func NewJobTransactionCostsResource(j job.Job, chainID utils.Big, from, to time.Time, costs bulletprooftxmanager.TransactionCosts) *JobTransactionCostsResource {
+	return &JobTransactionCostsResource{
+		JAID:               NewJAIDInt32(j.ID),
+		EVMChainID:         chainID,
+		Subject:            costs.Subject,
+		From:               from,
+		To:                 to,
+		TxCount:            costs.TxCount,
+		TotalGasUsed:       costs.TotalGasUsed,
+		TotalFeeWei:        *utils.NewBig(costs.TotalFeeWei),
+		AverageGasPriceWei: *utils.NewBig(costs.AverageGasPriceWei),
+	}
+}
+
+// UpkeepResource represents an upkeep of a keeper job
+type UpkeepResource struct {
+	JAID
+	JobID              int32      `json:"jobID"`
+	Paused             bool       `json:"paused"`
+	MaxPerformGasPrice *utils.Big `json:"maxPerformGasPrice"`
+	GracePeriodBlocks  null.Int   `json:"gracePeriodBlocks"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepResource) GetName() string {
+	return "upkeeps"
+}
+
+// NewUpkeepResource initializes a new JSONAPI upkeep resource
+func NewUpkeepResource(j job.Job, upkeep keeper.UpkeepRegistration) *UpkeepResource {
+	return &UpkeepResource{
+		JAID:               NewJAIDInt64(upkeep.UpkeepID),
+		JobID:              j.ID,
+		Paused:             upkeep.Paused,
+		MaxPerformGasPrice: upkeep.MaxPerformGasPrice,
+		GracePeriodBlocks:  upkeep.GracePeriodBlocks,
+	}
+}
+
+// UpkeepRunResource represents a perform transaction created for an upkeep of
+// a keeper job
+type UpkeepRunResource struct {
+	JAID
+	UpkeepID    int64                  `json:"upkeepID"`
+	BlockHeight int64                  `json:"blockHeight"`
+	EthTxID     *int64                 `json:"ethTxID"`
+	Status      keeper.UpkeepRunStatus `json:"status"`
+	CreatedAt   time.Time              `json:"createdAt"`
+	UpdatedAt   time.Time              `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepRunResource) GetName() string {
+	return "upkeepRuns"
+}
+
+// NewUpkeepRunResource initializes a new JSONAPI upkeep run resource
+func NewUpkeepRunResource(run keeper.UpkeepRun) *UpkeepRunResource {
+	return &UpkeepRunResource{
+		JAID:        NewJAIDInt64(run.ID),
+		UpkeepID:    run.UpkeepID,
+		BlockHeight: run.BlockHeight,
+		EthTxID:     run.EthTxID,
+		Status:      run.Status,
+		CreatedAt:   run.CreatedAt,
+		UpdatedAt:   run.UpdatedAt,
+	}
+}
+
+// NewUpkeepRunResources initializes a slice of JSONAPI upkeep run resources
+func NewUpkeepRunResources(runs []keeper.UpkeepRun) []UpkeepRunResource {
+	rs := []UpkeepRunResource{}
+	for _, run := range runs {
+		rs = append(rs, *NewUpkeepRunResource(run))
+	}
+	return rs
+}
+
+// UpkeepStatsResource represents the settings of an upkeep of a keeper job,
+// along with the number of its runs by status
+type UpkeepStatsResource struct {
+	UpkeepResource
+	LastRunBlockHeight int64      `json:"lastRunBlockHeight"`
+	PendingRuns        int64      `json:"pendingRuns"`
+	SuccessfulRuns     int64      `json:"successfulRuns"`
+	RevertedRuns       int64      `json:"revertedRuns"`
+	FailedRuns         int64      `json:"failedRuns"`
+	LastRunAt          *time.Time `json:"lastRunAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepStatsResource) GetName() string {
+	return "upkeepStats"
+}
+
+// NewUpkeepStatsResource initializes a new JSONAPI upkeep stats resource
+func NewUpkeepStatsResource(j job.Job, upkeep keeper.UpkeepRegistration, stats keeper.UpkeepRunStats) *UpkeepStatsResource {
+	return &UpkeepStatsResource{
+		UpkeepResource:     *NewUpkeepResource(j, upkeep),
+		LastRunBlockHeight: upkeep.LastRunBlockHeight,
+		PendingRuns:        stats.Pending,
+		SuccessfulRuns:     stats.Success,
+		RevertedRuns:       stats.Reverted,
+		FailedRuns:         stats.Failed,
+		LastRunAt:          stats.LastRunAt,
+	}
+}
+
+// UpkeepEligibilityResource explains whether an upkeep of a keeper job is
+// eligible to be performed by this node at a block
+type UpkeepEligibilityResource struct {
+	JAID
+	BlockNumber       int64       `json:"blockNumber"`
+	TurnBlockHash     common.Hash `json:"turnBlockHash"`
+	Paused            bool        `json:"paused"`
+	GracePeriodBlocks int64       `json:"gracePeriodBlocks"`
+	PerformedRecently bool        `json:"performedRecently"`
+	Position          int64       `json:"position"`
+	TurnKeeperIndex   int64       `json:"turnKeeperIndex"`
+	KeeperIndex       int32       `json:"keeperIndex"`
+	Eligible          bool        `json:"eligible"`
+	Reason            string      `json:"reason,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepEligibilityResource) GetName() string {
+	return "upkeepEligibilities"
+}
+
+// NewUpkeepEligibilityResource initializes a new JSONAPI upkeep eligibility
+// resource
+func NewUpkeepEligibilityResource(upkeepID int64, e keeper.UpkeepEligibility) *UpkeepEligibilityResource {
+	return &UpkeepEligibilityResource{
+		JAID:              NewJAIDInt64(upkeepID),
+		BlockNumber:       e.BlockNumber,
+		TurnBlockHash:     e.TurnBlockHash,
+		Paused:            e.Paused,
+		GracePeriodBlocks: e.GracePeriodBlocks,
+		PerformedRecently: e.PerformedRecently,
+		Position:          e.Position,
+		TurnKeeperIndex:   e.TurnKeeperIndex,
+		KeeperIndex:       e.KeeperIndex,
+		Eligible:          e.Eligible,
+		Reason:            e.Reason,
+	}
+}
+
+// TxSubjectResource summarizes the transactions queued under a subject (see
+// TxStrategy#Subject)
+type TxSubjectResource struct {
+	JAID
+	JobTxQueue
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r TxSubjectResource) GetName() string {
+	return "txSubjects"
+}
+
+// NewTxSubjectResource initializes a new JSONAPI tx subject resource. The age
+// of the oldest pending transaction is calculated as of now.
+func NewTxSubjectResource(s bulletprooftxmanager.TxQueueSummary, now time.Time) *TxSubjectResource {
+	return &TxSubjectResource{
+		JAID:       NewJAID(s.Subject.String()),
+		JobTxQueue: *NewJobTxQueue(s, now),
+	}
 }
 
 // NewJobResource initializes a new JSONAPI job resource
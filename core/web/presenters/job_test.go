@@ -1,10 +1,12 @@
 package presenters_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/lib/pq"
@@ -14,6 +16,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -30,6 +33,10 @@ func TestJob(t *testing.T) {
 	cronSchedule := "0 0 0 1 1 *"
 	evmChainID := utils.NewBigI(42)
 
+	// Used in flux monitor test
+	gasLimit := uint64(500000)
+	maxGasPriceGWei := 0.5
+
 	// Used in OCR tests
 	var ocrKeyBundleID = "f5bf259689b26f1374efb3c9a9868796953a0f814bb2d39b968d0e61b58620a5"
 	ocrKeyID := models.MustSha256HashFromHex(ocrKeyBundleID)
@@ -115,6 +122,9 @@ func TestJob(t *testing.T) {
 					PollTimerPeriod:   1 * time.Second,
 					PollTimerDisabled: false,
 					MinPayment:        assets.NewLinkFromJuels(1),
+					GasLimit:          &gasLimit,
+					GasLimitEnv:       true,
+					MaxGasPriceGWei:   &maxGasPriceGWei,
 					CreatedAt:         timestamp,
 					UpdatedAt:         timestamp,
 					EVMChainID:        evmChainID,
@@ -157,6 +167,9 @@ func TestJob(t *testing.T) {
               				"drumbeatRandomDelay": null,
               				"drumbeatSchedule": null,
 							"minPayment": "1",
+							"gasLimit": 500000,
+							"gasLimitEnv": true,
+							"maxGasPriceGWei": 0.5,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
 							"evmChainID": "42"
@@ -497,3 +510,105 @@ func TestJob(t *testing.T) {
 		})
 	}
 }
+
+func TestJob_TxQueue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2000, 1, 1, 1, 0, 0, 0, time.UTC)
+	oldestPendingAt := time.Date(2000, 1, 1, 0, 59, 30, 0, time.UTC)
+	lastConfirmedAt := time.Date(2000, 1, 1, 0, 30, 0, 0, time.UTC)
+	lastConfirmedTxHash := common.HexToHash("0x4a4f1b0bc1c8c6c3d1f7a1d8c7a5a5d3c1e0e2f0a9b8c7d6e5f4a3b2c1d0e9f8")
+	subject := uuid.FromStringOrNil("0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46")
+
+	testCases := []struct {
+		name    string
+		summary bulletprooftxmanager.TxQueueSummary
+		want    string
+	}{
+		{
+			name: "with queued transactions",
+			summary: bulletprooftxmanager.TxQueueSummary{
+				Subject:         subject,
+				Unstarted:       2,
+				Unconfirmed:     1,
+				OldestPendingAt: &oldestPendingAt,
+			},
+			want: `{
+				"subject": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+				"unstarted": 2,
+				"unconfirmed": 1,
+				"oldestPendingAt": "2000-01-01T00:59:30Z",
+				"oldestPendingAge": "30s",
+				"lastConfirmedTxHash": null,
+				"lastConfirmedAt": null
+			}`,
+		},
+		{
+			name: "with confirmed transactions",
+			summary: bulletprooftxmanager.TxQueueSummary{
+				Subject:             subject,
+				LastConfirmedTxHash: &lastConfirmedTxHash,
+				LastConfirmedAt:     &lastConfirmedAt,
+			},
+			want: `{
+				"subject": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+				"unstarted": 0,
+				"unconfirmed": 0,
+				"oldestPendingAt": null,
+				"oldestPendingAge": null,
+				"lastConfirmedTxHash": "0x4a4f1b0bc1c8c6c3d1f7a1d8c7a5a5d3c1e0e2f0a9b8c7d6e5f4a3b2c1d0e9f8",
+				"lastConfirmedAt": "2000-01-01T00:30:00Z"
+			}`,
+		},
+		{
+			name:    "with no transactions",
+			summary: bulletprooftxmanager.TxQueueSummary{Subject: subject},
+			want: `{
+				"subject": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+				"unstarted": 0,
+				"unconfirmed": 0,
+				"oldestPendingAt": null,
+				"oldestPendingAge": null,
+				"lastConfirmedTxHash": null,
+				"lastConfirmedAt": null
+			}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := presenters.NewJobResource(job.Job{
+				ID:            1,
+				Type:          job.Keeper,
+				KeeperSpec:    &job.KeeperSpec{},
+				ExternalJobID: subject,
+				PipelineSpec:  &pipeline.Spec{},
+			})
+			r.TxQueue = presenters.NewJobTxQueue(tc.summary, now)
+			b, err := jsonapi.Marshal(r)
+			require.NoError(t, err)
+
+			var doc struct {
+				Data struct {
+					Attributes struct {
+						TxQueue json.RawMessage `json:"txQueue"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(b, &doc))
+			assert.JSONEq(t, tc.want, string(doc.Data.Attributes.TxQueue))
+		})
+	}
+
+	t.Run("is omitted if not set", func(t *testing.T) {
+		t.Parallel()
+
+		r := presenters.NewJobResource(job.Job{ID: 1, Type: job.Cron, CronSpec: &job.CronSpec{}, PipelineSpec: &pipeline.Spec{}})
+		b, err := jsonapi.Marshal(r)
+		require.NoError(t, err)
+		assert.NotContains(t, string(b), "txQueue")
+	})
+}
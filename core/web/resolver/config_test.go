@@ -239,6 +239,10 @@ func TestResolver_Config(t *testing.T) {
         "key":"KEEPER_MAXIMUM_GRACE_PERIOD",
         "value":"0"
       },
+      {
+        "key":"KEEPER_MINIMUM_BALANCE_BUFFER_PERCENT",
+        "value":"0"
+      },
       {
         "key":"KEEPER_REGISTRY_CHECK_GAS_OVERHEAD",
         "value":"0"
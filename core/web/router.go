@@ -266,7 +266,13 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		txs := TransactionsController{app}
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
+		authv2.GET("/transactions/bulk_status", txs.BulkStatus)
 		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.POST("/eth_txes/:ID/cancel", txs.Cancel)
+		authv2.GET("/tx_subjects", txs.Subjects)
+
+		gusc := GasUsedStatsController{app}
+		authv2.GET("/gas_used_stats", gusc.Index)
 
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", rc.ReplayFromBlock)
@@ -282,6 +288,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/eth", ekc.Create)
 		authv2.PUT("/keys/eth/:keyID", ekc.Update)
 		authv2.DELETE("/keys/eth/:keyID", ekc.Delete)
+		authv2.POST("/keys/eth/:keyID/bump_all", ekc.BumpAll)
 		authv2.POST("/keys/eth/import", ekc.Import)
 		authv2.POST("/keys/eth/export/:address", ekc.Export)
 
@@ -327,11 +334,16 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/vrf/import", vrfkc.Import)
 		authv2.POST("/keys/vrf/export/:keyID", vrfkc.Export)
 
-		jc := JobsController{app}
+		jc := NewJobsController(app)
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", jc.Create)
 		authv2.DELETE("/jobs/:ID", jc.Delete)
+		authv2.GET("/jobs/:ID/transaction_costs", jc.TransactionCosts)
+		authv2.PATCH("/jobs/:ID/upkeeps/:upkeepID", jc.UpdateUpkeep)
+		authv2.GET("/jobs/:ID/upkeeps/:upkeepID/runs", jc.UpkeepRuns)
+		authv2.GET("/jobs/:ID/upkeeps/:upkeepID/stats", jc.UpkeepStats)
+		authv2.GET("/jobs/:ID/upkeeps/:upkeepID/explain", jc.ExplainUpkeep)
 
 		jpc := JobProposalsController{app}
 		authv2.GET("/job_proposals", jpc.Index)
@@ -362,6 +374,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/chains/evm", chc.Create)
 		authv2.GET("/chains/evm/:ID", chc.Show)
 		authv2.PATCH("/chains/evm/:ID", chc.Update)
+		authv2.POST("/chains/evm/:ID/config/validate", chc.ValidateConfig)
+		authv2.POST("/chains/evm/:ID/profile", chc.ApplyProfile)
+		authv2.GET("/chains/evm/:ID/profile/drift", chc.ProfileDrift)
+		authv2.GET("/chain_profiles/evm", chc.Profiles)
+		authv2.GET("/chains/evm/:ID/pending", chc.Pending)
 		authv2.DELETE("/chains/evm/:ID", chc.Delete)
 
 		nc := NodesController{app}
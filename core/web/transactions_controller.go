@@ -3,13 +3,18 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 )
 
 // TransactionsController displays Ethereum transactions requests.
@@ -46,3 +51,99 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// maxBulkStatusHashes is the most transaction hashes BulkStatus accepts
+const maxBulkStatusHashes = 100
+
+// BulkStatus returns the transactions with an attempt with any of the hashes
+// given by the hash query param, up to maxBulkStatusHashes of them. Hashes
+// that are not found are left out.
+// Example:
+//  "<application>/transactions/bulk_status?hash=0x...&hash=0x..."
+func (tc *TransactionsController) BulkStatus(c *gin.Context) {
+	params := c.QueryArray("hash")
+	if len(params) == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("at least one hash is required"))
+		return
+	} else if len(params) > maxBulkStatusHashes {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("at most %d hashes are allowed", maxBulkStatusHashes))
+		return
+	}
+	hashes := make([]common.Hash, len(params))
+	for i, p := range params {
+		hashes[i] = common.HexToHash(p)
+	}
+
+	attempts, err := tc.App.BPTXMORM().FindEthTxAttemptsByHashes(hashes)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	ptxs := make([]presenters.EthTxResource, len(attempts))
+	for i, attempt := range attempts {
+		ptxs[i] = presenters.NewEthTxResourceFromAttempt(attempt)
+	}
+	jsonAPIResponse(c, ptxs, "transactions")
+}
+
+// Cancel errors an unstarted transaction so that it is never sent. A
+// transaction that has been picked up for broadcast can no longer be
+// cancelled.
+// Example:
+//  "<application>/eth_txes/:ID/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid ID"))
+		return
+	}
+
+	q := pg.NewQ(tc.App.GetSqlxDB(), tc.App.GetLogger(), tc.App.GetConfig(), pg.WithParentCtx(c.Request.Context()))
+	etx, err := bulletprooftxmanager.CancelEthTx(q, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	} else if errors.Is(err, bulletprooftxmanager.ErrEthTxNotCancellable) {
+		jsonAPIError(c, http.StatusConflict, bulletprooftxmanager.ErrEthTxNotCancellable)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEthTxResource(etx), "transaction")
+}
+
+// Subjects returns a summary of the transactions queued under each of the
+// subjects given by the subject query param (see TxStrategy#Subject), in the
+// order they were given.
+// Example:
+//  "<application>/tx_subjects?subject=<uuid>&subject=<uuid>"
+func (tc *TransactionsController) Subjects(c *gin.Context) {
+	params := c.QueryArray("subject")
+	if len(params) == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("at least one subject is required"))
+		return
+	}
+	subjects := make([]uuid.UUID, len(params))
+	for i, p := range params {
+		subject, err := uuid.FromString(p)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrapf(err, "invalid subject %q", p))
+			return
+		}
+		subjects[i] = subject
+	}
+
+	summaries, err := tc.App.BPTXMORM().TxQueueSummaries(subjects, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	now := time.Now()
+	resources := make([]presenters.TxSubjectResource, len(subjects))
+	for i, subject := range subjects {
+		resources[i] = *presenters.NewTxSubjectResource(summaries[subject], now)
+	}
+	jsonAPIResponse(c, resources, "txSubjects")
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/manyminds/api2go/jsonapi"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -124,3 +125,96 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_BulkStatus(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+
+	borm := app.BPTXMORM()
+	client := app.NewHTTPClient()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	tx1 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, from)
+	tx2 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 2, from)
+
+	resp, cleanup := client.Get(fmt.Sprintf("/v2/transactions/bulk_status?hash=%s&hash=%s&hash=%s",
+		tx1.EthTxAttempts[0].Hash.Hex(), utils.NewHash().Hex(), tx2.EthTxAttempts[0].Hash.Hex()))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var ptxs []presenters.EthTxResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptxs))
+	require.Len(t, ptxs, 2)
+	assert.Equal(t, tx1.EthTxAttempts[0].Hash, ptxs[0].Hash)
+	assert.Equal(t, tx1.ID, ptxs[0].EthTxID)
+	assert.Equal(t, tx2.EthTxAttempts[0].Hash, ptxs[1].Hash)
+
+	t.Run("without hashes", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/transactions/bulk_status")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
+
+func TestTransactionsController_Cancel(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+
+	borm := app.BPTXMORM()
+	client := app.NewHTTPClient()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	unstarted := cltest.MustInsertUnstartedEthTx(t, borm, from)
+	unconfirmed := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, from)
+
+	resp, cleanup := client.Post(fmt.Sprintf("/v2/eth_txes/%d/cancel", unstarted.ID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var ptx presenters.EthTxResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+	assert.Equal(t, string(bulletprooftxmanager.EthTxFatalError), ptx.State)
+
+	resp, cleanup = client.Post(fmt.Sprintf("/v2/eth_txes/%d/cancel", unconfirmed.ID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	resp, cleanup = client.Post("/v2/eth_txes/0/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestTransactionsController_Subjects(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+
+	borm := app.BPTXMORM()
+	client := app.NewHTTPClient()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	subject := uuid.NewV4()
+	empty := uuid.NewV4()
+	cltest.MustInsertUnstartedEthTx(t, borm, from, subject)
+
+	resp, cleanup := client.Get(fmt.Sprintf("/v2/tx_subjects?subject=%s&subject=%s", subject, empty))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resources []presenters.TxSubjectResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resources))
+	require.Len(t, resources, 2)
+	assert.Equal(t, subject.String(), resources[0].ID)
+	assert.Equal(t, int64(1), resources[0].Unstarted)
+	assert.NotNil(t, resources[0].OldestPendingAt)
+	assert.Equal(t, empty.String(), resources[1].ID)
+	assert.Equal(t, int64(0), resources[1].Unstarted)
+
+	t.Run("invalid subject", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/tx_subjects?subject=foo")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
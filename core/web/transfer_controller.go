@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web/auth"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/gin-gonic/gin"
@@ -42,7 +43,7 @@ func (tc *TransfersController) Create(c *gin.Context) {
 
 	db := tc.App.GetSqlxDB()
 	q := pg.NewQ(db, tc.App.GetLogger(), tc.App.GetConfig())
-	etx, err := bulletprooftxmanager.SendEther(q, chain.ID(), tr.FromAddress, tr.DestinationAddress, tr.Amount, chain.Config().EvmGasLimitTransfer())
+	etx, err := bulletprooftxmanager.SendEther(q, chain.ID(), tr.FromAddress, tr.DestinationAddress, tr.Amount, chain.Config().EvmGasLimitTransfer(), transferOrigin(c))
 	if err != nil {
 		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("transaction failed: %v", err))
 		return
@@ -50,3 +51,18 @@ func (tc *TransfersController) Create(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResource(etx), "eth_tx")
 }
+
+// transferOrigin returns the user who requested a transfer, and how they
+// authenticated, to be recorded as the origin of the transaction
+func transferOrigin(c *gin.Context) (origin bulletprooftxmanager.EthTxOrigin) {
+	user, ok := auth.GetAuthenticatedUser(c)
+	if !ok {
+		return origin
+	}
+	origin.User = user.Email
+	origin.Credential = bulletprooftxmanager.OriginCredentialSession
+	if auth.IsAuthenticatedByToken(c) {
+		origin.Credential = bulletprooftxmanager.OriginCredentialAPIToken
+	}
+	return origin
+}
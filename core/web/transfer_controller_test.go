@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 
@@ -41,6 +42,14 @@ func TestTransfersController_CreateSuccess_From(t *testing.T) {
 	assert.Len(t, errors.Errors, 0)
 
 	cltest.AssertCount(t, app.GetSqlxDB(), "eth_txes", 1)
+
+	var origin struct {
+		User       string `db:"origin_user"`
+		Credential string `db:"origin_credential"`
+	}
+	require.NoError(t, app.GetSqlxDB().Get(&origin, `SELECT origin_user, origin_credential FROM eth_txes`))
+	assert.Equal(t, cltest.APIEmail, origin.User)
+	assert.Equal(t, bulletprooftxmanager.OriginCredentialSession, origin.Credential)
 }
 
 func TestTransfersController_TransferError(t *testing.T) {